@@ -21,6 +21,7 @@ type Config struct {
 	Security SecurityConfig
 	CORS     CORSConfig
 	Social   SocialConfig
+	OIDC     OIDCConfig
 }
 
 // ServerConfig holds server configuration
@@ -49,7 +50,7 @@ type JWTConfig struct {
 
 // EmailConfig holds email configuration
 type EmailConfig struct {
-	Provider    string // "sendgrid", "smtp", "mock"
+	Provider    string // "sendgrid", "smtp", "catcher" ("mock")
 	APIKey      string // For SendGrid
 	FromAddress string
 	FromName    string
@@ -65,6 +66,21 @@ type EmailConfig struct {
 type SecurityConfig struct {
 	EncryptionKey string
 	BcryptCost    int
+	TOTPIssuer    string // Account label shown in authenticator apps
+
+	// WebAuthn relying party: the site's domain, display name, and the
+	// origins the frontend is served from
+	WebAuthnRPID    string
+	WebAuthnRPName  string
+	WebAuthnOrigins []string
+
+	// SAMLLoginRedirectURL is the frontend page the SAML assertion
+	// consumer service redirects to after sign-in
+	SAMLLoginRedirectURL string
+
+	// WebhookAllowPrivate lets team webhooks reach loopback and private
+	// network addresses. Only for local development.
+	WebhookAllowPrivate bool
 }
 
 // CORSConfig holds CORS configuration
@@ -81,6 +97,16 @@ type SocialConfig struct {
 	LinkedIn PlatformConfig
 }
 
+// OIDCConfig holds sign-in provider configuration. A provider is enabled
+// when its client ID is set; callback URLs point at the frontend, which
+// posts the code back to the API.
+type OIDCConfig struct {
+	Google          PlatformConfig
+	GitHub          PlatformConfig
+	Microsoft       PlatformConfig
+	MicrosoftTenant string
+}
+
 // PlatformConfig holds individual platform configuration
 type PlatformConfig struct {
 	ClientID     string
@@ -129,6 +155,15 @@ func LoadConfig() *Config {
 		Security: SecurityConfig{
 			EncryptionKey: getEnv("ENCRYPTION_KEY", ""),
 			BcryptCost:    getEnvAsInt("BCRYPT_COST", 10),
+			TOTPIssuer:    getEnv("TOTP_ISSUER", "SocialQueue"),
+
+			WebAuthnRPID:    getEnv("WEBAUTHN_RP_ID", "localhost"),
+			WebAuthnRPName:  getEnv("WEBAUTHN_RP_NAME", "SocialQueue"),
+			WebAuthnOrigins: strings.Split(getEnv("WEBAUTHN_ORIGINS", "http://localhost:3000"), ","),
+
+			SAMLLoginRedirectURL: getEnv("SAML_LOGIN_REDIRECT_URL", "http://localhost:3000/auth/sso/complete"),
+
+			WebhookAllowPrivate: getEnvAsBool("WEBHOOK_ALLOW_PRIVATE_TARGETS", false),
 		},
 
 		CORS: CORSConfig{
@@ -154,6 +189,25 @@ func LoadConfig() *Config {
 				CallbackURL:  getEnv("LINKEDIN_CALLBACK_URL", "http://localhost:8000/api/social/auth/linkedin/callback"),
 			},
 		},
+
+		OIDC: OIDCConfig{
+			Google: PlatformConfig{
+				ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
+				ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
+				CallbackURL:  getEnv("GOOGLE_CALLBACK_URL", "http://localhost:3000/auth/oidc/google/callback"),
+			},
+			GitHub: PlatformConfig{
+				ClientID:     getEnv("GITHUB_CLIENT_ID", ""),
+				ClientSecret: getEnv("GITHUB_CLIENT_SECRET", ""),
+				CallbackURL:  getEnv("GITHUB_CALLBACK_URL", "http://localhost:3000/auth/oidc/github/callback"),
+			},
+			Microsoft: PlatformConfig{
+				ClientID:     getEnv("MICROSOFT_CLIENT_ID", ""),
+				ClientSecret: getEnv("MICROSOFT_CLIENT_SECRET", ""),
+				CallbackURL:  getEnv("MICROSOFT_CALLBACK_URL", "http://localhost:3000/auth/oidc/microsoft/callback"),
+			},
+			MicrosoftTenant: getEnv("MICROSOFT_TENANT", "common"),
+		},
 	}
 }
 
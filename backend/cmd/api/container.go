@@ -5,24 +5,32 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 
 	"github.com/redis/go-redis/v9"
-
 	socialAdapter "github.com/techappsUT/social-queue/internal/adapters/social"
 	"github.com/techappsUT/social-queue/internal/adapters/social/facebook"
 	"github.com/techappsUT/social-queue/internal/adapters/social/linkedin"
 	"github.com/techappsUT/social-queue/internal/adapters/social/twitter"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/auth"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	inboxUC "github.com/techappsUT/social-queue/internal/application/inbox"
+	notificationUC "github.com/techappsUT/social-queue/internal/application/notification"
 	postUC "github.com/techappsUT/social-queue/internal/application/post"
+	"github.com/techappsUT/social-queue/internal/application/realtime"
 	socialUC "github.com/techappsUT/social-queue/internal/application/social"
 	teamUC "github.com/techappsUT/social-queue/internal/application/team"
 	userUC "github.com/techappsUT/social-queue/internal/application/user"
 	"github.com/techappsUT/social-queue/internal/db"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	inboxDomain "github.com/techappsUT/social-queue/internal/domain/inbox"
+	notificationDomain "github.com/techappsUT/social-queue/internal/domain/notification"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
 	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
@@ -45,29 +53,67 @@ type Container struct {
 
 	// Infrastructure Services
 	TokenService      common.TokenService
+	WebAuthn          common.WebAuthnVerifier
+	IdentityProviders auth.IdentityProviders
+	SAMLProvider      common.SAMLServiceProvider
 	EmailService      common.EmailService
 	CacheService      common.CacheService
+	LoginThrottle     common.LoginThrottle
+	Transactor        common.Transactor
+	EventBus          *services.EventBus
 	Logger            common.Logger
 	WorkerQueue       *services.WorkerQueueService
+	SchedulerControl  *services.SchedulerControl
 	EncryptionService *services.EncryptionService
+	PlatformLimiter   common.PlatformRateLimiter
+	CircuitBreaker    common.CircuitBreaker
+	RealtimeFeed      common.RealtimeFeed
 	Queries           *db.Queries // ← ADD THIS LINE
 
 	// Repositories
-	UserRepo   userDomain.Repository
-	TeamRepo   teamDomain.Repository
-	MemberRepo teamDomain.MemberRepository
-	PostRepo   postDomain.Repository
-	SocialRepo socialDomain.AccountRepository
+	UserRepo      userDomain.Repository
+	SessionRepo   userDomain.SessionRepository
+	TwoFactorRepo userDomain.TwoFactorRepository
+	PasskeyRepo   userDomain.PasskeyRepository
+	IdentityRepo  userDomain.IdentityRepository
+	TeamRepo      teamDomain.Repository
+	SSORepo       teamDomain.SSORepository
+	SCIMRepo      teamDomain.SCIMRepository
+	MemberRepo    teamDomain.MemberRepository
+	RoleRepo      teamDomain.RoleRepository
+	APIKeyRepo    teamDomain.APIKeyRepository
+	WebhookRepo   teamDomain.WebhookRepository
+	DeliveryRepo  teamDomain.WebhookDeliveryRepository
+	OAuthRepo     teamDomain.OAuthRepository
+	AuditRepo     audit.Repository
+	AccessRepo    socialDomain.AccessRepository
+	PostRepo      postDomain.Repository
+	SocialRepo    socialDomain.AccountRepository
+	InboundRepo   socialDomain.WebhookRepository
+	InboxRepo     *persistence.InboxRepository
+	InboxRuleRepo inboxDomain.RuleRepository
+
+	NotificationRepo     notificationDomain.Repository
+	NotificationPrefRepo notificationDomain.PreferenceRepository
 
 	// Domain Services
 	UserService *userDomain.Service
 	TeamService *teamDomain.Service
 
+	// Refresh token sessions
+	SessionManager *auth.SessionManager
+
+	// Audit log
+	AuditRecorder *auditlog.Recorder
+
 	// Social Platform Adapters
 	SocialAdapters map[socialDomain.Platform]socialAdapter.Adapter
+	WebhookParsers socialDomain.WebhookParsers
 
 	// Use Cases - Auth (ALL auth use cases)
 	LoginUC              *auth.LoginUseCase
+	GetAccountLockoutUC  *auth.GetAccountLockoutUseCase
+	UnlockAccountUC      *auth.UnlockAccountUseCase
 	RefreshTokenUC       *auth.RefreshTokenUseCase
 	LogoutUC             *auth.LogoutUseCase
 	VerifyEmailUC        *auth.VerifyEmailUseCase
@@ -75,6 +121,94 @@ type Container struct {
 	ForgotPasswordUC     *auth.ForgotPasswordUseCase
 	ResetPasswordUC      *auth.ResetPasswordUseCase
 	ChangePasswordUC     *auth.ChangePasswordUseCase
+	ListSessionsUC       *auth.ListSessionsUseCase
+	RevokeSessionUC      *auth.RevokeSessionUseCase
+
+	// Use Cases - Two-factor (nil without an encryption key)
+	TwoFactorStatusUC         *auth.GetTwoFactorStatusUseCase
+	SetupTwoFactorUC          *auth.SetupTwoFactorUseCase
+	EnableTwoFactorUC         *auth.EnableTwoFactorUseCase
+	DisableTwoFactorUC        *auth.DisableTwoFactorUseCase
+	RegenerateRecoveryCodesUC *auth.RegenerateRecoveryCodesUseCase
+	VerifyTwoFactorUC         *auth.VerifyTwoFactorUseCase
+
+	// Use Cases - Passkeys
+	BeginPasskeyRegistrationUC  *auth.BeginPasskeyRegistrationUseCase
+	FinishPasskeyRegistrationUC *auth.FinishPasskeyRegistrationUseCase
+	ListPasskeysUC              *auth.ListPasskeysUseCase
+	RenamePasskeyUC             *auth.RenamePasskeyUseCase
+	DeletePasskeyUC             *auth.DeletePasskeyUseCase
+	BeginPasskeyLoginUC         *auth.BeginPasskeyLoginUseCase
+	FinishPasskeyLoginUC        *auth.FinishPasskeyLoginUseCase
+
+	// Use Cases - External identity providers
+	BeginOIDCLoginUC  *auth.BeginOIDCLoginUseCase
+	FinishOIDCLoginUC *auth.FinishOIDCLoginUseCase
+	ListIdentitiesUC  *auth.ListIdentitiesUseCase
+	UnlinkIdentityUC  *auth.UnlinkIdentityUseCase
+	SetPasswordUC     *auth.SetPasswordUseCase
+	RemovePasswordUC  *auth.RemovePasswordUseCase
+
+	// Use Cases - SAML single sign-on
+	BeginSAMLLoginUC  *auth.BeginSAMLLoginUseCase
+	FinishSAMLLoginUC *auth.FinishSAMLLoginUseCase
+	GetSSOConfigUC    *teamUC.GetSSOConfigUseCase
+	ConfigureSSOUC    *teamUC.ConfigureSSOUseCase
+	DeleteSSOConfigUC *teamUC.DeleteSSOConfigUseCase
+	VerifySSODomainUC *teamUC.VerifySSODomainUseCase
+
+	// Use Cases - SCIM provisioning
+	AuthenticateSCIMUC   *teamUC.AuthenticateSCIMUseCase
+	ListSCIMUsersUC      *teamUC.ListSCIMUsersUseCase
+	GetSCIMUserUC        *teamUC.GetSCIMUserUseCase
+	CreateSCIMUserUC     *teamUC.CreateSCIMUserUseCase
+	UpdateSCIMUserUC     *teamUC.UpdateSCIMUserUseCase
+	DeleteSCIMUserUC     *teamUC.DeleteSCIMUserUseCase
+	ListSCIMGroupsUC     *teamUC.ListSCIMGroupsUseCase
+	GetSCIMGroupUC       *teamUC.GetSCIMGroupUseCase
+	CreateSCIMGroupUC    *teamUC.CreateSCIMGroupUseCase
+	UpdateSCIMGroupUC    *teamUC.UpdateSCIMGroupUseCase
+	DeleteSCIMGroupUC    *teamUC.DeleteSCIMGroupUseCase
+	CreateSCIMTokenUC    *teamUC.CreateSCIMTokenUseCase
+	ListSCIMTokensUC     *teamUC.ListSCIMTokensUseCase
+	RevokeSCIMTokenUC    *teamUC.RevokeSCIMTokenUseCase
+	ListSCIMGroupRolesUC *teamUC.ListSCIMGroupRolesUseCase
+	SetSCIMGroupRoleUC   *teamUC.SetSCIMGroupRoleUseCase
+
+	// Custom role use cases
+	ListRolesUC  *teamUC.ListRolesUseCase
+	CreateRoleUC *teamUC.CreateRoleUseCase
+	UpdateRoleUC *teamUC.UpdateRoleUseCase
+	DeleteRoleUC *teamUC.DeleteRoleUseCase
+
+	// API key use cases
+	CreateAPIKeyUC       *teamUC.CreateAPIKeyUseCase
+	ListAPIKeysUC        *teamUC.ListAPIKeysUseCase
+	RevokeAPIKeyUC       *teamUC.RevokeAPIKeyUseCase
+	AuthenticateAPIKeyUC *teamUC.AuthenticateAPIKeyUseCase
+
+	// Outgoing webhook use cases
+	WebhookDispatcher       *teamUC.WebhookDispatcher
+	CreateWebhookUC         *teamUC.CreateWebhookUseCase
+	ListWebhooksUC          *teamUC.ListWebhooksUseCase
+	UpdateWebhookUC         *teamUC.UpdateWebhookUseCase
+	DeleteWebhookUC         *teamUC.DeleteWebhookUseCase
+	ListWebhookDeliveriesUC *teamUC.ListWebhookDeliveriesUseCase
+	SendTestWebhookUC       *teamUC.SendTestWebhookUseCase
+	ChangeTeamPlanUC        *teamUC.ChangeTeamPlanUseCase
+
+	// OAuth provider use cases
+	RegisterOAuthClientUC  *teamUC.RegisterOAuthClientUseCase
+	ListOAuthClientsUC     *teamUC.ListOAuthClientsUseCase
+	DeleteOAuthClientUC    *teamUC.DeleteOAuthClientUseCase
+	GetOAuthConsentUC      *auth.GetOAuthConsentUseCase
+	DecideOAuthConsentUC   *auth.DecideOAuthConsentUseCase
+	OAuthTokenUC           *auth.OAuthTokenUseCase
+	IntrospectOAuthTokenUC *auth.IntrospectOAuthTokenUseCase
+	RevokeOAuthTokenUC     *auth.RevokeOAuthTokenUseCase
+
+	// Audit log use cases
+	ListAuditLogUC *auditlog.ListAuditLogUseCase
 
 	// Use Cases - User
 	CreateUserUC *userUC.CreateUserUseCase
@@ -111,15 +245,68 @@ type Container struct {
 	ListAccountsUC      *socialUC.ListAccountsUseCase
 	PublishPostUC       *socialUC.PublishPostUseCase
 	GetAnalyticsUC      *socialUC.GetAnalyticsUseCase
+	GetAccountQuotaUC   *socialUC.GetAccountQuotaUseCase
+	GetAccountAccessUC  *socialUC.GetAccountAccessUseCase
+	SetAccountAccessUC  *socialUC.SetAccountAccessUseCase
+
+	// Inbound platform webhook use cases
+	VerifyWebhookSubscriptionUC *socialUC.VerifyWebhookSubscriptionUseCase
+	ReceivePlatformWebhookUC    *socialUC.ReceivePlatformWebhookUseCase
+	ReplayPlatformWebhooksUC    *socialUC.ReplayPlatformWebhooksUseCase
+
+	// Use Cases - Inbox
+	ListConversationsUC       *inboxUC.ListConversationsUseCase
+	GetConversationUC         *inboxUC.GetConversationUseCase
+	UpdateConversationStateUC *inboxUC.UpdateConversationStateUseCase
+	AssignConversationUC      *inboxUC.AssignConversationUseCase
+	ReplyUC                   *inboxUC.ReplyUseCase
+	ListSavedRepliesUC        *inboxUC.ListSavedRepliesUseCase
+	CreateSavedReplyUC        *inboxUC.CreateSavedReplyUseCase
+	UpdateSavedReplyUC        *inboxUC.UpdateSavedReplyUseCase
+	DeleteSavedReplyUC        *inboxUC.DeleteSavedReplyUseCase
+	ListInboxRulesUC          *inboxUC.ListRulesUseCase
+	CreateInboxRuleUC         *inboxUC.CreateRuleUseCase
+	UpdateInboxRuleUC         *inboxUC.UpdateRuleUseCase
+	DeleteInboxRuleUC         *inboxUC.DeleteRuleUseCase
+	TestInboxRulesUC          *inboxUC.TestRulesUseCase
+
+	// Use Cases - Realtime (nil without Redis)
+	RealtimeStreamUC *realtime.StreamUseCase
+	RealtimeTicketUC *realtime.TicketUseCase
+
+	// Use Cases - Notifications
+	ListNotificationsUC        *notificationUC.ListNotificationsUseCase
+	MarkNotificationReadUC     *notificationUC.MarkNotificationReadUseCase
+	MarkAllNotificationsReadUC *notificationUC.MarkAllNotificationsReadUseCase
+	GetNotificationPrefsUC     *notificationUC.GetPreferencesUseCase
+	UpdateNotificationPrefsUC  *notificationUC.UpdatePreferencesUseCase
 
 	// HTTP Handlers
-	AuthHandler   *handlers.AuthHandler // ✅ FIXED: Changed from AuthHandlerV2
-	TeamHandler   *handlers.TeamHandler
-	PostHandler   *handlers.PostHandler
-	SocialHandler *handlers.SocialHandler
+	AuthHandler         *handlers.AuthHandler // ✅ FIXED: Changed from AuthHandlerV2
+	TwoFactorHandler    *handlers.TwoFactorHandler
+	PasskeyHandler      *handlers.PasskeyHandler
+	OIDCHandler         *handlers.OIDCHandler
+	SSOHandler          *handlers.SSOHandler
+	SCIMHandler         *handlers.SCIMHandler
+	RoleHandler         *handlers.RoleHandler
+	APIKeyHandler       *handlers.APIKeyHandler
+	WebhookHandler      *handlers.WebhookHandler
+	InboundHandler      *handlers.PlatformWebhookHandler
+	InboxHandler        *handlers.InboxHandler
+	InboxRulesHandler   *handlers.InboxRulesHandler
+	RealtimeHandler     *handlers.RealtimeHandler
+	NotificationHandler *handlers.NotificationHandler
+	OAuthHandler        *handlers.OAuthHandler
+	AuditLogHandler     *handlers.AuditLogHandler
+	TeamHandler         *handlers.TeamHandler
+	PostHandler         *handlers.PostHandler
+	SocialHandler       *handlers.SocialHandler
+	AccessHandler       *handlers.AccountAccessHandler
+	AdminHandler        *handlers.AdminHandler
 
 	// Middleware
 	AuthMiddleware *middleware.AuthMiddleware
+	Policy         *middleware.PolicyMiddleware
 	RateLimiter    *middleware.RateLimiter
 }
 
@@ -174,16 +361,48 @@ func (c *Container) initializeInfrastructure() error {
 	)
 	c.Logger.Info("Token service initialized successfully")
 
+	c.WebAuthn = services.NewWebAuthnService(
+		c.Config.Security.WebAuthnRPID,
+		c.Config.Security.WebAuthnRPName,
+		c.Config.Security.WebAuthnOrigins,
+		true,
+	)
+
+	c.IdentityProviders = auth.IdentityProviders{}
+	if cfg := c.Config.OIDC.Google; cfg.ClientID != "" {
+		c.IdentityProviders["google"] = services.NewOIDCProvider("google", services.GoogleIssuer, cfg.ClientID, cfg.ClientSecret, cfg.CallbackURL, nil)
+	}
+	if cfg := c.Config.OIDC.Microsoft; cfg.ClientID != "" {
+		issuer := fmt.Sprintf(services.MicrosoftIssuerFormat, c.Config.OIDC.MicrosoftTenant)
+		c.IdentityProviders["microsoft"] = services.NewMicrosoftProvider(issuer, cfg.ClientID, cfg.ClientSecret, cfg.CallbackURL, nil)
+	}
+	if cfg := c.Config.OIDC.GitHub; cfg.ClientID != "" {
+		c.IdentityProviders["github"] = services.NewGitHubProvider(cfg.ClientID, cfg.ClientSecret, cfg.CallbackURL, nil)
+	}
+	c.Logger.Info("Identity providers configured", "providers", c.IdentityProviders.Names())
+
+	c.SAMLProvider = services.NewSAMLServiceProvider(c.Config.BaseURL + "/api/v2/sso/saml")
+
 	// ========================================================================
 	// EMAIL SERVICE
 	// ========================================================================
+	// Emails are queued in the outbox and sent by the worker
 	emailConfig := services.EmailConfig{
 		Provider:    c.Config.Email.Provider,
 		APIKey:      c.Config.Email.APIKey,
 		FromAddress: c.Config.Email.FromAddress,
 		FromName:    c.Config.Email.FromName,
 	}
-	c.EmailService = services.NewEmailService(emailConfig)
+	emailService, err := services.NewEmailService(
+		emailConfig,
+		persistence.NewEmailOutboxRepository(c.DB),
+		persistence.NewUserLocaleRepository(c.DB),
+		c.Logger,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize email service: %w", err)
+	}
+	c.EmailService = emailService
 	c.Logger.Info("Email service initialized successfully")
 
 	// ========================================================================
@@ -228,11 +447,16 @@ func (c *Container) initializeInfrastructure() error {
 		c.Redis = cacheService.(*services.RedisCacheService).Client()
 	}
 
+	// Failed sign-in counts live in the cache, shared by replicas on Redis
+	c.LoginThrottle = services.NewLoginThrottle(c.CacheService, services.DefaultLoginThrottleConfig)
+
 	// ========================================================================
 	// RATE LIMITER (NEW)
 	// ========================================================================
 	if c.Redis != nil {
 		c.RateLimiter = middleware.NewRateLimiter(c.Redis, c.Logger)
+		c.PlatformLimiter = services.NewPlatformRateLimiter(c.Redis)
+		c.CircuitBreaker = services.NewRedisCircuitBreaker(c.Redis, services.DefaultCircuitFailureThreshold, services.DefaultCircuitCooldown, c.Logger)
 		c.Logger.Info("✅ Rate limiter initialized successfully")
 	} else {
 		c.Logger.Warn("Rate limiter not initialized - Redis unavailable")
@@ -243,11 +467,21 @@ func (c *Container) initializeInfrastructure() error {
 	// ========================================================================
 	if c.Redis != nil {
 		c.WorkerQueue = services.NewWorkerQueueService(c.Redis, c.Logger)
+		c.SchedulerControl = services.NewSchedulerControl(c.Redis, c.WorkerQueue, c.Logger)
 		c.Logger.Info("✅ Worker queue service initialized successfully")
 	} else {
 		c.Logger.Warn("Worker queue not initialized - Redis unavailable")
 	}
 
+	// ========================================================================
+	// REALTIME FEED (fans updates out to the clients of every replica)
+	// ========================================================================
+	if c.Redis != nil {
+		c.RealtimeFeed = services.NewRedisRealtimeFeed(c.Redis, c.Logger)
+	} else {
+		c.Logger.Warn("Realtime updates not available - Redis unavailable")
+	}
+
 	// ========================================================================
 	// ENCRYPTION SERVICE (for Social OAuth)
 	// ========================================================================
@@ -275,14 +509,48 @@ func (c *Container) initializeInfrastructure() error {
 	// ========================================================================
 	// ✅ FIX: Pass both c.DB and queries to NewUserRepository
 	c.UserRepo = persistence.NewUserRepository(c.DB, c.Queries)
+	c.SessionRepo = persistence.NewSessionRepository(c.DB)
+	c.PasskeyRepo = persistence.NewPasskeyRepository(c.DB)
+	c.IdentityRepo = persistence.NewIdentityRepository(c.DB)
+	c.SSORepo = persistence.NewSSORepository(c.DB)
+	c.SCIMRepo = persistence.NewSCIMRepository(c.DB)
 	c.TeamRepo = persistence.NewTeamRepository(c.DB)
 	c.MemberRepo = persistence.NewTeamMemberRepository(c.DB)
+	c.RoleRepo = persistence.NewRoleRepository(c.DB)
+	c.APIKeyRepo = persistence.NewAPIKeyRepository(c.DB)
+	c.DeliveryRepo = persistence.NewWebhookDeliveryRepository(c.DB)
+	c.InboundRepo = persistence.NewPlatformWebhookRepository(c.DB)
+	c.InboxRepo = persistence.NewInboxRepository(c.DB)
+	c.InboxRuleRepo = persistence.NewInboxRuleRepository(c.DB)
+	c.NotificationRepo = persistence.NewNotificationRepository(c.DB)
+	c.NotificationPrefRepo = persistence.NewNotificationPreferenceRepository(c.DB)
+	c.OAuthRepo = persistence.NewOAuthRepository(c.DB)
+	c.AuditRepo = persistence.NewAuditRepository(c.DB)
+
+	// Domain events go through the outbox; the relay forwards them to the
+	// worker's Redis stream when Redis is available
+	c.Transactor = persistence.NewTransactor(c.DB)
+	var eventSink services.EventSink
+	if c.Redis != nil {
+		eventSink = services.NewRedisEventStream(c.Redis, services.DefaultEventStream)
+	}
+	c.EventBus = services.NewEventBus(persistence.NewOutboxRepository(c.DB), eventSink, services.DefaultEventBusConfig, c.Logger)
+	c.AccessRepo = persistence.NewAccountAccessRepository(c.DB)
 	c.PostRepo = persistence.NewPostRepository(c.DB, c.Queries)
 
 	// Social Repository (requires encryption service)
 	if c.EncryptionService != nil {
-		c.SocialRepo = persistence.NewSocialRepository(c.Queries, c.EncryptionService)
+		c.SocialRepo = persistence.NewSocialRepository(c.DB, c.Queries, c.EncryptionService)
+		c.TwoFactorRepo = persistence.NewTwoFactorRepository(c.DB, c.EncryptionService)
 		c.Logger.Info("Social repository initialized successfully")
+
+		webhooks := persistence.NewWebhookRepository(c.DB, c.EncryptionService)
+		if n, err := webhooks.(*persistence.WebhookRepository).EncryptLegacySecrets(context.Background()); err != nil {
+			c.Logger.Error("Failed to encrypt webhook secrets", "error", err)
+		} else if n > 0 {
+			c.Logger.Info("Encrypted plaintext webhook secrets", "count", n)
+		}
+		c.WebhookRepo = webhooks
 	} else {
 		c.Logger.Warn("Social repository not initialized - encryption service unavailable")
 	}
@@ -297,7 +565,7 @@ func (c *Container) initializeDomainServices() error {
 	c.UserService = userDomain.NewService(c.UserRepo)
 
 	// Team Domain Service
-	c.TeamService = teamDomain.NewService(c.TeamRepo, c.MemberRepo)
+	c.TeamService = teamDomain.NewService(c.TeamRepo, c.MemberRepo, c.RoleRepo, c.EventBus)
 
 	c.Logger.Info("✅ Domain services initialized successfully")
 	return nil
@@ -351,6 +619,24 @@ func (c *Container) initializeSocialAdapters() error {
 		c.Logger.Info("Facebook adapter initialized")
 	}
 
+	// Platform outages trip a circuit shared with the worker
+	for platform, adapter := range c.SocialAdapters {
+		c.SocialAdapters[platform] = socialAdapter.WithCircuitBreaker(string(platform), adapter, c.CircuitBreaker)
+	}
+
+	// Inbound webhooks are accepted from platforms with a webhook secret
+	var parsers []socialDomain.WebhookParser
+	if facebookAppSecret != "" {
+		parsers = append(parsers, facebook.NewWebhookParser(facebookAppSecret, os.Getenv("FACEBOOK_WEBHOOK_VERIFY_TOKEN")))
+	}
+	if secret := os.Getenv("TWITTER_CONSUMER_SECRET"); secret != "" {
+		parsers = append(parsers, twitter.NewWebhookParser(secret))
+	}
+	if linkedinClientSecret != "" {
+		parsers = append(parsers, linkedin.NewWebhookParser(linkedinClientSecret))
+	}
+	c.WebhookParsers = socialDomain.NewWebhookParsers(parsers...)
+
 	if len(c.SocialAdapters) > 0 {
 		c.Logger.Info(fmt.Sprintf("✅ %d social adapters initialized", len(c.SocialAdapters)))
 	} else {
@@ -368,13 +654,52 @@ func (c *Container) initializeUseCases() error {
 	// ========================================================================
 	// AUTH USE CASES - Initialize ALL auth use cases
 	// ========================================================================
+	c.AuditRecorder = auditlog.NewRecorder(c.AuditRepo, c.Logger)
+
+	// Realtime updates are projected from the events this API relays,
+	// including the worker's
+	if c.RealtimeFeed != nil {
+		projector := realtime.NewProjector(c.RealtimeFeed, c.Logger)
+		for _, eventType := range realtime.EventTypes() {
+			if err := c.EventBus.Subscribe(eventType, projector.HandleEvent); err != nil {
+				return err
+			}
+		}
+		c.RealtimeStreamUC = realtime.NewStreamUseCase(c.RealtimeFeed, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.Logger)
+		c.RealtimeTicketUC = realtime.NewTicketUseCase(c.CacheService, c.MemberRepo, c.RoleRepo)
+	}
+
+	// Notifications are raised and emailed by the worker
+	c.ListNotificationsUC = notificationUC.NewListNotificationsUseCase(c.NotificationRepo)
+	c.MarkNotificationReadUC = notificationUC.NewMarkNotificationReadUseCase(c.NotificationRepo, c.Logger)
+	c.MarkAllNotificationsReadUC = notificationUC.NewMarkAllNotificationsReadUseCase(c.NotificationRepo)
+	c.GetNotificationPrefsUC = notificationUC.NewGetPreferencesUseCase(c.NotificationPrefRepo)
+	c.UpdateNotificationPrefsUC = notificationUC.NewUpdatePreferencesUseCase(c.NotificationPrefRepo, c.Logger)
+
+	c.SessionManager = auth.NewSessionManager(
+		c.SessionRepo,
+		c.UserRepo,
+		c.TokenService,
+		c.AuditRecorder,
+		c.Logger,
+	)
+
 	c.LoginUC = auth.NewLoginUseCase(
 		c.UserRepo,
 		c.UserService,
-		c.TokenService,
+		c.SessionManager,
+		c.TwoFactorRepo,
+		c.PasskeyRepo,
+		c.TeamRepo,
+		c.SSORepo,
 		c.CacheService,
+		c.LoginThrottle,
+		c.EmailService,
+		c.AuditRecorder,
 		c.Logger,
 	)
+	c.GetAccountLockoutUC = auth.NewGetAccountLockoutUseCase(c.UserRepo, c.LoginThrottle)
+	c.UnlockAccountUC = auth.NewUnlockAccountUseCase(c.UserRepo, c.LoginThrottle, c.Logger)
 
 	// ✅ NEW: Initialize remaining auth use cases
 	// Note: You'll need to create these use case files if they don't exist yet
@@ -383,14 +708,12 @@ func (c *Container) initializeUseCases() error {
 	// Uncomment these when the use cases are implemented:
 
 	c.RefreshTokenUC = auth.NewRefreshTokenUseCase(
-		c.UserRepo,
-		c.TokenService,
-		c.CacheService, // ← CRITICAL: This was missing!
+		c.SessionManager,
 		c.Logger,
 	)
 
 	c.LogoutUC = auth.NewLogoutUseCase(
-		c.TokenService,
+		c.SessionManager,
 		c.Logger,
 	)
 
@@ -416,8 +739,9 @@ func (c *Container) initializeUseCases() error {
 
 	c.ResetPasswordUC = auth.NewResetPasswordUseCase(
 		c.UserRepo,
-		c.Queries,      // ← Add this
-		c.UserService,  // ← Add this
+		c.Queries,     // ← Add this
+		c.UserService, // ← Add this
+		c.SessionManager,
 		c.EmailService, // ← Add this
 		c.Logger,
 	)
@@ -425,6 +749,96 @@ func (c *Container) initializeUseCases() error {
 	c.ChangePasswordUC = auth.NewChangePasswordUseCase(
 		c.UserRepo,
 		c.UserService, // ← Add this
+		c.SessionManager,
+		c.Logger,
+	)
+
+	c.ListSessionsUC = auth.NewListSessionsUseCase(
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	c.RevokeSessionUC = auth.NewRevokeSessionUseCase(
+		c.SessionRepo,
+		c.Logger,
+	)
+
+	// Two-factor secrets are stored encrypted
+	if c.TwoFactorRepo != nil {
+		c.TwoFactorStatusUC = auth.NewGetTwoFactorStatusUseCase(c.TwoFactorRepo, c.TeamRepo, c.Logger)
+		c.SetupTwoFactorUC = auth.NewSetupTwoFactorUseCase(
+			c.UserRepo,
+			c.TwoFactorRepo,
+			c.CacheService,
+			c.Config.Security.TOTPIssuer,
+			c.Logger,
+		)
+		c.EnableTwoFactorUC = auth.NewEnableTwoFactorUseCase(
+			c.UserRepo,
+			c.TwoFactorRepo,
+			c.SessionManager,
+			c.CacheService,
+			c.Logger,
+		)
+		c.DisableTwoFactorUC = auth.NewDisableTwoFactorUseCase(c.UserRepo, c.TwoFactorRepo, c.TeamRepo, c.Logger)
+		c.RegenerateRecoveryCodesUC = auth.NewRegenerateRecoveryCodesUseCase(c.TwoFactorRepo, c.Logger)
+		c.VerifyTwoFactorUC = auth.NewVerifyTwoFactorUseCase(
+			c.UserRepo,
+			c.TwoFactorRepo,
+			c.SessionManager,
+			c.CacheService,
+			c.Logger,
+		)
+	} else {
+		c.Logger.Warn("Two-factor authentication unavailable - encryption service unavailable")
+	}
+
+	c.BeginPasskeyRegistrationUC = auth.NewBeginPasskeyRegistrationUseCase(
+		c.UserRepo,
+		c.PasskeyRepo,
+		c.WebAuthn,
+		c.CacheService,
+		c.Logger,
+	)
+	c.FinishPasskeyRegistrationUC = auth.NewFinishPasskeyRegistrationUseCase(c.PasskeyRepo, c.WebAuthn, c.CacheService, c.Logger)
+	c.ListPasskeysUC = auth.NewListPasskeysUseCase(c.PasskeyRepo, c.Logger)
+	c.RenamePasskeyUC = auth.NewRenamePasskeyUseCase(c.PasskeyRepo, c.Logger)
+	c.DeletePasskeyUC = auth.NewDeletePasskeyUseCase(c.PasskeyRepo, c.Logger)
+	c.BeginPasskeyLoginUC = auth.NewBeginPasskeyLoginUseCase(c.PasskeyRepo, c.WebAuthn, c.CacheService, c.Logger)
+	c.FinishPasskeyLoginUC = auth.NewFinishPasskeyLoginUseCase(
+		c.UserRepo,
+		c.PasskeyRepo,
+		c.TeamRepo,
+		c.SSORepo,
+		c.WebAuthn,
+		c.SessionManager,
+		c.CacheService,
+		c.Logger,
+	)
+
+	c.BeginOIDCLoginUC = auth.NewBeginOIDCLoginUseCase(c.IdentityProviders, c.CacheService, c.Logger)
+	c.FinishOIDCLoginUC = auth.NewFinishOIDCLoginUseCase(
+		c.UserRepo,
+		c.IdentityRepo,
+		c.IdentityProviders,
+		c.LoginUC,
+		c.CacheService,
+		c.Logger,
+	)
+	c.ListIdentitiesUC = auth.NewListIdentitiesUseCase(c.UserRepo, c.IdentityRepo, c.IdentityProviders, c.Logger)
+	c.UnlinkIdentityUC = auth.NewUnlinkIdentityUseCase(c.UserRepo, c.IdentityRepo, c.Logger)
+	c.SetPasswordUC = auth.NewSetPasswordUseCase(c.UserRepo, c.Logger)
+	c.RemovePasswordUC = auth.NewRemovePasswordUseCase(c.UserRepo, c.IdentityRepo, c.Logger)
+
+	c.BeginSAMLLoginUC = auth.NewBeginSAMLLoginUseCase(c.SSORepo, c.TeamRepo, c.SAMLProvider, c.CacheService, c.Logger)
+	c.FinishSAMLLoginUC = auth.NewFinishSAMLLoginUseCase(
+		c.UserRepo,
+		c.IdentityRepo,
+		c.SSORepo,
+		c.MemberRepo,
+		c.SAMLProvider,
+		c.SessionManager,
+		c.CacheService,
 		c.Logger,
 	)
 
@@ -435,7 +849,7 @@ func (c *Container) initializeUseCases() error {
 		c.UserRepo,
 		// c.Queries,
 		c.UserService,
-		c.TokenService,
+		c.SessionManager,
 		c.EmailService,
 		c.Logger,
 	)
@@ -496,21 +910,27 @@ func (c *Container) initializeUseCases() error {
 	c.InviteMemberUC = teamUC.NewInviteMemberUseCase(
 		c.TeamRepo,
 		c.MemberRepo,
+		c.RoleRepo,
 		c.UserRepo,
 		c.EmailService,
+		c.Transactor,
+		c.EventBus,
 		c.Logger,
 	)
 
 	c.RemoveMemberUC = teamUC.NewRemoveMemberUseCase(
 		c.TeamRepo,
 		c.MemberRepo,
+		c.RoleRepo,
 		c.Logger,
 	)
 
 	c.UpdateMemberRoleUC = teamUC.NewUpdateMemberRoleUseCase(
 		c.TeamRepo,
 		c.MemberRepo,
+		c.RoleRepo,
 		c.UserRepo,
+		c.AuditRecorder,
 		c.Logger,
 	)
 
@@ -530,52 +950,138 @@ func (c *Container) initializeUseCases() error {
 		c.Logger,
 	)
 
+	// Single sign-on configuration
+	c.GetSSOConfigUC = teamUC.NewGetSSOConfigUseCase(c.SSORepo, c.MemberRepo, c.SAMLProvider)
+	c.ConfigureSSOUC = teamUC.NewConfigureSSOUseCase(c.SSORepo, c.TeamRepo, c.MemberRepo, c.SAMLProvider, c.Logger)
+	c.DeleteSSOConfigUC = teamUC.NewDeleteSSOConfigUseCase(c.SSORepo, c.MemberRepo, c.Logger)
+	c.VerifySSODomainUC = teamUC.NewVerifySSODomainUseCase(c.SSORepo, c.MemberRepo, net.DefaultResolver, c.SAMLProvider, c.Logger)
+
+	// SCIM provisioning
+	c.AuthenticateSCIMUC = teamUC.NewAuthenticateSCIMUseCase(c.SCIMRepo, c.TeamRepo, c.Logger)
+	c.ListSCIMUsersUC = teamUC.NewListSCIMUsersUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.GetSCIMUserUC = teamUC.NewGetSCIMUserUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.CreateSCIMUserUC = teamUC.NewCreateSCIMUserUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.SSORepo, c.SessionRepo, c.Logger)
+	c.UpdateSCIMUserUC = teamUC.NewUpdateSCIMUserUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.SSORepo, c.SessionRepo, c.Logger)
+	c.DeleteSCIMUserUC = teamUC.NewDeleteSCIMUserUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.SessionRepo, c.Logger)
+	c.ListSCIMGroupsUC = teamUC.NewListSCIMGroupsUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.GetSCIMGroupUC = teamUC.NewGetSCIMGroupUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.CreateSCIMGroupUC = teamUC.NewCreateSCIMGroupUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.UpdateSCIMGroupUC = teamUC.NewUpdateSCIMGroupUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.DeleteSCIMGroupUC = teamUC.NewDeleteSCIMGroupUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+	c.CreateSCIMTokenUC = teamUC.NewCreateSCIMTokenUseCase(c.SCIMRepo, c.TeamRepo, c.MemberRepo, c.Logger)
+	c.ListSCIMTokensUC = teamUC.NewListSCIMTokensUseCase(c.SCIMRepo, c.MemberRepo)
+	c.RevokeSCIMTokenUC = teamUC.NewRevokeSCIMTokenUseCase(c.SCIMRepo, c.MemberRepo, c.Logger)
+	c.ListSCIMGroupRolesUC = teamUC.NewListSCIMGroupRolesUseCase(c.SCIMRepo, c.MemberRepo)
+	c.SetSCIMGroupRoleUC = teamUC.NewSetSCIMGroupRoleUseCase(c.SCIMRepo, c.MemberRepo, c.UserRepo, c.IdentityRepo, c.Logger)
+
+	// Custom roles
+	c.ListRolesUC = teamUC.NewListRolesUseCase(c.MemberRepo, c.RoleRepo, c.Logger)
+	c.CreateRoleUC = teamUC.NewCreateRoleUseCase(c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+	c.UpdateRoleUC = teamUC.NewUpdateRoleUseCase(c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+	c.DeleteRoleUC = teamUC.NewDeleteRoleUseCase(c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+
+	// API keys
+	c.CreateAPIKeyUC = teamUC.NewCreateAPIKeyUseCase(c.APIKeyRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+	c.ListAPIKeysUC = teamUC.NewListAPIKeysUseCase(c.APIKeyRepo, c.MemberRepo, c.RoleRepo)
+	c.RevokeAPIKeyUC = teamUC.NewRevokeAPIKeyUseCase(c.APIKeyRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+	c.AuthenticateAPIKeyUC = teamUC.NewAuthenticateAPIKeyUseCase(c.APIKeyRepo, c.TeamRepo, c.UserRepo, c.AuditRecorder, c.Logger)
+
+	// Outgoing webhooks: events are delivered by the worker; the API only
+	// sends test events. Secrets are encrypted, so they need the key.
+	if c.WebhookRepo != nil {
+		webhookSender := services.NewHTTPWebhookSender(services.DefaultWebhookTimeout, c.Config.Security.WebhookAllowPrivate)
+		c.WebhookDispatcher = teamUC.NewWebhookDispatcher(c.WebhookRepo, c.DeliveryRepo, c.TeamRepo, webhookSender, c.AuditRecorder, c.Logger)
+		c.CreateWebhookUC = teamUC.NewCreateWebhookUseCase(c.WebhookRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+		c.ListWebhooksUC = teamUC.NewListWebhooksUseCase(c.WebhookRepo, c.MemberRepo, c.RoleRepo)
+		c.UpdateWebhookUC = teamUC.NewUpdateWebhookUseCase(c.WebhookRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+		c.DeleteWebhookUC = teamUC.NewDeleteWebhookUseCase(c.WebhookRepo, c.MemberRepo, c.RoleRepo, c.AuditRecorder, c.Logger)
+		c.ListWebhookDeliveriesUC = teamUC.NewListWebhookDeliveriesUseCase(c.WebhookRepo, c.DeliveryRepo, c.MemberRepo, c.RoleRepo)
+		c.SendTestWebhookUC = teamUC.NewSendTestWebhookUseCase(c.WebhookRepo, c.TeamRepo, c.WebhookDispatcher, c.MemberRepo, c.RoleRepo)
+	}
+
+	c.ChangeTeamPlanUC = teamUC.NewChangeTeamPlanUseCase(c.TeamService, c.Transactor, c.AuditRecorder, c.Logger)
+
+	// OAuth provider
+	c.RegisterOAuthClientUC = teamUC.NewRegisterOAuthClientUseCase(c.OAuthRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.Logger)
+	c.ListOAuthClientsUC = teamUC.NewListOAuthClientsUseCase(c.OAuthRepo, c.MemberRepo, c.RoleRepo)
+	c.DeleteOAuthClientUC = teamUC.NewDeleteOAuthClientUseCase(c.OAuthRepo, c.MemberRepo, c.RoleRepo, c.Logger)
+	c.GetOAuthConsentUC = auth.NewGetOAuthConsentUseCase(c.OAuthRepo, c.TeamRepo)
+	c.DecideOAuthConsentUC = auth.NewDecideOAuthConsentUseCase(c.OAuthRepo, c.MemberRepo, c.RoleRepo, c.Logger)
+	c.OAuthTokenUC = auth.NewOAuthTokenUseCase(c.OAuthRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.UserRepo, c.TokenService, c.Logger)
+	c.IntrospectOAuthTokenUC = auth.NewIntrospectOAuthTokenUseCase(c.OAuthRepo, c.TokenService)
+	c.RevokeOAuthTokenUC = auth.NewRevokeOAuthTokenUseCase(c.OAuthRepo, c.Logger)
+
+	// Audit log
+	c.ListAuditLogUC = auditlog.NewListAuditLogUseCase(c.AuditRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo)
+
 	// ========================================================================
 	// POST USE CASES
 	// ========================================================================
 	c.CreateDraftUC = postUC.NewCreateDraftUseCase(
 		c.PostRepo,
 		c.TeamRepo,
+		c.SocialRepo,
 		c.MemberRepo,
+		c.RoleRepo,
+		c.AccessRepo,
 		c.Logger,
 	)
 
 	c.SchedulePostUC = postUC.NewSchedulePostUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
+		c.AccessRepo,
+		c.Transactor,
+		c.EventBus,
 		c.Logger,
 	)
 
 	c.UpdatePostUC = postUC.NewUpdatePostUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
+		c.AccessRepo,
 		c.Logger,
 	)
 
 	c.DeletePostUC = postUC.NewDeletePostUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
+		c.AccessRepo,
+		c.AuditRecorder,
 		c.Logger,
 	)
 
 	c.GetPostUC = postUC.NewGetPostUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
 		c.Logger,
 	)
 
 	c.ListPostsUC = postUC.NewListPostsUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
 		c.Logger,
 	)
 
 	c.PublishNowUC = postUC.NewPublishNowUseCase(
 		c.PostRepo,
 		c.MemberRepo,
+		c.RoleRepo,
+		c.AccessRepo,
+		c.AuditRecorder,
 		c.Logger,
 	)
 
+	// Inbound platform webhooks: logged here, processed by the worker
+	c.VerifyWebhookSubscriptionUC = socialUC.NewVerifyWebhookSubscriptionUseCase(c.WebhookParsers, c.Logger)
+	c.ReceivePlatformWebhookUC = socialUC.NewReceivePlatformWebhookUseCase(c.WebhookParsers, c.InboundRepo, c.Logger)
+	c.ReplayPlatformWebhooksUC = socialUC.NewReplayPlatformWebhooksUseCase(c.WebhookParsers, c.InboundRepo, c.Logger)
+
 	// ========================================================================
 	// SOCIAL USE CASES (if available)
 	// ========================================================================
@@ -583,42 +1089,122 @@ func (c *Container) initializeUseCases() error {
 		c.ConnectAccountUC = socialUC.NewConnectAccountUseCase(
 			c.SocialRepo,
 			c.MemberRepo,
+			c.RoleRepo,
 			c.SocialAdapters,
+			c.AuditRecorder,
 			c.Logger,
 		)
 
 		c.DisconnectAccountUC = socialUC.NewDisconnectAccountUseCase(
 			c.SocialRepo,
 			c.MemberRepo,
+			c.RoleRepo,
+			c.Transactor,
+			c.EventBus,
+			c.AuditRecorder,
 			c.Logger,
 		)
 
 		c.RefreshTokensUC = socialUC.NewRefreshTokensUseCase(
 			c.SocialRepo,
 			c.SocialAdapters,
+			c.Transactor,
+			c.EventBus,
 			c.Logger,
 		)
 
 		c.ListAccountsUC = socialUC.NewListAccountsUseCase(
 			c.SocialRepo,
 			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
 			c.Logger,
 		)
 
 		c.PublishPostUC = socialUC.NewPublishPostUseCase(
 			c.SocialRepo,
 			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
 			c.SocialAdapters,
+			c.PlatformLimiter,
 			c.Logger,
 		)
 
 		c.GetAnalyticsUC = socialUC.NewGetAnalyticsUseCase(
 			c.SocialRepo,
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
 			c.SocialAdapters,
 			c.CacheService,
 			c.Logger,
 		)
 
+		c.GetAccountQuotaUC = socialUC.NewGetAccountQuotaUseCase(
+			c.SocialRepo,
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
+			c.PlatformLimiter,
+			c.Logger,
+		)
+
+		c.GetAccountAccessUC = socialUC.NewGetAccountAccessUseCase(
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
+		)
+
+		c.SetAccountAccessUC = socialUC.NewSetAccountAccessUseCase(
+			c.SocialRepo,
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
+			c.Logger,
+		)
+
+		// Unified inbox; conversations are filed by the worker
+		c.ListConversationsUC = inboxUC.NewListConversationsUseCase(c.InboxRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo)
+		c.GetConversationUC = inboxUC.NewGetConversationUseCase(c.InboxRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.Logger)
+		c.UpdateConversationStateUC = inboxUC.NewUpdateConversationStateUseCase(c.InboxRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.Logger)
+		c.AssignConversationUC = inboxUC.NewAssignConversationUseCase(
+			c.InboxRepo,
+			c.TeamRepo,
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
+			c.Transactor,
+			c.EventBus,
+			c.AuditRecorder,
+			c.Logger,
+		)
+		c.ReplyUC = inboxUC.NewReplyUseCase(
+			c.InboxRepo,
+			c.InboxRuleRepo,
+			c.SocialRepo,
+			c.SocialAdapters,
+			c.PlatformLimiter,
+			c.TeamRepo,
+			c.MemberRepo,
+			c.RoleRepo,
+			c.AccessRepo,
+			c.Transactor,
+			c.AuditRecorder,
+			c.Logger,
+		)
+
+		// Saved replies and rules; the rules are run by the worker
+		c.ListSavedRepliesUC = inboxUC.NewListSavedRepliesUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo)
+		c.CreateSavedReplyUC = inboxUC.NewCreateSavedReplyUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.UpdateSavedReplyUC = inboxUC.NewUpdateSavedReplyUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.DeleteSavedReplyUC = inboxUC.NewDeleteSavedReplyUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.ListInboxRulesUC = inboxUC.NewListRulesUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo)
+		c.CreateInboxRuleUC = inboxUC.NewCreateRuleUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.UpdateInboxRuleUC = inboxUC.NewUpdateRuleUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.DeleteInboxRuleUC = inboxUC.NewDeleteRuleUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo, c.AuditRecorder, c.Logger)
+		c.TestInboxRulesUC = inboxUC.NewTestRulesUseCase(c.InboxRuleRepo, c.TeamRepo, c.MemberRepo, c.RoleRepo, c.AccessRepo)
+
 		c.Logger.Info("✅ Social use cases initialized successfully")
 	} else {
 		c.Logger.Warn("Social use cases not initialized - missing encryption service or adapters")
@@ -644,6 +1230,39 @@ func (c *Container) initializeHandlers() error {
 		c.ForgotPasswordUC,     // May be nil if not implemented yet
 		c.ResetPasswordUC,      // May be nil if not implemented yet
 		c.ChangePasswordUC,     // May be nil if not implemented yet
+		c.ListSessionsUC,
+		c.RevokeSessionUC,
+	)
+
+	if c.TwoFactorRepo != nil {
+		c.TwoFactorHandler = handlers.NewTwoFactorHandler(
+			c.TwoFactorStatusUC,
+			c.SetupTwoFactorUC,
+			c.EnableTwoFactorUC,
+			c.DisableTwoFactorUC,
+			c.RegenerateRecoveryCodesUC,
+			c.VerifyTwoFactorUC,
+		)
+	}
+
+	c.PasskeyHandler = handlers.NewPasskeyHandler(
+		c.BeginPasskeyRegistrationUC,
+		c.FinishPasskeyRegistrationUC,
+		c.ListPasskeysUC,
+		c.RenamePasskeyUC,
+		c.DeletePasskeyUC,
+		c.BeginPasskeyLoginUC,
+		c.FinishPasskeyLoginUC,
+	)
+
+	c.OIDCHandler = handlers.NewOIDCHandler(
+		c.IdentityProviders,
+		c.BeginOIDCLoginUC,
+		c.FinishOIDCLoginUC,
+		c.ListIdentitiesUC,
+		c.UnlinkIdentityUC,
+		c.SetPasswordUC,
+		c.RemovePasswordUC,
 	)
 
 	// Team Handler
@@ -660,6 +1279,79 @@ func (c *Container) initializeHandlers() error {
 		c.GetPendingInvitationsUC, // NEW
 	)
 
+	c.SSOHandler = handlers.NewSSOHandler(
+		c.SAMLProvider,
+		c.GetSSOConfigUC,
+		c.ConfigureSSOUC,
+		c.DeleteSSOConfigUC,
+		c.VerifySSODomainUC,
+		c.BeginSAMLLoginUC,
+		c.FinishSAMLLoginUC,
+		c.Config.Security.SAMLLoginRedirectURL,
+	)
+
+	c.SCIMHandler = handlers.NewSCIMHandler(
+		c.AuthenticateSCIMUC,
+		c.ListSCIMUsersUC,
+		c.GetSCIMUserUC,
+		c.CreateSCIMUserUC,
+		c.UpdateSCIMUserUC,
+		c.DeleteSCIMUserUC,
+		c.ListSCIMGroupsUC,
+		c.GetSCIMGroupUC,
+		c.CreateSCIMGroupUC,
+		c.UpdateSCIMGroupUC,
+		c.DeleteSCIMGroupUC,
+		c.CreateSCIMTokenUC,
+		c.ListSCIMTokensUC,
+		c.RevokeSCIMTokenUC,
+		c.ListSCIMGroupRolesUC,
+		c.SetSCIMGroupRoleUC,
+	)
+
+	c.RoleHandler = handlers.NewRoleHandler(
+		c.ListRolesUC,
+		c.CreateRoleUC,
+		c.UpdateRoleUC,
+		c.DeleteRoleUC,
+	)
+
+	c.APIKeyHandler = handlers.NewAPIKeyHandler(
+		c.CreateAPIKeyUC,
+		c.ListAPIKeysUC,
+		c.RevokeAPIKeyUC,
+	)
+
+	if c.WebhookRepo != nil {
+		c.WebhookHandler = handlers.NewWebhookHandler(
+			c.CreateWebhookUC,
+			c.ListWebhooksUC,
+			c.UpdateWebhookUC,
+			c.DeleteWebhookUC,
+			c.ListWebhookDeliveriesUC,
+			c.SendTestWebhookUC,
+		)
+	}
+
+	c.InboundHandler = handlers.NewPlatformWebhookHandler(
+		c.VerifyWebhookSubscriptionUC,
+		c.ReceivePlatformWebhookUC,
+	)
+
+	c.OAuthHandler = handlers.NewOAuthHandler(
+		c.RegisterOAuthClientUC,
+		c.ListOAuthClientsUC,
+		c.DeleteOAuthClientUC,
+		c.GetOAuthConsentUC,
+		c.DecideOAuthConsentUC,
+		c.OAuthTokenUC,
+		c.IntrospectOAuthTokenUC,
+		c.RevokeOAuthTokenUC,
+	)
+
+	// Audit Log Handler
+	c.AuditLogHandler = handlers.NewAuditLogHandler(c.ListAuditLogUC)
+
 	// Post Handler
 	c.PostHandler = handlers.NewPostHandler(
 		c.CreateDraftUC,
@@ -680,15 +1372,62 @@ func (c *Container) initializeHandlers() error {
 			c.ListAccountsUC,
 			c.PublishPostUC,
 			c.GetAnalyticsUC,
+			c.GetAccountQuotaUC,
 			c.SocialAdapters,
 		)
+		c.AccessHandler = handlers.NewAccountAccessHandler(
+			c.GetAccountAccessUC,
+			c.SetAccountAccessUC,
+		)
+		c.InboxHandler = handlers.NewInboxHandler(
+			c.ListConversationsUC,
+			c.GetConversationUC,
+			c.UpdateConversationStateUC,
+			c.AssignConversationUC,
+			c.ReplyUC,
+		)
+		c.InboxRulesHandler = handlers.NewInboxRulesHandler(
+			c.ListSavedRepliesUC,
+			c.CreateSavedReplyUC,
+			c.UpdateSavedReplyUC,
+			c.DeleteSavedReplyUC,
+			c.ListInboxRulesUC,
+			c.CreateInboxRuleUC,
+			c.UpdateInboxRuleUC,
+			c.DeleteInboxRuleUC,
+			c.TestInboxRulesUC,
+		)
 		c.Logger.Info("✅ Social handler initialized successfully")
 	} else {
 		c.Logger.Warn("Social handler not initialized - social features unavailable")
 	}
 
+	if c.RealtimeStreamUC != nil {
+		c.RealtimeHandler = handlers.NewRealtimeHandler(c.RealtimeStreamUC, c.RealtimeTicketUC, c.Logger)
+	}
+
+	c.NotificationHandler = handlers.NewNotificationHandler(
+		c.ListNotificationsUC,
+		c.MarkNotificationReadUC,
+		c.MarkAllNotificationsReadUC,
+		c.GetNotificationPrefsUC,
+		c.UpdateNotificationPrefsUC,
+	)
+
+	// Admin Handler (job triggers require the worker queue)
+	var jobScheduler common.JobScheduler
+	if c.SchedulerControl != nil {
+		jobScheduler = c.SchedulerControl
+	} else {
+		c.Logger.Warn("Admin job routes unavailable - worker queue unavailable")
+	}
+	c.AdminHandler = handlers.NewAdminHandler(jobScheduler, c.GetAccountLockoutUC, c.UnlockAccountUC, c.ReplayPlatformWebhooksUC, c.ChangeTeamPlanUC)
+
 	// Auth Middleware
-	c.AuthMiddleware = middleware.NewAuthMiddleware(c.TokenService)
+	c.AuthMiddleware = middleware.NewAuthMiddleware(c.TokenService, c.AuthenticateAPIKeyUC)
+
+	// Team permission middleware
+	c.Policy = middleware.NewPolicyMiddleware(teamDomain.NewAuthorizer(c.MemberRepo, c.RoleRepo))
 
 	c.Logger.Info("✅ Handlers initialized successfully")
 	return nil
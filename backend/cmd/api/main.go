@@ -152,6 +152,11 @@ func (app *App) Run() error {
 	// Channel to listen for errors
 	serverErrors := make(chan error, 1)
 
+	// Relay domain events from the outbox to subscribers
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	go app.Container.EventBus.Run(relayCtx)
+
 	// Start HTTP server in goroutine
 	go func() {
 		log.Printf("🌐 Server listening on http://%s", app.Server.Addr)
@@ -215,4 +220,5 @@ func logAvailableEndpoints() {
 	log.Println("  GET  /api/v2/users/:id    - Get user (protected)")
 	log.Println("  POST /api/v2/teams        - Create team (protected)")
 	log.Println("  POST /api/v2/posts        - Create post (protected)")
+	log.Println("  GET  /api/v2/admin/jobs   - Worker job status (admin)")
 }
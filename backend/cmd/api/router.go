@@ -3,6 +3,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -11,7 +12,10 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 
+	socialAdapter "github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/common"
 	"github.com/techappsUT/social-queue/internal/handlers/routes"
+	appMiddleware "github.com/techappsUT/social-queue/internal/middleware"
 )
 
 // SetupRouter creates and configures the HTTP router ✅ Fixed: Capitalized
@@ -25,7 +29,8 @@ func SetupRouter(container *Container) *chi.Mux {
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(60 * time.Second))
+	r.Use(appMiddleware.AuditContext)
+	r.Use(appMiddleware.Timeout(60*time.Second, "/api/v2"+routes.RealtimeStreamPattern))
 
 	// CORS Configuration ✅ Fixed: Use container.Config.CORS.AllowedOrigins
 	r.Use(cors.Handler(cors.Options{
@@ -44,19 +49,38 @@ func SetupRouter(container *Container) *chi.Mux {
 	r.Get("/health", handleHealth(container))
 	r.Get("/version", handleVersion(container))
 
+	// SCIM 2.0 provisioning (team SCIM tokens)
+	routes.RegisterSCIMAPIRoutes(r, container.SCIMHandler)
+
+	// Inbound social platform webhooks (platform signatures)
+	routes.RegisterPlatformWebhookRoutes(r, container.InboundHandler)
+
 	// ============================================================================
 	// API V2 ROUTES (Clean Architecture)
 	// ============================================================================
 	r.Route("/api/v2", func(r chi.Router) {
 		// Auth routes (public: signup, login, etc.)
-		routes.RegisterAuthRoutes(r, container.AuthHandler, container.AuthMiddleware)
+		routes.RegisterAuthRoutes(r, container.AuthHandler, container.AuthMiddleware, container.RateLimiter)
+		routes.RegisterTwoFactorRoutes(r, container.TwoFactorHandler, container.AuthMiddleware)
+		routes.RegisterPasskeyRoutes(r, container.PasskeyHandler, container.AuthMiddleware)
+		routes.RegisterOIDCRoutes(r, container.OIDCHandler, container.AuthMiddleware)
+		routes.RegisterSSORoutes(r, container.SSOHandler, container.AuthMiddleware)
+		routes.RegisterSCIMRoutes(r, container.SCIMHandler, container.AuthMiddleware)
+		routes.RegisterRoleRoutes(r, container.RoleHandler, container.AuthMiddleware, container.Policy)
+		routes.RegisterAPIKeyRoutes(r, container.APIKeyHandler, container.AuthMiddleware)
+		routes.RegisterOAuthRoutes(r, container.OAuthHandler, container.AuthMiddleware)
+		routes.RegisterAuditLogRoutes(r, container.AuditLogHandler, container.AuthMiddleware, container.Policy)
+		routes.RegisterWebhookRoutes(r, container.WebhookHandler, container.AuthMiddleware, container.Policy)
+		routes.RegisterInboxRoutes(r, container.InboxHandler, container.AuthMiddleware, container.Policy)
+		routes.RegisterInboxRuleRoutes(r, container.InboxRulesHandler, container.AuthMiddleware, container.Policy)
+		routes.RegisterRealtimeRoutes(r, container.RealtimeHandler, container.AuthMiddleware)
 
 		// User routes (protected) ✅ Fixed: Use AuthHandler, not UserHandler
-		routes.RegisterUserRoutes(r, container.AuthHandler, container.AuthMiddleware)
+		routes.RegisterUserRoutes(r, container.AuthHandler, container.NotificationHandler, container.AuthMiddleware)
 
 		// Team routes (protected) ✅ Fixed: Add authMiddleware parameter
 		if container.TeamHandler != nil {
-			routes.RegisterTeamRoutes(r, container.TeamHandler, container.AuthMiddleware)
+			routes.RegisterTeamRoutes(r, container.TeamHandler, container.AuthMiddleware, container.Policy)
 		}
 
 		// Post routes (protected) ✅ Fixed: Add authMiddleware parameter
@@ -66,7 +90,13 @@ func SetupRouter(container *Container) *chi.Mux {
 
 		// Social routes (protected) ✅ Fixed: Add authMiddleware parameter
 		if container.SocialHandler != nil {
-			routes.RegisterSocialRoutes(r, container.SocialHandler, container.AuthMiddleware)
+			routes.RegisterSocialRoutes(r, container.SocialHandler, container.AuthMiddleware, container.Policy)
+			routes.RegisterAccountAccessRoutes(r, container.AccessHandler, container.AuthMiddleware, container.Policy)
+		}
+
+		// Admin routes (admin role required)
+		if container.AdminHandler != nil {
+			routes.RegisterAdminRoutes(r, container.AdminHandler, container.AuthMiddleware)
 		}
 	})
 
@@ -110,9 +140,11 @@ func handleHealth(container *Container) http.HandlerFunc {
 			}
 		}
 
+		platforms, platformsHealthy := socialPlatformHealth(r.Context(), container)
+
 		status := "healthy"
 		statusCode := http.StatusOK
-		if !dbHealthy || !redisHealthy {
+		if !dbHealthy || !redisHealthy || !platformsHealthy {
 			status = "degraded"
 			if !dbHealthy {
 				status = "unhealthy"
@@ -135,8 +167,9 @@ func handleHealth(container *Container) http.HandlerFunc {
 			},
 			"features": map[string]interface{}{
 				"social_oauth": map[string]interface{}{
-					"enabled":  container.SocialHandler != nil,
-					"adapters": len(container.SocialAdapters),
+					"enabled":   container.SocialHandler != nil,
+					"adapters":  len(container.SocialAdapters),
+					"platforms": platforms,
 				},
 			},
 		}
@@ -147,6 +180,45 @@ func handleHealth(container *Container) http.HandlerFunc {
 	}
 }
 
+// socialPlatformHealth reports each configured platform as operational,
+// degraded (some endpoint circuit not closed) or outage (publishing paused)
+func socialPlatformHealth(ctx context.Context, container *Container) (map[string]interface{}, bool) {
+	circuits := make(map[string][]common.CircuitState)
+	healthy := true
+	if container.CircuitBreaker != nil {
+		states, err := container.CircuitBreaker.States(ctx)
+		if err == nil {
+			for _, s := range states {
+				circuits[s.Platform] = append(circuits[s.Platform], s)
+			}
+		}
+	}
+
+	platforms := make(map[string]interface{}, len(container.SocialAdapters))
+	for platform := range container.SocialAdapters {
+		status := "operational"
+		open := circuits[string(platform)]
+		if open == nil {
+			open = []common.CircuitState{}
+		}
+		for _, s := range open {
+			healthy = false
+			if s.Endpoint == socialAdapter.EndpointPublish && s.State == common.CircuitOpen {
+				status = "outage"
+			} else if status != "outage" {
+				status = "degraded"
+			}
+		}
+
+		platforms[string(platform)] = map[string]interface{}{
+			"status":   status,
+			"circuits": open,
+		}
+	}
+
+	return platforms, healthy
+}
+
 func handleVersion(container *Container) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		response := map[string]interface{}{
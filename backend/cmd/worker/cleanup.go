@@ -20,7 +20,6 @@ type CleanupProcessor struct {
 	db           *sql.DB
 	queueService *services.WorkerQueueService
 	logger       common.Logger
-	stopChan     chan struct{}
 }
 
 // NewCleanupProcessor creates a new cleanup processor
@@ -33,7 +32,6 @@ func NewCleanupProcessor(
 		db:           db,
 		queueService: queueService,
 		logger:       logger,
-		stopChan:     make(chan struct{}),
 	}
 }
 
@@ -42,45 +40,24 @@ func (p *CleanupProcessor) Name() string {
 	return "CleanupProcessor"
 }
 
-// Run starts the processor loop
-func (p *CleanupProcessor) Run(ctx context.Context) error {
-	p.logger.Info("CleanupProcessor started (runs daily at 2 AM)")
+// DefaultSchedule runs daily at 2 AM (UTC)
+func (p *CleanupProcessor) DefaultSchedule() string {
+	return "0 2 * * *"
+}
 
-	// Calculate time until next 2 AM
-	now := time.Now()
-	next2AM := time.Date(now.Year(), now.Month(), now.Day(), 2, 0, 0, 0, now.Location())
-	if now.After(next2AM) {
-		next2AM = next2AM.Add(24 * time.Hour)
-	}
+// Singleton is true: cleanup runs once cluster-wide
+func (p *CleanupProcessor) Singleton() bool {
+	return true
+}
 
-	// Wait until 2 AM
-	timer := time.NewTimer(time.Until(next2AM))
-	defer timer.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			p.logger.Info("CleanupProcessor stopping (context cancelled)")
-			return nil
-		case <-p.stopChan:
-			p.logger.Info("CleanupProcessor stopped")
-			return nil
-		case <-timer.C:
-			// Run cleanup tasks
-			if err := p.runCleanup(ctx); err != nil {
-				p.logger.Error(fmt.Sprintf("Cleanup failed: %v", err))
-			}
-
-			// Schedule next run (24 hours from now)
-			timer.Reset(24 * time.Hour)
-		}
-	}
+// Execute runs all cleanup tasks
+func (p *CleanupProcessor) Execute(ctx context.Context) error {
+	return p.runCleanup(ctx)
 }
 
 // Stop gracefully stops the processor
 func (p *CleanupProcessor) Stop(ctx context.Context) error {
 	p.logger.Info("Stopping CleanupProcessor...")
-	close(p.stopChan)
 	return nil
 }
 
@@ -110,7 +87,37 @@ func (p *CleanupProcessor) runCleanup(ctx context.Context) error {
 		p.logger.Error(fmt.Sprintf("Failed to cleanup DLQ: %v", err))
 	}
 
-	// Task 5: Vacuum database (optional, for PostgreSQL)
+	// Task 5: Delete delivered outbox events (7+ days)
+	if err := p.cleanupEventOutbox(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup event outbox: %v", err))
+	}
+
+	// Task 6: Delete finished webhook deliveries (30+ days)
+	if err := p.cleanupWebhookDeliveries(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup webhook deliveries: %v", err))
+	}
+
+	// Task 7: Delete processed platform webhooks (30+ days)
+	if err := p.cleanupPlatformWebhooks(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup platform webhooks: %v", err))
+	}
+
+	// Task 8: Delete inbox rule runs (7+ days)
+	if err := p.cleanupInboxRuleRuns(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup inbox rule runs: %v", err))
+	}
+
+	// Task 9: Delete read and emailed notifications (90+ days)
+	if err := p.cleanupNotifications(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup notifications: %v", err))
+	}
+
+	// Task 10: Delete sent (7+ days) and given up (30+ days) emails
+	if err := p.cleanupEmailOutbox(ctx); err != nil {
+		p.logger.Error(fmt.Sprintf("Failed to cleanup email outbox: %v", err))
+	}
+
+	// Task 11: Vacuum database (optional, for PostgreSQL)
 	if err := p.vacuumDatabase(ctx); err != nil {
 		p.logger.Error(fmt.Sprintf("Failed to vacuum database: %v", err))
 	}
@@ -232,6 +239,136 @@ func (p *CleanupProcessor) vacuumDatabase(ctx context.Context) error {
 	return nil
 }
 
+// cleanupEventOutbox deletes outbox events delivered more than 7 days ago.
+// Events that were given up on are kept for inspection.
+func (p *CleanupProcessor) cleanupEventOutbox(ctx context.Context) error {
+	p.logger.Info("Cleaning up delivered outbox events (7+ days)...")
+
+	cutoffDate := time.Now().AddDate(0, 0, -7)
+
+	query := `
+		DELETE FROM event_outbox
+		WHERE dispatched_at < $1
+	`
+
+	result, err := p.db.ExecContext(ctx, query, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup event outbox: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d delivered outbox events", rowsAffected))
+
+	return nil
+}
+
+// cleanupWebhookDeliveries deletes webhook delivery logs that finished more
+// than 30 days ago
+func (p *CleanupProcessor) cleanupWebhookDeliveries(ctx context.Context) error {
+	p.logger.Info("Cleaning up finished webhook deliveries (30+ days)...")
+
+	cutoffDate := time.Now().AddDate(0, 0, -30)
+
+	query := `
+		DELETE FROM webhook_deliveries
+		WHERE completed_at < $1
+	`
+
+	result, err := p.db.ExecContext(ctx, query, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup webhook deliveries: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d webhook deliveries", rowsAffected))
+
+	return nil
+}
+
+// cleanupPlatformWebhooks deletes logged platform webhooks that were
+// processed more than 30 days ago. Until then they can be replayed.
+func (p *CleanupProcessor) cleanupPlatformWebhooks(ctx context.Context) error {
+	p.logger.Info("Cleaning up processed platform webhooks (30+ days)...")
+
+	cutoffDate := time.Now().AddDate(0, 0, -30)
+
+	query := `
+		DELETE FROM webhooks_log
+		WHERE source = 'social_platform' AND processed AND processed_at < $1
+	`
+
+	result, err := p.db.ExecContext(ctx, query, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup platform webhooks: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d platform webhooks", rowsAffected))
+
+	return nil
+}
+
+// cleanupInboxRuleRuns deletes inbox rule runs older than 7 days. Rules
+// only count runs of the last hour, and messages are not evaluated again.
+func (p *CleanupProcessor) cleanupInboxRuleRuns(ctx context.Context) error {
+	p.logger.Info("Cleaning up inbox rule runs (7+ days)...")
+
+	cutoffDate := time.Now().AddDate(0, 0, -7)
+
+	result, err := p.db.ExecContext(ctx, `DELETE FROM inbox_rule_runs WHERE ran_at < $1`, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup inbox rule runs: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d inbox rule runs", rowsAffected))
+
+	return nil
+}
+
+// cleanupNotifications deletes notifications older than 90 days that are
+// no longer unread in the app nor waiting for a digest
+func (p *CleanupProcessor) cleanupNotifications(ctx context.Context) error {
+	p.logger.Info("Cleaning up notifications (90+ days)...")
+
+	cutoffDate := time.Now().AddDate(0, 0, -90)
+
+	result, err := p.db.ExecContext(ctx, `
+		DELETE FROM notifications
+		WHERE created_at < $1
+			AND NOT email_pending
+			AND (read_at IS NOT NULL OR NOT in_app)
+	`, cutoffDate)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup notifications: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d notifications", rowsAffected))
+
+	return nil
+}
+
+// cleanupEmailOutbox deletes sent emails after 7 days, as their links carry
+// tokens, and emails given up on after 30 days
+func (p *CleanupProcessor) cleanupEmailOutbox(ctx context.Context) error {
+	p.logger.Info("Cleaning up email outbox...")
+
+	now := time.Now()
+	result, err := p.db.ExecContext(ctx, `
+		DELETE FROM email_outbox
+		WHERE sent_at < $1 OR failed_at < $2
+	`, now.AddDate(0, 0, -7), now.AddDate(0, 0, -30))
+	if err != nil {
+		return fmt.Errorf("failed to cleanup email outbox: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	p.logger.Info(fmt.Sprintf("Deleted %d outbox emails", rowsAffected))
+
+	return nil
+}
+
 // Additional utility methods
 
 // cleanupOldJobRuns deletes job run records older than 30 days
@@ -0,0 +1,104 @@
+// ============================================================================
+// FILE: backend/cmd/worker/email.go
+// PURPOSE: Processor sending the email outbox
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// EmailOutboxProcessor sends the emails queued by the API and the worker
+type EmailOutboxProcessor struct {
+	dispatcher *services.MailDispatcher
+	logger     common.Logger
+}
+
+// NewEmailOutboxProcessor creates a new email outbox processor
+func NewEmailOutboxProcessor(dispatcher *services.MailDispatcher, logger common.Logger) *EmailOutboxProcessor {
+	return &EmailOutboxProcessor{dispatcher: dispatcher, logger: logger}
+}
+
+// Name returns the processor name
+func (p *EmailOutboxProcessor) Name() string {
+	return "EmailOutboxProcessor"
+}
+
+// DefaultSchedule polls for queued emails every 10 seconds
+func (p *EmailOutboxProcessor) DefaultSchedule() string {
+	return "@every 10s"
+}
+
+// Singleton is false: emails are claimed, so replicas share the work
+func (p *EmailOutboxProcessor) Singleton() bool {
+	return false
+}
+
+// Execute sends due emails until none are left or ctx is canceled
+func (p *EmailOutboxProcessor) Execute(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := p.dispatcher.DispatchPending(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to send emails: %w", err)
+		}
+		total += n
+		if n == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		p.logger.Info(fmt.Sprintf("Processed %d queued emails", total))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *EmailOutboxProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping EmailOutboxProcessor...")
+	return nil
+}
+
+// newEmailWorkers builds the email service queuing the worker's emails and
+// the dispatcher sending the outbox through EMAIL_PROVIDER
+func newEmailWorkers(database *sql.DB, logger common.Logger) (common.EmailService, *services.MailDispatcher, error) {
+	requireTLS, err := strconv.ParseBool(getEnv("SMTP_REQUIRE_TLS", "true"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid SMTP_REQUIRE_TLS: %w", err)
+	}
+	config := services.EmailConfig{
+		Provider:       getEnv("EMAIL_PROVIDER", "mock"),
+		APIKey:         os.Getenv("SENDGRID_API_KEY"),
+		FromAddress:    getEnv("EMAIL_FROM", "noreply@socialqueue.com"),
+		FromName:       getEnv("EMAIL_FROM_NAME", "SocialQueue"),
+		SMTPHost:       os.Getenv("SMTP_HOST"),
+		SMTPPort:       getEnvInt("SMTP_PORT", 587),
+		SMTPUser:       os.Getenv("SMTP_USER"),
+		SMTPPassword:   os.Getenv("SMTP_PASSWORD"),
+		SMTPRequireTLS: requireTLS,
+	}
+
+	outbox := persistence.NewEmailOutboxRepository(database)
+	email, err := services.NewEmailService(config, outbox, persistence.NewUserLocaleRepository(database), logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transport, err := services.NewMailTransport(config, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := transport.(*services.MailCatcher); ok {
+		logger.Warn("EMAIL_PROVIDER is not smtp or sendgrid, emails are caught and not sent")
+	}
+	return email, services.NewMailDispatcher(outbox, transport, services.DefaultMailDispatcherConfig, logger), nil
+}
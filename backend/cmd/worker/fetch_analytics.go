@@ -8,6 +8,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/techappsUT/social-queue/internal/application/common"
@@ -19,21 +21,23 @@ import (
 type FetchAnalyticsProcessor struct {
 	postRepo     post.Repository
 	queueService *services.WorkerQueueService
+	pool         *services.FairWorkerPool
 	logger       common.Logger
-	stopChan     chan struct{}
 }
 
-// NewFetchAnalyticsProcessor creates a new analytics processor
+// NewFetchAnalyticsProcessor creates a new analytics processor that fetches
+// metrics for up to concurrency posts at a time
 func NewFetchAnalyticsProcessor(
 	postRepo post.Repository,
 	queueService *services.WorkerQueueService,
+	concurrency int,
 	logger common.Logger,
 ) *FetchAnalyticsProcessor {
 	return &FetchAnalyticsProcessor{
 		postRepo:     postRepo,
 		queueService: queueService,
+		pool:         services.NewFairWorkerPool("analytics", concurrency, nil, logger),
 		logger:       logger,
-		stopChan:     make(chan struct{}),
 	}
 }
 
@@ -42,39 +46,25 @@ func (p *FetchAnalyticsProcessor) Name() string {
 	return "FetchAnalyticsProcessor"
 }
 
-// Run starts the processor loop
-func (p *FetchAnalyticsProcessor) Run(ctx context.Context) error {
-	ticker := time.NewTicker(6 * time.Hour)
-	defer ticker.Stop()
-
-	p.logger.Info("FetchAnalyticsProcessor started (runs every 6 hours)")
+// DefaultSchedule runs every 6 hours
+func (p *FetchAnalyticsProcessor) DefaultSchedule() string {
+	return "0 */6 * * *"
+}
 
-	// Run immediately on startup
-	if err := p.fetchAnalytics(ctx); err != nil {
-		p.logger.Error(fmt.Sprintf("Error fetching analytics: %v", err))
-	}
+// Singleton is true: analytics are fetched once cluster-wide
+func (p *FetchAnalyticsProcessor) Singleton() bool {
+	return true
+}
 
-	for {
-		select {
-		case <-ctx.Done():
-			p.logger.Info("FetchAnalyticsProcessor stopping (context cancelled)")
-			return nil
-		case <-p.stopChan:
-			p.logger.Info("FetchAnalyticsProcessor stopped")
-			return nil
-		case <-ticker.C:
-			if err := p.fetchAnalytics(ctx); err != nil {
-				p.logger.Error(fmt.Sprintf("Error fetching analytics: %v", err))
-			}
-		}
-	}
+// Execute fetches analytics for published posts
+func (p *FetchAnalyticsProcessor) Execute(ctx context.Context) error {
+	return p.fetchAnalytics(ctx)
 }
 
 // Stop gracefully stops the processor
 func (p *FetchAnalyticsProcessor) Stop(ctx context.Context) error {
 	p.logger.Info("Stopping FetchAnalyticsProcessor...")
-	close(p.stopChan)
-	return nil
+	return p.pool.Stop(ctx)
 }
 
 // fetchAnalytics fetches analytics for published posts
@@ -98,19 +88,42 @@ func (p *FetchAnalyticsProcessor) fetchAnalytics(ctx context.Context) error {
 
 	p.logger.Info(fmt.Sprintf("Found %d published posts to fetch analytics for", len(posts)))
 
-	successCount := 0
-	failureCount := 0
-
-	for _, post := range posts {
-		if err := p.fetchPostAnalytics(ctx, post); err != nil {
-			p.logger.Error(fmt.Sprintf("Failed to fetch analytics for post %s: %v", post.ID(), err))
-			failureCount++
-			continue
+	var successCount, failureCount atomic.Int32
+	var wg sync.WaitGroup
+
+	for _, publishedPost := range posts {
+		wg.Add(1)
+		submitted := p.pool.Submit(&services.PoolTask{
+			ID:       publishedPost.ID().String(),
+			GroupKey: publishedPost.TeamID().String(),
+			Run: func(taskCtx context.Context) error {
+				defer wg.Done()
+				if err := p.fetchPostAnalytics(taskCtx, publishedPost); err != nil {
+					failureCount.Add(1)
+					return err
+				}
+				successCount.Add(1)
+				return nil
+			},
+		})
+		if !submitted {
+			wg.Done()
 		}
-		successCount++
 	}
 
-	p.logger.Info(fmt.Sprintf("✅ Analytics fetch completed: %d succeeded, %d failed", successCount, failureCount))
+	// Wait for this run's tasks so the singleton run lock covers them
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("analytics fetch interrupted: %w", ctx.Err())
+	}
+
+	p.logger.Info(fmt.Sprintf("✅ Analytics fetch completed: %d succeeded, %d failed", successCount.Load(), failureCount.Load()))
 	return nil
 }
 
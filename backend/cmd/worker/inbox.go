@@ -0,0 +1,174 @@
+// ============================================================================
+// FILE: backend/cmd/worker/inbox.go
+// PURPOSE: Processors syncing the inbox and running its rules
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	inboxUC "github.com/techappsUT/social-queue/internal/application/inbox"
+	"github.com/techappsUT/social-queue/internal/db"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// InboxSyncProcessor fetches new comments, mentions and messages of the
+// accounts whose platform sends no webhooks
+type InboxSyncProcessor struct {
+	syncer *inboxUC.Syncer
+	logger common.Logger
+}
+
+// NewInboxSyncProcessor creates a new inbox sync processor
+func NewInboxSyncProcessor(syncer *inboxUC.Syncer, logger common.Logger) *InboxSyncProcessor {
+	return &InboxSyncProcessor{syncer: syncer, logger: logger}
+}
+
+// Name returns the processor name
+func (p *InboxSyncProcessor) Name() string {
+	return "InboxSyncProcessor"
+}
+
+// DefaultSchedule syncs every 5 minutes
+func (p *InboxSyncProcessor) DefaultSchedule() string {
+	return "@every 5m"
+}
+
+// Singleton is true: replicas would read the same windows twice
+func (p *InboxSyncProcessor) Singleton() bool {
+	return true
+}
+
+// Execute syncs every polled account once
+func (p *InboxSyncProcessor) Execute(ctx context.Context) error {
+	n, err := p.syncer.SyncAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to sync inbox: %w", err)
+	}
+	if n > 0 {
+		p.logger.Info(fmt.Sprintf("Synced inbox of %d social accounts", n))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *InboxSyncProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping InboxSyncProcessor...")
+	return nil
+}
+
+// InboxRuleProcessor runs the teams' inbox rules on received messages
+type InboxRuleProcessor struct {
+	engine *inboxUC.RuleEngine
+	logger common.Logger
+}
+
+// NewInboxRuleProcessor creates a new inbox rule processor
+func NewInboxRuleProcessor(engine *inboxUC.RuleEngine, logger common.Logger) *InboxRuleProcessor {
+	return &InboxRuleProcessor{engine: engine, logger: logger}
+}
+
+// Name returns the processor name
+func (p *InboxRuleProcessor) Name() string {
+	return "InboxRuleProcessor"
+}
+
+// DefaultSchedule polls for received messages every 10 seconds
+func (p *InboxRuleProcessor) DefaultSchedule() string {
+	return "@every 10s"
+}
+
+// Singleton is false: messages are claimed, so replicas share the work
+func (p *InboxRuleProcessor) Singleton() bool {
+	return false
+}
+
+// Execute runs the rules until no message is left or ctx is canceled
+func (p *InboxRuleProcessor) Execute(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := p.engine.ProcessDue(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to run inbox rules: %w", err)
+		}
+		total += n
+		if n == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		p.logger.Info(fmt.Sprintf("Ran inbox rules on %d messages", total))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *InboxRuleProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping InboxRuleProcessor...")
+	return nil
+}
+
+// newInboxWorkers builds the inbox syncer and rule engine. They are nil if
+// ENCRYPTION_KEY or every platform credential is missing.
+func newInboxWorkers(
+	database *sql.DB,
+	queries *db.Queries,
+	limiter common.PlatformRateLimiter,
+	breaker common.CircuitBreaker,
+	tx common.Transactor,
+	events common.EventBus,
+	logger common.Logger,
+) (*inboxUC.Syncer, *inboxUC.RuleEngine, error) {
+	encryptionKey := os.Getenv("ENCRYPTION_KEY")
+	if encryptionKey == "" {
+		logger.Warn("ENCRYPTION_KEY not set, inbox will not be synced and its rules will not run")
+		return nil, nil, nil
+	}
+
+	encryption, err := services.NewEncryptionService(encryptionKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
+	adapters := newSocialAdapters(breaker)
+	if len(adapters) == 0 {
+		return nil, nil, nil
+	}
+
+	accountRepo := persistence.NewSocialRepository(database, queries, encryption)
+	teamRepo := persistence.NewTeamRepository(database)
+	inboxRepo := persistence.NewInboxRepository(database)
+
+	syncer := inboxUC.NewSyncer(
+		accountRepo,
+		teamRepo,
+		inboxRepo,
+		adapters,
+		newWebhookParsers(),
+		limiter,
+		tx,
+		events,
+		logger,
+	)
+	engine := inboxUC.NewRuleEngine(
+		inboxRepo,
+		inboxRepo,
+		persistence.NewInboxRuleRepository(database),
+		teamRepo,
+		persistence.NewTeamMemberRepository(database),
+		persistence.NewRoleRepository(database),
+		accountRepo,
+		adapters,
+		limiter,
+		tx,
+		events,
+		logger,
+	)
+	return syncer, engine, nil
+}
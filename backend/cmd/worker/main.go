@@ -12,15 +12,18 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
-
 	"github.com/techappsUT/social-queue/internal/application/common"
+	inboxUC "github.com/techappsUT/social-queue/internal/application/inbox"
+	notificationUC "github.com/techappsUT/social-queue/internal/application/notification"
 	"github.com/techappsUT/social-queue/internal/db"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
 	"github.com/techappsUT/social-queue/internal/infrastructure/services"
 )
@@ -31,13 +34,20 @@ type WorkerApp struct {
 	Redis        *redis.Client
 	Logger       common.Logger
 	QueueService *services.WorkerQueueService
+	Scheduler    *Scheduler
 	Processors   []JobProcessor
+	Events       *services.EventStreamConsumer
 }
 
 // JobProcessor interface for all job processors
 type JobProcessor interface {
 	Name() string
-	Run(ctx context.Context) error
+	// DefaultSchedule is the cron expression used unless overridden via env
+	DefaultSchedule() string
+	// Singleton jobs run on the elected leader only (once cluster-wide)
+	Singleton() bool
+	// Execute performs a single run of the job
+	Execute(ctx context.Context) error
 	Stop(ctx context.Context) error
 }
 
@@ -86,41 +96,200 @@ func NewWorkerApp() (*WorkerApp, error) {
 	// Initialize repositories
 	postRepo := persistence.NewPostRepository(database, queries)
 
+	// Platform publisher (idempotent, reconciles interrupted attempts)
+	breaker := services.NewRedisCircuitBreaker(redisClient, services.DefaultCircuitFailureThreshold, services.DefaultCircuitCooldown, logger)
+	limiter := services.NewPlatformRateLimiter(redisClient)
+	publisher, err := newPublisher(database, queries, postRepo, limiter, breaker, logger)
+	if err != nil {
+		return nil, fmt.Errorf("publisher initialization failed: %w", err)
+	}
+
+	// Domain events: written to the outbox with the change, relayed by the
+	// API; events from the API arrive on the stream
+	transactor := persistence.NewTransactor(database)
+	eventBus := services.NewEventBus(persistence.NewOutboxRepository(database), nil, services.DefaultEventBusConfig, logger)
+	eventConsumer := services.NewEventStreamConsumer(
+		redisClient,
+		services.DefaultEventStream,
+		"worker",
+		workerConsumerName(),
+		services.DefaultEventStreamConsumerConfig,
+		logger,
+	)
+
+	// Outgoing team webhooks: first attempts as events arrive, retries on
+	// a schedule
+	webhookDispatcher, err := newWebhookDispatcher(database, logger)
+	if err != nil {
+		return nil, fmt.Errorf("webhook initialization failed: %w", err)
+	}
+	if webhookDispatcher != nil {
+		if err := eventConsumer.Subscribe(services.AllEvents, webhookDispatcher.HandleEvent); err != nil {
+			return nil, fmt.Errorf("failed to subscribe webhooks: %w", err)
+		}
+	}
+
+	// Inbound platform webhooks logged by the API
+	platformWebhooks, err := newPlatformWebhookDispatcher(database, queries, transactor, eventBus, logger)
+	if err != nil {
+		return nil, fmt.Errorf("platform webhook initialization failed: %w", err)
+	}
+
+	// Unified inbox: interactions from webhooks and sync are filed as they
+	// arrive; platforms without webhooks are polled and the teams' rules
+	// run on what was received
+	inboxIngester := inboxUC.NewIngester(
+		persistence.NewInboxRepository(database),
+		persistence.NewTeamRepository(database),
+		transactor,
+		eventBus,
+		logger,
+	)
+	if err := eventConsumer.Subscribe(socialDomain.EventInteractionReceived, inboxIngester.HandleInteraction); err != nil {
+		return nil, fmt.Errorf("failed to subscribe inbox: %w", err)
+	}
+	inboxSyncer, inboxRules, err := newInboxWorkers(database, queries, limiter, breaker, transactor, eventBus, logger)
+	if err != nil {
+		return nil, fmt.Errorf("inbox sync initialization failed: %w", err)
+	}
+
+	// Emails are queued in the outbox and sent with retries
+	emailService, mailDispatcher, err := newEmailWorkers(database, logger)
+	if err != nil {
+		return nil, fmt.Errorf("email initialization failed: %w", err)
+	}
+
+	// Notifications: raised as events arrive, emailed in digests
+	notifier, notificationProcessors, err := newNotificationWorkers(database, queries, emailService, transactor, logger)
+	if err != nil {
+		return nil, fmt.Errorf("notification initialization failed: %w", err)
+	}
+	for _, eventType := range notificationUC.EventTypes() {
+		if err := eventConsumer.Subscribe(eventType, notifier.HandleEvent); err != nil {
+			return nil, fmt.Errorf("failed to subscribe notifications: %w", err)
+		}
+	}
+
+	// Distributed locks for leader election, per-post and per-account locking
+	locker := newDistributedLocker(database, redisClient, logger)
+
 	// Initialize job processors
 	processors := []JobProcessor{
-		NewPublishPostProcessor(postRepo, queueService, logger),
-		NewFetchAnalyticsProcessor(postRepo, queueService, logger),
+		NewPublishPostProcessor(
+			postRepo,
+			queueService,
+			locker,
+			publisher,
+			breaker,
+			transactor,
+			eventBus,
+			getEnvInt("WORKER_PUBLISH_CONCURRENCY", 10),
+			getEnvInt("WORKER_PUBLISH_PER_TEAM_LIMIT", 20),
+			logger,
+		),
+		NewFetchAnalyticsProcessor(postRepo, queueService, getEnvInt("WORKER_ANALYTICS_CONCURRENCY", 4), logger),
+		NewEmailOutboxProcessor(mailDispatcher, logger),
 		NewCleanupProcessor(database, queueService, logger),
 	}
+	if webhookDispatcher != nil {
+		processors = append(processors, NewWebhookRetryProcessor(webhookDispatcher, logger))
+	}
+	if platformWebhooks != nil {
+		processors = append(processors, NewPlatformWebhookProcessor(platformWebhooks, logger))
+	}
+	if inboxSyncer != nil {
+		processors = append(processors, NewInboxSyncProcessor(inboxSyncer, logger))
+	}
+	if inboxRules != nil {
+		processors = append(processors, NewInboxRuleProcessor(inboxRules, logger))
+	}
+	processors = append(processors, notificationProcessors...)
+
+	// Initialize scheduler with leader election
+	elector := services.NewLeaderElector(locker, services.DefaultLeaderKey, services.DefaultLeaderLeaseTTL, logger)
+	control := services.NewSchedulerControl(redisClient, queueService, logger)
+	scheduler := NewScheduler(elector, locker, control, queueService, logger)
+	for _, p := range processors {
+		if err := scheduler.Register(p); err != nil {
+			return nil, fmt.Errorf("failed to schedule %s: %w", p.Name(), err)
+		}
+	}
 
 	return &WorkerApp{
 		DB:           database,
 		Redis:        redisClient,
 		Logger:       logger,
 		QueueService: queueService,
+		Scheduler:    scheduler,
 		Processors:   processors,
+		Events:       eventConsumer,
 	}, nil
 }
 
+// newDistributedLocker selects the lock backend from WORKER_LEADER_BACKEND
+// ("redis" by default, or "postgres" for advisory locks)
+func newDistributedLocker(database *sql.DB, redisClient *redis.Client, logger common.Logger) services.DistributedLocker {
+	switch os.Getenv("WORKER_LEADER_BACKEND") {
+	case "postgres":
+		logger.Info("✓ Using PostgreSQL advisory locks for leader election")
+		return services.NewPostgresLocker(database)
+	default:
+		logger.Info("✓ Using Redis locks for leader election")
+		return services.NewRedisLocker(redisClient)
+	}
+}
+
+// workerConsumerName identifies this replica in the event consumer group
+func workerConsumerName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "worker"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// getEnv reads a variable from the environment, falling back to def
+func getEnv(key, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
+
+// getEnvInt reads a positive integer from the environment, falling back to def
+func getEnvInt(key string, def int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return def
+	}
+	return value
+}
+
 // Start starts all job processors
 func (app *WorkerApp) Start() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Start each processor in a goroutine
-	for _, processor := range app.Processors {
-		go func(p JobProcessor) {
-			app.Logger.Info(fmt.Sprintf("▶️  Starting processor: %s", p.Name()))
-			if err := p.Run(ctx); err != nil {
-				app.Logger.Error(fmt.Sprintf("Processor %s failed: %v", p.Name(), err))
-			}
-		}(processor)
-	}
+	// Start the scheduler (drives every processor)
+	app.Scheduler.Start(ctx)
+
+	// Consume domain events relayed by the API
+	eventsDone := make(chan struct{})
+	go func() {
+		defer close(eventsDone)
+		if err := app.Events.Run(ctx); err != nil {
+			app.Logger.Error(fmt.Sprintf("Event consumer stopped: %v", err))
+		}
+	}()
 
 	app.Logger.Info("✨ Worker started successfully")
-	app.Logger.Info("📊 Active processors:")
-	for _, p := range app.Processors {
-		app.Logger.Info(fmt.Sprintf("   • %s", p.Name()))
+	app.Logger.Info("📊 Scheduled jobs:")
+	for _, job := range app.Scheduler.jobList() {
+		singleton := ""
+		if job.processor.Singleton() {
+			singleton = " (singleton)"
+		}
+		app.Logger.Info(fmt.Sprintf("   • %s [%s]%s", job.key, job.schedule, singleton))
 	}
 
 	// Wait for interrupt signal
@@ -130,10 +299,20 @@ func (app *WorkerApp) Start() {
 
 	app.Logger.Info("🛑 Shutting down worker...")
 
-	// Stop all processors gracefully
+	// Stop scheduling new runs, then let in-flight runs finish
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
+	cancel()
+	if err := app.Scheduler.Wait(shutdownCtx); err != nil {
+		app.Logger.Error(fmt.Sprintf("Scheduler did not drain: %v", err))
+	}
+	select {
+	case <-eventsDone:
+	case <-shutdownCtx.Done():
+		app.Logger.Error("Event consumer did not stop in time")
+	}
+
 	for _, processor := range app.Processors {
 		if err := processor.Stop(shutdownCtx); err != nil {
 			app.Logger.Error(fmt.Sprintf("Failed to stop processor %s: %v", processor.Name(), err))
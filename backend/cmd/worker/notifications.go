@@ -0,0 +1,163 @@
+// ============================================================================
+// FILE: backend/cmd/worker/notifications.go
+// PURPOSE: Processors emailing notification digests and warning of
+//          expiring tokens
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	notificationUC "github.com/techappsUT/social-queue/internal/application/notification"
+	"github.com/techappsUT/social-queue/internal/db"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// NotificationDigestProcessor emails users their pending notifications
+type NotificationDigestProcessor struct {
+	sender *notificationUC.DigestSender
+	logger common.Logger
+}
+
+// NewNotificationDigestProcessor creates a new notification digest processor
+func NewNotificationDigestProcessor(sender *notificationUC.DigestSender, logger common.Logger) *NotificationDigestProcessor {
+	return &NotificationDigestProcessor{sender: sender, logger: logger}
+}
+
+// Name returns the processor name
+func (p *NotificationDigestProcessor) Name() string {
+	return "NotificationDigestProcessor"
+}
+
+// DefaultSchedule checks for due digests every 5 minutes
+func (p *NotificationDigestProcessor) DefaultSchedule() string {
+	return "@every 5m"
+}
+
+// Singleton is true: two replicas could email the same user a digest each
+func (p *NotificationDigestProcessor) Singleton() bool {
+	return true
+}
+
+// Execute sends the digests that are due
+func (p *NotificationDigestProcessor) Execute(ctx context.Context) error {
+	n, err := p.sender.SendDue(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to send notification digests: %w", err)
+	}
+	if n > 0 {
+		p.logger.Info(fmt.Sprintf("Sent %d notification digests", n))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *NotificationDigestProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping NotificationDigestProcessor...")
+	return nil
+}
+
+// TokenExpiryProcessor notifies teams of social account tokens that
+// expire soon
+type TokenExpiryProcessor struct {
+	notifier *notificationUC.Notifier
+	logger   common.Logger
+}
+
+// NewTokenExpiryProcessor creates a new token expiry processor
+func NewTokenExpiryProcessor(notifier *notificationUC.Notifier, logger common.Logger) *TokenExpiryProcessor {
+	return &TokenExpiryProcessor{notifier: notifier, logger: logger}
+}
+
+// Name returns the processor name
+func (p *TokenExpiryProcessor) Name() string {
+	return "TokenExpiryProcessor"
+}
+
+// DefaultSchedule checks hourly
+func (p *TokenExpiryProcessor) DefaultSchedule() string {
+	return "@hourly"
+}
+
+// Singleton is true: notifications are deduplicated, but there is no
+// point checking twice
+func (p *TokenExpiryProcessor) Singleton() bool {
+	return true
+}
+
+// Execute notifies of the tokens expiring soon
+func (p *TokenExpiryProcessor) Execute(ctx context.Context) error {
+	n, err := p.notifier.NotifyExpiringTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to notify expiring tokens: %w", err)
+	}
+	if n > 0 {
+		p.logger.Info(fmt.Sprintf("Raised %d token expiry notifications", n))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *TokenExpiryProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping TokenExpiryProcessor...")
+	return nil
+}
+
+// newNotificationWorkers builds the notifier and the processors emailing
+// digests and checking tokens. Tokens are not checked if ENCRYPTION_KEY is
+// missing, as accounts cannot be loaded without it.
+func newNotificationWorkers(
+	database *sql.DB,
+	queries *db.Queries,
+	email common.EmailService,
+	tx common.Transactor,
+	logger common.Logger,
+) (*notificationUC.Notifier, []JobProcessor, error) {
+	repo := persistence.NewNotificationRepository(database)
+	prefRepo := persistence.NewNotificationPreferenceRepository(database)
+
+	var accountRepo socialDomain.AccountRepository
+	if encryptionKey := os.Getenv("ENCRYPTION_KEY"); encryptionKey != "" {
+		encryption, err := services.NewEncryptionService(encryptionKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create encryption service: %w", err)
+		}
+		accountRepo = persistence.NewSocialRepository(database, queries, encryption)
+	} else {
+		logger.Warn("ENCRYPTION_KEY not set, expiring tokens will not be notified")
+	}
+
+	notifier := notificationUC.NewNotifier(
+		repo,
+		prefRepo,
+		persistence.NewTeamRepository(database),
+		persistence.NewTeamMemberRepository(database),
+		persistence.NewRoleRepository(database),
+		persistence.NewAccountAccessRepository(database),
+		persistence.NewPostRepository(database, queries),
+		accountRepo,
+		logger,
+	)
+
+	digests := notificationUC.NewDigestSender(
+		repo,
+		prefRepo,
+		persistence.NewUserRepository(database, queries),
+		email,
+		tx,
+		logger,
+	)
+
+	processors := []JobProcessor{NewNotificationDigestProcessor(digests, logger)}
+	if accountRepo != nil {
+		processors = append(processors, NewTokenExpiryProcessor(notifier, logger))
+	}
+	return notifier, processors, nil
+}
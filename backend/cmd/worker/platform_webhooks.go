@@ -0,0 +1,125 @@
+// ============================================================================
+// FILE: backend/cmd/worker/platform_webhooks.go
+// PURPOSE: Processor for inbound platform webhooks logged by the API
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/techappsUT/social-queue/internal/adapters/social/facebook"
+	"github.com/techappsUT/social-queue/internal/adapters/social/linkedin"
+	"github.com/techappsUT/social-queue/internal/adapters/social/twitter"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	socialUC "github.com/techappsUT/social-queue/internal/application/social"
+	"github.com/techappsUT/social-queue/internal/db"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// PlatformWebhookProcessor processes the platform webhooks the API logged,
+// and retries failed ones
+type PlatformWebhookProcessor struct {
+	dispatcher *socialUC.PlatformWebhookDispatcher
+	logger     common.Logger
+}
+
+// NewPlatformWebhookProcessor creates a new platform webhook processor
+func NewPlatformWebhookProcessor(dispatcher *socialUC.PlatformWebhookDispatcher, logger common.Logger) *PlatformWebhookProcessor {
+	return &PlatformWebhookProcessor{dispatcher: dispatcher, logger: logger}
+}
+
+// Name returns the processor name
+func (p *PlatformWebhookProcessor) Name() string {
+	return "PlatformWebhookProcessor"
+}
+
+// DefaultSchedule polls for logged webhooks every 10 seconds
+func (p *PlatformWebhookProcessor) DefaultSchedule() string {
+	return "@every 10s"
+}
+
+// Singleton is false: entries are claimed, so replicas share the work
+func (p *PlatformWebhookProcessor) Singleton() bool {
+	return false
+}
+
+// Execute processes due webhooks until none are left or ctx is canceled
+func (p *PlatformWebhookProcessor) Execute(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := p.dispatcher.ProcessDue(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to process platform webhooks: %w", err)
+		}
+		total += n
+		if n == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		p.logger.Info(fmt.Sprintf("Processed %d platform webhooks", total))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *PlatformWebhookProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping PlatformWebhookProcessor...")
+	return nil
+}
+
+// newPlatformWebhookDispatcher builds the dispatcher for logged platform
+// webhooks. It returns nil if ENCRYPTION_KEY is missing, as accounts cannot
+// be loaded without it.
+func newPlatformWebhookDispatcher(
+	database *sql.DB,
+	queries *db.Queries,
+	tx common.Transactor,
+	events common.EventBus,
+	logger common.Logger,
+) (*socialUC.PlatformWebhookDispatcher, error) {
+	encryptionKey := os.Getenv("ENCRYPTION_KEY")
+	if encryptionKey == "" {
+		logger.Warn("ENCRYPTION_KEY not set, platform webhooks will not be processed")
+		return nil, nil
+	}
+
+	encryption, err := services.NewEncryptionService(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
+	return socialUC.NewPlatformWebhookDispatcher(
+		newWebhookParsers(),
+		persistence.NewPlatformWebhookRepository(database),
+		persistence.NewSocialRepository(database, queries, encryption),
+		persistence.NewPostMetricsRepository(database),
+		tx,
+		events,
+		auditlog.NewRecorder(persistence.NewAuditRepository(database), logger),
+		logger,
+	), nil
+}
+
+// newWebhookParsers creates a parser for every platform with a webhook
+// secret, matching the platforms the API accepts webhooks from
+func newWebhookParsers() socialDomain.WebhookParsers {
+	var parsers []socialDomain.WebhookParser
+	if secret := os.Getenv("FACEBOOK_APP_SECRET"); secret != "" {
+		parsers = append(parsers, facebook.NewWebhookParser(secret, os.Getenv("FACEBOOK_WEBHOOK_VERIFY_TOKEN")))
+	}
+	if secret := os.Getenv("TWITTER_CONSUMER_SECRET"); secret != "" {
+		parsers = append(parsers, twitter.NewWebhookParser(secret))
+	}
+	if secret := os.Getenv("LINKEDIN_CLIENT_SECRET"); secret != "" {
+		parsers = append(parsers, linkedin.NewWebhookParser(secret))
+	}
+	return socialDomain.NewWebhookParsers(parsers...)
+}
@@ -7,33 +7,69 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
+	socialAdapter "github.com/techappsUT/social-queue/internal/adapters/social"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	postApp "github.com/techappsUT/social-queue/internal/application/post"
 	"github.com/techappsUT/social-queue/internal/domain/post"
 	"github.com/techappsUT/social-queue/internal/infrastructure/services"
 )
 
+const (
+	// Max due posts fetched per scheduling pass
+	publishBatchLimit = 100
+
+	// How long a post stays locked while being published
+	publishPostLockTTL = 5 * time.Minute
+)
+
 // PublishPostProcessor handles publishing scheduled posts
 type PublishPostProcessor struct {
 	postRepo     post.Repository
 	queueService *services.WorkerQueueService
+	locker       services.DistributedLocker
+	publisher    *postApp.PublishToPlatformUseCase
+	breaker      common.CircuitBreaker
+	tx           common.Transactor
+	events       common.EventBus
+	pool         *services.FairWorkerPool
+	perTeam      int
 	logger       common.Logger
-	stopChan     chan struct{}
 }
 
-// NewPublishPostProcessor creates a new publish post processor
+// NewPublishPostProcessor creates a new publish post processor. Posts are
+// published on a pool of the given size; each pass takes at most perTeam
+// due posts from any one team. publisher may be nil when social publishing
+// is not configured, in which case due posts are left scheduled. Posts for a
+// platform whose publish circuit is open are deferred without being sent.
 func NewPublishPostProcessor(
 	postRepo post.Repository,
 	queueService *services.WorkerQueueService,
+	locker services.DistributedLocker,
+	publisher *postApp.PublishToPlatformUseCase,
+	breaker common.CircuitBreaker,
+	tx common.Transactor,
+	events common.EventBus,
+	concurrency int,
+	perTeam int,
 	logger common.Logger,
 ) *PublishPostProcessor {
 	return &PublishPostProcessor{
 		postRepo:     postRepo,
 		queueService: queueService,
+		locker:       locker,
+		publisher:    publisher,
+		breaker:      breaker,
+		tx:           tx,
+		events:       events,
+		pool:         services.NewFairWorkerPool("publish", concurrency, locker, logger),
+		perTeam:      perTeam,
 		logger:       logger,
-		stopChan:     make(chan struct{}),
 	}
 }
 
@@ -42,40 +78,38 @@ func (p *PublishPostProcessor) Name() string {
 	return "PublishPostProcessor"
 }
 
-// Run starts the processor loop
-func (p *PublishPostProcessor) Run(ctx context.Context) error {
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
-
-	p.logger.Info("PublishPostProcessor started (polling every 30s)")
-
-	for {
-		select {
-		case <-ctx.Done():
-			p.logger.Info("PublishPostProcessor stopping (context cancelled)")
-			return nil
-		case <-p.stopChan:
-			p.logger.Info("PublishPostProcessor stopped")
-			return nil
-		case <-ticker.C:
-			if err := p.processScheduledPosts(ctx); err != nil {
-				p.logger.Error(fmt.Sprintf("Error processing posts: %v", err))
-			}
-		}
-	}
+// DefaultSchedule polls for due posts every 30 seconds
+func (p *PublishPostProcessor) DefaultSchedule() string {
+	return "@every 30s"
+}
+
+// Singleton is false: the scheduler runs this job on every replica, not
+// only the leader. A replica publishes a post only while holding its
+// "publish:post:<id>" lock, see publishPost.
+func (p *PublishPostProcessor) Singleton() bool {
+	return false
+}
+
+// Execute publishes all posts that are currently due
+func (p *PublishPostProcessor) Execute(ctx context.Context) error {
+	return p.processScheduledPosts(ctx)
 }
 
-// Stop gracefully stops the processor
+// Stop stops accepting posts and waits for in-flight publishes to finish
 func (p *PublishPostProcessor) Stop(ctx context.Context) error {
 	p.logger.Info("Stopping PublishPostProcessor...")
-	close(p.stopChan)
-	return nil
+	return p.pool.Stop(ctx)
 }
 
-// processScheduledPosts finds and processes due posts
+// processScheduledPosts finds due posts and hands them to the worker pool.
+// Posts are grouped by team for fairness and serialized per social account.
 func (p *PublishPostProcessor) processScheduledPosts(ctx context.Context) error {
-	// Find posts that are due for publishing
-	duePosts, err := p.postRepo.FindDuePosts(ctx, time.Now())
+	if p.publisher == nil {
+		p.logger.Warn("Social publishing not configured (ENCRYPTION_KEY or platform credentials missing), skipping")
+		return nil
+	}
+
+	duePosts, err := p.postRepo.FindDuePostsPerTeam(ctx, time.Now(), p.perTeam, publishBatchLimit)
 	if err != nil {
 		return fmt.Errorf("failed to find due posts: %w", err)
 	}
@@ -84,79 +118,203 @@ func (p *PublishPostProcessor) processScheduledPosts(ctx context.Context) error
 		return nil // No posts to process
 	}
 
-	p.logger.Info(fmt.Sprintf("Found %d posts due for publishing", len(duePosts)))
+	// Platforms in an outage are paused until their circuit probes again
+	openCircuits := p.openCircuits(ctx)
 
-	// Process each post
+	submitted := 0
 	for _, duePost := range duePosts {
-		if err := p.publishPost(ctx, duePost); err != nil {
-			p.logger.Error(fmt.Sprintf("Failed to publish post %s: %v", duePost.ID(), err))
-			continue
+		postID := duePost.ID()
+		serialKey := ""
+		if accountID := duePost.SocialAccountID(); accountID != uuid.Nil {
+			serialKey = "social_account:" + accountID.String()
+		}
+
+		run := func(ctx context.Context) error {
+			return p.publishPost(ctx, postID)
+		}
+		if platform, until, paused := pausedPlatform(duePost, openCircuits); paused {
+			run = func(ctx context.Context) error {
+				return p.deferPost(ctx, postID, until, fmt.Sprintf("%s is unavailable", platform))
+			}
+		}
+
+		if p.pool.Submit(&services.PoolTask{
+			ID:        postID.String(),
+			GroupKey:  duePost.TeamID().String(),
+			SerialKey: serialKey,
+			Run:       run,
+		}) {
+			submitted++
 		}
 	}
 
+	pending, running := p.pool.Stats()
+	p.logger.Info(fmt.Sprintf("Found %d posts due for publishing (%d new, %d pending, %d running)",
+		len(duePosts), submitted, pending, running))
+
 	return nil
 }
 
-// publishPost publishes a single post
-func (p *PublishPostProcessor) publishPost(ctx context.Context, duePost *post.Post) error {
-	postID := duePost.ID().String()
+// openCircuits returns the platforms whose publish circuit is open
+func (p *PublishPostProcessor) openCircuits(ctx context.Context) map[string]time.Time {
+	if p.breaker == nil {
+		return nil
+	}
 
-	// Try to acquire distributed lock (prevent duplicate processing)
-	locked, err := p.queueService.GetQueueLength(ctx, "lock:"+postID)
+	states, err := p.breaker.States(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to check lock: %w", err)
-	}
-	if locked > 0 {
-		p.logger.Warn(fmt.Sprintf("Post %s is already being processed", postID))
+		p.logger.Warn(fmt.Sprintf("Failed to read circuit states: %v", err))
 		return nil
 	}
 
-	// Lock the post for 5 minutes
-	payload := map[string]interface{}{
-		"post_id": postID,
+	return services.OpenCircuits(states)
+}
+
+// pausedPlatform returns the first platform of the post with an open
+// publish circuit and when that circuit probes again
+func pausedPlatform(duePost *post.Post, openCircuits map[string]time.Time) (post.Platform, time.Time, bool) {
+	for _, platform := range duePost.Platforms() {
+		if until, ok := openCircuits[string(platform)+":"+socialAdapter.EndpointPublish]; ok {
+			return platform, until, true
+		}
 	}
-	if _, err := p.queueService.Enqueue(ctx, "lock:"+postID, payload); err != nil {
+	return "", time.Time{}, false
+}
+
+// deferPost reschedules a due post without publishing it
+func (p *PublishPostProcessor) deferPost(ctx context.Context, id uuid.UUID, until time.Time, reason string) error {
+	postID := id.String()
+
+	lockKey := "publish:post:" + postID
+	acquired, err := p.locker.TryAcquire(ctx, lockKey, publishPostLockTTL)
+	if err != nil {
 		return fmt.Errorf("failed to acquire lock: %w", err)
 	}
-	defer p.queueService.MarkComplete(ctx, "lock:"+postID, postID)
+	if !acquired {
+		return nil
+	}
+	defer p.locker.Release(context.Background(), lockKey)
 
-	// Mark post as publishing
-	if err := duePost.MarkPublishing(); err != nil {
-		return fmt.Errorf("failed to mark as publishing: %w", err)
+	duePost, err := p.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load post: %w", err)
+	}
+	if duePost.Status() != post.StatusScheduled {
+		// Interrupted publishes are resumed once the circuit closes
+		return nil
 	}
 
+	if err := duePost.Defer(until, reason); err != nil {
+		return fmt.Errorf("failed to defer post: %w", err)
+	}
 	if err := p.postRepo.Update(ctx, duePost); err != nil {
-		return fmt.Errorf("failed to update post status: %w", err)
+		return fmt.Errorf("failed to update post: %w", err)
 	}
 
-	p.logger.Info(fmt.Sprintf("Publishing post %s to platforms: %v", postID, duePost.Platforms()))
+	p.logger.Warn(fmt.Sprintf("Deferred post %s until %s: %s", postID, until.Format(time.RFC3339), reason))
+	return nil
+}
 
-	// TODO: Actually publish to social platforms
-	// This would involve:
-	// 1. Get social account tokens
-	// 2. Call platform adapters (Twitter, LinkedIn, etc.)
-	// 3. Handle platform-specific errors
-	// 4. Store platform post IDs
+// publishPost publishes a single post
+func (p *PublishPostProcessor) publishPost(ctx context.Context, id uuid.UUID) error {
+	postID := id.String()
 
-	// Simulate publishing (in real implementation, use social adapters)
-	time.Sleep(2 * time.Second)
+	// Lock the post cluster-wide so no other replica publishes it
+	lockKey := "publish:post:" + postID
+	acquired, err := p.locker.TryAcquire(ctx, lockKey, publishPostLockTTL)
+	if err != nil {
+		return fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !acquired {
+		p.logger.Warn(fmt.Sprintf("Post %s is already being processed", postID))
+		return nil
+	}
+	defer p.locker.Release(context.Background(), lockKey)
+
+	// Reload under the lock; another replica may have published it already
+	duePost, err := p.postRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load post: %w", err)
+	}
+	if duePost.Status() == post.StatusScheduled {
+		if err := duePost.Queue(); err != nil {
+			return fmt.Errorf("failed to queue post: %w", err)
+		}
+	}
+
+	switch duePost.Status() {
+	case post.StatusQueued:
+		if err := duePost.MarkPublishing(); err != nil {
+			return fmt.Errorf("failed to mark as publishing: %w", err)
+		}
+
+		if err := p.postRepo.Update(ctx, duePost); err != nil {
+			return fmt.Errorf("failed to update post status: %w", err)
+		}
+	case post.StatusPublishing:
+		// Abandoned by a crashed worker; the publisher reconciles it
+		p.logger.Warn(fmt.Sprintf("Resuming interrupted publish of post %s", postID))
+	default:
+		p.logger.Info(fmt.Sprintf("Post %s is %s, skipping", postID, duePost.Status()))
+		return nil
+	}
+
+	p.logger.Info(fmt.Sprintf("Publishing post %s to platforms: %v", postID, duePost.Platforms()))
+
+	result, err := p.publisher.Execute(ctx, postApp.PublishToPlatformInput{Post: duePost})
+	var deferred *post.DeferredError
+	if errors.As(err, &deferred) {
+		// Platform quota exhausted: reschedule instead of failing
+		if deferErr := duePost.Defer(deferred.Until, deferred.Reason); deferErr != nil {
+			return fmt.Errorf("failed to defer post: %w", deferErr)
+		}
+		if err := p.postRepo.Update(ctx, duePost); err != nil {
+			return fmt.Errorf("failed to update post: %w", err)
+		}
+		p.logger.Warn(fmt.Sprintf("Deferred post %s until %s: %s", postID, deferred.Until.Format(time.RFC3339), deferred.Reason))
+		return nil
+	}
+	if err != nil {
+		reason := err.Error()
+		duePost.MarkFailed(reason)
+		updateErr := p.tx.WithinTx(ctx, func(ctx context.Context) error {
+			if err := p.postRepo.Update(ctx, duePost); err != nil {
+				return err
+			}
+			return p.events.Publish(ctx, post.NewPostFailed(duePost, reason))
+		})
+		if updateErr != nil {
+			p.logger.Error(fmt.Sprintf("Failed to mark post %s as failed: %v", postID, updateErr))
+		}
+		return fmt.Errorf("failed to publish: %w", err)
+	}
 
-	// For now, mark as published
 	now := time.Now()
 	if err := duePost.MarkPublished(); err != nil {
 		return fmt.Errorf("failed to mark as published: %w", err)
 	}
 
-	if err := p.postRepo.Update(ctx, duePost); err != nil {
+	err = p.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := p.postRepo.Update(ctx, duePost); err != nil {
+			return err
+		}
+		return p.events.Publish(ctx, post.NewPostPublished(duePost, result.PlatformPostID, result.URL))
+	})
+	if err != nil {
 		return fmt.Errorf("failed to update post: %w", err)
 	}
 
-	p.logger.Info(fmt.Sprintf("✅ Successfully published post %s", postID))
+	if result.Reconciled {
+		p.logger.Info(fmt.Sprintf("✅ Post %s was already on the platform (%s), not re-posted", postID, result.PlatformPostID))
+	} else {
+		p.logger.Info(fmt.Sprintf("✅ Successfully published post %s", postID))
+	}
 
 	// Enqueue analytics fetch job (fetch metrics after 1 hour)
 	analyticsPayload := map[string]interface{}{
-		"post_id":    postID,
-		"fetch_time": now.Add(1 * time.Hour).Unix(),
+		"post_id":          postID,
+		"platform_post_id": result.PlatformPostID,
+		"fetch_time":       now.Add(1 * time.Hour).Unix(),
 	}
 	if _, err := p.queueService.Enqueue(ctx, "fetch_analytics", analyticsPayload); err != nil {
 		p.logger.Warn(fmt.Sprintf("Failed to enqueue analytics job: %v", err))
@@ -164,22 +322,3 @@ func (p *PublishPostProcessor) publishPost(ctx context.Context, duePost *post.Po
 
 	return nil
 }
-
-// publishToTwitter publishes content to Twitter (placeholder)
-func (p *PublishPostProcessor) publishToTwitter(ctx context.Context, content string) (string, error) {
-	// TODO: Implement Twitter API call
-	// Use twitter adapter from infrastructure/adapters/social/twitter
-	return "twitter_post_id_123", nil
-}
-
-// publishToLinkedIn publishes content to LinkedIn (placeholder)
-func (p *PublishPostProcessor) publishToLinkedIn(ctx context.Context, content string) (string, error) {
-	// TODO: Implement LinkedIn API call
-	return "linkedin_post_id_456", nil
-}
-
-// publishToFacebook publishes content to Facebook (placeholder)
-func (p *PublishPostProcessor) publishToFacebook(ctx context.Context, content string) (string, error) {
-	// TODO: Implement Facebook API call
-	return "facebook_post_id_789", nil
-}
@@ -0,0 +1,99 @@
+// ============================================================================
+// FILE: backend/cmd/worker/publishing.go
+// PURPOSE: Wiring for publishing posts to social platforms from the worker
+// ============================================================================
+
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	socialAdapter "github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/adapters/social/facebook"
+	"github.com/techappsUT/social-queue/internal/adapters/social/linkedin"
+	"github.com/techappsUT/social-queue/internal/adapters/social/twitter"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	postApp "github.com/techappsUT/social-queue/internal/application/post"
+	"github.com/techappsUT/social-queue/internal/db"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// newPublisher builds the idempotent platform publisher. It returns nil if
+// ENCRYPTION_KEY or all platform credentials are missing.
+func newPublisher(
+	database *sql.DB,
+	queries *db.Queries,
+	postRepo *persistence.PostRepository,
+	limiter common.PlatformRateLimiter,
+	breaker common.CircuitBreaker,
+	logger common.Logger,
+) (*postApp.PublishToPlatformUseCase, error) {
+	encryptionKey := os.Getenv("ENCRYPTION_KEY")
+	if encryptionKey == "" {
+		logger.Warn("ENCRYPTION_KEY not set, posts will not be published")
+		return nil, nil
+	}
+
+	encryption, err := services.NewEncryptionService(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
+	adapters := newSocialAdapters(breaker)
+	if len(adapters) == 0 {
+		logger.Warn("No social platform credentials set, posts will not be published")
+		return nil, nil
+	}
+	logger.Info(fmt.Sprintf("✓ %d social adapters initialized", len(adapters)))
+
+	return postApp.NewPublishToPlatformUseCase(
+		persistence.NewPublishAttemptRepository(database),
+		postRepo,
+		persistence.NewSocialRepository(database, queries, encryption),
+		adapters,
+		limiter,
+		auditlog.NewRecorder(persistence.NewAuditRepository(database), logger),
+		logger,
+	), nil
+}
+
+// newSocialAdapters creates adapters for every platform with credentials,
+// each behind the platform's circuit breaker. The worker never handles OAuth
+// callbacks but the adapters need a redirect URI.
+func newSocialAdapters(breaker common.CircuitBreaker) map[socialDomain.Platform]socialAdapter.Adapter {
+	adapters := make(map[socialDomain.Platform]socialAdapter.Adapter)
+
+	baseURL := os.Getenv("API_BASE_URL")
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("http://localhost:%s", os.Getenv("PORT"))
+	}
+
+	if id, secret := os.Getenv("TWITTER_CLIENT_ID"), os.Getenv("TWITTER_CLIENT_SECRET"); id != "" && secret != "" {
+		adapters[socialDomain.PlatformTwitter] = twitter.NewTwitterAdapter(
+			id, secret, fmt.Sprintf("%s/api/v2/social/auth/twitter/callback", baseURL),
+		)
+	}
+
+	if id, secret := os.Getenv("LINKEDIN_CLIENT_ID"), os.Getenv("LINKEDIN_CLIENT_SECRET"); id != "" && secret != "" {
+		adapters[socialDomain.PlatformLinkedIn] = linkedin.NewLinkedInAdapter(
+			id, secret, fmt.Sprintf("%s/api/v2/social/auth/linkedin/callback", baseURL),
+		)
+	}
+
+	if id, secret := os.Getenv("FACEBOOK_APP_ID"), os.Getenv("FACEBOOK_APP_SECRET"); id != "" && secret != "" {
+		adapters[socialDomain.PlatformFacebook] = facebook.NewFacebookAdapter(
+			id, secret, fmt.Sprintf("%s/api/v2/social/auth/facebook/callback", baseURL),
+		)
+	}
+
+	for platform, adapter := range adapters {
+		adapters[platform] = socialAdapter.WithCircuitBreaker(string(platform), adapter, breaker)
+	}
+
+	return adapters
+}
@@ -0,0 +1,272 @@
+// ============================================================================
+// FILE: backend/cmd/worker/scheduler.go
+// PURPOSE: Cron-style scheduler that drives job processors, with leader
+//          election for singleton jobs and manual "run now" triggers
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+const (
+	// Env prefix for per-job schedule overrides, e.g. WORKER_SCHEDULE_CLEANUP="0 3 * * *"
+	scheduleEnvPrefix = "WORKER_SCHEDULE_"
+
+	// Upper bound on a singleton run; the run lock expires after this
+	singletonRunLockTTL = time.Hour
+
+	// How long the trigger listener blocks waiting for manual triggers
+	triggerPollTimeout = 5 * time.Second
+)
+
+// scheduledJob binds a processor to its parsed schedule
+type scheduledJob struct {
+	key       string
+	processor JobProcessor
+	schedule  services.CronSchedule
+	running   atomic.Bool
+}
+
+// Scheduler runs job processors according to their cron schedules
+type Scheduler struct {
+	jobs    map[string]*scheduledJob
+	order   []string
+	elector *services.LeaderElector
+	locker  services.DistributedLocker
+	control *services.SchedulerControl
+	queue   *services.WorkerQueueService
+	logger  common.Logger
+	wg      sync.WaitGroup
+}
+
+// NewScheduler creates a new scheduler
+func NewScheduler(
+	elector *services.LeaderElector,
+	locker services.DistributedLocker,
+	control *services.SchedulerControl,
+	queue *services.WorkerQueueService,
+	logger common.Logger,
+) *Scheduler {
+	return &Scheduler{
+		jobs:    make(map[string]*scheduledJob),
+		elector: elector,
+		locker:  locker,
+		control: control,
+		queue:   queue,
+		logger:  logger,
+	}
+}
+
+// Register adds a processor, applying any schedule override from the environment
+func (s *Scheduler) Register(p JobProcessor) error {
+	key := jobKey(p.Name())
+	if _, exists := s.jobs[key]; exists {
+		return fmt.Errorf("job %s already registered", key)
+	}
+
+	expr := p.DefaultSchedule()
+	if override := os.Getenv(scheduleEnvPrefix + strings.ToUpper(key)); override != "" {
+		expr = override
+	}
+
+	schedule, err := services.ParseCronSchedule(expr)
+	if err != nil {
+		return fmt.Errorf("invalid schedule for %s (%q): %w", key, expr, err)
+	}
+
+	s.jobs[key] = &scheduledJob{
+		key:       key,
+		processor: p,
+		schedule:  schedule,
+	}
+	s.order = append(s.order, key)
+	return nil
+}
+
+// jobList returns the registered jobs in registration order
+func (s *Scheduler) jobList() []*scheduledJob {
+	jobs := make([]*scheduledJob, 0, len(s.order))
+	for _, key := range s.order {
+		jobs = append(jobs, s.jobs[key])
+	}
+	return jobs
+}
+
+// Start launches the leader elector, one timer loop per job and the manual
+// trigger listener. All goroutines exit when ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	go s.elector.Run(ctx)
+
+	for _, job := range s.jobList() {
+		next := job.schedule.Next(time.Now())
+		if err := s.control.RegisterJob(ctx, job.key, job.schedule.String(), job.processor.Singleton(), next); err != nil {
+			s.logger.Warn(fmt.Sprintf("Failed to register job %s: %v", job.key, err))
+		}
+
+		s.wg.Add(1)
+		go func(j *scheduledJob) {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}(job)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.listenForTriggers(ctx)
+	}()
+}
+
+// Wait blocks until every loop and in-flight run has returned, or ctx expires
+func (s *Scheduler) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("timed out waiting for jobs to finish: %w", ctx.Err())
+	}
+}
+
+// loop sleeps until each activation time and fires the job
+func (s *Scheduler) loop(ctx context.Context, job *scheduledJob) {
+	for {
+		next := job.schedule.Next(time.Now())
+		if next.IsZero() {
+			s.logger.Warn(fmt.Sprintf("Schedule %q for %s never fires, disabling", job.schedule, job.key))
+			return
+		}
+		if err := s.control.RecordNextRun(ctx, job.key, next); err != nil {
+			s.logger.Warn(fmt.Sprintf("Failed to record next run for %s: %v", job.key, err))
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			if job.processor.Singleton() && !s.elector.IsLeader() {
+				continue // Another replica owns singleton jobs
+			}
+			s.run(ctx, job, "schedule")
+		}
+	}
+}
+
+// listenForTriggers consumes manual "run now" requests from the job queue.
+// Only one replica receives each trigger.
+func (s *Scheduler) listenForTriggers(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		job, err := s.queue.Dequeue(ctx, services.SchedulerTriggerJobType, triggerPollTimeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			s.logger.Warn(fmt.Sprintf("Failed to read job triggers: %v", err))
+			time.Sleep(triggerPollTimeout)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+
+		name, _ := job.Payload["job"].(string)
+		target, ok := s.jobs[name]
+		if !ok {
+			s.queue.MarkFailed(ctx, services.SchedulerTriggerJobType, job.ID, fmt.Sprintf("unknown job %q", name))
+			continue
+		}
+
+		s.logger.Info(fmt.Sprintf("▶️  Manual run of %s", name))
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(ctx, target, "manual")
+		}()
+		s.queue.MarkComplete(ctx, services.SchedulerTriggerJobType, job.ID)
+	}
+}
+
+// run executes a job once, skipping if it is already running. Singleton
+// jobs additionally take a cluster-wide run lock so scheduled and manual
+// runs never overlap across replicas.
+func (s *Scheduler) run(ctx context.Context, job *scheduledJob, reason string) {
+	if !job.running.CompareAndSwap(false, true) {
+		s.logger.Warn(fmt.Sprintf("Skipping %s run of %s: previous run still in progress", reason, job.key))
+		return
+	}
+	defer job.running.Store(false)
+
+	if job.processor.Singleton() {
+		lockKey := "scheduler:run:" + job.key
+		acquired, err := s.locker.TryAcquire(ctx, lockKey, singletonRunLockTTL)
+		if err != nil {
+			s.logger.Error(fmt.Sprintf("Failed to lock %s: %v", job.key, err))
+			return
+		}
+		if !acquired {
+			s.logger.Warn(fmt.Sprintf("Skipping %s run of %s: running on another replica", reason, job.key))
+			return
+		}
+		defer s.locker.Release(context.Background(), lockKey)
+	}
+
+	s.control.MarkRunning(ctx, job.key, true)
+
+	startedAt := time.Now()
+	err := job.processor.Execute(ctx)
+	duration := time.Since(startedAt)
+
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("Job %s failed after %v: %v", job.key, duration, err))
+	}
+
+	// Record with a fresh context so shutdown doesn't lose the final status
+	recordCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if recErr := s.control.RecordRun(recordCtx, job.key, startedAt, duration, err); recErr != nil {
+		s.logger.Warn(fmt.Sprintf("Failed to record run of %s: %v", job.key, recErr))
+	}
+}
+
+// jobKey converts a processor name such as "PublishPostProcessor" into the
+// snake_case key used for env overrides and triggers ("publish_post")
+func jobKey(name string) string {
+	name = strings.TrimSuffix(name, "Processor")
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,97 @@
+// ============================================================================
+// FILE: backend/cmd/worker/webhooks.go
+// PURPOSE: Processor retrying failed outgoing webhook deliveries
+// ============================================================================
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	teamUC "github.com/techappsUT/social-queue/internal/application/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/persistence"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// newWebhookDispatcher wires outgoing team webhooks. Signing secrets are
+// encrypted, so without ENCRYPTION_KEY no webhooks are delivered.
+func newWebhookDispatcher(database *sql.DB, logger common.Logger) (*teamUC.WebhookDispatcher, error) {
+	encryptionKey := os.Getenv("ENCRYPTION_KEY")
+	if encryptionKey == "" {
+		logger.Warn("ENCRYPTION_KEY not set, team webhooks will not be delivered")
+		return nil, nil
+	}
+
+	encryption, err := services.NewEncryptionService(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create encryption service: %w", err)
+	}
+
+	allowPrivate, _ := strconv.ParseBool(os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS"))
+	return teamUC.NewWebhookDispatcher(
+		persistence.NewWebhookRepository(database, encryption),
+		persistence.NewWebhookDeliveryRepository(database),
+		persistence.NewTeamRepository(database),
+		services.NewHTTPWebhookSender(services.DefaultWebhookTimeout, allowPrivate),
+		auditlog.NewRecorder(persistence.NewAuditRepository(database), logger),
+		logger,
+	), nil
+}
+
+// WebhookRetryProcessor retries webhook deliveries whose next attempt is
+// due. First attempts are made by the event consumer as events arrive.
+type WebhookRetryProcessor struct {
+	dispatcher *teamUC.WebhookDispatcher
+	logger     common.Logger
+}
+
+// NewWebhookRetryProcessor creates a new webhook retry processor
+func NewWebhookRetryProcessor(dispatcher *teamUC.WebhookDispatcher, logger common.Logger) *WebhookRetryProcessor {
+	return &WebhookRetryProcessor{dispatcher: dispatcher, logger: logger}
+}
+
+// Name returns the processor name
+func (p *WebhookRetryProcessor) Name() string {
+	return "WebhookRetryProcessor"
+}
+
+// DefaultSchedule polls for due retries every 30 seconds
+func (p *WebhookRetryProcessor) DefaultSchedule() string {
+	return "@every 30s"
+}
+
+// Singleton is false: deliveries are claimed, so replicas share the work
+func (p *WebhookRetryProcessor) Singleton() bool {
+	return false
+}
+
+// Execute retries due deliveries until none are left or ctx is canceled
+func (p *WebhookRetryProcessor) Execute(ctx context.Context) error {
+	total := 0
+	for ctx.Err() == nil {
+		n, err := p.dispatcher.RetryDue(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retry webhook deliveries: %w", err)
+		}
+		total += n
+		if n == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		p.logger.Info(fmt.Sprintf("Retried %d webhook deliveries", total))
+	}
+	return nil
+}
+
+// Stop gracefully stops the processor
+func (p *WebhookRetryProcessor) Stop(ctx context.Context) error {
+	p.logger.Info("Stopping WebhookRetryProcessor...")
+	return nil
+}
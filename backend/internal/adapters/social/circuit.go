@@ -0,0 +1,234 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/circuit.go
+// PURPOSE: Circuit breaker around platform adapter calls
+// ============================================================================
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+// Endpoint classes with their own circuit per platform
+const (
+	EndpointAuth      = "auth"
+	EndpointPublish   = "publish"
+	EndpointRead      = "read"
+	EndpointAnalytics = "analytics"
+)
+
+// UnavailableError is returned when the platform answers with a 5xx
+type UnavailableError struct {
+	StatusCode int
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("platform unavailable (%d)", e.StatusCode)
+}
+
+// RejectedError is returned when the platform refuses a request with a 4xx
+// other than 429. Nothing was created on the platform.
+type RejectedError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("platform rejected the request (%d): %s", e.StatusCode, e.Body)
+}
+
+// ErrInvalidContent is returned when content breaks a platform rule checked
+// before calling the platform, such as its character limit
+var ErrInvalidContent = errors.New("content not accepted by the platform")
+
+// IsRejected reports whether err is a definitive refusal: the platform
+// answered with a 4xx or the content never left the adapter. Timeouts,
+// transport errors and 5xx are not, as the platform may have acted on the
+// request.
+func IsRejected(err error) bool {
+	var rejected *RejectedError
+	return errors.As(err, &rejected) || errors.Is(err, ErrInvalidContent)
+}
+
+// CircuitOpenError is returned without calling the platform while its
+// circuit is open
+type CircuitOpenError struct {
+	Platform   string
+	Endpoint   string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s %s circuit open, retry after %s", e.Platform, e.Endpoint, e.RetryAfter.Round(time.Second))
+}
+
+// IsOutage reports whether err means the platform is failing: a 5xx, a
+// timeout or a transport error. Cancellation by the caller is not an outage.
+func IsOutage(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var unavailable *UnavailableError
+	if errors.As(err, &unavailable) {
+		return true
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// WithCircuitBreaker wraps an adapter so every call goes through the
+// platform's circuit for its endpoint class. The result implements
+// RecentPostLister only if the adapter does, and the inbox interfaces only
+// if the adapter implements all of them.
+func WithCircuitBreaker(platform string, adapter Adapter, breaker common.CircuitBreaker) Adapter {
+	if breaker == nil {
+		return adapter
+	}
+
+	b := &breakerAdapter{platform: platform, next: adapter, breaker: breaker}
+	lister, canList := adapter.(RecentPostLister)
+	inbox, hasInbox := adapter.(inboxAdapter)
+	switch {
+	case canList && hasInbox:
+		return &breakerListerInboxAdapter{
+			breakerInboxAdapter: &breakerInboxAdapter{breakerAdapter: b, inbox: inbox},
+			lister:              &breakerListerAdapter{breakerAdapter: b, lister: lister},
+		}
+	case canList:
+		return &breakerListerAdapter{breakerAdapter: b, lister: lister}
+	case hasInbox:
+		return &breakerInboxAdapter{breakerAdapter: b, inbox: inbox}
+	}
+	return b
+}
+
+// inboxAdapter is an adapter the inbox can read, reply and moderate through
+type inboxAdapter interface {
+	InteractionLister
+	Replier
+	CommentModerator
+}
+
+type breakerAdapter struct {
+	platform string
+	next     Adapter
+	breaker  common.CircuitBreaker
+}
+
+// call runs fn if the circuit allows it and records the outcome.
+// Breaker failures never block the call.
+func (b *breakerAdapter) call(ctx context.Context, endpoint string, fn func() error) error {
+	allowed, retryAfter, err := b.breaker.Allow(ctx, b.platform, endpoint)
+	if err == nil && !allowed {
+		return &CircuitOpenError{Platform: b.platform, Endpoint: endpoint, RetryAfter: retryAfter}
+	}
+
+	callErr := fn()
+
+	// Rate limits and rejected requests still mean the platform is up. A
+	// cancelled call says nothing; an unfinished probe expires on its own.
+	if ctx.Err() == nil {
+		_ = b.breaker.Record(ctx, b.platform, endpoint, IsOutage(ctx, callErr))
+	}
+	return callErr
+}
+
+func (b *breakerAdapter) GetAuthURL(state string, scopes []string) string {
+	return b.next.GetAuthURL(state, scopes)
+}
+
+func (b *breakerAdapter) ExchangeCode(ctx context.Context, code string) (token *Token, err error) {
+	err = b.call(ctx, EndpointAuth, func() error {
+		token, err = b.next.ExchangeCode(ctx, code)
+		return err
+	})
+	return token, err
+}
+
+func (b *breakerAdapter) RefreshToken(ctx context.Context, refreshToken string) (token *Token, err error) {
+	err = b.call(ctx, EndpointAuth, func() error {
+		token, err = b.next.RefreshToken(ctx, refreshToken)
+		return err
+	})
+	return token, err
+}
+
+func (b *breakerAdapter) ValidateToken(ctx context.Context, token *Token) (valid bool, err error) {
+	err = b.call(ctx, EndpointAuth, func() error {
+		valid, err = b.next.ValidateToken(ctx, token)
+		return err
+	})
+	return valid, err
+}
+
+func (b *breakerAdapter) PublishPost(ctx context.Context, token *Token, content *PostContent) (result *PublishResult, err error) {
+	err = b.call(ctx, EndpointPublish, func() error {
+		result, err = b.next.PublishPost(ctx, token, content)
+		return err
+	})
+	return result, err
+}
+
+func (b *breakerAdapter) GetPostAnalytics(ctx context.Context, token *Token, postID string) (analytics *Analytics, err error) {
+	err = b.call(ctx, EndpointAnalytics, func() error {
+		analytics, err = b.next.GetPostAnalytics(ctx, token, postID)
+		return err
+	})
+	return analytics, err
+}
+
+type breakerListerAdapter struct {
+	*breakerAdapter
+	lister RecentPostLister
+}
+
+func (b *breakerListerAdapter) ListRecentPosts(ctx context.Context, token *Token, since time.Time) (posts []*RecentPost, err error) {
+	err = b.call(ctx, EndpointRead, func() error {
+		posts, err = b.lister.ListRecentPosts(ctx, token, since)
+		return err
+	})
+	return posts, err
+}
+
+type breakerInboxAdapter struct {
+	*breakerAdapter
+	inbox inboxAdapter
+}
+
+type breakerListerInboxAdapter struct {
+	*breakerInboxAdapter
+	lister *breakerListerAdapter
+}
+
+func (b *breakerListerInboxAdapter) ListRecentPosts(ctx context.Context, token *Token, since time.Time) ([]*RecentPost, error) {
+	return b.lister.ListRecentPosts(ctx, token, since)
+}
+
+func (b *breakerInboxAdapter) ListInteractions(ctx context.Context, token *Token, since time.Time) (interactions []*Interaction, err error) {
+	err = b.call(ctx, EndpointRead, func() error {
+		interactions, err = b.inbox.ListInteractions(ctx, token, since)
+		return err
+	})
+	return interactions, err
+}
+
+func (b *breakerInboxAdapter) Reply(ctx context.Context, token *Token, to *ReplyTarget, text string) (result *ReplyResult, err error) {
+	err = b.call(ctx, EndpointPublish, func() error {
+		result, err = b.inbox.Reply(ctx, token, to, text)
+		return err
+	})
+	return result, err
+}
+
+func (b *breakerInboxAdapter) HideComment(ctx context.Context, token *Token, commentID string) error {
+	return b.call(ctx, EndpointPublish, func() error {
+		return b.inbox.HideComment(ctx, token, commentID)
+	})
+}
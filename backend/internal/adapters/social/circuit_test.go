@@ -0,0 +1,107 @@
+// path: backend/internal/adapters/social/circuit_test.go
+package social
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+type closedBreaker struct {
+	common.CircuitBreaker
+	recorded []string
+}
+
+func (b *closedBreaker) Allow(ctx context.Context, platform, endpoint string) (bool, time.Duration, error) {
+	return true, 0, nil
+}
+
+func (b *closedBreaker) Record(ctx context.Context, platform, endpoint string, failed bool) error {
+	b.recorded = append(b.recorded, endpoint)
+	return nil
+}
+
+// inboxOnly supports the inbox but cannot list recent posts, like Facebook
+type inboxOnly struct {
+	Adapter
+	replies int
+}
+
+func (a *inboxOnly) ListInteractions(ctx context.Context, token *Token, since time.Time) ([]*Interaction, error) {
+	return nil, nil
+}
+
+func (a *inboxOnly) Reply(ctx context.Context, token *Token, to *ReplyTarget, text string) (*ReplyResult, error) {
+	a.replies++
+	return &ReplyResult{}, nil
+}
+
+func (a *inboxOnly) HideComment(ctx context.Context, token *Token, commentID string) error {
+	return nil
+}
+
+type listerOnly struct {
+	Adapter
+}
+
+func (a *listerOnly) ListRecentPosts(ctx context.Context, token *Token, since time.Time) ([]*RecentPost, error) {
+	return nil, nil
+}
+
+type listerAndInbox struct {
+	inboxOnly
+}
+
+func (a *listerAndInbox) ListRecentPosts(ctx context.Context, token *Token, since time.Time) ([]*RecentPost, error) {
+	return nil, nil
+}
+
+func TestWithCircuitBreakerKeepsCapabilities(t *testing.T) {
+	cases := []struct {
+		name            string
+		adapter         Adapter
+		canList, hasBox bool
+	}{
+		{"plain", struct{ Adapter }{}, false, false},
+		{"lister only", &listerOnly{}, true, false},
+		{"inbox only", &inboxOnly{}, false, true},
+		{"lister and inbox", &listerAndInbox{}, true, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrapped := WithCircuitBreaker("facebook", tc.adapter, &closedBreaker{})
+
+			if _, ok := wrapped.(RecentPostLister); ok != tc.canList {
+				t.Errorf("RecentPostLister = %v, want %v", ok, tc.canList)
+			}
+			if _, ok := wrapped.(InteractionLister); ok != tc.hasBox {
+				t.Errorf("InteractionLister = %v, want %v", ok, tc.hasBox)
+			}
+			if _, ok := wrapped.(Replier); ok != tc.hasBox {
+				t.Errorf("Replier = %v, want %v", ok, tc.hasBox)
+			}
+			if _, ok := wrapped.(CommentModerator); ok != tc.hasBox {
+				t.Errorf("CommentModerator = %v, want %v", ok, tc.hasBox)
+			}
+		})
+	}
+}
+
+func TestWithCircuitBreakerRoutesInboxCallsThroughCircuit(t *testing.T) {
+	adapter := &inboxOnly{}
+	breaker := &closedBreaker{}
+	replier := WithCircuitBreaker("facebook", adapter, breaker).(Replier)
+
+	if _, err := replier.Reply(context.Background(), &Token{}, &ReplyTarget{}, "thanks"); err != nil {
+		t.Fatalf("Reply: %v", err)
+	}
+	if adapter.replies != 1 {
+		t.Errorf("adapter replies = %d, want 1", adapter.replies)
+	}
+	if len(breaker.recorded) != 1 || breaker.recorded[0] != EndpointPublish {
+		t.Errorf("recorded = %v, want [%s]", breaker.recorded, EndpointPublish)
+	}
+}
@@ -22,7 +22,6 @@ const (
 	facebookTokenURL = "https://graph.facebook.com/v18.0/oauth/access_token"
 	facebookGraphURL = "https://graph.facebook.com/v18.0"
 	charLimit        = 63206
-	maxRetries       = 3
 )
 
 type FacebookAdapter struct {
@@ -80,6 +79,9 @@ func (f *FacebookAdapter) ExchangeCode(ctx context.Context, code string) (*socia
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("facebook oauth failed (%d): %s", resp.StatusCode, string(body))
@@ -129,6 +131,9 @@ func (f *FacebookAdapter) exchangeForLongLivedToken(ctx context.Context, shortTo
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return "", nil, fmt.Errorf("long-lived token exchange failed (%d): %s", resp.StatusCode, string(body))
@@ -156,7 +161,7 @@ func (f *FacebookAdapter) RefreshToken(ctx context.Context, refreshToken string)
 func (f *FacebookAdapter) PublishPost(ctx context.Context, token *social.Token, content *social.PostContent) (*social.PublishResult, error) {
 	// Validate content
 	if len(content.Text) > charLimit {
-		return nil, fmt.Errorf("post exceeds %d character limit", charLimit)
+		return nil, fmt.Errorf("%w: post exceeds %d character limit", social.ErrInvalidContent, charLimit)
 	}
 
 	// Get user's pages
@@ -184,23 +189,14 @@ func (f *FacebookAdapter) PublishPost(ctx context.Context, token *social.Token,
 		payload["link"] = content.MediaURLs[0]
 	}
 
-	// Create post with retry logic
-	var lastErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		result, err := f.createPagePost(ctx, page.ID, page.AccessToken, payload)
-		if err == nil {
-			return result, nil
-		}
-		lastErr = err
-
-		if strings.Contains(err.Error(), "429") {
-			time.Sleep(time.Duration(attempt+1) * 5 * time.Second)
-			continue
-		}
-		break
+	// A 429 comes back as *social.RateLimitError so the caller can defer
+	// the post instead of sleeping here
+	result, err := f.createPagePost(ctx, page.ID, page.AccessToken, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create page post: %w", err)
 	}
 
-	return nil, fmt.Errorf("failed after %d attempts: %w", maxRetries, lastErr)
+	return result, nil
 }
 
 // createPagePost makes the API call to create a page post
@@ -230,8 +226,18 @@ func (f *FacebookAdapter) createPagePost(ctx context.Context, pageID, pageAccess
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, &social.RejectedError{StatusCode: resp.StatusCode, Body: string(body)}
+		}
 		return nil, fmt.Errorf("post creation failed (%d): %s", resp.StatusCode, string(body))
 	}
 
@@ -247,6 +253,7 @@ func (f *FacebookAdapter) createPagePost(ctx context.Context, pageID, pageAccess
 		PlatformPostID: postResp.ID,
 		URL:            fmt.Sprintf("https://www.facebook.com/%s", postResp.ID),
 		PublishedAt:    time.Now(),
+		RateLimit:      social.ParseRateLimitHeaders(resp.Header),
 	}, nil
 }
 
@@ -265,6 +272,9 @@ func (f *FacebookAdapter) getUserPages(ctx context.Context, accessToken string)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to get pages (%d)", resp.StatusCode)
 	}
@@ -314,6 +324,9 @@ func (f *FacebookAdapter) GetPostAnalytics(ctx context.Context, token *social.To
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to get analytics (%d)", resp.StatusCode)
 	}
@@ -348,6 +361,81 @@ func (f *FacebookAdapter) GetPostAnalytics(ctx context.Context, token *social.To
 	}, nil
 }
 
+// ListRecentPosts returns posts on the publishing page created at or after since
+func (f *FacebookAdapter) ListRecentPosts(ctx context.Context, token *social.Token, since time.Time) ([]*social.RecentPost, error) {
+	pages, err := f.getUserPages(ctx, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pages: %w", err)
+	}
+
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("no Facebook pages found")
+	}
+
+	// Same page PublishPost posts to
+	page := pages[0]
+
+	params := url.Values{}
+	params.Set("fields", "id,message,created_time,permalink_url")
+	params.Set("since", fmt.Sprintf("%d", since.Unix()))
+	params.Set("limit", "100")
+	params.Set("access_token", page.AccessToken)
+	endpoint := fmt.Sprintf("%s/%s/posts?%s", facebookGraphURL, page.ID, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list page posts (%d)", resp.StatusCode)
+	}
+
+	var postsResp struct {
+		Data []struct {
+			ID           string `json:"id"`
+			Message      string `json:"message"`
+			CreatedTime  string `json:"created_time"`
+			PermalinkURL string `json:"permalink_url"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&postsResp); err != nil {
+		return nil, err
+	}
+
+	posts := make([]*social.RecentPost, 0, len(postsResp.Data))
+	for _, p := range postsResp.Data {
+		// Graph API timestamps look like 2024-01-01T12:00:00+0000
+		createdAt, _ := time.Parse("2006-01-02T15:04:05-0700", p.CreatedTime)
+		postURL := p.PermalinkURL
+		if postURL == "" {
+			postURL = fmt.Sprintf("https://www.facebook.com/%s", p.ID)
+		}
+		posts = append(posts, &social.RecentPost{
+			PlatformPostID: p.ID,
+			URL:            postURL,
+			Text:           p.Message,
+			CreatedAt:      createdAt,
+		})
+	}
+
+	return posts, nil
+}
+
 type FacebookPage struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
@@ -0,0 +1,280 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/facebook/inbox.go
+// Reading and answering page comments, mentions and Messenger messages
+// ============================================================================
+package facebook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+)
+
+// graphTime is the Graph API timestamp layout, e.g. 2024-01-01T12:00:00+0000
+const graphTime = "2006-01-02T15:04:05-0700"
+
+type graphAuthor struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListInteractions returns comments on the page's recent posts and posts
+// the page was tagged in, created at or after since. Messenger messages
+// only arrive by webhook. The page's own comments are skipped.
+func (f *FacebookAdapter) ListInteractions(ctx context.Context, token *social.Token, since time.Time) ([]*social.Interaction, error) {
+	pages, err := f.getUserPages(ctx, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pages: %w", err)
+	}
+
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("no Facebook pages found")
+	}
+
+	// Same page PublishPost posts to
+	page := pages[0]
+
+	comments, err := f.listComments(ctx, page, since)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := f.listMentions(ctx, page, since)
+	if err != nil {
+		return nil, err
+	}
+	return append(comments, mentions...), nil
+}
+
+func (f *FacebookAdapter) listComments(ctx context.Context, page FacebookPage, since time.Time) ([]*social.Interaction, error) {
+	params := url.Values{}
+	params.Set("fields", fmt.Sprintf("id,comments.since(%d).limit(100){id,message,created_time,from}", since.Unix()))
+	params.Set("limit", "25")
+	params.Set("access_token", page.AccessToken)
+	endpoint := fmt.Sprintf("%s/%s/feed?%s", facebookGraphURL, page.ID, params.Encode())
+
+	var feedResp struct {
+		Data []struct {
+			ID       string `json:"id"`
+			Comments struct {
+				Data []struct {
+					ID          string      `json:"id"`
+					Message     string      `json:"message"`
+					CreatedTime string      `json:"created_time"`
+					From        graphAuthor `json:"from"`
+				} `json:"data"`
+			} `json:"comments"`
+		} `json:"data"`
+	}
+	if err := f.getJSON(ctx, endpoint, "page comments", &feedResp); err != nil {
+		return nil, err
+	}
+
+	var interactions []*social.Interaction
+	for _, post := range feedResp.Data {
+		for _, c := range post.Comments.Data {
+			if c.From.ID == page.ID {
+				continue
+			}
+			createdAt, _ := time.Parse(graphTime, c.CreatedTime)
+			interactions = append(interactions, &social.Interaction{
+				Kind:       social.InteractionComment,
+				ObjectID:   post.ID,
+				ItemID:     c.ID,
+				AuthorID:   c.From.ID,
+				AuthorName: c.From.Name,
+				Text:       c.Message,
+				CreatedAt:  createdAt,
+			})
+		}
+	}
+	return interactions, nil
+}
+
+func (f *FacebookAdapter) listMentions(ctx context.Context, page FacebookPage, since time.Time) ([]*social.Interaction, error) {
+	params := url.Values{}
+	params.Set("fields", "id,message,created_time,from")
+	params.Set("since", fmt.Sprintf("%d", since.Unix()))
+	params.Set("limit", "100")
+	params.Set("access_token", page.AccessToken)
+	endpoint := fmt.Sprintf("%s/%s/tagged?%s", facebookGraphURL, page.ID, params.Encode())
+
+	var taggedResp struct {
+		Data []struct {
+			ID          string      `json:"id"`
+			Message     string      `json:"message"`
+			CreatedTime string      `json:"created_time"`
+			From        graphAuthor `json:"from"`
+		} `json:"data"`
+	}
+	if err := f.getJSON(ctx, endpoint, "page mentions", &taggedResp); err != nil {
+		return nil, err
+	}
+
+	interactions := make([]*social.Interaction, 0, len(taggedResp.Data))
+	for _, p := range taggedResp.Data {
+		createdAt, _ := time.Parse(graphTime, p.CreatedTime)
+		interactions = append(interactions, &social.Interaction{
+			Kind:       social.InteractionMention,
+			ItemID:     p.ID,
+			AuthorID:   p.From.ID,
+			AuthorName: p.From.Name,
+			Text:       p.Message,
+			CreatedAt:  createdAt,
+		})
+	}
+	return interactions, nil
+}
+
+func (f *FacebookAdapter) getJSON(ctx context.Context, endpoint, what string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to list %s (%d)", what, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Reply answers a comment with a reply in its thread, a mention with a
+// comment on the post, and a Messenger message with a message back
+func (f *FacebookAdapter) Reply(ctx context.Context, token *social.Token, to *social.ReplyTarget, text string) (*social.ReplyResult, error) {
+	if len(text) > charLimit {
+		return nil, fmt.Errorf("reply exceeds %d character limit", charLimit)
+	}
+
+	pages, err := f.getUserPages(ctx, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pages: %w", err)
+	}
+
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("no Facebook pages found")
+	}
+	page := pages[0]
+
+	data := url.Values{}
+	var endpoint string
+	if to.Kind == social.InteractionMessage {
+		recipient, _ := json.Marshal(map[string]string{"id": to.RecipientID})
+		message, _ := json.Marshal(map[string]string{"text": text})
+		data.Set("recipient", string(recipient))
+		data.Set("message", string(message))
+		data.Set("messaging_type", "RESPONSE")
+		endpoint = fmt.Sprintf("%s/%s/messages", facebookGraphURL, page.ID)
+	} else {
+		data.Set("message", text)
+		endpoint = fmt.Sprintf("%s/%s/comments", facebookGraphURL, url.PathEscape(to.ItemID))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.URL.RawQuery = "access_token=" + url.QueryEscape(page.AccessToken)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("reply failed (%d): %s", resp.StatusCode, string(body))
+	}
+
+	var replyResp struct {
+		ID        string `json:"id"`         // comments
+		MessageID string `json:"message_id"` // messages
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&replyResp); err != nil {
+		return nil, err
+	}
+
+	id := replyResp.ID
+	if id == "" {
+		id = replyResp.MessageID
+	}
+	return &social.ReplyResult{
+		PlatformMessageID: id,
+		SentAt:            time.Now(),
+		RateLimit:         social.ParseRateLimitHeaders(resp.Header),
+	}, nil
+}
+
+// HideComment hides a comment on one of the page's posts from everyone but
+// its author and their friends
+func (f *FacebookAdapter) HideComment(ctx context.Context, token *social.Token, commentID string) error {
+	pages, err := f.getUserPages(ctx, token.AccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to get pages: %w", err)
+	}
+
+	if len(pages) == 0 {
+		return fmt.Errorf("no Facebook pages found")
+	}
+
+	data := url.Values{}
+	data.Set("is_hidden", "true")
+
+	endpoint := fmt.Sprintf("%s/%s", facebookGraphURL, url.PathEscape(commentID))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.URL.RawQuery = "access_token=" + url.QueryEscape(pages[0].AccessToken)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("hiding comment failed (%d): %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
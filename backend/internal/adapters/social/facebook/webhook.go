@@ -0,0 +1,309 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/facebook/webhook.go
+// Graph API webhooks for Pages and the Instagram accounts linked to them
+// ============================================================================
+package facebook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// WebhookParser implements socialDomain.WebhookParser for the webhooks of
+// the Meta app. Page and Instagram events share the app's callback URL.
+type WebhookParser struct {
+	appSecret   string
+	verifyToken string // set in the App Dashboard
+}
+
+func NewWebhookParser(appSecret, verifyToken string) *WebhookParser {
+	return &WebhookParser{appSecret: appSecret, verifyToken: verifyToken}
+}
+
+func (p *WebhookParser) Platform() socialDomain.Platform {
+	return socialDomain.PlatformFacebook
+}
+
+func (p *WebhookParser) SignatureHeader() string {
+	return "X-Hub-Signature-256"
+}
+
+// VerifySignature checks a "sha256=<hex HMAC-SHA256 of the body>"
+// signature keyed with the app secret
+func (p *WebhookParser) VerifySignature(body []byte, signature string) bool {
+	sig, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok || p.appSecret == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.appSecret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(sig), []byte(expected))
+}
+
+// Challenge echoes hub.challenge when the verify token matches
+func (p *WebhookParser) Challenge(query url.Values) (*socialDomain.WebhookChallenge, error) {
+	token := query.Get("hub.verify_token")
+	if query.Get("hub.mode") != "subscribe" || p.verifyToken == "" ||
+		subtle.ConstantTimeCompare([]byte(token), []byte(p.verifyToken)) != 1 {
+		return nil, socialDomain.ErrInvalidWebhookChallenge
+	}
+
+	return &socialDomain.WebhookChallenge{
+		ContentType: "text/plain",
+		Body:        []byte(query.Get("hub.challenge")),
+	}, nil
+}
+
+// webhookPayload is the envelope of every Graph API webhook
+type webhookPayload struct {
+	Object string `json:"object"` // "page", "instagram", "permissions"
+	Entry  []struct {
+		ID        string             `json:"id"`
+		Time      int64              `json:"time"`
+		Changes   []webhookChange    `json:"changes"`
+		Messaging []webhookMessaging `json:"messaging"`
+	} `json:"entry"`
+}
+
+type webhookChange struct {
+	Field string          `json:"field"`
+	Value json.RawMessage `json:"value"`
+}
+
+type webhookMessaging struct {
+	Sender struct {
+		ID string `json:"id"`
+	} `json:"sender"`
+	Timestamp int64 `json:"timestamp"` // milliseconds
+	Message   *struct {
+		MID    string `json:"mid"`
+		Text   string `json:"text"`
+		IsEcho bool   `json:"is_echo"`
+	} `json:"message"`
+}
+
+// pageFeedChange is the value of a Page "feed" change
+type pageFeedChange struct {
+	Item      string `json:"item"` // "comment", "post", "reaction", ...
+	Verb      string `json:"verb"` // "add", "edited", "remove"
+	PostID    string `json:"post_id"`
+	CommentID string `json:"comment_id"`
+	From      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"from"`
+	Message     string `json:"message"`
+	CreatedTime int64  `json:"created_time"`
+}
+
+// pageMentionChange is the value of a Page "mention" change
+type pageMentionChange struct {
+	Item       string `json:"item"` // "post" or "comment"
+	Verb       string `json:"verb"`
+	PostID     string `json:"post_id"`
+	CommentID  string `json:"comment_id"`
+	SenderID   string `json:"sender_id"`
+	SenderName string `json:"sender_name"`
+	Message    string `json:"message"`
+}
+
+// instagramCommentChange is the value of an Instagram "comments" change
+type instagramCommentChange struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	From struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"from"`
+	Media struct {
+		ID string `json:"id"`
+	} `json:"media"`
+}
+
+// instagramMentionChange is the value of an Instagram "mentions" change
+type instagramMentionChange struct {
+	MediaID   string `json:"media_id"`
+	CommentID string `json:"comment_id"`
+}
+
+// permissionChange is the value of a "permissions" change
+type permissionChange struct {
+	Verb string `json:"verb"` // "granted" or "revoked"
+}
+
+// EventType is the payload's object
+func (p *WebhookParser) EventType(body []byte) string {
+	var payload struct {
+		Object string `json:"object"`
+	}
+	if json.Unmarshal(body, &payload) != nil || payload.Object == "" {
+		return "unknown"
+	}
+	return payload.Object
+}
+
+// Parse extracts comments, mentions and messages for Pages and Instagram
+// accounts, Instagram story insights and permission revocations
+func (p *WebhookParser) Parse(body []byte) ([]socialDomain.PlatformEvent, error) {
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", socialDomain.ErrInvalidWebhookPayload, err)
+	}
+
+	platform := socialDomain.PlatformFacebook
+	if payload.Object == "instagram" {
+		platform = socialDomain.PlatformInstagram
+	}
+
+	var events []socialDomain.PlatformEvent
+	for _, entry := range payload.Entry {
+		at := time.Unix(entry.Time, 0).UTC()
+		base := socialDomain.PlatformEvent{Platform: platform, PlatformUserID: entry.ID, OccurredAt: at}
+
+		for _, change := range entry.Changes {
+			e, ok, err := parseChange(payload.Object, change, base)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s %s change: %v", socialDomain.ErrInvalidWebhookPayload, payload.Object, change.Field, err)
+			}
+			if ok {
+				events = append(events, e)
+			}
+		}
+
+		for _, m := range entry.Messaging {
+			// Echoes are the account's own replies
+			if m.Message == nil || m.Message.IsEcho || m.Sender.ID == entry.ID {
+				continue
+			}
+			e := base
+			e.Kind = socialDomain.PlatformEventMessage
+			e.ItemID = m.Message.MID
+			e.AuthorID = m.Sender.ID
+			e.Text = m.Message.Text
+			if m.Timestamp > 0 {
+				e.OccurredAt = time.UnixMilli(m.Timestamp).UTC()
+			}
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+// parseChange maps one change. ok is false for changes that are ignored.
+func parseChange(object string, change webhookChange, e socialDomain.PlatformEvent) (_ socialDomain.PlatformEvent, ok bool, err error) {
+	switch object + "/" + change.Field {
+	case "page/feed":
+		var v pageFeedChange
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		// Only new comments by others; the Page's own replies come back too
+		if v.Item != "comment" || v.Verb != "add" || v.From.ID == e.PlatformUserID {
+			return e, false, nil
+		}
+		e.Kind = socialDomain.PlatformEventComment
+		e.ObjectID = v.PostID
+		e.ItemID = v.CommentID
+		e.AuthorID = v.From.ID
+		e.AuthorName = v.From.Name
+		e.Text = v.Message
+		if v.CreatedTime > 0 {
+			e.OccurredAt = time.Unix(v.CreatedTime, 0).UTC()
+		}
+
+	case "page/mention":
+		var v pageMentionChange
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		if v.Verb != "add" {
+			return e, false, nil
+		}
+		e.Kind = socialDomain.PlatformEventMention
+		e.ObjectID = v.PostID
+		e.ItemID = v.PostID
+		if v.Item == "comment" {
+			e.ItemID = v.CommentID
+		}
+		e.AuthorID = v.SenderID
+		e.AuthorName = v.SenderName
+		e.Text = v.Message
+
+	case "instagram/comments":
+		var v instagramCommentChange
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		if v.From.ID == e.PlatformUserID {
+			return e, false, nil
+		}
+		e.Kind = socialDomain.PlatformEventComment
+		e.ObjectID = v.Media.ID
+		e.ItemID = v.ID
+		e.AuthorID = v.From.ID
+		e.AuthorName = v.From.Username
+		e.Text = v.Text
+
+	case "instagram/mentions":
+		var v instagramMentionChange
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		e.Kind = socialDomain.PlatformEventMention
+		e.ObjectID = v.MediaID
+		e.ItemID = v.MediaID
+		if v.CommentID != "" {
+			e.ItemID = v.CommentID
+		}
+
+	case "instagram/story_insights":
+		var v map[string]json.RawMessage
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		e.Kind = socialDomain.PlatformEventInsights
+		e.Metrics = make(map[string]int64)
+		for k, raw := range v {
+			if k == "media_id" {
+				json.Unmarshal(raw, &e.ObjectID)
+				continue
+			}
+			var n int64
+			if json.Unmarshal(raw, &n) == nil {
+				e.Metrics[k] = n
+			}
+		}
+		if e.ObjectID == "" {
+			return e, false, nil
+		}
+
+	default:
+		// Permission changes name the permission as the field
+		if object != "permissions" {
+			return e, false, nil
+		}
+		var v permissionChange
+		if err := json.Unmarshal(change.Value, &v); err != nil {
+			return e, false, err
+		}
+		if v.Verb != "revoked" {
+			return e, false, nil
+		}
+		e.Kind = socialDomain.PlatformEventTokenRevoked
+	}
+
+	return e, true, nil
+}
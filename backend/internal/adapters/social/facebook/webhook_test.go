@@ -0,0 +1,97 @@
+// path: backend/internal/adapters/social/facebook/webhook_test.go
+package facebook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"testing"
+
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+func TestWebhookParser(t *testing.T) {
+	parser := NewWebhookParser("app_secret", "verify_me")
+
+	t.Run("verifies signatures", func(t *testing.T) {
+		body := []byte(`{"object":"page"}`)
+		mac := hmac.New(sha256.New, []byte("app_secret"))
+		mac.Write(body)
+		sig := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+		if !parser.VerifySignature(body, sig) {
+			t.Error("valid signature rejected")
+		}
+		if parser.VerifySignature([]byte(`{"object":"user"}`), sig) {
+			t.Error("tampered body verified")
+		}
+		if parser.VerifySignature(body, "") {
+			t.Error("missing signature verified")
+		}
+	})
+
+	t.Run("answers the handshake with the right token only", func(t *testing.T) {
+		q := url.Values{"hub.mode": {"subscribe"}, "hub.verify_token": {"verify_me"}, "hub.challenge": {"42"}}
+		c, err := parser.Challenge(q)
+		if err != nil || string(c.Body) != "42" {
+			t.Fatalf("challenge=%v err=%v", c, err)
+		}
+
+		q.Set("hub.verify_token", "guess")
+		if _, err := parser.Challenge(q); !errors.Is(err, socialDomain.ErrInvalidWebhookChallenge) {
+			t.Fatalf("err = %v, want %v", err, socialDomain.ErrInvalidWebhookChallenge)
+		}
+	})
+
+	t.Run("parses page and instagram events", func(t *testing.T) {
+		body := []byte(`{"object":"page","entry":[{"id":"p1","time":1700000000,"changes":[
+			{"field":"feed","value":{"item":"comment","verb":"add","post_id":"p1_9","comment_id":"c1","from":{"id":"u1","name":"Ann"},"message":"hi","created_time":1700000001}},
+			{"field":"feed","value":{"item":"comment","verb":"add","post_id":"p1_9","comment_id":"c2","from":{"id":"p1","name":"Page"},"message":"own reply"}},
+			{"field":"feed","value":{"item":"reaction","verb":"add","post_id":"p1_9"}}
+		],"messaging":[{"sender":{"id":"u2"},"timestamp":1700000002000,"message":{"mid":"m1","text":"dm"}}]}]}`)
+
+		events, err := parser.Parse(body)
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		if len(events) != 2 {
+			t.Fatalf("got %d events, want 2: %+v", len(events), events)
+		}
+		if e := events[0]; e.Kind != socialDomain.PlatformEventComment || e.ItemID != "c1" || e.ObjectID != "p1_9" || e.PlatformUserID != "p1" || e.Text != "hi" {
+			t.Errorf("comment = %+v", e)
+		}
+		if e := events[1]; e.Kind != socialDomain.PlatformEventMessage || e.AuthorID != "u2" || e.OccurredAt.UnixMilli() != 1700000002000 {
+			t.Errorf("message = %+v", e)
+		}
+
+		insights := []byte(`{"object":"instagram","entry":[{"id":"ig1","time":1700000000,"changes":[
+			{"field":"story_insights","value":{"media_id":"s1","impressions":10,"reach":7}}
+		]}]}`)
+		events, err = parser.Parse(insights)
+		if err != nil || len(events) != 1 {
+			t.Fatalf("events=%+v err=%v", events, err)
+		}
+		if e := events[0]; e.Platform != socialDomain.PlatformInstagram || e.ObjectID != "s1" || e.Metrics["impressions"] != 10 || e.Metrics["reach"] != 7 {
+			t.Errorf("insights = %+v", e)
+		}
+	})
+
+	t.Run("parses permission revocations", func(t *testing.T) {
+		body := []byte(`{"object":"permissions","entry":[{"id":"u9","time":1700000000,"changes":[
+			{"field":"pages_manage_posts","value":{"verb":"revoked"}}
+		]}]}`)
+
+		events, err := parser.Parse(body)
+		if err != nil || len(events) != 1 || events[0].Kind != socialDomain.PlatformEventTokenRevoked || events[0].PlatformUserID != "u9" {
+			t.Fatalf("events=%+v err=%v", events, err)
+		}
+	})
+
+	t.Run("rejects malformed payloads", func(t *testing.T) {
+		if _, err := parser.Parse([]byte(`{"object":`)); !errors.Is(err, socialDomain.ErrInvalidWebhookPayload) {
+			t.Fatalf("err = %v, want %v", err, socialDomain.ErrInvalidWebhookPayload)
+		}
+	})
+}
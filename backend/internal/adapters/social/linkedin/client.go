@@ -23,7 +23,6 @@ const (
 	linkedinTokenURL = "https://www.linkedin.com/oauth/v2/accessToken"
 	linkedinAPIURL   = "https://api.linkedin.com/v2"
 	charLimit        = 3000
-	maxRetries       = 3
 )
 
 type LinkedInAdapter struct {
@@ -82,6 +81,9 @@ func (l *LinkedInAdapter) ExchangeCode(ctx context.Context, code string) (*socia
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("linkedin oauth failed (%d): %s", resp.StatusCode, string(body))
@@ -116,7 +118,7 @@ func (l *LinkedInAdapter) RefreshToken(ctx context.Context, refreshToken string)
 func (l *LinkedInAdapter) PublishPost(ctx context.Context, token *social.Token, content *social.PostContent) (*social.PublishResult, error) {
 	// Validate content
 	if len(content.Text) > charLimit {
-		return nil, fmt.Errorf("post exceeds %d character limit", charLimit)
+		return nil, fmt.Errorf("%w: post exceeds %d character limit", social.ErrInvalidContent, charLimit)
 	}
 
 	// Get user's LinkedIn ID
@@ -152,23 +154,14 @@ func (l *LinkedInAdapter) PublishPost(ctx context.Context, token *social.Token,
 		payload["specificContent"].(map[string]interface{})["com.linkedin.ugc.ShareContent"].(map[string]interface{})["media"] = mediaAssets
 	}
 
-	// Create post with retry logic
-	var lastErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		result, err := l.createPost(ctx, token.AccessToken, payload)
-		if err == nil {
-			return result, nil
-		}
-		lastErr = err
-
-		if strings.Contains(err.Error(), "429") {
-			time.Sleep(time.Duration(attempt+1) * 5 * time.Second)
-			continue
-		}
-		break
+	// A 429 comes back as *social.RateLimitError so the caller can defer
+	// the post instead of sleeping here
+	result, err := l.createPost(ctx, token.AccessToken, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
 
-	return nil, fmt.Errorf("failed after %d attempts: %w", maxRetries, lastErr)
+	return result, nil
 }
 
 // createPost makes the API call to create a post
@@ -193,8 +186,18 @@ func (l *LinkedInAdapter) createPost(ctx context.Context, accessToken string, pa
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, &social.RejectedError{StatusCode: resp.StatusCode, Body: string(body)}
+		}
 		return nil, fmt.Errorf("post creation failed (%d): %s", resp.StatusCode, string(body))
 	}
 
@@ -210,9 +213,84 @@ func (l *LinkedInAdapter) createPost(ctx context.Context, accessToken string, pa
 		PlatformPostID: postResp.ID,
 		URL:            fmt.Sprintf("https://www.linkedin.com/feed/update/%s", postResp.ID),
 		PublishedAt:    time.Now(),
+		RateLimit:      social.ParseRateLimitHeaders(resp.Header),
 	}, nil
 }
 
+// ListRecentPosts returns the member's posts created at or after since
+func (l *LinkedInAdapter) ListRecentPosts(ctx context.Context, token *social.Token, since time.Time) ([]*social.RecentPost, error) {
+	userID, err := l.getUserID(ctx, token.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user ID: %w", err)
+	}
+
+	// Newest first, so the listing can stop at since
+	author := url.QueryEscape(fmt.Sprintf("urn:li:person:%s", userID))
+	endpoint := fmt.Sprintf("%s/ugcPosts?q=authors&authors=List(%s)&sortBy=CREATED&count=50", linkedinAPIURL, author)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list posts (%d)", resp.StatusCode)
+	}
+
+	var postsResp struct {
+		Elements []struct {
+			ID      string `json:"id"`
+			Created struct {
+				Time int64 `json:"time"`
+			} `json:"created"`
+			SpecificContent struct {
+				ShareContent struct {
+					ShareCommentary struct {
+						Text string `json:"text"`
+					} `json:"shareCommentary"`
+				} `json:"com.linkedin.ugc.ShareContent"`
+			} `json:"specificContent"`
+		} `json:"elements"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&postsResp); err != nil {
+		return nil, err
+	}
+
+	posts := make([]*social.RecentPost, 0, len(postsResp.Elements))
+	for _, p := range postsResp.Elements {
+		// created.time is in epoch milliseconds
+		createdAt := time.UnixMilli(p.Created.Time)
+		if createdAt.Before(since) {
+			break
+		}
+		posts = append(posts, &social.RecentPost{
+			PlatformPostID: p.ID,
+			URL:            fmt.Sprintf("https://www.linkedin.com/feed/update/%s", p.ID),
+			Text:           p.SpecificContent.ShareContent.ShareCommentary.Text,
+			CreatedAt:      createdAt,
+		})
+	}
+
+	return posts, nil
+}
+
 // getUserID retrieves the authenticated user's LinkedIn ID
 func (l *LinkedInAdapter) getUserID(ctx context.Context, accessToken string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", linkedinAPIURL+"/me", nil)
@@ -228,6 +306,9 @@ func (l *LinkedInAdapter) getUserID(ctx context.Context, accessToken string) (st
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("failed to get user info (%d)", resp.StatusCode)
 	}
@@ -288,6 +369,9 @@ func (l *LinkedInAdapter) GetPostAnalytics(ctx context.Context, token *social.To
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to get analytics (%d)", resp.StatusCode)
 	}
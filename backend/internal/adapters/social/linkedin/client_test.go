@@ -0,0 +1,64 @@
+// path: backend/internal/adapters/social/linkedin/client_test.go
+package linkedin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+)
+
+// toServer sends every request to the test server instead of LinkedIn
+type toServer struct {
+	target *url.URL
+}
+
+func (t toServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestListRecentPosts(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/me":
+			w.Write([]byte(`{"id":"abc"}`))
+		case "/v2/ugcPosts":
+			if got := r.URL.Query().Get("authors"); got != "List(urn:li:person:abc)" {
+				t.Errorf("authors = %q", got)
+			}
+			w.Write([]byte(`{"elements":[
+				{"id":"urn:li:share:2","created":{"time":` + strconv.FormatInt(since.Add(time.Minute).UnixMilli(), 10) + `},
+				 "specificContent":{"com.linkedin.ugc.ShareContent":{"shareCommentary":{"text":"Launch day"}}}},
+				{"id":"urn:li:share:1","created":{"time":` + strconv.FormatInt(since.Add(-time.Hour).UnixMilli(), 10) + `},
+				 "specificContent":{"com.linkedin.ugc.ShareContent":{"shareCommentary":{"text":"Older"}}}}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	target, _ := url.Parse(srv.URL)
+	adapter := NewLinkedInAdapter("id", "secret", "")
+	adapter.httpClient = &http.Client{Transport: toServer{target: target}}
+
+	posts, err := adapter.ListRecentPosts(context.Background(), &social.Token{AccessToken: "token"}, since)
+	if err != nil {
+		t.Fatalf("ListRecentPosts: %v", err)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("got %d posts, want only the one after since: %+v", len(posts), posts)
+	}
+	if p := posts[0]; p.PlatformPostID != "urn:li:share:2" || p.Text != "Launch day" || !p.CreatedAt.Equal(since.Add(time.Minute)) {
+		t.Errorf("got %+v", p)
+	}
+}
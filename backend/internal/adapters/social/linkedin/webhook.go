@@ -0,0 +1,148 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/linkedin/webhook.go
+// Organization social action notifications
+// ============================================================================
+package linkedin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// WebhookParser implements socialDomain.WebhookParser for LinkedIn
+// webhooks, which are signed with the app's client secret
+type WebhookParser struct {
+	clientSecret string
+}
+
+func NewWebhookParser(clientSecret string) *WebhookParser {
+	return &WebhookParser{clientSecret: clientSecret}
+}
+
+func (p *WebhookParser) Platform() socialDomain.Platform {
+	return socialDomain.PlatformLinkedIn
+}
+
+func (p *WebhookParser) SignatureHeader() string {
+	return "X-LI-Signature"
+}
+
+// VerifySignature checks a "hmacsha256=<hex HMAC-SHA256 of the body>"
+// signature. The prefix is optional.
+func (p *WebhookParser) VerifySignature(body []byte, signature string) bool {
+	if p.clientSecret == "" || signature == "" {
+		return false
+	}
+	signature = strings.TrimPrefix(signature, "hmacsha256=")
+	return hmac.Equal([]byte(signature), []byte(p.sign(body)))
+}
+
+// Challenge answers the validation request LinkedIn sends on registration
+// and every few hours afterwards
+func (p *WebhookParser) Challenge(query url.Values) (*socialDomain.WebhookChallenge, error) {
+	code := query.Get("challengeCode")
+	if code == "" || p.clientSecret == "" {
+		return nil, socialDomain.ErrInvalidWebhookChallenge
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"challengeCode":     code,
+		"challengeResponse": p.sign([]byte(code)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &socialDomain.WebhookChallenge{ContentType: "application/json", Body: body}, nil
+}
+
+func (p *WebhookParser) sign(data []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.clientSecret))
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type notificationPayload struct {
+	Type          string `json:"type"`
+	Notifications []struct {
+		Action               string `json:"action"` // "COMMENT", "SHARE_MENTION", "LIKE", ...
+		OrganizationalEntity string `json:"organizationalEntity"`
+		SourcePost           string `json:"sourcePost"`
+		GeneratedActivity    string `json:"generatedActivity"`
+		LastModifiedAt       int64  `json:"lastModifiedAt"` // milliseconds
+		Decorated            struct {
+			Comment struct {
+				Text  string `json:"text"`
+				Owner string `json:"owner"`
+			} `json:"comment"`
+		} `json:"decoratedGeneratedActivity"`
+	} `json:"notifications"`
+}
+
+// EventType is the notification type
+func (p *WebhookParser) EventType(body []byte) string {
+	var payload struct {
+		Type string `json:"type"`
+	}
+	if json.Unmarshal(body, &payload) != nil || payload.Type == "" {
+		return "unknown"
+	}
+	return payload.Type
+}
+
+// Parse extracts comments on and mentions of organization pages. The
+// account is identified by the organization's ID.
+func (p *WebhookParser) Parse(body []byte) ([]socialDomain.PlatformEvent, error) {
+	var payload notificationPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", socialDomain.ErrInvalidWebhookPayload, err)
+	}
+
+	var events []socialDomain.PlatformEvent
+	for _, n := range payload.Notifications {
+		var kind socialDomain.PlatformEventKind
+		switch n.Action {
+		case "COMMENT":
+			kind = socialDomain.PlatformEventComment
+		case "SHARE_MENTION", "COMMENT_MENTION":
+			kind = socialDomain.PlatformEventMention
+		default:
+			continue
+		}
+
+		e := socialDomain.PlatformEvent{
+			Kind:           kind,
+			Platform:       socialDomain.PlatformLinkedIn,
+			PlatformUserID: urnID(n.OrganizationalEntity),
+			ObjectID:       n.SourcePost,
+			ItemID:         n.GeneratedActivity,
+			AuthorID:       n.Decorated.Comment.Owner,
+			Text:           n.Decorated.Comment.Text,
+			OccurredAt:     time.Now().UTC(),
+		}
+		if e.ItemID == "" {
+			e.ItemID = n.SourcePost
+		}
+		if n.LastModifiedAt > 0 {
+			e.OccurredAt = time.UnixMilli(n.LastModifiedAt).UTC()
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// urnID returns the last segment of a URN such as urn:li:organization:123
+func urnID(urn string) string {
+	if i := strings.LastIndex(urn, ":"); i >= 0 {
+		return urn[i+1:]
+	}
+	return urn
+}
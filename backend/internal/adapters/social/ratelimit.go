@@ -0,0 +1,157 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/ratelimit.go
+// PURPOSE: Rate limit information reported by platform responses
+// ============================================================================
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+// RateLimit is the quota a platform reported on a response
+type RateLimit struct {
+	Limit     int // 0 if the platform doesn't say
+	Remaining int
+	ResetAt   time.Time // zero if unknown
+
+	// Percent is true when Limit is 100 and Remaining the share of the
+	// platform's budget left, rather than request counts. Facebook and
+	// Instagram report usage this way without disclosing the budget.
+	Percent bool
+}
+
+// RateLimitError is returned when the platform rejects a call with 429
+type RateLimitError struct {
+	RetryAfter time.Duration
+	Limit      *RateLimit
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("platform rate limit exceeded (429), retry after %s", e.RetryAfter)
+}
+
+// Facebook usage headers report percentages of a rolling one-hour window
+const facebookUsageWindow = time.Hour
+
+// facebookUsage is one entry of x-app-usage or x-business-use-case-usage
+type facebookUsage struct {
+	CallCount    int `json:"call_count"`
+	TotalTime    int `json:"total_time"`
+	TotalCPUTime int `json:"total_cputime"`
+	// Minutes until calls are allowed again, business use case usage only
+	EstimatedTimeToRegainAccess int `json:"estimated_time_to_regain_access"`
+}
+
+func (u facebookUsage) used() int {
+	return max(u.CallCount, u.TotalTime, u.TotalCPUTime)
+}
+
+// ParseRateLimitHeaders reads the platform's rate limit headers:
+// x-rate-limit-* (Twitter), x-app-usage and x-business-use-case-usage
+// (Facebook, Instagram). It returns nil if none are present.
+func ParseRateLimitHeaders(h http.Header) *RateLimit {
+	if remaining := h.Get("X-Rate-Limit-Remaining"); remaining != "" {
+		r, err := strconv.Atoi(remaining)
+		if err != nil {
+			return nil
+		}
+		rl := &RateLimit{Remaining: r}
+		if limit, err := strconv.Atoi(h.Get("X-Rate-Limit-Limit")); err == nil {
+			rl.Limit = limit
+		}
+		if reset, err := strconv.ParseInt(h.Get("X-Rate-Limit-Reset"), 10, 64); err == nil {
+			rl.ResetAt = time.Unix(reset, 0)
+		}
+		return rl
+	}
+
+	return parseFacebookUsage(h.Get("X-App-Usage"), h.Get("X-Business-Use-Case-Usage"))
+}
+
+// parseFacebookUsage reads x-app-usage, {"call_count":28,"total_time":25,
+// "total_cputime":25}, and x-business-use-case-usage, the same per business
+// and use case: {"<business id>":[{"type":"pages","call_count":90,...}]}.
+// The most used dimension of any of them is the account's usage.
+func parseFacebookUsage(appUsage, businessUsage string) *RateLimit {
+	var (
+		found  bool
+		used   int
+		regain time.Duration
+	)
+
+	if appUsage != "" {
+		var usage facebookUsage
+		if err := json.Unmarshal([]byte(appUsage), &usage); err == nil {
+			found = true
+			used = usage.used()
+		}
+	}
+
+	if businessUsage != "" {
+		var businesses map[string][]facebookUsage
+		if err := json.Unmarshal([]byte(businessUsage), &businesses); err == nil {
+			for _, usages := range businesses {
+				for _, usage := range usages {
+					found = true
+					used = max(used, usage.used())
+					regain = max(regain, time.Duration(usage.EstimatedTimeToRegainAccess)*time.Minute)
+				}
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	resetAt := time.Now().Add(facebookUsageWindow)
+	if regain > 0 {
+		resetAt = time.Now().Add(regain)
+	}
+	return &RateLimit{
+		Limit:     100,
+		Remaining: max(0, 100-used),
+		ResetAt:   resetAt,
+		Percent:   true,
+	}
+}
+
+// ObserveRateLimit records what a platform reported on the limiter, as
+// request counts or, for Percent quotas, as the share of the budget used
+func ObserveRateLimit(ctx context.Context, limiter common.PlatformRateLimiter, platform, accountID, class string, rl *RateLimit) error {
+	if limiter == nil || rl == nil {
+		return nil
+	}
+	if rl.Percent {
+		return limiter.ObserveUsage(ctx, platform, accountID, class, 100-rl.Remaining, rl.ResetAt)
+	}
+	return limiter.Observe(ctx, platform, accountID, class, rl.Remaining, rl.Limit, rl.ResetAt)
+}
+
+// NewRateLimitError builds a RateLimitError from a 429 response
+func NewRateLimitError(h http.Header) *RateLimitError {
+	rl := ParseRateLimitHeaders(h)
+	if rl == nil {
+		rl = &RateLimit{}
+	}
+	rl.Remaining = 0
+
+	retryAfter := time.Minute
+	if secs, err := strconv.Atoi(h.Get("Retry-After")); err == nil {
+		retryAfter = time.Duration(secs) * time.Second
+	} else if !rl.ResetAt.IsZero() {
+		retryAfter = time.Until(rl.ResetAt)
+	}
+	if rl.ResetAt.IsZero() {
+		rl.ResetAt = time.Now().Add(retryAfter)
+	}
+
+	return &RateLimitError{RetryAfter: retryAfter, Limit: rl}
+}
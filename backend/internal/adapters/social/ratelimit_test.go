@@ -0,0 +1,53 @@
+// path: backend/internal/adapters/social/ratelimit_test.go
+package social
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	t.Run("twitter counts", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("X-Rate-Limit-Limit", "300")
+		h.Set("X-Rate-Limit-Remaining", "12")
+		h.Set("X-Rate-Limit-Reset", "1736935200")
+
+		rl := ParseRateLimitHeaders(h)
+		if rl == nil || rl.Percent || rl.Limit != 300 || rl.Remaining != 12 || rl.ResetAt.Unix() != 1736935200 {
+			t.Fatalf("got %+v", rl)
+		}
+	})
+
+	t.Run("facebook business use case usage", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("X-App-Usage", `{"call_count":10,"total_time":5,"total_cputime":5}`)
+		h.Set("X-Business-Use-Case-Usage",
+			`{"1234":[{"type":"pages","call_count":95,"total_time":20,"total_cputime":20,"estimated_time_to_regain_access":15}]}`)
+
+		rl := ParseRateLimitHeaders(h)
+		if rl == nil || !rl.Percent || rl.Limit != 100 || rl.Remaining != 5 {
+			t.Fatalf("got %+v, want 5%% left", rl)
+		}
+		if until := time.Until(rl.ResetAt); until < 14*time.Minute || until > 15*time.Minute {
+			t.Fatalf("resets in %s, want the 15 minutes to regain access", until)
+		}
+	})
+
+	t.Run("facebook app usage", func(t *testing.T) {
+		h := http.Header{}
+		h.Set("X-App-Usage", `{"call_count":28,"total_time":40,"total_cputime":25}`)
+
+		rl := ParseRateLimitHeaders(h)
+		if rl == nil || !rl.Percent || rl.Remaining != 60 {
+			t.Fatalf("got %+v, want 60%% left", rl)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		if rl := ParseRateLimitHeaders(http.Header{}); rl != nil {
+			t.Fatalf("got %+v, want nil", rl)
+		}
+	})
+}
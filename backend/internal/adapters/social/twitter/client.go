@@ -22,7 +22,6 @@ const (
 	twitterAuthURL  = "https://twitter.com/i/oauth2/authorize"
 	twitterTokenURL = "https://api.twitter.com/2/oauth2/token"
 	twitterAPIURL   = "https://api.twitter.com/2"
-	maxRetries      = 3
 	charLimit       = 280
 )
 
@@ -85,6 +84,9 @@ func (t *TwitterAdapter) ExchangeCode(ctx context.Context, code string) (*social
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("twitter oauth failed (%d): %s", resp.StatusCode, string(body))
@@ -135,6 +137,9 @@ func (t *TwitterAdapter) getUserID(ctx context.Context, accessToken string) (str
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", fmt.Errorf("failed to get user info (%d)", resp.StatusCode)
 	}
@@ -174,6 +179,9 @@ func (t *TwitterAdapter) RefreshToken(ctx context.Context, refreshToken string)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("refresh failed (%d): %s", resp.StatusCode, string(body))
@@ -207,7 +215,7 @@ func (t *TwitterAdapter) RefreshToken(ctx context.Context, refreshToken string)
 // PublishPost publishes a tweet
 func (t *TwitterAdapter) PublishPost(ctx context.Context, token *social.Token, content *social.PostContent) (*social.PublishResult, error) {
 	if len(content.Text) > charLimit {
-		return nil, fmt.Errorf("tweet exceeds %d character limit", charLimit)
+		return nil, fmt.Errorf("%w: tweet exceeds %d character limit", social.ErrInvalidContent, charLimit)
 	}
 
 	payload := map[string]interface{}{
@@ -224,22 +232,14 @@ func (t *TwitterAdapter) PublishPost(ctx context.Context, token *social.Token, c
 		}
 	}
 
-	var lastErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		result, err := t.createTweet(ctx, token.AccessToken, payload)
-		if err == nil {
-			return result, nil
-		}
-		lastErr = err
-
-		if strings.Contains(err.Error(), "429") {
-			time.Sleep(time.Duration(attempt+1) * 5 * time.Second)
-			continue
-		}
-		break
+	// A 429 comes back as *social.RateLimitError so the caller can defer
+	// the post instead of sleeping here
+	result, err := t.createTweet(ctx, token.AccessToken, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tweet: %w", err)
 	}
 
-	return nil, fmt.Errorf("failed after %d attempts: %w", maxRetries, lastErr)
+	return result, nil
 }
 
 func (t *TwitterAdapter) createTweet(ctx context.Context, accessToken string, payload map[string]interface{}) (*social.PublishResult, error) {
@@ -262,8 +262,18 @@ func (t *TwitterAdapter) createTweet(ctx context.Context, accessToken string, pa
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode >= http.StatusBadRequest {
+			return nil, &social.RejectedError{StatusCode: resp.StatusCode, Body: string(body)}
+		}
 		return nil, fmt.Errorf("tweet creation failed (%d): %s", resp.StatusCode, string(body))
 	}
 
@@ -282,6 +292,7 @@ func (t *TwitterAdapter) createTweet(ctx context.Context, accessToken string, pa
 		PlatformPostID: tweetResp.Data.ID,
 		URL:            fmt.Sprintf("https://twitter.com/i/status/%s", tweetResp.Data.ID),
 		PublishedAt:    time.Now(),
+		RateLimit:      social.ParseRateLimitHeaders(resp.Header),
 	}, nil
 }
 
@@ -323,6 +334,9 @@ func (t *TwitterAdapter) GetPostAnalytics(ctx context.Context, token *social.Tok
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to get analytics (%d)", resp.StatusCode)
 	}
@@ -352,3 +366,69 @@ func (t *TwitterAdapter) GetPostAnalytics(ctx context.Context, token *social.Tok
 		Comments: analyticsResp.Data.PublicMetrics.Replies,
 	}, nil
 }
+
+// ListRecentPosts returns the account's tweets created at or after since
+func (t *TwitterAdapter) ListRecentPosts(ctx context.Context, token *social.Token, since time.Time) ([]*social.RecentPost, error) {
+	userID := token.PlatformUserID
+	if userID == "" {
+		id, err := t.getUserID(ctx, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = id
+	}
+
+	params := url.Values{}
+	params.Set("start_time", since.UTC().Format(time.RFC3339))
+	params.Set("max_results", "100")
+	params.Set("tweet.fields", "created_at")
+	endpoint := fmt.Sprintf("%s/users/%s/tweets?%s", twitterAPIURL, userID, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list tweets (%d)", resp.StatusCode)
+	}
+
+	var timelineResp struct {
+		Data []struct {
+			ID        string    `json:"id"`
+			Text      string    `json:"text"`
+			CreatedAt time.Time `json:"created_at"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&timelineResp); err != nil {
+		return nil, err
+	}
+
+	posts := make([]*social.RecentPost, 0, len(timelineResp.Data))
+	for _, tweet := range timelineResp.Data {
+		posts = append(posts, &social.RecentPost{
+			PlatformPostID: tweet.ID,
+			URL:            fmt.Sprintf("https://twitter.com/i/status/%s", tweet.ID),
+			Text:           tweet.Text,
+			CreatedAt:      tweet.CreatedAt,
+		})
+	}
+
+	return posts, nil
+}
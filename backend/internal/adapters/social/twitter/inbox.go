@@ -0,0 +1,288 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/twitter/inbox.go
+// Reading and answering mentions, replies and direct messages
+// ============================================================================
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+)
+
+// ListInteractions returns replies to and mentions of the account created
+// at or after since, and its direct messages if the token may read them.
+// The account's own tweets and messages are skipped.
+func (t *TwitterAdapter) ListInteractions(ctx context.Context, token *social.Token, since time.Time) ([]*social.Interaction, error) {
+	userID := token.PlatformUserID
+	if userID == "" {
+		id, err := t.getUserID(ctx, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = id
+	}
+
+	interactions, err := t.listMentions(ctx, token.AccessToken, userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(token.Scopes, "dm.read") {
+		messages, err := t.listDirectMessages(ctx, token.AccessToken, userID, since)
+		if err != nil {
+			return nil, err
+		}
+		interactions = append(interactions, messages...)
+	}
+
+	return interactions, nil
+}
+
+func (t *TwitterAdapter) listMentions(ctx context.Context, accessToken, userID string, since time.Time) ([]*social.Interaction, error) {
+	params := url.Values{}
+	params.Set("start_time", since.UTC().Format(time.RFC3339))
+	params.Set("max_results", "100")
+	params.Set("tweet.fields", "created_at,author_id,referenced_tweets")
+	params.Set("expansions", "author_id")
+	params.Set("user.fields", "username")
+	endpoint := fmt.Sprintf("%s/users/%s/mentions?%s", twitterAPIURL, userID, params.Encode())
+
+	var mentionsResp struct {
+		Data []struct {
+			ID               string    `json:"id"`
+			Text             string    `json:"text"`
+			AuthorID         string    `json:"author_id"`
+			CreatedAt        time.Time `json:"created_at"`
+			ReferencedTweets []struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"referenced_tweets"`
+		} `json:"data"`
+		Includes struct {
+			Users []struct {
+				ID       string `json:"id"`
+				Username string `json:"username"`
+			} `json:"users"`
+		} `json:"includes"`
+	}
+	if err := t.getJSON(ctx, accessToken, endpoint, "mentions", &mentionsResp); err != nil {
+		return nil, err
+	}
+
+	usernames := make(map[string]string, len(mentionsResp.Includes.Users))
+	for _, u := range mentionsResp.Includes.Users {
+		usernames[u.ID] = u.Username
+	}
+
+	interactions := make([]*social.Interaction, 0, len(mentionsResp.Data))
+	for _, tweet := range mentionsResp.Data {
+		if tweet.AuthorID == userID {
+			continue
+		}
+		i := &social.Interaction{
+			Kind:       social.InteractionMention,
+			ItemID:     tweet.ID,
+			AuthorID:   tweet.AuthorID,
+			AuthorName: usernames[tweet.AuthorID],
+			Text:       tweet.Text,
+			CreatedAt:  tweet.CreatedAt,
+		}
+		for _, ref := range tweet.ReferencedTweets {
+			if ref.Type == "replied_to" {
+				i.Kind = social.InteractionComment
+				i.ObjectID = ref.ID
+			}
+		}
+		interactions = append(interactions, i)
+	}
+	return interactions, nil
+}
+
+func (t *TwitterAdapter) listDirectMessages(ctx context.Context, accessToken, userID string, since time.Time) ([]*social.Interaction, error) {
+	params := url.Values{}
+	params.Set("event_types", "MessageCreate")
+	params.Set("max_results", "100")
+	params.Set("dm_event.fields", "created_at,sender_id,text")
+	endpoint := fmt.Sprintf("%s/dm_events?%s", twitterAPIURL, params.Encode())
+
+	var eventsResp struct {
+		Data []struct {
+			ID        string    `json:"id"`
+			Text      string    `json:"text"`
+			SenderID  string    `json:"sender_id"`
+			CreatedAt time.Time `json:"created_at"`
+		} `json:"data"`
+	}
+	if err := t.getJSON(ctx, accessToken, endpoint, "direct messages", &eventsResp); err != nil {
+		return nil, err
+	}
+
+	// Events come newest first and the endpoint has no start time
+	var interactions []*social.Interaction
+	for _, dm := range eventsResp.Data {
+		if dm.CreatedAt.Before(since) {
+			break
+		}
+		if dm.SenderID == userID {
+			continue
+		}
+		interactions = append(interactions, &social.Interaction{
+			Kind:      social.InteractionMessage,
+			ItemID:    dm.ID,
+			AuthorID:  dm.SenderID,
+			Text:      dm.Text,
+			CreatedAt: dm.CreatedAt,
+		})
+	}
+	return interactions, nil
+}
+
+func (t *TwitterAdapter) getJSON(ctx context.Context, accessToken, endpoint, what string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to list %s (%d)", what, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Reply answers a reply or mention with a tweet in its thread, and a direct
+// message with a message to the same participant
+func (t *TwitterAdapter) Reply(ctx context.Context, token *social.Token, to *social.ReplyTarget, text string) (*social.ReplyResult, error) {
+	if len(text) > charLimit {
+		return nil, fmt.Errorf("tweet exceeds %d character limit", charLimit)
+	}
+
+	if to.Kind == social.InteractionMessage {
+		return t.sendDirectMessage(ctx, token.AccessToken, to.RecipientID, text)
+	}
+
+	result, err := t.createTweet(ctx, token.AccessToken, map[string]interface{}{
+		"text":  text,
+		"reply": map[string]interface{}{"in_reply_to_tweet_id": to.ItemID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reply: %w", err)
+	}
+
+	return &social.ReplyResult{
+		PlatformMessageID: result.PlatformPostID,
+		SentAt:            result.PublishedAt,
+		RateLimit:         result.RateLimit,
+	}, nil
+}
+
+func (t *TwitterAdapter) sendDirectMessage(ctx context.Context, accessToken, recipientID, text string) (*social.ReplyResult, error) {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/dm_conversations/with/%s/messages", twitterAPIURL, url.PathEscape(recipientID))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("direct message failed (%d): %s", resp.StatusCode, string(body))
+	}
+
+	var messageResp struct {
+		Data struct {
+			EventID string `json:"dm_event_id"`
+		} `json:"data"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&messageResp); err != nil {
+		return nil, err
+	}
+
+	return &social.ReplyResult{
+		PlatformMessageID: messageResp.Data.EventID,
+		SentAt:            time.Now(),
+		RateLimit:         social.ParseRateLimitHeaders(resp.Header),
+	}, nil
+}
+
+// HideComment hides a reply to one of the account's tweets. The token
+// needs the tweet.moderate.write scope.
+func (t *TwitterAdapter) HideComment(ctx context.Context, token *social.Token, commentID string) error {
+	body, err := json.Marshal(map[string]bool{"hidden": true})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/tweets/%s/hidden", twitterAPIURL, url.PathEscape(commentID))
+	req, err := http.NewRequestWithContext(ctx, "PUT", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return social.NewRateLimitError(resp.Header)
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &social.UnavailableError{StatusCode: resp.StatusCode}
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("hiding reply failed (%d): %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
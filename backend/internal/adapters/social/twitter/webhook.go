@@ -0,0 +1,187 @@
+// ============================================================================
+// FILE: backend/internal/adapters/social/twitter/webhook.go
+// Account Activity API webhooks
+// ============================================================================
+package twitter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// WebhookParser implements socialDomain.WebhookParser for the Account
+// Activity API. Payloads and CRC checks are signed with the app's consumer
+// secret.
+type WebhookParser struct {
+	consumerSecret string
+}
+
+func NewWebhookParser(consumerSecret string) *WebhookParser {
+	return &WebhookParser{consumerSecret: consumerSecret}
+}
+
+func (p *WebhookParser) Platform() socialDomain.Platform {
+	return socialDomain.PlatformTwitter
+}
+
+func (p *WebhookParser) SignatureHeader() string {
+	return "X-Twitter-Webhooks-Signature"
+}
+
+// VerifySignature checks a "sha256=<base64 HMAC-SHA256 of the body>"
+// signature
+func (p *WebhookParser) VerifySignature(body []byte, signature string) bool {
+	if p.consumerSecret == "" || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(p.sign(body)))
+}
+
+// Challenge answers the CRC check sent on registration and periodically
+// afterwards
+func (p *WebhookParser) Challenge(query url.Values) (*socialDomain.WebhookChallenge, error) {
+	crc := query.Get("crc_token")
+	if crc == "" || p.consumerSecret == "" {
+		return nil, socialDomain.ErrInvalidWebhookChallenge
+	}
+
+	body, err := json.Marshal(map[string]string{"response_token": p.sign([]byte(crc))})
+	if err != nil {
+		return nil, err
+	}
+	return &socialDomain.WebhookChallenge{ContentType: "application/json", Body: body}, nil
+}
+
+func (p *WebhookParser) sign(data []byte) string {
+	mac := hmac.New(sha256.New, []byte(p.consumerSecret))
+	mac.Write(data)
+	return "sha256=" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// activityPayload is an Account Activity delivery for one subscribed user
+type activityPayload struct {
+	ForUserID           string          `json:"for_user_id"`
+	TweetCreateEvents   []activityTweet `json:"tweet_create_events"`
+	DirectMessageEvents []struct {
+		Type             string `json:"type"`
+		ID               string `json:"id"`
+		CreatedTimestamp string `json:"created_timestamp"` // milliseconds
+		MessageCreate    struct {
+			SenderID    string `json:"sender_id"`
+			MessageData struct {
+				Text string `json:"text"`
+			} `json:"message_data"`
+		} `json:"message_create"`
+	} `json:"direct_message_events"`
+	UserEvent *struct {
+		Revoke *struct {
+			Source struct {
+				UserID string `json:"user_id"`
+			} `json:"source"`
+		} `json:"revoke"`
+	} `json:"user_event"`
+}
+
+type activityTweet struct {
+	IDStr                string `json:"id_str"`
+	Text                 string `json:"text"`
+	CreatedAt            string `json:"created_at"` // e.g. "Wed Oct 10 20:19:24 +0000 2018"
+	InReplyToStatusIDStr string `json:"in_reply_to_status_id_str"`
+	User                 struct {
+		IDStr      string `json:"id_str"`
+		ScreenName string `json:"screen_name"`
+	} `json:"user"`
+}
+
+// activityTypes are the payload keys worth naming in the log, in order
+var activityTypes = []string{
+	"tweet_create_events",
+	"direct_message_events",
+	"user_event",
+	"favorite_events",
+	"follow_events",
+	"tweet_delete_events",
+}
+
+// EventType is the kind of activity in the payload
+func (p *WebhookParser) EventType(body []byte) string {
+	var payload map[string]json.RawMessage
+	if json.Unmarshal(body, &payload) != nil {
+		return "unknown"
+	}
+	for _, t := range activityTypes {
+		if _, ok := payload[t]; ok {
+			return t
+		}
+	}
+	return "unknown"
+}
+
+// Parse extracts replies and mentions, direct messages and app
+// revocations. The subscribed user's own activity is skipped.
+func (p *WebhookParser) Parse(body []byte) ([]socialDomain.PlatformEvent, error) {
+	var payload activityPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %v", socialDomain.ErrInvalidWebhookPayload, err)
+	}
+
+	base := socialDomain.PlatformEvent{
+		Platform:       socialDomain.PlatformTwitter,
+		PlatformUserID: payload.ForUserID,
+		OccurredAt:     time.Now().UTC(),
+	}
+
+	var events []socialDomain.PlatformEvent
+	for _, t := range payload.TweetCreateEvents {
+		if t.User.IDStr == payload.ForUserID {
+			continue
+		}
+		e := base
+		e.Kind = socialDomain.PlatformEventMention
+		if t.InReplyToStatusIDStr != "" {
+			e.Kind = socialDomain.PlatformEventComment
+			e.ObjectID = t.InReplyToStatusIDStr
+		}
+		e.ItemID = t.IDStr
+		e.AuthorID = t.User.IDStr
+		e.AuthorName = t.User.ScreenName
+		e.Text = t.Text
+		if at, err := time.Parse(time.RubyDate, t.CreatedAt); err == nil {
+			e.OccurredAt = at.UTC()
+		}
+		events = append(events, e)
+	}
+
+	for _, dm := range payload.DirectMessageEvents {
+		if dm.Type != "message_create" || dm.MessageCreate.SenderID == payload.ForUserID {
+			continue
+		}
+		e := base
+		e.Kind = socialDomain.PlatformEventMessage
+		e.ItemID = dm.ID
+		e.AuthorID = dm.MessageCreate.SenderID
+		e.Text = dm.MessageCreate.MessageData.Text
+		if ms, err := strconv.ParseInt(dm.CreatedTimestamp, 10, 64); err == nil {
+			e.OccurredAt = time.UnixMilli(ms).UTC()
+		}
+		events = append(events, e)
+	}
+
+	if payload.UserEvent != nil && payload.UserEvent.Revoke != nil {
+		e := base
+		e.Kind = socialDomain.PlatformEventTokenRevoked
+		e.PlatformUserID = payload.UserEvent.Revoke.Source.UserID
+		events = append(events, e)
+	}
+
+	return events, nil
+}
@@ -24,6 +24,39 @@ type Adapter interface {
 	GetPostAnalytics(ctx context.Context, token *Token, postID string) (*Analytics, error)
 }
 
+// RecentPostLister is implemented by adapters that can list an account's
+// recent posts. Publishing uses it to check whether an interrupted attempt
+// already reached the platform before posting again.
+type RecentPostLister interface {
+	ListRecentPosts(ctx context.Context, token *Token, since time.Time) ([]*RecentPost, error)
+}
+
+// InteractionLister is implemented by adapters that can read the comments,
+// mentions and direct messages an account received. The inbox polls it for
+// platforms that send no webhooks.
+type InteractionLister interface {
+	ListInteractions(ctx context.Context, token *Token, since time.Time) ([]*Interaction, error)
+}
+
+// Replier is implemented by adapters that can answer a comment, mention or
+// direct message from the inbox
+type Replier interface {
+	Reply(ctx context.Context, token *Token, to *ReplyTarget, text string) (*ReplyResult, error)
+}
+
+// CommentModerator is implemented by adapters that can hide a comment or
+// reply from the public thread
+type CommentModerator interface {
+	HideComment(ctx context.Context, token *Token, commentID string) error
+}
+
+// Interaction kinds
+const (
+	InteractionComment = "comment"
+	InteractionMention = "mention"
+	InteractionMessage = "message"
+)
+
 // Token represents OAuth tokens
 type Token struct {
 	AccessToken    string
@@ -46,6 +79,40 @@ type PublishResult struct {
 	PlatformPostID string
 	URL            string
 	PublishedAt    time.Time
+	RateLimit      *RateLimit // Quota reported on the response, if any
+}
+
+// RecentPost is a post read back from the platform
+type RecentPost struct {
+	PlatformPostID string
+	URL            string
+	Text           string
+	CreatedAt      time.Time
+}
+
+// Interaction is a comment, mention or direct message read from the platform
+type Interaction struct {
+	Kind       string
+	ObjectID   string // the post commented on
+	ItemID     string // the comment, mention or message itself
+	AuthorID   string
+	AuthorName string
+	Text       string
+	CreatedAt  time.Time
+}
+
+// ReplyTarget is what a reply answers
+type ReplyTarget struct {
+	Kind        string
+	ItemID      string // the comment or mention replied to
+	RecipientID string // the other participant of a direct message thread
+}
+
+// ReplyResult is a reply as created on the platform
+type ReplyResult struct {
+	PlatformMessageID string
+	SentAt            time.Time
+	RateLimit         *RateLimit // Quota reported on the response, if any
 }
 
 // Analytics represents post analytics/metrics
@@ -0,0 +1,151 @@
+// path: backend/internal/application/auditlog/list_audit_log.go
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+	// MaxExportSize caps a single CSV export
+	MaxExportSize = 10000
+)
+
+type EntryDTO struct {
+	ID         uuid.UUID         `json:"id"`
+	TeamID     *uuid.UUID        `json:"teamId,omitempty"`
+	ActorID    *uuid.UUID        `json:"actorId,omitempty"`
+	Action     string            `json:"action"`
+	TargetType string            `json:"targetType"`
+	TargetID   string            `json:"targetId"`
+	IPAddress  string            `json:"ipAddress,omitempty"`
+	UserAgent  string            `json:"userAgent,omitempty"`
+	Changes    audit.Changes     `json:"changes,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	CreatedAt  time.Time         `json:"createdAt"`
+}
+
+func mapEntryToDTO(e *audit.Entry) EntryDTO {
+	dto := EntryDTO{
+		ID:         e.ID(),
+		Action:     string(e.Action()),
+		TargetType: e.TargetType(),
+		TargetID:   e.TargetID(),
+		IPAddress:  e.IPAddress(),
+		UserAgent:  e.UserAgent(),
+		Changes:    e.Changes(),
+		Metadata:   e.Metadata(),
+		CreatedAt:  e.CreatedAt(),
+	}
+	if id := e.TeamID(); id != uuid.Nil {
+		dto.TeamID = &id
+	}
+	if id := e.ActorID(); id != uuid.Nil {
+		dto.ActorID = &id
+	}
+	return dto
+}
+
+type ListAuditLogInput struct {
+	TeamID     uuid.UUID
+	UserID     uuid.UUID
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Since      *time.Time
+	Until      *time.Time
+	Before     *time.Time
+	Limit      int
+	// Export raises the limit to MaxExportSize for CSV downloads
+	Export bool
+}
+
+type ListAuditLogOutput struct {
+	Entries []EntryDTO `json:"entries"`
+	// NextBefore is the cursor for the next page, nil on the last page
+	NextBefore *time.Time `json:"nextBefore,omitempty"`
+}
+
+// ListAuditLogUseCase reads a team's audit log. It needs the audit.view
+// permission and a plan with the audit log feature.
+type ListAuditLogUseCase struct {
+	repo       audit.Repository
+	teamRepo   team.Repository
+	authorizer *team.Authorizer
+}
+
+func NewListAuditLogUseCase(repo audit.Repository, teamRepo team.Repository, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *ListAuditLogUseCase {
+	return &ListAuditLogUseCase{
+		repo:       repo,
+		teamRepo:   teamRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+func (uc *ListAuditLogUseCase) Execute(ctx context.Context, input ListAuditLogInput) (*ListAuditLogOutput, error) {
+	// 1. Check permission and plan
+	role, err := uc.authorizer.Role(ctx, input.TeamID, input.UserID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+	if !role.Allows(team.PermAuditView) {
+		return nil, fmt.Errorf("access denied: %s permission required", team.PermAuditView)
+	}
+
+	t, err := uc.teamRepo.FindByID(ctx, input.TeamID)
+	if err != nil {
+		return nil, team.ErrTeamNotFound
+	}
+	if !t.HasFeature("audit_log") {
+		return nil, team.ErrFeatureNotAvailable
+	}
+
+	// 2. Query one page (plus one row to detect the next page)
+	limit := input.Limit
+	switch {
+	case input.Export:
+		limit = MaxExportSize
+	case limit <= 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	entries, err := uc.repo.List(ctx, audit.Filter{
+		TeamID:     input.TeamID,
+		ActorID:    input.ActorID,
+		Action:     audit.Action(input.Action),
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Since:      input.Since,
+		Until:      input.Until,
+		Before:     input.Before,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log: %w", err)
+	}
+
+	output := &ListAuditLogOutput{Entries: make([]EntryDTO, 0, len(entries))}
+	if len(entries) > limit {
+		entries = entries[:limit]
+		next := entries[limit-1].CreatedAt()
+		output.NextBefore = &next
+	}
+	for _, e := range entries {
+		output.Entries = append(output.Entries, mapEntryToDTO(e))
+	}
+	return output, nil
+}
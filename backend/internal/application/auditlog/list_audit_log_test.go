@@ -0,0 +1,135 @@
+// path: backend/internal/application/auditlog/list_audit_log_test.go
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type oneTeam struct {
+	team.Repository
+	team *team.Team
+}
+
+func (r oneTeam) FindByID(ctx context.Context, id uuid.UUID) (*team.Team, error) {
+	if r.team.ID() != id {
+		return nil, team.ErrTeamNotFound
+	}
+	return r.team, nil
+}
+
+// rolesByUser makes each listed user an active member with the given role
+type rolesByUser struct {
+	team.MemberRepository
+	roles map[uuid.UUID]team.MemberRole
+}
+
+func (r rolesByUser) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	role, ok := r.roles[userID]
+	if !ok {
+		return nil, team.ErrMemberNotFound
+	}
+	joined := time.Now()
+	return team.ReconstructMember(uuid.New(), teamID, userID, role, team.MemberStatusActive, userID, joined, &joined, nil), nil
+}
+
+type adminCanAudit struct {
+	team.RoleRepository
+}
+
+func (adminCanAudit) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	permissions := []team.Permission{team.PermPostsView}
+	if name == team.MemberRoleAdmin {
+		permissions = append(permissions, team.PermAuditView)
+	}
+	return team.ReconstructRole(uuid.New(), teamID, name, "", permissions, true, time.Now(), time.Now()), nil
+}
+
+type listFixture struct {
+	team          *team.Team
+	admin, viewer uuid.UUID
+	log           *memoryLog
+	uc            *ListAuditLogUseCase
+}
+
+func newListFixture(t *testing.T, plan team.Plan, entries int) *listFixture {
+	t.Helper()
+
+	now := time.Now()
+	acme := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", uuid.New(), plan, team.StatusActive,
+		team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	admin, viewer := uuid.New(), uuid.New()
+	members := rolesByUser{roles: map[uuid.UUID]team.MemberRole{admin: team.MemberRoleAdmin, viewer: team.MemberRoleViewer}}
+
+	log := &memoryLog{}
+	for i := 0; i < entries; i++ {
+		log.entries = append(log.entries, audit.ReconstructEntry(uuid.New(), acme.ID(), admin, audit.ActionPostDeleted,
+			audit.TargetPost, uuid.NewString(), "", "", nil, nil, now.Add(time.Duration(i-entries)*time.Minute)))
+	}
+
+	return &listFixture{
+		team:   acme,
+		admin:  admin,
+		viewer: viewer,
+		log:    log,
+		uc:     NewListAuditLogUseCase(log, oneTeam{team: acme}, members, adminCanAudit{}),
+	}
+}
+
+func TestListAuditLogPages(t *testing.T) {
+	f := newListFixture(t, team.PlanEnterprise, 5)
+	ctx := context.Background()
+
+	page, err := f.uc.Execute(ctx, ListAuditLogInput{TeamID: f.team.ID(), UserID: f.admin, Action: "post.*", Limit: 3})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if f.log.filter.TeamID != f.team.ID() || f.log.filter.Action != "post.*" {
+		t.Errorf("filter = %+v, want the team and action passed through", f.log.filter)
+	}
+	if len(page.Entries) != 3 || page.NextBefore == nil {
+		t.Fatalf("first page: %d entries, next %v; want 3 and a cursor", len(page.Entries), page.NextBefore)
+	}
+
+	rest, err := f.uc.Execute(ctx, ListAuditLogInput{TeamID: f.team.ID(), UserID: f.admin, Before: page.NextBefore, Limit: 3})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(rest.Entries) != 2 || rest.NextBefore != nil {
+		t.Errorf("last page: %d entries, next %v; want 2 and no cursor", len(rest.Entries), rest.NextBefore)
+	}
+	if !rest.Entries[0].CreatedAt.Before(page.Entries[2].CreatedAt) {
+		t.Error("second page overlaps the first")
+	}
+
+	if _, err := f.uc.Execute(ctx, ListAuditLogInput{TeamID: f.team.ID(), UserID: f.admin, Limit: 10 * maxPageSize}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if f.log.filter.Limit != maxPageSize+1 {
+		t.Errorf("limit = %d, want it capped at %d", f.log.filter.Limit, maxPageSize+1)
+	}
+}
+
+func TestListAuditLogAccess(t *testing.T) {
+	ctx := context.Background()
+
+	f := newListFixture(t, team.PlanEnterprise, 1)
+	for name, userID := range map[string]uuid.UUID{"viewer": f.viewer, "outsider": uuid.New()} {
+		_, err := f.uc.Execute(ctx, ListAuditLogInput{TeamID: f.team.ID(), UserID: userID})
+		if err == nil || !strings.HasPrefix(err.Error(), "access denied") {
+			t.Errorf("%s: err = %v, want access denied", name, err)
+		}
+	}
+
+	free := newListFixture(t, team.PlanFree, 1)
+	if _, err := free.uc.Execute(ctx, ListAuditLogInput{TeamID: free.team.ID(), UserID: free.admin}); !errors.Is(err, team.ErrFeatureNotAvailable) {
+		t.Errorf("free plan: err = %v, want ErrFeatureNotAvailable", err)
+	}
+}
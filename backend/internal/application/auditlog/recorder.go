@@ -0,0 +1,45 @@
+// path: backend/internal/application/auditlog/recorder.go
+package auditlog
+
+import (
+	"context"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+)
+
+// Recorder appends entries to the audit log on behalf of use cases
+type Recorder struct {
+	repo   audit.Repository
+	logger common.Logger
+}
+
+func NewRecorder(repo audit.Repository, logger common.Logger) *Recorder {
+	return &Recorder{repo: repo, logger: logger}
+}
+
+// Append writes an entry and returns any failure. Called inside a
+// transaction, the change it describes is rolled back if the entry can't be
+// written. A nil recorder records nothing.
+func (r *Recorder) Append(ctx context.Context, entry *audit.Entry) error {
+	if r == nil {
+		return nil
+	}
+	return r.repo.Append(ctx, entry)
+}
+
+// Record appends an entry. The action it describes has already happened,
+// so a failure is logged rather than returned. A nil recorder records
+// nothing.
+func (r *Recorder) Record(ctx context.Context, entry *audit.Entry) {
+	if r == nil {
+		return
+	}
+	if err := r.repo.Append(ctx, entry); err != nil {
+		r.logger.Error("Failed to write audit log",
+			"action", entry.Action(),
+			"teamId", entry.TeamID(),
+			"actorId", entry.ActorID(),
+			"error", err)
+	}
+}
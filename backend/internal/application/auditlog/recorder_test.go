@@ -0,0 +1,66 @@
+// path: backend/internal/application/auditlog/recorder_test.go
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// memoryLog keeps entries in order of appending, newest last
+type memoryLog struct {
+	entries []*audit.Entry
+	filter  audit.Filter
+	err     error
+}
+
+func (r *memoryLog) Append(ctx context.Context, e *audit.Entry) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.entries = append(r.entries, e)
+	return nil
+}
+
+func (r *memoryLog) List(ctx context.Context, f audit.Filter) ([]*audit.Entry, error) {
+	r.filter = f
+	var entries []*audit.Entry
+	for i := len(r.entries) - 1; i >= 0 && len(entries) < f.Limit; i-- {
+		if e := r.entries[i]; f.Before == nil || e.CreatedAt().Before(*f.Before) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	entry := audit.NewEntry(ctx, uuid.New(), uuid.New(), audit.ActionPlanChanged, audit.TargetTeam, "t1")
+
+	log := &memoryLog{}
+	recorder := NewRecorder(log, services.NewLogger())
+	recorder.Record(ctx, entry)
+	if err := recorder.Append(ctx, entry); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if len(log.entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(log.entries))
+	}
+
+	// Record only logs a failure; Append hands it to the caller
+	log.err = errors.New("disk full")
+	recorder.Record(ctx, entry)
+	if err := recorder.Append(ctx, entry); !errors.Is(err, log.err) {
+		t.Errorf("Append: err = %v, want %v", err, log.err)
+	}
+
+	var none *Recorder
+	none.Record(ctx, entry)
+	if err := none.Append(ctx, entry); err != nil {
+		t.Errorf("nil recorder: Append = %v", err)
+	}
+}
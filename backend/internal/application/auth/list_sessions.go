@@ -0,0 +1,139 @@
+// path: backend/internal/application/auth/list_sessions.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// ============================================================================
+// LIST SESSIONS USE CASE
+// ============================================================================
+
+type ListSessionsUseCase struct {
+	sessionRepo user.SessionRepository
+	logger      common.Logger
+}
+
+type ListSessionsInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+type SessionDTO struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+type ListSessionsOutput struct {
+	Sessions []SessionDTO `json:"sessions"`
+}
+
+func NewListSessionsUseCase(
+	sessionRepo user.SessionRepository,
+	logger common.Logger,
+) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, input ListSessionsInput) (*ListSessionsOutput, error) {
+	if input.UserID == uuid.Nil {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	sessions, err := uc.sessionRepo.ListSessions(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to list sessions", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to list sessions")
+	}
+
+	output := &ListSessionsOutput{Sessions: make([]SessionDTO, 0, len(sessions))}
+	for _, s := range sessions {
+		output.Sessions = append(output.Sessions, SessionDTO{
+			ID:         s.ID.String(),
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+		})
+	}
+
+	return output, nil
+}
+
+// ============================================================================
+// REVOKE SESSION USE CASE
+// ============================================================================
+
+type RevokeSessionUseCase struct {
+	sessionRepo user.SessionRepository
+	logger      common.Logger
+}
+
+// RevokeSessionInput revokes one session, or every session when SessionID
+// is nil
+type RevokeSessionInput struct {
+	UserID    uuid.UUID `json:"-"`
+	SessionID uuid.UUID `json:"-"`
+}
+
+type RevokeSessionOutput struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func NewRevokeSessionUseCase(
+	sessionRepo user.SessionRepository,
+	logger common.Logger,
+) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		sessionRepo: sessionRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, input RevokeSessionInput) (*RevokeSessionOutput, error) {
+	if input.UserID == uuid.Nil {
+		return nil, fmt.Errorf("user ID is required")
+	}
+
+	if input.SessionID == uuid.Nil {
+		if err := uc.sessionRepo.RevokeAllForUser(ctx, input.UserID, user.RevokedSessionRevoked); err != nil {
+			uc.logger.Error("Failed to revoke sessions", "userId", input.UserID, "error", err)
+			return nil, fmt.Errorf("failed to revoke sessions")
+		}
+
+		uc.logger.Info("All sessions revoked", "userId", input.UserID)
+		return &RevokeSessionOutput{
+			Success: true,
+			Message: "All sessions revoked",
+		}, nil
+	}
+
+	if err := uc.sessionRepo.RevokeSession(ctx, input.UserID, input.SessionID, user.RevokedSessionRevoked); err != nil {
+		if errors.Is(err, user.ErrSessionNotFound) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to revoke session", "sessionId", input.SessionID, "error", err)
+		return nil, fmt.Errorf("failed to revoke session")
+	}
+
+	uc.logger.Info("Session revoked", "userId", input.UserID, "sessionId", input.SessionID)
+	return &RevokeSessionOutput{
+		Success: true,
+		Message: "Session revoked",
+	}, nil
+}
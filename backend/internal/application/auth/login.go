@@ -4,33 +4,82 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 	"github.com/techappsUT/social-queue/internal/domain/user"
 )
 
 type LoginUseCase struct {
-	userRepo     user.Repository
-	userService  *user.Service
-	tokenService common.TokenService
-	cacheService common.CacheService
-	logger       common.Logger
+	userRepo      user.Repository
+	userService   *user.Service
+	sessions      *SessionManager
+	twoFactorRepo user.TwoFactorRepository // nil when two-factor is unavailable
+	passkeyRepo   user.PasskeyRepository   // nil when passkeys are unavailable
+	teamRepo      team.Repository
+	ssoRepo       team.SSORepository // nil when single sign-on is unavailable
+	challenges    mfaChallenges
+	cacheService  common.CacheService
+	throttle      common.LoginThrottle // nil disables brute-force protection
+	emailService  common.EmailService
+	recorder      *auditlog.Recorder
+	logger        common.Logger
 }
 
 type LoginInput struct {
-	Identifier string `json:"identifier"`
-	Password   string `json:"password"`
+	Identifier string     `json:"identifier"`
+	Password   string     `json:"password"`
+	Client     ClientInfo `json:"-"`
 }
 
+// LoginOutput carries either tokens, or a challenge token when a second
+// factor is needed (see VerifyTwoFactorUseCase and EnableTwoFactorUseCase)
 type LoginOutput struct {
-	User         *UserDTO `json:"user"`
-	AccessToken  string   `json:"accessToken"`
-	RefreshToken string   `json:"refreshToken"`
-	ExpiresIn    int      `json:"expiresIn"`
+	User         *UserDTO `json:"user,omitempty"`
+	AccessToken  string   `json:"accessToken,omitempty"`
+	RefreshToken string   `json:"refreshToken,omitempty"`
+	ExpiresIn    int      `json:"expiresIn,omitempty"`
+
+	MFARequired           bool   `json:"mfaRequired,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfaEnrollmentRequired,omitempty"`
+	ChallengeToken        string `json:"challengeToken,omitempty"`
+
+	// MFAMethods lists the second factors the user can answer with:
+	// "totp" and/or "passkey"
+	MFAMethods []string `json:"mfaMethods,omitempty"`
+}
+
+// LoginThrottledError is returned without checking the password while the
+// account is locked, or the client must wait before trying again
+type LoginThrottledError struct {
+	RetryAfter      time.Duration
+	LockedUntil     *time.Time
+	CaptchaRequired bool
+}
+
+func (e *LoginThrottledError) Error() string {
+	if e.LockedUntil != nil {
+		return "account temporarily locked after too many failed sign-ins"
+	}
+	return "too many failed sign-ins, try again later"
 }
 
+// LoginFailedError is a failed sign-in; CaptchaRequired tells the client to
+// show a CAPTCHA before the next attempt
+type LoginFailedError struct {
+	CaptchaRequired bool
+}
+
+func (e *LoginFailedError) Error() string { return common.ErrInvalidCredentials.Error() }
+func (e *LoginFailedError) Unwrap() error { return common.ErrInvalidCredentials }
+
 type UserDTO struct {
 	ID            string     `json:"id"`
 	Email         string     `json:"email"`
@@ -46,16 +95,31 @@ type UserDTO struct {
 func NewLoginUseCase(
 	userRepo user.Repository,
 	userService *user.Service,
-	tokenService common.TokenService,
+	sessions *SessionManager,
+	twoFactorRepo user.TwoFactorRepository,
+	passkeyRepo user.PasskeyRepository,
+	teamRepo team.Repository,
+	ssoRepo team.SSORepository,
 	cacheService common.CacheService,
+	throttle common.LoginThrottle,
+	emailService common.EmailService,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *LoginUseCase {
 	return &LoginUseCase{
-		userRepo:     userRepo,
-		userService:  userService,
-		tokenService: tokenService,
-		cacheService: cacheService,
-		logger:       logger,
+		userRepo:      userRepo,
+		userService:   userService,
+		sessions:      sessions,
+		twoFactorRepo: twoFactorRepo,
+		passkeyRepo:   passkeyRepo,
+		teamRepo:      teamRepo,
+		ssoRepo:       ssoRepo,
+		challenges:    mfaChallenges{cache: cacheService},
+		cacheService:  cacheService,
+		throttle:      throttle,
+		emailService:  emailService,
+		recorder:      recorder,
+		logger:        logger,
 	}
 }
 
@@ -65,6 +129,13 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOu
 		return nil, err
 	}
 
+	// Refuse locked accounts and throttled clients before the password is
+	// checked
+	account, target := uc.throttleAccount(ctx, input.Identifier)
+	if err := uc.checkThrottle(ctx, account, input.Client.IPAddress); err != nil {
+		return nil, err
+	}
+
 	// Authenticate user
 	authenticatedUser, err := uc.userService.AuthenticateUser(
 		ctx,
@@ -73,46 +144,224 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOu
 	)
 	if err != nil {
 		uc.logger.Warn("Authentication failed", "identifier", input.Identifier)
-		return nil, common.ErrInvalidCredentials
+		if target != nil {
+			uc.recorder.Record(ctx, audit.NewEntry(ctx, uuid.Nil, target.ID(), audit.ActionLoginFailed, audit.TargetUser, target.ID().String()).
+				WithClient(input.Client.IPAddress, input.Client.UserAgent))
+		}
+		return nil, uc.recordFailure(ctx, account, target, input.Client.IPAddress)
 	}
+	uc.resetThrottle(ctx, account)
+
+	// ✅ FIX #6: RecordLogin is now properly implemented in user.go
+	// Record login is already called in service.AuthenticateUser
+	// No need to call it again here
 
+	return uc.CompleteLogin(ctx, authenticatedUser, input.Client)
+}
+
+// CompleteLogin finishes signing in a user whose first factor has been
+// checked, by a password or an external identity provider: it asks for a
+// second factor when needed, otherwise it starts a session
+func (uc *LoginUseCase) CompleteLogin(ctx context.Context, u *user.User, client ClientInfo) (*LoginOutput, error) {
 	// Check if user can access platform
-	if !authenticatedUser.CanAccessPlatform() {
-		return nil, fmt.Errorf("account %s", authenticatedUser.Status())
+	if !u.CanAccessPlatform() {
+		return nil, fmt.Errorf("account %s", u.Status())
 	}
 
-	// Generate tokens
-	accessToken, err := uc.tokenService.GenerateAccessToken(
-		authenticatedUser.ID().String(),
-		authenticatedUser.Email(),
-		string(authenticatedUser.Role()),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token")
+	// Teams enforcing SSO sign their members in through their IdP
+	if err := requireSSO(ctx, uc.ssoRepo, uc.teamRepo, u); err != nil {
+		if !errors.Is(err, team.ErrSSORequired) {
+			uc.logger.Error("Failed to check SSO enforcement", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+		return nil, err
 	}
 
-	refreshToken, err := uc.tokenService.GenerateRefreshToken(
-		authenticatedUser.ID().String(),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate refresh token")
+	// Ask for a second factor before issuing tokens
+	if challenge, err := uc.secondFactor(ctx, u); err != nil || challenge != nil {
+		return challenge, err
 	}
 
-	// ✅ FIX #6: RecordLogin is now properly implemented in user.go
-	// Record login is already called in service.AuthenticateUser
-	// No need to call it again here
+	// Start a new session
+	tokens, err := uc.sessions.Start(ctx, u, client)
+	if err != nil {
+		return nil, err
+	}
 
 	// Cache user session
-	uc.cacheUserSession(ctx, authenticatedUser)
+	uc.cacheUserSession(ctx, u)
 
 	return &LoginOutput{
-		User:         uc.mapToDTO(authenticatedUser),
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		User:         mapUserToDTO(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		ExpiresIn:    900, // 15 minutes
 	}, nil
 }
 
+// secondFactor returns a challenge when the user has a TOTP authenticator
+// or passkey, or must enroll because a team requires two-factor
+// authentication
+func (uc *LoginUseCase) secondFactor(ctx context.Context, u *user.User) (*LoginOutput, error) {
+	var methods []string
+	if uc.twoFactorRepo != nil {
+		tf, err := uc.twoFactorRepo.FindByUserID(ctx, u.ID())
+		switch {
+		case err == nil:
+			if tf.IsEnabled() {
+				methods = append(methods, "totp")
+			}
+		case !errors.Is(err, user.ErrTwoFactorNotEnabled):
+			uc.logger.Error("Failed to load two-factor enrollment", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+	}
+	if uc.passkeyRepo != nil {
+		passkeys, err := uc.passkeyRepo.ListByUser(ctx, u.ID())
+		if err != nil {
+			uc.logger.Error("Failed to list passkeys", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+		if len(passkeys) > 0 {
+			methods = append(methods, "passkey")
+		}
+	}
+
+	enroll := false
+	if len(methods) == 0 {
+		required, err := teamRequiresTwoFactor(ctx, uc.teamRepo, u.ID())
+		if err != nil {
+			uc.logger.Error("Failed to check team two-factor policy", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+		if !required {
+			return nil, nil
+		}
+		if uc.twoFactorRepo == nil {
+			// Without a two-factor store (ENCRYPTION_KEY unset) nobody can
+			// enroll; refuse rather than skip the team's requirement
+			uc.logger.Error("Team requires two-factor authentication but it is not configured", "userId", u.ID())
+			return nil, user.ErrTwoFactorRequired
+		}
+		enroll = true
+	}
+
+	token, err := uc.challenges.issue(ctx, u.ID(), enroll)
+	if err != nil {
+		uc.logger.Error("Failed to issue MFA challenge", "userId", u.ID(), "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	return &LoginOutput{
+		MFARequired:           true,
+		MFAEnrollmentRequired: enroll,
+		ChallengeToken:        token,
+		MFAMethods:            methods,
+	}, nil
+}
+
+// requireSSO returns ErrSSORequired for members of a team that enforces
+// single sign-on for the user's email domain. Every sign-in method except
+// the team's own SAML connection checks it, after the first factor so it
+// does not reveal which accounts exist.
+func requireSSO(ctx context.Context, ssoRepo team.SSORepository, teamRepo team.Repository, u *user.User) error {
+	if ssoRepo == nil {
+		return nil
+	}
+
+	_, domain, _ := strings.Cut(strings.ToLower(u.Email()), "@")
+	config, err := ssoRepo.FindByDomain(ctx, domain)
+	if errors.Is(err, team.ErrSSONotConfigured) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load SSO config: %w", err)
+	}
+	if !config.RequiresSSO(u.Email()) {
+		return nil
+	}
+
+	teams, err := teamRepo.FindByMemberID(ctx, u.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list teams: %w", err)
+	}
+	for _, t := range teams {
+		if t.ID() == config.TeamID() {
+			return team.ErrSSORequired
+		}
+	}
+	return nil
+}
+
+// throttleAccount returns the key failures are counted under: the user ID
+// when the identifier matches a user, so email and username share a count
+func (uc *LoginUseCase) throttleAccount(ctx context.Context, identifier string) (string, *user.User) {
+	if uc.throttle == nil {
+		return "", nil
+	}
+	u, err := uc.userRepo.FindByEmailOrUsername(ctx, identifier)
+	if err != nil {
+		return "unknown:" + strings.ToLower(strings.TrimSpace(identifier)), nil
+	}
+	return u.ID().String(), u
+}
+
+func (uc *LoginUseCase) checkThrottle(ctx context.Context, account, ip string) error {
+	if uc.throttle == nil {
+		return nil
+	}
+	status, err := uc.throttle.Check(ctx, account, ip)
+	if err != nil {
+		// Fail open, like the request rate limiter
+		uc.logger.Warn("Login throttle check failed", "error", err)
+		return nil
+	}
+	if status.RetryAfter > 0 {
+		return &LoginThrottledError{
+			RetryAfter:      status.RetryAfter,
+			LockedUntil:     status.LockedUntil,
+			CaptchaRequired: status.CaptchaRequired,
+		}
+	}
+	return nil
+}
+
+// recordFailure counts a failed sign-in, tells the owner when it locked
+// their account, and returns the error for the client
+func (uc *LoginUseCase) recordFailure(ctx context.Context, account string, target *user.User, ip string) error {
+	if uc.throttle == nil {
+		return common.ErrInvalidCredentials
+	}
+	status, err := uc.throttle.RecordFailure(ctx, account, ip)
+	if err != nil {
+		uc.logger.Warn("Failed to record login failure", "error", err)
+		return common.ErrInvalidCredentials
+	}
+
+	if status.NewlyLocked && target != nil && status.LockedUntil != nil {
+		uc.logger.Warn("Account locked after failed sign-ins",
+			"userId", target.ID(),
+			"ip", ip,
+			"lockedUntil", status.LockedUntil)
+		if uc.emailService != nil {
+			if err := uc.emailService.SendAccountLockedEmail(ctx, target.Email(), *status.LockedUntil); err != nil {
+				uc.logger.Warn("Failed to send account locked email", "userId", target.ID(), "error", err)
+			}
+		}
+	}
+
+	return &LoginFailedError{CaptchaRequired: status.CaptchaRequired}
+}
+
+func (uc *LoginUseCase) resetThrottle(ctx context.Context, account string) {
+	if uc.throttle == nil {
+		return
+	}
+	if err := uc.throttle.Reset(ctx, account); err != nil {
+		uc.logger.Warn("Failed to reset login throttle", "error", err)
+	}
+}
+
 func (uc *LoginUseCase) validateInput(input LoginInput) error {
 	if input.Identifier == "" {
 		return fmt.Errorf("email or username is required")
@@ -133,7 +382,7 @@ func (uc *LoginUseCase) cacheUserSession(ctx context.Context, u *user.User) {
 	}
 }
 
-func (uc *LoginUseCase) mapToDTO(u *user.User) *UserDTO {
+func mapUserToDTO(u *user.User) *UserDTO {
 	return &UserDTO{
 		ID:            u.ID().String(),
 		Email:         u.Email(),
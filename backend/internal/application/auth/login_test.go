@@ -0,0 +1,159 @@
+// path: backend/internal/application/auth/login_test.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type ssoByDomain struct {
+	team.SSORepository
+	config *team.SSOConfig
+}
+
+func (r *ssoByDomain) FindByDomain(ctx context.Context, domain string) (*team.SSOConfig, error) {
+	for _, d := range r.config.Domains() {
+		if d.Domain == domain && d.IsVerified() {
+			return r.config, nil
+		}
+	}
+	return nil, team.ErrSSONotConfigured
+}
+
+type memberTeams struct {
+	team.Repository
+	teams map[uuid.UUID][]*team.Team
+}
+
+func (r *memberTeams) FindByMemberID(ctx context.Context, userID uuid.UUID) ([]*team.Team, error) {
+	return r.teams[userID], nil
+}
+
+// TestSSOEnforcementCoversEveryFirstFactor checks members of a team that
+// enforces SSO cannot sign in through an external identity provider either
+func TestSSOEnforcementCoversEveryFirstFactor(t *testing.T) {
+	now := time.Now()
+	acme := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", uuid.New(), team.PlanEnterprise, team.StatusActive,
+		team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	config, err := team.NewSSOConfig(acme.ID(), "https://idp.example.com", "https://idp.example.com/sso", []string{"cert"})
+	if err != nil {
+		t.Fatalf("NewSSOConfig: %v", err)
+	}
+	if err := config.SetDomains([]string{"acme.com"}); err != nil {
+		t.Fatalf("SetDomains: %v", err)
+	}
+	if err := config.VerifyDomain("acme.com", []string{config.Domains()[0].RecordValue()}, now); err != nil {
+		t.Fatalf("VerifyDomain: %v", err)
+	}
+	if err := config.SetEnabled(true); err != nil {
+		t.Fatalf("SetEnabled: %v", err)
+	}
+	config.SetEnforceSSO(true)
+
+	member, err := user.NewExternalUser("ana@acme.com", "ana", "Ana", "Lima", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	contractor, err := user.NewExternalUser("bob@acme.com", "bob", "Bob", "Berg", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	teams := &memberTeams{teams: map[uuid.UUID][]*team.Team{member.ID(): {acme}}}
+	sso := &ssoByDomain{config: config}
+
+	login := &LoginUseCase{teamRepo: teams, ssoRepo: sso, logger: services.NewLogger()}
+	if _, err := login.CompleteLogin(context.Background(), member, ClientInfo{}); !errors.Is(err, team.ErrSSORequired) {
+		t.Fatalf("CompleteLogin: err = %v, want ErrSSORequired", err)
+	}
+
+	// Only members of the enforcing team are held to it
+	if err := requireSSO(context.Background(), sso, teams, contractor); err != nil {
+		t.Errorf("non-member: err = %v", err)
+	}
+}
+
+type noPasskeys struct {
+	user.PasskeyRepository
+}
+
+func (noPasskeys) ListByUser(ctx context.Context, userID uuid.UUID) ([]*user.Passkey, error) {
+	return nil, nil
+}
+
+// TestTeamTwoFactorFailsClosedWithoutFactorStore checks a team's two-factor
+// requirement is not skipped when TOTP is not configured
+func TestTeamTwoFactorFailsClosedWithoutFactorStore(t *testing.T) {
+	now := time.Now()
+	acme := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", uuid.New(), team.PlanFree, team.StatusActive,
+		team.TeamSettings{RequireTwoFactor: true}, team.TeamLimits{}, now, now, nil)
+	member, err := user.NewExternalUser("ana@acme.com", "ana", "Ana", "Lima", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	teams := &memberTeams{teams: map[uuid.UUID][]*team.Team{member.ID(): {acme}}}
+
+	for name, passkeys := range map[string]user.PasskeyRepository{"no factor store": nil, "passkeys only": noPasskeys{}} {
+		login := &LoginUseCase{passkeyRepo: passkeys, teamRepo: teams, logger: services.NewLogger()}
+		out, err := login.secondFactor(context.Background(), member)
+		if !errors.Is(err, user.ErrTwoFactorRequired) {
+			t.Errorf("%s: got %+v, err = %v, want ErrTwoFactorRequired", name, out, err)
+		}
+	}
+
+	// Without a team requirement sign-in goes on
+	login := &LoginUseCase{teamRepo: &memberTeams{}, logger: services.NewLogger()}
+	if out, err := login.secondFactor(context.Background(), member); out != nil || err != nil {
+		t.Errorf("no requirement: got %+v, err = %v", out, err)
+	}
+}
+
+// countingTwoFactor counts the codes that get as far as being checked
+type countingTwoFactor struct {
+	user.TwoFactorRepository
+	checked atomic.Int32
+}
+
+func (r *countingTwoFactor) FindByUserID(ctx context.Context, userID uuid.UUID) (*user.TwoFactor, error) {
+	r.checked.Add(1)
+	return nil, user.ErrTwoFactorNotEnabled
+}
+
+// TestMFAChallengeAttemptsAreBoundUnderConcurrency checks parallel guesses
+// share one attempt budget and burn the challenge
+func TestMFAChallengeAttemptsAreBoundUnderConcurrency(t *testing.T) {
+	ctx := context.Background()
+	cache := services.NewInMemoryCacheService()
+	factors := &countingTwoFactor{}
+	uc := NewVerifyTwoFactorUseCase(nil, factors, nil, cache, services.NewLogger())
+
+	token, err := uc.challenges.issue(ctx, uuid.New(), false)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4*mfaChallengeMaxAttempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uc.Execute(ctx, VerifyTwoFactorInput{ChallengeToken: token, Code: "000000"})
+		}()
+	}
+	wg.Wait()
+
+	if got := factors.checked.Load(); got > mfaChallengeMaxAttempts {
+		t.Errorf("%d codes checked, want at most %d", got, mfaChallengeMaxAttempts)
+	}
+	if _, err := uc.challenges.load(ctx, token); err == nil {
+		t.Error("challenge still usable after its attempts ran out")
+	}
+}
@@ -9,8 +9,8 @@ import (
 )
 
 type LogoutUseCase struct {
-	tokenService common.TokenService
-	logger       common.Logger
+	sessions *SessionManager
+	logger   common.Logger
 }
 
 type LogoutInput struct {
@@ -23,12 +23,12 @@ type LogoutOutput struct {
 }
 
 func NewLogoutUseCase(
-	tokenService common.TokenService,
+	sessions *SessionManager,
 	logger common.Logger,
 ) *LogoutUseCase {
 	return &LogoutUseCase{
-		tokenService: tokenService,
-		logger:       logger,
+		sessions: sessions,
+		logger:   logger,
 	}
 }
 
@@ -37,8 +37,8 @@ func (uc *LogoutUseCase) Execute(ctx context.Context, input LogoutInput) (*Logou
 		return nil, fmt.Errorf("refresh token is required")
 	}
 
-	// Revoke the refresh token
-	if err := uc.tokenService.RevokeRefreshToken(ctx, input.RefreshToken); err != nil {
+	// Revoke the session the refresh token belongs to
+	if err := uc.sessions.End(ctx, input.RefreshToken); err != nil {
 		uc.logger.Error("Failed to revoke refresh token", "error", err)
 		return nil, fmt.Errorf("failed to logout")
 	}
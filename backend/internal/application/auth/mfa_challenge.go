@@ -0,0 +1,214 @@
+// path: backend/internal/application/auth/mfa_challenge.go
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const (
+	mfaChallengeTTL         = 5 * time.Minute
+	mfaChallengeMaxAttempts = 5
+	mfaChallengeKeyPrefix   = "mfa:challenge:"
+)
+
+// mfaChallenge is the state behind a challenge token handed out after a
+// correct password. Enroll challenges may only be used to set up two-factor
+// authentication required by a team.
+type mfaChallenge struct {
+	UserID uuid.UUID `json:"userId"`
+	Enroll bool      `json:"enroll"`
+}
+
+// mfaChallenges keeps challenges in the cache, keyed by token hash
+type mfaChallenges struct {
+	cache common.CacheService
+}
+
+func (c mfaChallenges) issue(ctx context.Context, userID uuid.UUID, enroll bool) (string, error) {
+	token, err := user.GenerateToken()
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.save(ctx, token, &mfaChallenge{UserID: userID, Enroll: enroll}); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (c mfaChallenges) load(ctx context.Context, token string) (*mfaChallenge, error) {
+	if token == "" {
+		return nil, user.ErrInvalidToken
+	}
+
+	value, err := c.cache.Get(ctx, c.key(token))
+	if err != nil || value == "" {
+		return nil, user.ErrInvalidToken
+	}
+
+	var ch mfaChallenge
+	if err := json.Unmarshal([]byte(value), &ch); err != nil {
+		return nil, user.ErrInvalidToken
+	}
+	return &ch, nil
+}
+
+// attempt counts a try at the challenge's code, before it is checked, and
+// burns the challenge once the tries are used up. The count is an atomic
+// counter of its own so concurrent tries can't undercount.
+func (c mfaChallenges) attempt(ctx context.Context, token string) error {
+	n, err := c.cache.Increment(ctx, c.attemptsKey(token), mfaChallengeTTL)
+	if err != nil {
+		return fmt.Errorf("failed to count MFA attempt: %w", err)
+	}
+	if n > mfaChallengeMaxAttempts {
+		c.consume(ctx, token)
+		return user.ErrInvalidToken
+	}
+	return nil
+}
+
+func (c mfaChallenges) consume(ctx context.Context, token string) {
+	_ = c.cache.Delete(ctx, c.key(token))
+	_ = c.cache.Delete(ctx, c.attemptsKey(token))
+}
+
+func (c mfaChallenges) save(ctx context.Context, token string, ch *mfaChallenge) error {
+	value, err := json.Marshal(ch)
+	if err != nil {
+		return err
+	}
+	if err := c.cache.Set(ctx, c.key(token), string(value), mfaChallengeTTL); err != nil {
+		return fmt.Errorf("failed to store MFA challenge: %w", err)
+	}
+	return nil
+}
+
+func (c mfaChallenges) key(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return mfaChallengeKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+func (c mfaChallenges) attemptsKey(token string) string {
+	return c.key(token) + ":attempts"
+}
+
+// teamRequiresTwoFactor reports whether any of the user's teams requires
+// two-factor authentication
+func teamRequiresTwoFactor(ctx context.Context, teamRepo team.Repository, userID uuid.UUID) (bool, error) {
+	teams, err := teamRepo.FindByMemberID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range teams {
+		if t.RequiresTwoFactor() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ============================================================================
+// VERIFY TWO-FACTOR USE CASE (second login step)
+// ============================================================================
+
+type VerifyTwoFactorUseCase struct {
+	userRepo      user.Repository
+	twoFactorRepo user.TwoFactorRepository
+	sessions      *SessionManager
+	challenges    mfaChallenges
+	logger        common.Logger
+}
+
+type VerifyTwoFactorInput struct {
+	ChallengeToken string     `json:"challengeToken" validate:"required"`
+	Code           string     `json:"code,omitempty"`
+	RecoveryCode   string     `json:"recoveryCode,omitempty"`
+	Client         ClientInfo `json:"-"`
+}
+
+func NewVerifyTwoFactorUseCase(
+	userRepo user.Repository,
+	twoFactorRepo user.TwoFactorRepository,
+	sessions *SessionManager,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *VerifyTwoFactorUseCase {
+	return &VerifyTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		sessions:      sessions,
+		challenges:    mfaChallenges{cache: cacheService},
+		logger:        logger,
+	}
+}
+
+// Execute completes a login with a TOTP or recovery code
+func (uc *VerifyTwoFactorUseCase) Execute(ctx context.Context, input VerifyTwoFactorInput) (*LoginOutput, error) {
+	if input.Code == "" && input.RecoveryCode == "" {
+		return nil, fmt.Errorf("code or recovery code is required")
+	}
+
+	ch, err := uc.challenges.load(ctx, input.ChallengeToken)
+	if err != nil || ch.Enroll {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	if err := uc.challenges.attempt(ctx, input.ChallengeToken); err != nil {
+		uc.logger.Warn("MFA challenge attempt refused", "userId", ch.UserID, "error", err)
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	tf, err := uc.twoFactorRepo.FindByUserID(ctx, ch.UserID)
+	if err != nil || !tf.IsEnabled() {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	if input.Code != "" {
+		err = tf.Verify(input.Code, time.Now())
+		if err == nil {
+			err = uc.twoFactorRepo.ConsumeStep(ctx, ch.UserID, tf.LastUsedStep())
+		}
+	} else {
+		err = uc.twoFactorRepo.UseRecoveryCode(ctx, ch.UserID, user.HashRecoveryCode(input.RecoveryCode))
+		if err == nil {
+			uc.logger.Info("Recovery code used", "userId", ch.UserID)
+		}
+	}
+	if err != nil {
+		uc.logger.Warn("Two-factor verification failed", "userId", ch.UserID)
+		return nil, err
+	}
+
+	uc.challenges.consume(ctx, input.ChallengeToken)
+
+	u, err := uc.userRepo.FindByID(ctx, ch.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if !u.CanAccessPlatform() {
+		return nil, fmt.Errorf("account %s", u.Status())
+	}
+
+	tokens, err := uc.sessions.Start(ctx, u, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		User:         mapUserToDTO(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    900, // 15 minutes
+	}, nil
+}
@@ -0,0 +1,546 @@
+// path: backend/internal/application/auth/oauth_provider.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// OAuth grant types supported by the token endpoint
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+	GrantRefreshToken      = "refresh_token"
+)
+
+// OAuthError is an RFC 6749 error response
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func oauthError(code, description string) *OAuthError {
+	return &OAuthError{Code: code, Description: description}
+}
+
+// ============================================================================
+// AUTHORIZATION REQUEST
+// ============================================================================
+
+// OAuthAuthorizeInput is an authorization request (RFC 6749 §4.1.1,
+// RFC 7636). The signed-in user is the resource owner.
+type OAuthAuthorizeInput struct {
+	UserID              uuid.UUID `json:"-"`
+	ClientID            string    `json:"client_id"`
+	RedirectURI         string    `json:"redirect_uri"`
+	ResponseType        string    `json:"response_type"`
+	Scope               string    `json:"scope"`
+	State               string    `json:"state"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+}
+
+// authorizationRequest is a validated authorization request
+type authorizationRequest struct {
+	client      *team.OAuthClient
+	redirectURI string
+	scopes      []team.Permission
+}
+
+// validateAuthorizationRequest checks a request before the user is asked.
+// Errors about the client or redirect URI must be shown to the user, never
+// sent to the redirect URI.
+func validateAuthorizationRequest(ctx context.Context, oauthRepo team.OAuthRepository, input OAuthAuthorizeInput) (*authorizationRequest, error) {
+	client, err := oauthRepo.FindClient(ctx, input.ClientID)
+	if err != nil {
+		return nil, oauthError("invalid_client", "unknown client")
+	}
+
+	redirectURI := input.RedirectURI
+	if redirectURI == "" && len(client.RedirectURIs()) == 1 {
+		redirectURI = client.RedirectURIs()[0]
+	}
+	if !client.AllowsRedirectURI(redirectURI) {
+		return nil, oauthError("invalid_request", "redirect_uri is not registered for this client")
+	}
+
+	if input.ResponseType != "code" {
+		return nil, oauthError("unsupported_response_type", "only the code response type is supported")
+	}
+	if input.CodeChallenge == "" && !client.IsConfidential() {
+		return nil, oauthError("invalid_request", "public clients must use PKCE")
+	}
+	if input.CodeChallenge != "" && input.CodeChallengeMethod != "S256" {
+		return nil, oauthError("invalid_request", "code_challenge_method must be S256")
+	}
+
+	scopes, err := client.NarrowScopes(team.ParseOAuthScope(input.Scope))
+	if err != nil {
+		return nil, oauthError("invalid_scope", err.Error())
+	}
+
+	return &authorizationRequest{client: client, redirectURI: redirectURI, scopes: scopes}, nil
+}
+
+// OAuthConsentTeam is a team the user may grant access to
+type OAuthConsentTeam struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// OAuthConsentOutput is what the consent screen shows
+type OAuthConsentOutput struct {
+	ClientID    string                `json:"clientId"`
+	ClientName  string                `json:"clientName"`
+	RedirectURI string                `json:"redirectUri"`
+	Scopes      []team.PermissionInfo `json:"scopes"`
+	Teams       []OAuthConsentTeam    `json:"teams"`
+	State       string                `json:"state,omitempty"`
+}
+
+// GetOAuthConsentUseCase validates an authorization request and returns the
+// data for the consent screen
+type GetOAuthConsentUseCase struct {
+	oauthRepo team.OAuthRepository
+	teamRepo  team.Repository
+}
+
+func NewGetOAuthConsentUseCase(oauthRepo team.OAuthRepository, teamRepo team.Repository) *GetOAuthConsentUseCase {
+	return &GetOAuthConsentUseCase{oauthRepo: oauthRepo, teamRepo: teamRepo}
+}
+
+func (uc *GetOAuthConsentUseCase) Execute(ctx context.Context, input OAuthAuthorizeInput) (*OAuthConsentOutput, error) {
+	req, err := validateAuthorizationRequest(ctx, uc.oauthRepo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := make(map[team.Permission]string)
+	for _, info := range team.Permissions() {
+		descriptions[info.Name] = info.Description
+	}
+	scopes := make([]team.PermissionInfo, 0, len(req.scopes))
+	for _, s := range req.scopes {
+		scopes = append(scopes, team.PermissionInfo{Name: s, Description: descriptions[s]})
+	}
+
+	teams, err := uc.teamRepo.FindByMemberID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	choices := make([]OAuthConsentTeam, 0, len(teams))
+	for _, t := range teams {
+		choices = append(choices, OAuthConsentTeam{ID: t.ID(), Name: t.Name()})
+	}
+
+	return &OAuthConsentOutput{
+		ClientID:    req.client.ClientID(),
+		ClientName:  req.client.Name(),
+		RedirectURI: req.redirectURI,
+		Scopes:      scopes,
+		Teams:       choices,
+		State:       input.State,
+	}, nil
+}
+
+// OAuthDecisionInput is the user's answer on the consent screen
+type OAuthDecisionInput struct {
+	OAuthAuthorizeInput
+	TeamID  uuid.UUID `json:"team_id"`
+	Approve bool      `json:"approve"`
+}
+
+// OAuthDecisionOutput is where the browser goes next
+type OAuthDecisionOutput struct {
+	RedirectTo string `json:"redirectTo"`
+}
+
+// DecideOAuthConsentUseCase issues an authorization code, or an
+// access_denied error, to the client's redirect URI
+type DecideOAuthConsentUseCase struct {
+	oauthRepo  team.OAuthRepository
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewDecideOAuthConsentUseCase(
+	oauthRepo team.OAuthRepository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	logger common.Logger,
+) *DecideOAuthConsentUseCase {
+	return &DecideOAuthConsentUseCase{
+		oauthRepo:  oauthRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+func (uc *DecideOAuthConsentUseCase) Execute(ctx context.Context, input OAuthDecisionInput) (*OAuthDecisionOutput, error) {
+	req, err := validateAuthorizationRequest(ctx, uc.oauthRepo, input.OAuthAuthorizeInput)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if input.State != "" {
+		params.Set("state", input.State)
+	}
+
+	if !input.Approve {
+		params.Set("error", "access_denied")
+		return &OAuthDecisionOutput{RedirectTo: withQuery(req.redirectURI, params)}, nil
+	}
+
+	// Only active members can grant access to a team
+	if _, err := uc.authorizer.Role(ctx, input.TeamID, input.UserID); err != nil {
+		if errors.Is(err, team.ErrMemberNotFound) {
+			return nil, fmt.Errorf("access denied: not a team member")
+		}
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+
+	code, raw, err := team.NewOAuthAuthorizationCode(req.client, input.UserID, input.TeamID, req.redirectURI, req.scopes, input.CodeChallenge)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.oauthRepo.CreateCode(ctx, code); err != nil {
+		uc.logger.Error("Failed to store authorization code", "clientId", input.ClientID, "error", err)
+		return nil, fmt.Errorf("failed to authorize client")
+	}
+
+	uc.logger.Info("OAuth client authorized",
+		"clientId", req.client.ClientID(),
+		"userId", input.UserID,
+		"teamId", input.TeamID,
+		"scope", team.FormatOAuthScope(req.scopes))
+
+	params.Set("code", raw)
+	return &OAuthDecisionOutput{RedirectTo: withQuery(req.redirectURI, params)}, nil
+}
+
+func withQuery(redirectURI string, params url.Values) string {
+	sep := "?"
+	if strings.Contains(redirectURI, "?") {
+		sep = "&"
+	}
+	return redirectURI + sep + params.Encode()
+}
+
+// ============================================================================
+// TOKEN ENDPOINT
+// ============================================================================
+
+// OAuthClientCredentials authenticates a client at the token, introspection
+// and revocation endpoints (HTTP Basic or form parameters)
+type OAuthClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// authenticateOAuthClient returns the client; confidential clients must
+// present their secret
+func authenticateOAuthClient(ctx context.Context, oauthRepo team.OAuthRepository, creds OAuthClientCredentials) (*team.OAuthClient, error) {
+	client, err := oauthRepo.FindClient(ctx, creds.ClientID)
+	if err != nil {
+		return nil, oauthError("invalid_client", "client authentication failed")
+	}
+	if client.IsConfidential() && !client.VerifySecret(creds.ClientSecret) {
+		return nil, oauthError("invalid_client", "client authentication failed")
+	}
+	return client, nil
+}
+
+type OAuthTokenInput struct {
+	OAuthClientCredentials
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+}
+
+// OAuthTokenOutput is an RFC 6749 §5.1 access token response
+type OAuthTokenOutput struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+type OAuthTokenUseCase struct {
+	oauthRepo    team.OAuthRepository
+	teamRepo     team.Repository
+	userRepo     user.Repository
+	authorizer   *team.Authorizer
+	tokenService common.TokenService
+	logger       common.Logger
+}
+
+func NewOAuthTokenUseCase(
+	oauthRepo team.OAuthRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	userRepo user.Repository,
+	tokenService common.TokenService,
+	logger common.Logger,
+) *OAuthTokenUseCase {
+	return &OAuthTokenUseCase{
+		oauthRepo:    oauthRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		authorizer:   team.NewAuthorizer(memberRepo, roleRepo),
+		tokenService: tokenService,
+		logger:       logger,
+	}
+}
+
+func (uc *OAuthTokenUseCase) Execute(ctx context.Context, input OAuthTokenInput) (*OAuthTokenOutput, error) {
+	client, err := authenticateOAuthClient(ctx, uc.oauthRepo, input.OAuthClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.GrantType {
+	case GrantAuthorizationCode:
+		return uc.exchangeCode(ctx, client, input)
+	case GrantRefreshToken:
+		return uc.refresh(ctx, client, input)
+	case GrantClientCredentials:
+		return uc.clientCredentials(ctx, client, input)
+	default:
+		return nil, oauthError("unsupported_grant_type", "grant_type must be authorization_code, refresh_token or client_credentials")
+	}
+}
+
+func (uc *OAuthTokenUseCase) exchangeCode(ctx context.Context, client *team.OAuthClient, input OAuthTokenInput) (*OAuthTokenOutput, error) {
+	code, err := uc.oauthRepo.ConsumeCode(ctx, team.HashOAuthSecret(input.Code))
+	if err != nil {
+		return nil, grantError(err)
+	}
+	if err := code.Redeem(client, input.RedirectURI, input.CodeVerifier, time.Now().UTC()); err != nil {
+		return nil, grantError(err)
+	}
+	return uc.issue(ctx, client, code.UserID(), code.TeamID(), code.Scopes(), true)
+}
+
+func (uc *OAuthTokenUseCase) refresh(ctx context.Context, client *team.OAuthClient, input OAuthTokenInput) (*OAuthTokenOutput, error) {
+	token, err := uc.oauthRepo.FindRefreshToken(ctx, team.HashOAuthSecret(input.RefreshToken))
+	if err != nil {
+		return nil, grantError(err)
+	}
+	now := time.Now().UTC()
+	if token.ClientID() != client.ID() || !token.IsActive(now) {
+		return nil, grantError(team.ErrInvalidOAuthGrant)
+	}
+
+	// A refresh may ask for fewer scopes, never more
+	scopes, err := team.NarrowOAuthScopes(token.Scopes(), team.ParseOAuthScope(input.Scope))
+	if err != nil {
+		return nil, oauthError("invalid_scope", err.Error())
+	}
+
+	// Rotate; losing the race to a concurrent refresh fails this one
+	if err := uc.oauthRepo.RevokeRefreshToken(ctx, token.ID(), now); err != nil {
+		return nil, grantError(err)
+	}
+	return uc.issue(ctx, client, token.UserID(), token.TeamID(), scopes, true)
+}
+
+func (uc *OAuthTokenUseCase) clientCredentials(ctx context.Context, client *team.OAuthClient, input OAuthTokenInput) (*OAuthTokenOutput, error) {
+	if !client.IsConfidential() {
+		return nil, oauthError("unauthorized_client", "public clients cannot use client credentials")
+	}
+	scopes, err := client.NarrowScopes(team.ParseOAuthScope(input.Scope))
+	if err != nil {
+		return nil, oauthError("invalid_scope", err.Error())
+	}
+	return uc.issue(ctx, client, client.CreatedBy(), client.TeamID(), scopes, false)
+}
+
+// issue creates the access token and, for user grants, a new refresh token.
+// The user must still be able to sign in and be an active member of the
+// team, and the team's plan must still include API access.
+func (uc *OAuthTokenUseCase) issue(ctx context.Context, client *team.OAuthClient, userID, teamID uuid.UUID, scopes []team.Permission, withRefresh bool) (*OAuthTokenOutput, error) {
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil && !errors.Is(err, user.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	if err != nil || !u.CanAccessPlatform() {
+		return nil, oauthError("invalid_grant", "the user can no longer access the platform")
+	}
+
+	if _, err := uc.authorizer.Role(ctx, teamID, userID); err != nil {
+		if errors.Is(err, team.ErrMemberNotFound) {
+			return nil, oauthError("invalid_grant", "the user is no longer a member of the team")
+		}
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+
+	t, err := uc.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, oauthError("invalid_grant", "the team no longer exists")
+	}
+	if !t.HasFeature("api_access") {
+		return nil, oauthError("invalid_grant", "the team's plan does not include API access")
+	}
+
+	scopeNames := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		scopeNames = append(scopeNames, string(s))
+	}
+	accessToken, err := uc.tokenService.GenerateOAuthAccessToken(userID.String(), teamID.String(), client.ClientID(), scopeNames, team.OAuthAccessTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token: %w", err)
+	}
+
+	output := &OAuthTokenOutput{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(team.OAuthAccessTokenTTL.Seconds()),
+		Scope:       team.FormatOAuthScope(scopes),
+	}
+
+	if withRefresh {
+		refresh, raw, err := team.NewOAuthRefreshToken(client.ID(), userID, teamID, scopes)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.oauthRepo.CreateRefreshToken(ctx, refresh); err != nil {
+			uc.logger.Error("Failed to store refresh token", "clientId", client.ClientID(), "error", err)
+			return nil, fmt.Errorf("failed to issue refresh token")
+		}
+		output.RefreshToken = raw
+	}
+
+	uc.logger.Info("OAuth token issued", "clientId", client.ClientID(), "userId", userID, "teamId", teamID)
+	return output, nil
+}
+
+func grantError(err error) error {
+	if errors.Is(err, team.ErrInvalidOAuthGrant) {
+		return oauthError("invalid_grant", err.Error())
+	}
+	return err
+}
+
+// ============================================================================
+// INTROSPECTION AND REVOCATION
+// ============================================================================
+
+type OAuthTokenRequest struct {
+	OAuthClientCredentials
+	Token string
+}
+
+// OAuthIntrospection is an RFC 7662 introspection response
+type OAuthIntrospection struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	TeamID    string `json:"team_id,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+}
+
+// IntrospectOAuthTokenUseCase tells a client whether a token it holds is
+// active. Clients only learn about their own tokens.
+type IntrospectOAuthTokenUseCase struct {
+	oauthRepo    team.OAuthRepository
+	tokenService common.TokenService
+}
+
+func NewIntrospectOAuthTokenUseCase(oauthRepo team.OAuthRepository, tokenService common.TokenService) *IntrospectOAuthTokenUseCase {
+	return &IntrospectOAuthTokenUseCase{oauthRepo: oauthRepo, tokenService: tokenService}
+}
+
+func (uc *IntrospectOAuthTokenUseCase) Execute(ctx context.Context, input OAuthTokenRequest) (*OAuthIntrospection, error) {
+	client, err := authenticateOAuthClient(ctx, uc.oauthRepo, input.OAuthClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+	inactive := &OAuthIntrospection{Active: false}
+
+	if strings.HasPrefix(input.Token, team.OAuthRefreshTokenPrefix) {
+		token, err := uc.oauthRepo.FindRefreshToken(ctx, team.HashOAuthSecret(input.Token))
+		if err != nil || token.ClientID() != client.ID() || !token.IsActive(time.Now().UTC()) {
+			return inactive, nil
+		}
+		return &OAuthIntrospection{
+			Active:    true,
+			Scope:     team.FormatOAuthScope(token.Scopes()),
+			ClientID:  client.ClientID(),
+			Subject:   token.UserID().String(),
+			TeamID:    token.TeamID().String(),
+			TokenType: "refresh_token",
+			ExpiresAt: token.ExpiresAt().Unix(),
+			IssuedAt:  token.CreatedAt().Unix(),
+		}, nil
+	}
+
+	claims, err := uc.tokenService.ValidateAccessToken(input.Token)
+	if err != nil || claims.ClientID != client.ClientID() {
+		return inactive, nil
+	}
+	return &OAuthIntrospection{
+		Active:    true,
+		Scope:     strings.Join(claims.Scopes, " "),
+		ClientID:  claims.ClientID,
+		Subject:   claims.UserID,
+		TeamID:    claims.TeamID,
+		TokenType: "Bearer",
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		IssuedAt:  claims.IssuedAt.Unix(),
+	}, nil
+}
+
+// RevokeOAuthTokenUseCase revokes a refresh token (RFC 7009). Access tokens
+// are short-lived JWTs and simply expire.
+type RevokeOAuthTokenUseCase struct {
+	oauthRepo team.OAuthRepository
+	logger    common.Logger
+}
+
+func NewRevokeOAuthTokenUseCase(oauthRepo team.OAuthRepository, logger common.Logger) *RevokeOAuthTokenUseCase {
+	return &RevokeOAuthTokenUseCase{oauthRepo: oauthRepo, logger: logger}
+}
+
+// Execute succeeds for unknown tokens too, as RFC 7009 requires
+func (uc *RevokeOAuthTokenUseCase) Execute(ctx context.Context, input OAuthTokenRequest) error {
+	client, err := authenticateOAuthClient(ctx, uc.oauthRepo, input.OAuthClientCredentials)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(input.Token, team.OAuthRefreshTokenPrefix) {
+		return nil
+	}
+
+	token, err := uc.oauthRepo.FindRefreshToken(ctx, team.HashOAuthSecret(input.Token))
+	if err != nil || token.ClientID() != client.ID() {
+		return nil
+	}
+	if err := uc.oauthRepo.RevokeRefreshToken(ctx, token.ID(), time.Now().UTC()); err != nil && !errors.Is(err, team.ErrInvalidOAuthGrant) {
+		return err
+	}
+
+	uc.logger.Info("OAuth refresh token revoked", "clientId", client.ClientID(), "userId", token.UserID())
+	return nil
+}
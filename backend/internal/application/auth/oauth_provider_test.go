@@ -0,0 +1,419 @@
+// path: backend/internal/application/auth/oauth_provider_test.go
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memoryOAuth struct {
+	team.OAuthRepository
+	clients map[string]*team.OAuthClient
+	codes   map[string]*team.OAuthAuthorizationCode
+	tokens  map[string]*team.OAuthRefreshToken
+}
+
+func (r *memoryOAuth) FindClient(ctx context.Context, clientID string) (*team.OAuthClient, error) {
+	if c, ok := r.clients[clientID]; ok {
+		return c, nil
+	}
+	return nil, team.ErrOAuthClientNotFound
+}
+
+func (r *memoryOAuth) CreateCode(ctx context.Context, code *team.OAuthAuthorizationCode) error {
+	r.codes[code.CodeHash()] = code
+	return nil
+}
+
+func (r *memoryOAuth) ConsumeCode(ctx context.Context, codeHash string) (*team.OAuthAuthorizationCode, error) {
+	code, ok := r.codes[codeHash]
+	if !ok {
+		return nil, team.ErrInvalidOAuthGrant
+	}
+	delete(r.codes, codeHash)
+	return code, nil
+}
+
+func (r *memoryOAuth) CreateRefreshToken(ctx context.Context, token *team.OAuthRefreshToken) error {
+	r.tokens[token.TokenHash()] = token
+	return nil
+}
+
+func (r *memoryOAuth) FindRefreshToken(ctx context.Context, tokenHash string) (*team.OAuthRefreshToken, error) {
+	if t, ok := r.tokens[tokenHash]; ok {
+		return t, nil
+	}
+	return nil, team.ErrInvalidOAuthGrant
+}
+
+func (r *memoryOAuth) RevokeRefreshToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	for hash, t := range r.tokens {
+		if t.ID() != id {
+			continue
+		}
+		if t.RevokedAt() != nil {
+			return team.ErrInvalidOAuthGrant
+		}
+		r.tokens[hash] = team.ReconstructOAuthRefreshToken(t.ID(), t.TokenHash(), t.ClientID(), t.UserID(), t.TeamID(),
+			t.Scopes(), t.ExpiresAt(), &revokedAt, t.CreatedAt())
+		return nil
+	}
+	return team.ErrInvalidOAuthGrant
+}
+
+type memoryMembers struct {
+	team.MemberRepository
+	members map[uuid.UUID]*team.Member
+}
+
+func (r *memoryMembers) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	if m, ok := r.members[userID]; ok && m.TeamID() == teamID {
+		return m, nil
+	}
+	return nil, team.ErrMemberNotFound
+}
+
+type editorRole struct {
+	team.RoleRepository
+}
+
+func (r editorRole) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	return team.ReconstructRole(uuid.New(), teamID, name, "", []team.Permission{team.PermPostsView, team.PermPostsCreate}, true, time.Now(), time.Now()), nil
+}
+
+type memoryTeam struct {
+	team.Repository
+	team *team.Team
+}
+
+func (r *memoryTeam) FindByID(ctx context.Context, id uuid.UUID) (*team.Team, error) {
+	if r.team.ID() != id {
+		return nil, team.ErrTeamNotFound
+	}
+	return r.team, nil
+}
+
+type memoryUser struct {
+	user.Repository
+	user *user.User
+}
+
+func (r *memoryUser) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	if r.user.ID() != id {
+		return nil, user.ErrUserNotFound
+	}
+	return r.user, nil
+}
+
+const callback = "https://app.example.com/callback"
+
+type oauthFixture struct {
+	client        *team.OAuthClient
+	service       *team.OAuthClient // confidential, for client credentials
+	serviceSecret string
+	user          *user.User
+	userID        uuid.UUID
+	teamID        uuid.UUID
+	team          *memoryTeam
+	members       *memoryMembers
+	consent       *DecideOAuthConsentUseCase
+	token         *OAuthTokenUseCase
+}
+
+func newOAuthFixture(t *testing.T) *oauthFixture {
+	t.Helper()
+
+	u, err := user.NewExternalUser("ana@example.com", "ana", "Ana", "Lima", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	userID := u.ID()
+	now := time.Now()
+	tm := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", userID, team.PlanEnterprise, team.StatusActive,
+		team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	teamID := tm.ID()
+
+	client, _, err := team.NewOAuthClient(teamID, userID, "Scheduler CLI", []string{callback},
+		[]team.Permission{team.PermPostsView, team.PermPostsCreate}, false)
+	if err != nil {
+		t.Fatalf("NewOAuthClient: %v", err)
+	}
+	service, secret, err := team.NewOAuthClient(teamID, userID, "Reporting job", []string{callback},
+		[]team.Permission{team.PermPostsView}, true)
+	if err != nil {
+		t.Fatalf("NewOAuthClient: %v", err)
+	}
+
+	joined := time.Now()
+	members := &memoryMembers{members: map[uuid.UUID]*team.Member{
+		userID: team.ReconstructMember(uuid.New(), teamID, userID, team.MemberRoleEditor, team.MemberStatusActive, userID, joined, &joined, nil),
+	}}
+	repo := &memoryOAuth{
+		clients: map[string]*team.OAuthClient{client.ClientID(): client, service.ClientID(): service},
+		codes:   map[string]*team.OAuthAuthorizationCode{},
+		tokens:  map[string]*team.OAuthRefreshToken{},
+	}
+	logger := services.NewLogger()
+
+	teams := &memoryTeam{team: tm}
+	return &oauthFixture{
+		client:        client,
+		service:       service,
+		serviceSecret: secret,
+		user:          u,
+		userID:        userID,
+		teamID:        teamID,
+		team:          teams,
+		members:       members,
+		consent:       NewDecideOAuthConsentUseCase(repo, members, editorRole{}, logger),
+		token: NewOAuthTokenUseCase(repo, teams, members, editorRole{}, &memoryUser{user: u},
+			services.NewJWTTokenService("access-secret", "refresh-secret"), logger),
+	}
+}
+
+func s256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// authorize approves a request and returns the code sent to the client
+func (f *oauthFixture) authorize(t *testing.T, in OAuthAuthorizeInput) (string, error) {
+	t.Helper()
+
+	in.UserID = f.userID
+	in.ClientID = f.client.ClientID()
+	in.ResponseType = "code"
+	out, err := f.consent.Execute(context.Background(), OAuthDecisionInput{OAuthAuthorizeInput: in, TeamID: f.teamID, Approve: true})
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(out.RedirectTo)
+	if err != nil {
+		t.Fatalf("redirect %q: %v", out.RedirectTo, err)
+	}
+	return u.Query().Get("code"), nil
+}
+
+func (f *oauthFixture) exchange(code, redirectURI, verifier string) (*OAuthTokenOutput, error) {
+	return f.token.Execute(context.Background(), OAuthTokenInput{
+		OAuthClientCredentials: OAuthClientCredentials{ClientID: f.client.ClientID()},
+		GrantType:              GrantAuthorizationCode,
+		Code:                   code,
+		RedirectURI:            redirectURI,
+		CodeVerifier:           verifier,
+	})
+}
+
+func (f *oauthFixture) refresh(token, scope string) (*OAuthTokenOutput, error) {
+	return f.token.Execute(context.Background(), OAuthTokenInput{
+		OAuthClientCredentials: OAuthClientCredentials{ClientID: f.client.ClientID()},
+		GrantType:              GrantRefreshToken,
+		RefreshToken:           token,
+		Scope:                  scope,
+	})
+}
+
+func (f *oauthFixture) clientCredentials() (*OAuthTokenOutput, error) {
+	return f.token.Execute(context.Background(), OAuthTokenInput{
+		OAuthClientCredentials: OAuthClientCredentials{ClientID: f.service.ClientID(), ClientSecret: f.serviceSecret},
+		GrantType:              GrantClientCredentials,
+	})
+}
+
+// signIn runs the whole code flow with PKCE
+func (f *oauthFixture) signIn(t *testing.T) *OAuthTokenOutput {
+	t.Helper()
+
+	verifier := "a-verifier-long-enough-for-rfc-7636-0123456789"
+	code, err := f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"})
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	out, err := f.exchange(code, callback, verifier)
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	return out
+}
+
+func wantOAuthError(t *testing.T, step string, err error, code string) {
+	t.Helper()
+
+	var oerr *OAuthError
+	if !errors.As(err, &oerr) || oerr.Code != code {
+		t.Fatalf("%s: err = %v, want %s", step, err, code)
+	}
+}
+
+func TestOAuthPublicClientMustUsePKCES256(t *testing.T) {
+	f := newOAuthFixture(t)
+	verifier := "a-verifier-long-enough-for-rfc-7636-0123456789"
+
+	_, err := f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback})
+	wantOAuthError(t, "no challenge", err, "invalid_request")
+
+	_, err = f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: verifier, CodeChallengeMethod: "plain"})
+	wantOAuthError(t, "plain challenge", err, "invalid_request")
+
+	code, err := f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"})
+	if err != nil {
+		t.Fatalf("S256 challenge: %v", err)
+	}
+	_, err = f.exchange(code, callback, "another-verifier")
+	wantOAuthError(t, "wrong verifier", err, "invalid_grant")
+
+	// A failed exchange used the code up
+	_, err = f.exchange(code, callback, verifier)
+	wantOAuthError(t, "after a wrong verifier", err, "invalid_grant")
+}
+
+func TestOAuthCodeIsSingleUse(t *testing.T) {
+	f := newOAuthFixture(t)
+	verifier := "a-verifier-long-enough-for-rfc-7636-0123456789"
+
+	code, err := f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"})
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	out, err := f.exchange(code, callback, verifier)
+	if err != nil {
+		t.Fatalf("first exchange: %v", err)
+	}
+	if out.AccessToken == "" || out.RefreshToken == "" {
+		t.Fatalf("first exchange: got %+v, want access and refresh tokens", out)
+	}
+
+	_, err = f.exchange(code, callback, verifier)
+	wantOAuthError(t, "second exchange", err, "invalid_grant")
+}
+
+func TestOAuthRedirectURIMustMatchExactly(t *testing.T) {
+	f := newOAuthFixture(t)
+	verifier := "a-verifier-long-enough-for-rfc-7636-0123456789"
+	pkce := func(uri string) OAuthAuthorizeInput {
+		return OAuthAuthorizeInput{RedirectURI: uri, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"}
+	}
+
+	for _, uri := range []string{
+		callback + "/",
+		callback + "?next=/admin",
+		"https://APP.example.com/callback",
+		"https://app.example.com.evil.test/callback",
+	} {
+		_, err := f.authorize(t, pkce(uri))
+		wantOAuthError(t, uri, err, "invalid_request")
+	}
+
+	code, err := f.authorize(t, pkce(callback))
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	_, err = f.exchange(code, callback+"/", verifier)
+	wantOAuthError(t, "exchange with another redirect_uri", err, "invalid_grant")
+}
+
+func TestOAuthRefreshRotatesAndNarrowsScopes(t *testing.T) {
+	f := newOAuthFixture(t)
+	first := f.signIn(t)
+	if first.Scope != "posts.view posts.create" {
+		t.Fatalf("scope = %q, want the client's scopes", first.Scope)
+	}
+
+	narrowed, err := f.refresh(first.RefreshToken, "posts.view")
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if narrowed.Scope != "posts.view" || narrowed.RefreshToken == first.RefreshToken {
+		t.Fatalf("refresh: got scope %q, want posts.view with a new refresh token", narrowed.Scope)
+	}
+
+	// The rotated token is spent
+	_, err = f.refresh(first.RefreshToken, "")
+	wantOAuthError(t, "reused refresh token", err, "invalid_grant")
+
+	// Scopes given up are not granted back
+	_, err = f.refresh(narrowed.RefreshToken, "posts.view posts.create")
+	wantOAuthError(t, "widening", err, "invalid_scope")
+	_, err = f.refresh(narrowed.RefreshToken, "posts.delete")
+	wantOAuthError(t, "scope outside the client", err, "invalid_scope")
+
+	again, err := f.refresh(narrowed.RefreshToken, "")
+	if err != nil {
+		t.Fatalf("refresh without scope: %v", err)
+	}
+	if again.Scope != "posts.view" {
+		t.Errorf("refresh without scope: got %q, want posts.view", again.Scope)
+	}
+}
+
+func TestOAuthGrantsEndWithTeamMembership(t *testing.T) {
+	f := newOAuthFixture(t)
+	out := f.signIn(t)
+
+	verifier := "a-verifier-long-enough-for-rfc-7636-0123456789"
+	code, err := f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"})
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+
+	// The user leaves the team
+	left := time.Now()
+	m := f.members.members[f.userID]
+	f.members.members[f.userID] = team.ReconstructMember(m.ID(), m.TeamID(), m.UserID(), m.Role(), team.MemberStatusLeft,
+		m.InvitedBy(), m.InvitedAt(), m.JoinedAt(), &left)
+
+	_, err = f.refresh(out.RefreshToken, "")
+	wantOAuthError(t, "refresh", err, "invalid_grant")
+
+	_, err = f.exchange(code, callback, verifier)
+	wantOAuthError(t, "pending code", err, "invalid_grant")
+
+	_, err = f.authorize(t, OAuthAuthorizeInput{RedirectURI: callback, CodeChallenge: s256(verifier), CodeChallengeMethod: "S256"})
+	if err == nil {
+		t.Fatal("authorize: a former member granted access to the team")
+	}
+}
+
+func TestOAuthGrantsEndWhenTheUserIsSuspended(t *testing.T) {
+	f := newOAuthFixture(t)
+	out := f.signIn(t)
+	if _, err := f.clientCredentials(); err != nil {
+		t.Fatalf("client credentials: %v", err)
+	}
+
+	if err := f.user.Suspend(); err != nil {
+		t.Fatalf("Suspend: %v", err)
+	}
+
+	_, err := f.refresh(out.RefreshToken, "")
+	wantOAuthError(t, "refresh", err, "invalid_grant")
+
+	// Client credentials act as the user who registered the client
+	_, err = f.clientCredentials()
+	wantOAuthError(t, "client credentials", err, "invalid_grant")
+}
+
+func TestOAuthGrantsEndWithTheAPIPlan(t *testing.T) {
+	f := newOAuthFixture(t)
+	out := f.signIn(t)
+
+	tm := f.team.team
+	f.team.team = team.Reconstruct(tm.ID(), tm.Name(), tm.Slug(), "", "", tm.OwnerID(), team.PlanProfessional, tm.Status(),
+		tm.Settings(), tm.Limits(), tm.CreatedAt(), time.Now(), nil)
+
+	_, err := f.refresh(out.RefreshToken, "")
+	wantOAuthError(t, "refresh", err, "invalid_grant")
+
+	_, err = f.clientCredentials()
+	wantOAuthError(t, "client credentials", err, "invalid_grant")
+}
@@ -0,0 +1,451 @@
+// path: backend/internal/application/auth/oidc.go
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const (
+	oidcStateTTL       = 10 * time.Minute
+	oidcStateKeyPrefix = "oidc:state:"
+)
+
+// oidcState is what the server remembers between sending the browser to
+// the provider and the provider sending it back
+type oidcState struct {
+	Provider     string    `json:"provider"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"codeVerifier"`
+	LinkUserID   uuid.UUID `json:"linkUserId,omitempty"` // set when linking to a signed-in user
+}
+
+// IdentityProviders holds the configured sign-in providers by name
+type IdentityProviders map[string]common.IdentityProvider
+
+// Names lists the configured providers in a stable order
+func (p IdentityProviders) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p IdentityProviders) get(name string) (common.IdentityProvider, error) {
+	provider, ok := p[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown identity provider %q", name)
+	}
+	return provider, nil
+}
+
+type IdentityDTO struct {
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+}
+
+func mapIdentityToDTO(i *user.Identity) *IdentityDTO {
+	return &IdentityDTO{
+		Provider:    i.Provider(),
+		Email:       i.Email(),
+		CreatedAt:   i.CreatedAt(),
+		LastLoginAt: i.LastLoginAt(),
+	}
+}
+
+// ============================================================================
+// BEGIN OIDC LOGIN USE CASE
+// ============================================================================
+
+type BeginOIDCLoginUseCase struct {
+	providers IdentityProviders
+	cache     common.CacheService
+	logger    common.Logger
+}
+
+type BeginOIDCLoginInput struct {
+	Provider string    `json:"-"`
+	UserID   uuid.UUID `json:"-"` // signed-in user linking the provider, if any
+}
+
+type BeginOIDCLoginOutput struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+	State            string `json:"state"`
+}
+
+func NewBeginOIDCLoginUseCase(
+	providers IdentityProviders,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *BeginOIDCLoginUseCase {
+	return &BeginOIDCLoginUseCase{
+		providers: providers,
+		cache:     cacheService,
+		logger:    logger,
+	}
+}
+
+func (uc *BeginOIDCLoginUseCase) Execute(ctx context.Context, input BeginOIDCLoginInput) (*BeginOIDCLoginOutput, error) {
+	provider, err := uc.providers.get(input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &oidcState{Provider: input.Provider, LinkUserID: input.UserID}
+	token, err := randomURLToken()
+	if err == nil {
+		state.Nonce, err = randomURLToken()
+	}
+	if err == nil {
+		state.CodeVerifier, err = randomURLToken()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := sha256.Sum256([]byte(state.CodeVerifier))
+	authURL, err := provider.AuthCodeURL(ctx, token, state.Nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		uc.logger.Error("Failed to build authorization URL", "provider", input.Provider, "error", err)
+		return nil, fmt.Errorf("identity provider unavailable")
+	}
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cache.Set(ctx, oidcStateKeyPrefix+token, string(value), oidcStateTTL); err != nil {
+		uc.logger.Error("Failed to store OIDC state", "error", err)
+		return nil, fmt.Errorf("failed to start sign-in")
+	}
+
+	return &BeginOIDCLoginOutput{AuthorizationURL: authURL, State: token}, nil
+}
+
+// ============================================================================
+// FINISH OIDC LOGIN USE CASE
+// ============================================================================
+
+type FinishOIDCLoginUseCase struct {
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	providers    IdentityProviders
+	login        *LoginUseCase
+	cache        common.CacheService
+	logger       common.Logger
+}
+
+type FinishOIDCLoginInput struct {
+	Provider string     `json:"-"`
+	Code     string     `json:"code"`
+	State    string     `json:"state"`
+	Client   ClientInfo `json:"-"`
+}
+
+// FinishOIDCLoginOutput carries the login result, or the linked identity
+// when the flow was started by a signed-in user
+type FinishOIDCLoginOutput struct {
+	*LoginOutput
+	Linked *IdentityDTO `json:"linked,omitempty"`
+}
+
+func NewFinishOIDCLoginUseCase(
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	providers IdentityProviders,
+	login *LoginUseCase,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *FinishOIDCLoginUseCase {
+	return &FinishOIDCLoginUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		providers:    providers,
+		login:        login,
+		cache:        cacheService,
+		logger:       logger,
+	}
+}
+
+func (uc *FinishOIDCLoginUseCase) Execute(ctx context.Context, input FinishOIDCLoginInput) (*FinishOIDCLoginOutput, error) {
+	if input.Code == "" || input.State == "" {
+		return nil, fmt.Errorf("code and state are required")
+	}
+
+	state, err := uc.consumeState(ctx, input.State)
+	if err != nil || state.Provider != input.Provider {
+		return nil, fmt.Errorf("invalid or expired sign-in request")
+	}
+
+	provider, err := uc.providers.get(input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	ext, err := provider.Exchange(ctx, input.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		uc.logger.Warn("Identity provider exchange failed", "provider", input.Provider, "error", err)
+		return nil, common.ErrInvalidCredentials
+	}
+
+	identity, err := uc.identityRepo.FindBySubject(ctx, input.Provider, ext.Subject)
+	if err != nil && !errors.Is(err, user.ErrIdentityNotFound) {
+		uc.logger.Error("Failed to look up identity", "provider", input.Provider, "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	if state.LinkUserID != uuid.Nil {
+		return uc.link(ctx, state.LinkUserID, input.Provider, ext, identity)
+	}
+
+	var u *user.User
+	if identity != nil {
+		u, err = uc.userRepo.FindByID(ctx, identity.UserID())
+		if err != nil {
+			return nil, fmt.Errorf("user not found")
+		}
+		identity.RecordLogin(ext.Email, time.Now())
+		if err := uc.identityRepo.Update(ctx, identity); err != nil {
+			uc.logger.Warn("Failed to update identity", "userId", u.ID(), "error", err)
+		}
+	} else {
+		if u, err = uc.signUpOrMatch(ctx, input.Provider, ext); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.userRepo.UpdateLastLogin(ctx, u.ID(), time.Now()); err != nil {
+		uc.logger.Warn("Failed to update last login", "userId", u.ID(), "error", err)
+	}
+
+	output, err := uc.login.CompleteLogin(ctx, u, input.Client)
+	if err != nil {
+		return nil, err
+	}
+	return &FinishOIDCLoginOutput{LoginOutput: output}, nil
+}
+
+// signUpOrMatch links a new provider account to the user with the same
+// verified email, or creates a user when there is none. Matching requires
+// both sides to have verified the address, so nobody can take over an
+// account by registering its email at a provider.
+func (uc *FinishOIDCLoginUseCase) signUpOrMatch(ctx context.Context, provider string, ext *common.ExternalIdentity) (*user.User, error) {
+	if ext.Email == "" || !ext.EmailVerified {
+		return nil, fmt.Errorf("your %s account has no verified email address", provider)
+	}
+
+	u, err := uc.userRepo.FindByEmail(ctx, strings.ToLower(ext.Email))
+	switch {
+	case err == nil:
+		if !u.IsEmailVerified() {
+			return nil, user.ErrAccountLinkRequired
+		}
+	case errors.Is(err, user.ErrUserNotFound):
+		if u, err = uc.createUser(ctx, ext); err != nil {
+			return nil, err
+		}
+	default:
+		uc.logger.Error("Failed to look up user by email", "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	identity := user.NewIdentity(u.ID(), provider, ext.Subject, ext.Email)
+	identity.RecordLogin(ext.Email, time.Now())
+	if err := uc.identityRepo.Create(ctx, identity); err != nil {
+		if errors.Is(err, user.ErrIdentityAlreadyLinked) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to link identity", "userId", u.ID(), "provider", provider, "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	uc.logger.Info("Identity linked", "userId", u.ID(), "provider", provider)
+	return u, nil
+}
+
+func (uc *FinishOIDCLoginUseCase) createUser(ctx context.Context, ext *common.ExternalIdentity) (*user.User, error) {
+	username, err := user.NewService(uc.userRepo).AvailableUsername(ctx, ext.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := user.NewExternalUser(ext.Email, username, ext.FirstName, ext.LastName, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.Create(ctx, u); err != nil {
+		uc.logger.Error("Failed to create user", "email", ext.Email, "error", err)
+		return nil, fmt.Errorf("failed to create account")
+	}
+
+	uc.logger.Info("User signed up with identity provider", "userId", u.ID())
+	return u, nil
+}
+
+// link attaches the provider account to a signed-in user
+func (uc *FinishOIDCLoginUseCase) link(ctx context.Context, userID uuid.UUID, provider string, ext *common.ExternalIdentity, existing *user.Identity) (*FinishOIDCLoginOutput, error) {
+	if existing != nil {
+		if existing.UserID() != userID {
+			return nil, user.ErrIdentityAlreadyLinked
+		}
+		return &FinishOIDCLoginOutput{Linked: mapIdentityToDTO(existing)}, nil
+	}
+
+	identity := user.NewIdentity(userID, provider, ext.Subject, ext.Email)
+	if err := uc.identityRepo.Create(ctx, identity); err != nil {
+		if errors.Is(err, user.ErrIdentityAlreadyLinked) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to link identity", "userId", userID, "provider", provider, "error", err)
+		return nil, fmt.Errorf("failed to link account")
+	}
+
+	uc.logger.Info("Identity linked", "userId", userID, "provider", provider)
+	return &FinishOIDCLoginOutput{Linked: mapIdentityToDTO(identity)}, nil
+}
+
+func (uc *FinishOIDCLoginUseCase) consumeState(ctx context.Context, token string) (*oidcState, error) {
+	key := oidcStateKeyPrefix + token
+	value, err := uc.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return nil, user.ErrInvalidToken
+	}
+	_ = uc.cache.Delete(ctx, key)
+
+	var state oidcState
+	if err := json.Unmarshal([]byte(value), &state); err != nil {
+		return nil, user.ErrInvalidToken
+	}
+	return &state, nil
+}
+
+// ============================================================================
+// LINKED IDENTITIES USE CASES
+// ============================================================================
+
+type ListIdentitiesUseCase struct {
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	providers    IdentityProviders
+	logger       common.Logger
+}
+
+type ListIdentitiesInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+type ListIdentitiesOutput struct {
+	HasPassword bool           `json:"hasPassword"`
+	Identities  []*IdentityDTO `json:"identities"`
+	Providers   []string       `json:"providers"`
+}
+
+func NewListIdentitiesUseCase(
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	providers IdentityProviders,
+	logger common.Logger,
+) *ListIdentitiesUseCase {
+	return &ListIdentitiesUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		providers:    providers,
+		logger:       logger,
+	}
+}
+
+func (uc *ListIdentitiesUseCase) Execute(ctx context.Context, input ListIdentitiesInput) (*ListIdentitiesOutput, error) {
+	u, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	identities, err := uc.identityRepo.ListByUser(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to list identities", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to list linked accounts")
+	}
+
+	output := &ListIdentitiesOutput{
+		HasPassword: u.HasPassword(),
+		Identities:  make([]*IdentityDTO, 0, len(identities)),
+		Providers:   uc.providers.Names(),
+	}
+	for _, i := range identities {
+		output.Identities = append(output.Identities, mapIdentityToDTO(i))
+	}
+	return output, nil
+}
+
+type UnlinkIdentityUseCase struct {
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	logger       common.Logger
+}
+
+type UnlinkIdentityInput struct {
+	UserID   uuid.UUID `json:"-"`
+	Provider string    `json:"-"`
+}
+
+func NewUnlinkIdentityUseCase(
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *UnlinkIdentityUseCase {
+	return &UnlinkIdentityUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *UnlinkIdentityUseCase) Execute(ctx context.Context, input UnlinkIdentityInput) error {
+	u, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return fmt.Errorf("user not found")
+	}
+
+	if !u.HasPassword() {
+		identities, err := uc.identityRepo.ListByUser(ctx, input.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to list linked accounts: %w", err)
+		}
+		if len(identities) <= 1 {
+			return user.ErrLastSignInMethod
+		}
+	}
+
+	if err := uc.identityRepo.Delete(ctx, input.UserID, input.Provider); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Identity unlinked", "userId", input.UserID, "provider", input.Provider)
+	return nil
+}
+
+// randomURLToken returns 32 random bytes, base64url-encoded without
+// padding as PKCE code verifiers require
+func randomURLToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,612 @@
+// path: backend/internal/application/auth/passkeys.go
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const (
+	passkeyCeremonyTTL       = 5 * time.Minute
+	passkeyCeremonyKeyPrefix = "webauthn:ceremony:"
+	passkeyTimeoutMillis     = 300000
+)
+
+// passkeyCeremony is the server side of a WebAuthn ceremony, stored under
+// its challenge until the browser responds
+type passkeyCeremony struct {
+	Register bool      `json:"register"`
+	UserID   uuid.UUID `json:"userId,omitempty"`
+
+	// ChallengeToken is set when the passkey is the second login step
+	ChallengeToken string `json:"challengeToken,omitempty"`
+}
+
+// passkeyCeremonies keeps ceremonies in the cache. Each challenge can be
+// answered once.
+type passkeyCeremonies struct {
+	cache common.CacheService
+}
+
+func (c passkeyCeremonies) begin(ctx context.Context, ceremony *passkeyCeremony) ([]byte, error) {
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(ceremony)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.cache.Set(ctx, c.key(challenge), string(value), passkeyCeremonyTTL); err != nil {
+		return nil, fmt.Errorf("failed to store passkey challenge: %w", err)
+	}
+	return challenge, nil
+}
+
+func (c passkeyCeremonies) finish(ctx context.Context, challenge []byte) (*passkeyCeremony, error) {
+	key := c.key(challenge)
+	value, err := c.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+	_ = c.cache.Delete(ctx, key)
+
+	var ceremony passkeyCeremony
+	if err := json.Unmarshal([]byte(value), &ceremony); err != nil {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+	return &ceremony, nil
+}
+
+func (c passkeyCeremonies) key(challenge []byte) string {
+	return passkeyCeremonyKeyPrefix + base64.RawURLEncoding.EncodeToString(challenge)
+}
+
+// Option types mirror PublicKeyCredentialCreationOptions and
+// PublicKeyCredentialRequestOptions, with binary fields base64url-encoded.
+
+type PasskeyRelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PasskeyUserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type PasskeyCredentialParam struct {
+	Type string `json:"type"`
+	Alg  int    `json:"alg"`
+}
+
+type PasskeyCredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type PasskeyAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type PasskeyCreationOptions struct {
+	Challenge              string                        `json:"challenge"`
+	RP                     PasskeyRelyingParty           `json:"rp"`
+	User                   PasskeyUserEntity             `json:"user"`
+	PubKeyCredParams       []PasskeyCredentialParam      `json:"pubKeyCredParams"`
+	Timeout                int                           `json:"timeout"`
+	ExcludeCredentials     []PasskeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                        `json:"attestation"`
+}
+
+type PasskeyRequestOptions struct {
+	Challenge        string                        `json:"challenge"`
+	RPID             string                        `json:"rpId"`
+	Timeout          int                           `json:"timeout"`
+	AllowCredentials []PasskeyCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                        `json:"userVerification"`
+}
+
+type PasskeyDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Transports []string   `json:"transports,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+func mapPasskeyToDTO(p *user.Passkey) *PasskeyDTO {
+	return &PasskeyDTO{
+		ID:         p.ID().String(),
+		Name:       p.Name(),
+		Transports: p.Transports(),
+		CreatedAt:  p.CreatedAt(),
+		LastUsedAt: p.LastUsedAt(),
+	}
+}
+
+func passkeyDescriptors(passkeys []*user.Passkey) []PasskeyCredentialDescriptor {
+	descriptors := make([]PasskeyCredentialDescriptor, 0, len(passkeys))
+	for _, p := range passkeys {
+		descriptors = append(descriptors, PasskeyCredentialDescriptor{
+			Type:       "public-key",
+			ID:         base64.RawURLEncoding.EncodeToString(p.CredentialID()),
+			Transports: p.Transports(),
+		})
+	}
+	return descriptors
+}
+
+// ============================================================================
+// BEGIN PASSKEY REGISTRATION USE CASE
+// ============================================================================
+
+type BeginPasskeyRegistrationUseCase struct {
+	userRepo    user.Repository
+	passkeyRepo user.PasskeyRepository
+	verifier    common.WebAuthnVerifier
+	ceremonies  passkeyCeremonies
+	logger      common.Logger
+}
+
+type BeginPasskeyRegistrationInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+func NewBeginPasskeyRegistrationUseCase(
+	userRepo user.Repository,
+	passkeyRepo user.PasskeyRepository,
+	verifier common.WebAuthnVerifier,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *BeginPasskeyRegistrationUseCase {
+	return &BeginPasskeyRegistrationUseCase{
+		userRepo:    userRepo,
+		passkeyRepo: passkeyRepo,
+		verifier:    verifier,
+		ceremonies:  passkeyCeremonies{cache: cacheService},
+		logger:      logger,
+	}
+}
+
+func (uc *BeginPasskeyRegistrationUseCase) Execute(ctx context.Context, input BeginPasskeyRegistrationInput) (*PasskeyCreationOptions, error) {
+	u, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	existing, err := uc.passkeyRepo.ListByUser(ctx, u.ID())
+	if err != nil {
+		uc.logger.Error("Failed to list passkeys", "userId", u.ID(), "error", err)
+		return nil, fmt.Errorf("failed to start passkey registration")
+	}
+
+	challenge, err := uc.ceremonies.begin(ctx, &passkeyCeremony{Register: true, UserID: u.ID()})
+	if err != nil {
+		uc.logger.Error("Failed to start passkey registration", "userId", u.ID(), "error", err)
+		return nil, fmt.Errorf("failed to start passkey registration")
+	}
+
+	rpID, rpName := uc.verifier.RelyingParty()
+	userHandle := u.ID()
+
+	displayName := strings.TrimSpace(u.FullName())
+	if displayName == "" {
+		displayName = u.Username()
+	}
+
+	return &PasskeyCreationOptions{
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		RP:        PasskeyRelyingParty{ID: rpID, Name: rpName},
+		User: PasskeyUserEntity{
+			ID:          base64.RawURLEncoding.EncodeToString(userHandle[:]),
+			Name:        u.Email(),
+			DisplayName: displayName,
+		},
+		PubKeyCredParams: []PasskeyCredentialParam{
+			{Type: "public-key", Alg: -7},   // ES256
+			{Type: "public-key", Alg: -8},   // EdDSA
+			{Type: "public-key", Alg: -257}, // RS256
+		},
+		Timeout:            passkeyTimeoutMillis,
+		ExcludeCredentials: passkeyDescriptors(existing),
+		AuthenticatorSelection: PasskeyAuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: "required",
+		},
+		Attestation: "none",
+	}, nil
+}
+
+// ============================================================================
+// FINISH PASSKEY REGISTRATION USE CASE
+// ============================================================================
+
+type FinishPasskeyRegistrationUseCase struct {
+	passkeyRepo user.PasskeyRepository
+	verifier    common.WebAuthnVerifier
+	ceremonies  passkeyCeremonies
+	logger      common.Logger
+}
+
+type FinishPasskeyRegistrationInput struct {
+	UserID     uuid.UUID                   `json:"-"`
+	Name       string                      `json:"name"`
+	Credential common.WebAuthnRegistration `json:"credential"`
+}
+
+func NewFinishPasskeyRegistrationUseCase(
+	passkeyRepo user.PasskeyRepository,
+	verifier common.WebAuthnVerifier,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *FinishPasskeyRegistrationUseCase {
+	return &FinishPasskeyRegistrationUseCase{
+		passkeyRepo: passkeyRepo,
+		verifier:    verifier,
+		ceremonies:  passkeyCeremonies{cache: cacheService},
+		logger:      logger,
+	}
+}
+
+func (uc *FinishPasskeyRegistrationUseCase) Execute(ctx context.Context, input FinishPasskeyRegistrationInput) (*PasskeyDTO, error) {
+	challenge, err := uc.verifier.ClientDataChallenge(input.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := uc.ceremonies.finish(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	if !ceremony.Register || ceremony.UserID != input.UserID {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	cred, err := uc.verifier.VerifyRegistration(input.Credential, challenge)
+	if err != nil {
+		uc.logger.Warn("Passkey registration rejected", "userId", input.UserID, "error", err)
+		return nil, err
+	}
+
+	name := input.Name
+	if strings.TrimSpace(name) == "" {
+		name = "Passkey"
+	}
+
+	passkey, err := user.NewPasskey(input.UserID, name, cred.CredentialID, cred.PublicKey, cred.SignCount, cred.AAGUID, cred.Transports)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.passkeyRepo.Create(ctx, passkey); err != nil {
+		if errors.Is(err, user.ErrPasskeyExists) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to store passkey", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to register passkey")
+	}
+
+	uc.logger.Info("Passkey registered", "userId", input.UserID, "passkeyId", passkey.ID())
+	return mapPasskeyToDTO(passkey), nil
+}
+
+// ============================================================================
+// LIST / RENAME / DELETE PASSKEY USE CASES
+// ============================================================================
+
+type ListPasskeysUseCase struct {
+	passkeyRepo user.PasskeyRepository
+	logger      common.Logger
+}
+
+type ListPasskeysInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+func NewListPasskeysUseCase(passkeyRepo user.PasskeyRepository, logger common.Logger) *ListPasskeysUseCase {
+	return &ListPasskeysUseCase{
+		passkeyRepo: passkeyRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ListPasskeysUseCase) Execute(ctx context.Context, input ListPasskeysInput) ([]*PasskeyDTO, error) {
+	passkeys, err := uc.passkeyRepo.ListByUser(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to list passkeys", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to list passkeys")
+	}
+
+	dtos := make([]*PasskeyDTO, 0, len(passkeys))
+	for _, p := range passkeys {
+		dtos = append(dtos, mapPasskeyToDTO(p))
+	}
+	return dtos, nil
+}
+
+type RenamePasskeyUseCase struct {
+	passkeyRepo user.PasskeyRepository
+	logger      common.Logger
+}
+
+type RenamePasskeyInput struct {
+	UserID    uuid.UUID `json:"-"`
+	PasskeyID uuid.UUID `json:"-"`
+	Name      string    `json:"name"`
+}
+
+func NewRenamePasskeyUseCase(passkeyRepo user.PasskeyRepository, logger common.Logger) *RenamePasskeyUseCase {
+	return &RenamePasskeyUseCase{
+		passkeyRepo: passkeyRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *RenamePasskeyUseCase) Execute(ctx context.Context, input RenamePasskeyInput) (*PasskeyDTO, error) {
+	passkey, err := uc.passkeyRepo.FindByID(ctx, input.UserID, input.PasskeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := passkey.Rename(input.Name); err != nil {
+		return nil, err
+	}
+
+	if err := uc.passkeyRepo.Update(ctx, passkey); err != nil {
+		uc.logger.Error("Failed to rename passkey", "passkeyId", input.PasskeyID, "error", err)
+		return nil, fmt.Errorf("failed to rename passkey")
+	}
+	return mapPasskeyToDTO(passkey), nil
+}
+
+type DeletePasskeyUseCase struct {
+	passkeyRepo user.PasskeyRepository
+	logger      common.Logger
+}
+
+type DeletePasskeyInput struct {
+	UserID    uuid.UUID `json:"-"`
+	PasskeyID uuid.UUID `json:"-"`
+}
+
+func NewDeletePasskeyUseCase(passkeyRepo user.PasskeyRepository, logger common.Logger) *DeletePasskeyUseCase {
+	return &DeletePasskeyUseCase{
+		passkeyRepo: passkeyRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *DeletePasskeyUseCase) Execute(ctx context.Context, input DeletePasskeyInput) error {
+	if err := uc.passkeyRepo.Delete(ctx, input.UserID, input.PasskeyID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Passkey removed", "userId", input.UserID, "passkeyId", input.PasskeyID)
+	return nil
+}
+
+// ============================================================================
+// PASSKEY LOGIN USE CASES
+// ============================================================================
+
+// A passkey login either stands alone (discoverable credential, no
+// password) or answers the MFA challenge issued by LoginUseCase.
+
+type BeginPasskeyLoginUseCase struct {
+	passkeyRepo user.PasskeyRepository
+	verifier    common.WebAuthnVerifier
+	challenges  mfaChallenges
+	ceremonies  passkeyCeremonies
+	logger      common.Logger
+}
+
+type BeginPasskeyLoginInput struct {
+	ChallengeToken string `json:"challengeToken,omitempty"`
+}
+
+func NewBeginPasskeyLoginUseCase(
+	passkeyRepo user.PasskeyRepository,
+	verifier common.WebAuthnVerifier,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *BeginPasskeyLoginUseCase {
+	return &BeginPasskeyLoginUseCase{
+		passkeyRepo: passkeyRepo,
+		verifier:    verifier,
+		challenges:  mfaChallenges{cache: cacheService},
+		ceremonies:  passkeyCeremonies{cache: cacheService},
+		logger:      logger,
+	}
+}
+
+func (uc *BeginPasskeyLoginUseCase) Execute(ctx context.Context, input BeginPasskeyLoginInput) (*PasskeyRequestOptions, error) {
+	ceremony := &passkeyCeremony{}
+	allowed := []PasskeyCredentialDescriptor{}
+
+	if input.ChallengeToken != "" {
+		ch, err := uc.challenges.load(ctx, input.ChallengeToken)
+		if err != nil || ch.Enroll {
+			return nil, fmt.Errorf("invalid or expired challenge")
+		}
+
+		passkeys, err := uc.passkeyRepo.ListByUser(ctx, ch.UserID)
+		if err != nil {
+			uc.logger.Error("Failed to list passkeys", "userId", ch.UserID, "error", err)
+			return nil, fmt.Errorf("failed to start passkey login")
+		}
+		if len(passkeys) == 0 {
+			return nil, user.ErrPasskeyNotFound
+		}
+
+		ceremony.UserID = ch.UserID
+		ceremony.ChallengeToken = input.ChallengeToken
+		allowed = passkeyDescriptors(passkeys)
+	}
+
+	challenge, err := uc.ceremonies.begin(ctx, ceremony)
+	if err != nil {
+		uc.logger.Error("Failed to start passkey login", "error", err)
+		return nil, fmt.Errorf("failed to start passkey login")
+	}
+
+	rpID, _ := uc.verifier.RelyingParty()
+	return &PasskeyRequestOptions{
+		Challenge:        base64.RawURLEncoding.EncodeToString(challenge),
+		RPID:             rpID,
+		Timeout:          passkeyTimeoutMillis,
+		AllowCredentials: allowed,
+		UserVerification: "required",
+	}, nil
+}
+
+type FinishPasskeyLoginUseCase struct {
+	userRepo    user.Repository
+	passkeyRepo user.PasskeyRepository
+	teamRepo    team.Repository
+	ssoRepo     team.SSORepository
+	verifier    common.WebAuthnVerifier
+	sessions    *SessionManager
+	challenges  mfaChallenges
+	ceremonies  passkeyCeremonies
+	logger      common.Logger
+}
+
+type FinishPasskeyLoginInput struct {
+	Credential common.WebAuthnAssertion `json:"credential"`
+	Client     ClientInfo               `json:"-"`
+}
+
+func NewFinishPasskeyLoginUseCase(
+	userRepo user.Repository,
+	passkeyRepo user.PasskeyRepository,
+	teamRepo team.Repository,
+	ssoRepo team.SSORepository,
+	verifier common.WebAuthnVerifier,
+	sessions *SessionManager,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *FinishPasskeyLoginUseCase {
+	return &FinishPasskeyLoginUseCase{
+		userRepo:    userRepo,
+		passkeyRepo: passkeyRepo,
+		teamRepo:    teamRepo,
+		ssoRepo:     ssoRepo,
+		verifier:    verifier,
+		sessions:    sessions,
+		challenges:  mfaChallenges{cache: cacheService},
+		ceremonies:  passkeyCeremonies{cache: cacheService},
+		logger:      logger,
+	}
+}
+
+func (uc *FinishPasskeyLoginUseCase) Execute(ctx context.Context, input FinishPasskeyLoginInput) (*LoginOutput, error) {
+	challenge, err := uc.verifier.ClientDataChallenge(input.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := uc.ceremonies.finish(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.Register {
+		return nil, fmt.Errorf("invalid or expired challenge")
+	}
+
+	credentialID, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input.Credential.RawID, "="))
+	if err != nil || len(credentialID) == 0 {
+		return nil, fmt.Errorf("invalid credential id")
+	}
+
+	passkey, err := uc.passkeyRepo.FindByCredentialID(ctx, credentialID)
+	if err != nil {
+		if errors.Is(err, user.ErrPasskeyNotFound) {
+			return nil, common.ErrInvalidCredentials
+		}
+		uc.logger.Error("Failed to load passkey", "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	// A second-factor ceremony is bound to the user who entered the
+	// password, and only while their challenge is still open
+	if ceremony.ChallengeToken != "" {
+		if _, err := uc.challenges.load(ctx, ceremony.ChallengeToken); err != nil {
+			return nil, fmt.Errorf("invalid or expired challenge")
+		}
+		if ceremony.UserID != passkey.UserID() {
+			return nil, common.ErrInvalidCredentials
+		}
+	}
+
+	result, err := uc.verifier.VerifyAssertion(input.Credential, challenge, passkey.PublicKey())
+	if err != nil {
+		uc.logger.Warn("Passkey assertion rejected", "passkeyId", passkey.ID(), "error", err)
+		return nil, common.ErrInvalidCredentials
+	}
+
+	ownerHandle := passkey.UserID()
+	if len(result.UserHandle) > 0 && string(result.UserHandle) != string(ownerHandle[:]) {
+		uc.logger.Warn("Passkey user handle mismatch", "passkeyId", passkey.ID())
+		return nil, common.ErrInvalidCredentials
+	}
+
+	if err := passkey.RecordUse(result.SignCount, time.Now()); err != nil {
+		uc.logger.Warn("Possible cloned passkey", "userId", passkey.UserID(), "passkeyId", passkey.ID(),
+			"storedCount", passkey.SignCount(), "presentedCount", result.SignCount)
+		return nil, err
+	}
+	if err := uc.passkeyRepo.Update(ctx, passkey); err != nil {
+		uc.logger.Error("Failed to update passkey", "passkeyId", passkey.ID(), "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	if ceremony.ChallengeToken != "" {
+		uc.challenges.consume(ctx, ceremony.ChallengeToken)
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, passkey.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if !u.CanAccessPlatform() {
+		return nil, fmt.Errorf("account %s", u.Status())
+	}
+
+	// A passkey does not stand in for a team's IdP
+	if err := requireSSO(ctx, uc.ssoRepo, uc.teamRepo, u); err != nil {
+		if !errors.Is(err, team.ErrSSORequired) {
+			uc.logger.Error("Failed to check SSO enforcement", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+		return nil, err
+	}
+
+	tokens, err := uc.sessions.Start(ctx, u, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		User:         mapUserToDTO(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    900, // 15 minutes
+	}, nil
+}
@@ -108,6 +108,7 @@ type ResetPasswordUseCase struct {
 	userRepo     user.Repository
 	queries      *db.Queries
 	userService  *user.Service
+	sessions     *SessionManager
 	emailService common.EmailService
 	logger       common.Logger
 }
@@ -126,6 +127,7 @@ func NewResetPasswordUseCase(
 	userRepo user.Repository,
 	queries *db.Queries,
 	userService *user.Service,
+	sessions *SessionManager,
 	emailService common.EmailService,
 	logger common.Logger,
 ) *ResetPasswordUseCase {
@@ -133,6 +135,7 @@ func NewResetPasswordUseCase(
 		userRepo:     userRepo,
 		queries:      queries,
 		userService:  userService,
+		sessions:     sessions,
 		emailService: emailService,
 		logger:       logger,
 	}
@@ -184,8 +187,8 @@ func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input ResetPassword
 		uc.logger.Warn("Failed to clear reset token", "error", err)
 	}
 
-	// 7. Revoke all refresh tokens for security
-	if err := uc.queries.RevokeAllUserTokens(ctx, usr.ID()); err != nil {
+	// 7. Revoke all sessions for security
+	if err := uc.sessions.EndAll(ctx, usr.ID(), user.RevokedPasswordChanged); err != nil {
 		uc.logger.Warn("Failed to revoke refresh tokens", "error", err)
 	}
 
@@ -210,6 +213,7 @@ func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input ResetPassword
 type ChangePasswordUseCase struct {
 	userRepo    user.Repository
 	userService *user.Service
+	sessions    *SessionManager
 	logger      common.Logger
 }
 
@@ -227,11 +231,13 @@ type ChangePasswordOutput struct {
 func NewChangePasswordUseCase(
 	userRepo user.Repository,
 	userService *user.Service,
+	sessions *SessionManager,
 	logger common.Logger,
 ) *ChangePasswordUseCase {
 	return &ChangePasswordUseCase{
 		userRepo:    userRepo,
 		userService: userService,
+		sessions:    sessions,
 		logger:      logger,
 	}
 }
@@ -276,6 +282,11 @@ func (uc *ChangePasswordUseCase) Execute(ctx context.Context, input ChangePasswo
 		return nil, fmt.Errorf("failed to update password")
 	}
 
+	// Revoke every session so other devices must sign in again
+	if err := uc.sessions.EndAll(ctx, usr.ID(), user.RevokedPasswordChanged); err != nil {
+		uc.logger.Warn("Failed to revoke sessions", "userId", input.UserID, "error", err)
+	}
+
 	uc.logger.Info("Password changed successfully", "userId", input.UserID)
 
 	return &ChangePasswordOutput{
@@ -283,3 +294,107 @@ func (uc *ChangePasswordUseCase) Execute(ctx context.Context, input ChangePasswo
 		Message: "Password changed successfully",
 	}, nil
 }
+
+// ============================================================================
+// SET / REMOVE PASSWORD USE CASES (users signing in with a provider)
+// ============================================================================
+
+type SetPasswordUseCase struct {
+	userRepo user.Repository
+	logger   common.Logger
+}
+
+type SetPasswordInput struct {
+	UserID      uuid.UUID `json:"-"`
+	NewPassword string    `json:"newPassword" validate:"required,min=8"`
+}
+
+func NewSetPasswordUseCase(userRepo user.Repository, logger common.Logger) *SetPasswordUseCase {
+	return &SetPasswordUseCase{
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// Execute adds a password to an account created through an identity
+// provider. Accounts that already have one use ChangePasswordUseCase.
+func (uc *SetPasswordUseCase) Execute(ctx context.Context, input SetPasswordInput) (*ChangePasswordOutput, error) {
+	usr, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if err := usr.SetPassword(input.NewPassword); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, usr.ID(), usr.PasswordHash()); err != nil {
+		uc.logger.Error("Failed to set password", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to set password")
+	}
+
+	uc.logger.Info("Password set", "userId", input.UserID)
+
+	return &ChangePasswordOutput{
+		Success: true,
+		Message: "Password set successfully",
+	}, nil
+}
+
+type RemovePasswordUseCase struct {
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	logger       common.Logger
+}
+
+type RemovePasswordInput struct {
+	UserID          uuid.UUID `json:"-"`
+	CurrentPassword string    `json:"currentPassword" validate:"required"`
+}
+
+func NewRemovePasswordUseCase(
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *RemovePasswordUseCase {
+	return &RemovePasswordUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		logger:       logger,
+	}
+}
+
+// Execute leaves the account with provider sign-in only. At least one
+// linked identity must remain.
+func (uc *RemovePasswordUseCase) Execute(ctx context.Context, input RemovePasswordInput) (*ChangePasswordOutput, error) {
+	usr, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	identities, err := uc.identityRepo.ListByUser(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error("Failed to list identities", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to remove password")
+	}
+	if len(identities) == 0 {
+		return nil, user.ErrLastSignInMethod
+	}
+
+	if err := usr.RemovePassword(input.CurrentPassword); err != nil {
+		uc.logger.Warn("Invalid current password", "userId", input.UserID)
+		return nil, fmt.Errorf("current password is incorrect")
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, usr.ID(), ""); err != nil {
+		uc.logger.Error("Failed to remove password", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to remove password")
+	}
+
+	uc.logger.Info("Password removed", "userId", input.UserID)
+
+	return &ChangePasswordOutput{
+		Success: true,
+		Message: "Password removed",
+	}, nil
+}
@@ -0,0 +1,370 @@
+// path: backend/internal/application/auth/saml.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const (
+	samlRequestTTL       = 10 * time.Minute
+	samlRequestKeyPrefix = "saml:request:"
+)
+
+// samlIdentityProvider returns the IdP half of a team's configuration
+func samlIdentityProvider(c *team.SSOConfig) *common.SAMLIdentityProvider {
+	return &common.SAMLIdentityProvider{
+		EntityID:     c.IdPEntityID(),
+		SSOURL:       c.IdPSSOURL(),
+		Certificates: c.IdPCertificates(),
+	}
+}
+
+// samlProvider is the identity provider name SAML accounts are linked
+// under; NameIDs are only unique within one team's IdP
+func samlProvider(teamID uuid.UUID) string {
+	return "saml:" + teamID.String()
+}
+
+// ============================================================================
+// BEGIN SAML LOGIN USE CASE
+// ============================================================================
+
+type BeginSAMLLoginUseCase struct {
+	ssoRepo  team.SSORepository
+	teamRepo team.Repository
+	samlSP   common.SAMLServiceProvider
+	cache    common.CacheService
+	logger   common.Logger
+}
+
+// BeginSAMLLoginInput finds the team by the user's email domain or by the
+// team slug
+type BeginSAMLLoginInput struct {
+	Email    string `json:"email"`
+	TeamSlug string `json:"team"`
+}
+
+type BeginSAMLLoginOutput struct {
+	RedirectURL string `json:"redirectUrl"`
+}
+
+func NewBeginSAMLLoginUseCase(
+	ssoRepo team.SSORepository,
+	teamRepo team.Repository,
+	samlSP common.SAMLServiceProvider,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *BeginSAMLLoginUseCase {
+	return &BeginSAMLLoginUseCase{
+		ssoRepo:  ssoRepo,
+		teamRepo: teamRepo,
+		samlSP:   samlSP,
+		cache:    cacheService,
+		logger:   logger,
+	}
+}
+
+func (uc *BeginSAMLLoginUseCase) Execute(ctx context.Context, input BeginSAMLLoginInput) (*BeginSAMLLoginOutput, error) {
+	config, err := uc.findConfig(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if !config.IsEnabled() {
+		return nil, team.ErrSSONotConfigured
+	}
+
+	teamID := config.TeamID().String()
+	redirectURL, requestID, err := uc.samlSP.AuthnRequestURL(samlIdentityProvider(config), teamID, "")
+	if err != nil {
+		uc.logger.Error("Failed to build SAML request", "teamId", teamID, "error", err)
+		return nil, fmt.Errorf("failed to start sign-in")
+	}
+
+	// The response must answer a request we sent, for the same team
+	if err := uc.cache.Set(ctx, samlRequestKeyPrefix+requestID, teamID, samlRequestTTL); err != nil {
+		uc.logger.Error("Failed to store SAML request", "error", err)
+		return nil, fmt.Errorf("failed to start sign-in")
+	}
+
+	return &BeginSAMLLoginOutput{RedirectURL: redirectURL}, nil
+}
+
+func (uc *BeginSAMLLoginUseCase) findConfig(ctx context.Context, input BeginSAMLLoginInput) (*team.SSOConfig, error) {
+	if input.Email != "" {
+		_, domain, ok := strings.Cut(strings.ToLower(strings.TrimSpace(input.Email)), "@")
+		if !ok || domain == "" {
+			return nil, user.ErrInvalidEmailFormat
+		}
+		return uc.ssoRepo.FindByDomain(ctx, domain)
+	}
+
+	if input.TeamSlug == "" {
+		return nil, fmt.Errorf("email or team is required")
+	}
+	t, err := uc.teamRepo.FindBySlug(ctx, input.TeamSlug)
+	if err != nil {
+		return nil, team.ErrSSONotConfigured
+	}
+	return uc.ssoRepo.FindByTeamID(ctx, t.ID())
+}
+
+// ============================================================================
+// FINISH SAML LOGIN USE CASE (assertion consumer service)
+// ============================================================================
+
+type FinishSAMLLoginUseCase struct {
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	ssoRepo      team.SSORepository
+	memberRepo   team.MemberRepository
+	samlSP       common.SAMLServiceProvider
+	sessions     *SessionManager
+	cache        common.CacheService
+	logger       common.Logger
+}
+
+type FinishSAMLLoginInput struct {
+	TeamID       uuid.UUID
+	SAMLResponse string
+	Client       ClientInfo
+}
+
+func NewFinishSAMLLoginUseCase(
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	ssoRepo team.SSORepository,
+	memberRepo team.MemberRepository,
+	samlSP common.SAMLServiceProvider,
+	sessions *SessionManager,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *FinishSAMLLoginUseCase {
+	return &FinishSAMLLoginUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		ssoRepo:      ssoRepo,
+		memberRepo:   memberRepo,
+		samlSP:       samlSP,
+		sessions:     sessions,
+		cache:        cacheService,
+		logger:       logger,
+	}
+}
+
+// Execute signs in the asserted user. The IdP has authenticated them, so
+// no second factor is asked for here.
+func (uc *FinishSAMLLoginUseCase) Execute(ctx context.Context, input FinishSAMLLoginInput) (*LoginOutput, error) {
+	// 1. Verify the response against the team's IdP
+	config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !config.IsEnabled() {
+		return nil, team.ErrSSONotConfigured
+	}
+
+	assertion, err := uc.samlSP.ParseResponse(samlIdentityProvider(config), input.TeamID.String(), input.SAMLResponse)
+	if err != nil {
+		uc.logger.Warn("SAML response rejected", "teamId", input.TeamID, "error", err)
+		return nil, common.ErrInvalidCredentials
+	}
+	if err := uc.consumeRequest(ctx, assertion.InResponseTo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	// 2. Map attributes
+	email := strings.ToLower(firstValue(assertion.Attributes[config.EmailAttribute()], assertion.NameID))
+	if !strings.Contains(email, "@") || !config.HandlesEmail(email) {
+		uc.logger.Warn("SAML email outside the team's domains", "teamId", input.TeamID, "email", email)
+		return nil, fmt.Errorf("your email address is not managed by this team's single sign-on")
+	}
+
+	// 3. Find or provision the user
+	u, err := uc.resolveUser(ctx, config, assertion, email)
+	if err != nil {
+		return nil, err
+	}
+	if !u.CanAccessPlatform() {
+		return nil, fmt.Errorf("account %s", u.Status())
+	}
+
+	// 4. Provision the membership
+	role := config.RoleFor(assertion.Attributes[config.RoleAttribute()])
+	if err := uc.ensureMembership(ctx, config, u.ID(), role); err != nil {
+		return nil, err
+	}
+
+	// 5. Start the session
+	if err := uc.userRepo.UpdateLastLogin(ctx, u.ID(), time.Now()); err != nil {
+		uc.logger.Warn("Failed to update last login", "userId", u.ID(), "error", err)
+	}
+	tokens, err := uc.sessions.Start(ctx, u, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("User signed in with SAML", "userId", u.ID(), "teamId", input.TeamID)
+
+	return &LoginOutput{
+		User:         mapUserToDTO(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    900, // 15 minutes
+	}, nil
+}
+
+// resolveUser returns the user linked to the NameID, or links one. Without
+// domain ownership checks an IdP is only trusted for accounts it created
+// and for existing members of its own team.
+func (uc *FinishSAMLLoginUseCase) resolveUser(ctx context.Context, config *team.SSOConfig, assertion *common.SAMLAssertion, email string) (*user.User, error) {
+	provider := samlProvider(config.TeamID())
+
+	identity, err := uc.identityRepo.FindBySubject(ctx, provider, assertion.NameID)
+	switch {
+	case err == nil:
+		u, err := uc.userRepo.FindByID(ctx, identity.UserID())
+		if err != nil {
+			return nil, fmt.Errorf("user not found")
+		}
+		identity.RecordLogin(email, time.Now())
+		if err := uc.identityRepo.Update(ctx, identity); err != nil {
+			uc.logger.Warn("Failed to update identity", "userId", u.ID(), "error", err)
+		}
+		return u, nil
+	case !errors.Is(err, user.ErrIdentityNotFound):
+		uc.logger.Error("Failed to look up identity", "provider", provider, "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	u, err := uc.userRepo.FindByEmail(ctx, email)
+	switch {
+	case err == nil:
+		isMember, err := uc.memberRepo.IsMember(ctx, config.TeamID(), u.ID())
+		if err != nil {
+			uc.logger.Error("Failed to check membership", "userId", u.ID(), "error", err)
+			return nil, fmt.Errorf("failed to sign in")
+		}
+		if !isMember {
+			return nil, user.ErrAccountLinkRequired
+		}
+	case errors.Is(err, user.ErrUserNotFound):
+		if u, err = uc.createUser(ctx, config, assertion, email); err != nil {
+			return nil, err
+		}
+	default:
+		uc.logger.Error("Failed to look up user by email", "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+
+	identity = user.NewIdentity(u.ID(), provider, assertion.NameID, email)
+	identity.RecordLogin(email, time.Now())
+	if err := uc.identityRepo.Create(ctx, identity); err != nil {
+		if errors.Is(err, user.ErrIdentityAlreadyLinked) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to link identity", "userId", u.ID(), "provider", provider, "error", err)
+		return nil, fmt.Errorf("failed to sign in")
+	}
+	return u, nil
+}
+
+func (uc *FinishSAMLLoginUseCase) createUser(ctx context.Context, config *team.SSOConfig, assertion *common.SAMLAssertion, email string) (*user.User, error) {
+	username, err := user.NewService(uc.userRepo).AvailableUsername(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := user.NewExternalUser(
+		email, username,
+		firstValue(assertion.Attributes[config.FirstNameAttribute()], ""),
+		firstValue(assertion.Attributes[config.LastNameAttribute()], ""),
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.Create(ctx, u); err != nil {
+		uc.logger.Error("Failed to create user", "email", email, "error", err)
+		return nil, fmt.Errorf("failed to create account")
+	}
+
+	uc.logger.Info("User provisioned by SAML", "userId", u.ID(), "teamId", config.TeamID())
+	return u, nil
+}
+
+// ensureMembership adds the user to the team, or keeps an existing role in
+// sync when the IdP sends roles. Owners are left alone.
+func (uc *FinishSAMLLoginUseCase) ensureMembership(ctx context.Context, config *team.SSOConfig, userID uuid.UUID, role team.MemberRole) error {
+	member, err := uc.memberRepo.FindMember(ctx, config.TeamID(), userID)
+	if err != nil {
+		member, err = team.NewMember(config.TeamID(), userID, userID, role)
+		if err == nil {
+			err = member.AcceptInvitation()
+		}
+		if err == nil {
+			err = uc.memberRepo.AddMember(ctx, member)
+		}
+		if err != nil {
+			uc.logger.Error("Failed to provision membership", "userId", userID, "teamId", config.TeamID(), "error", err)
+			return fmt.Errorf("failed to join team")
+		}
+		return nil
+	}
+
+	changed := false
+	if member.IsPending() {
+		if err := member.AcceptInvitation(); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !member.IsActive() {
+		return fmt.Errorf("your team membership is inactive")
+	}
+	if config.RoleAttribute() != "" && member.Role() != team.MemberRoleOwner && member.Role() != role {
+		if err := member.ChangeRole(role, userID); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if changed {
+		if err := uc.memberRepo.UpdateMember(ctx, member); err != nil {
+			uc.logger.Error("Failed to update membership", "userId", userID, "teamId", config.TeamID(), "error", err)
+			return fmt.Errorf("failed to join team")
+		}
+	}
+	return nil
+}
+
+func (uc *FinishSAMLLoginUseCase) consumeRequest(ctx context.Context, requestID string, teamID uuid.UUID) error {
+	invalid := fmt.Errorf("invalid or expired sign-in request")
+	if requestID == "" {
+		return invalid // IdP-initiated sign-in is not supported
+	}
+
+	key := samlRequestKeyPrefix + requestID
+	value, err := uc.cache.Get(ctx, key)
+	if err != nil || value != teamID.String() {
+		return invalid
+	}
+	_ = uc.cache.Delete(ctx, key)
+	return nil
+}
+
+func firstValue(values []string, fallback string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return fallback
+}
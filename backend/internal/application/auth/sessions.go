@@ -0,0 +1,179 @@
+// path: backend/internal/application/auth/sessions.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// Matches the refresh token lifetime of the token service
+const refreshTokenTTL = 30 * 24 * time.Hour
+
+// ClientInfo identifies the device a session is used from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// SessionTokens is the token pair handed to a client
+type SessionTokens struct {
+	AccessToken  string
+	RefreshToken string
+	SessionID    uuid.UUID
+}
+
+// SessionManager issues and rotates refresh tokens. Every login starts a
+// token family (session); each refresh rotates the token within its family,
+// and presenting a token that was already rotated revokes the whole family.
+type SessionManager struct {
+	sessionRepo  user.SessionRepository
+	userRepo     user.Repository
+	tokenService common.TokenService
+	recorder     *auditlog.Recorder
+	logger       common.Logger
+}
+
+// NewSessionManager creates a new session manager
+func NewSessionManager(
+	sessionRepo user.SessionRepository,
+	userRepo user.Repository,
+	tokenService common.TokenService,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *SessionManager {
+	return &SessionManager{
+		sessionRepo:  sessionRepo,
+		userRepo:     userRepo,
+		tokenService: tokenService,
+		recorder:     recorder,
+		logger:       logger,
+	}
+}
+
+// Start opens a new session for an authenticated user
+func (m *SessionManager) Start(ctx context.Context, u *user.User, client ClientInfo) (*SessionTokens, error) {
+	accessToken, err := m.tokenService.GenerateAccessToken(u.ID().String(), u.Email(), string(u.Role()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token")
+	}
+
+	refreshToken, err := m.tokenService.GenerateRefreshToken(u.ID().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token")
+	}
+
+	token := user.NewRefreshToken(u.ID(), refreshToken, client.UserAgent, client.IPAddress, time.Now().Add(refreshTokenTTL))
+	if err := m.sessionRepo.Create(ctx, token); err != nil {
+		m.logger.Error("Failed to store refresh token", "userId", u.ID(), "error", err)
+		return nil, fmt.Errorf("failed to start session")
+	}
+
+	// Every sign-in method ends here
+	m.recorder.Record(ctx, audit.NewEntry(ctx, uuid.Nil, u.ID(), audit.ActionLogin, audit.TargetUser, u.ID().String()).
+		WithClient(client.IPAddress, client.UserAgent).
+		WithMetadata("sessionId", token.FamilyID().String()))
+
+	return &SessionTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		SessionID:    token.FamilyID(),
+	}, nil
+}
+
+// Rotate exchanges a refresh token for a new token pair in the same session
+func (m *SessionManager) Rotate(ctx context.Context, refreshToken string, client ClientInfo) (*SessionTokens, error) {
+	if _, err := m.tokenService.ValidateRefreshToken(refreshToken); err != nil {
+		return nil, user.ErrInvalidToken
+	}
+
+	current, err := m.sessionRepo.FindByHash(ctx, user.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, user.ErrTokenNotFound) {
+			return nil, user.ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	if current.IsRevoked() {
+		if current.WasRotated() {
+			// An old token came back: it was stolen or replayed
+			m.revokeReusedFamily(ctx, current)
+			return nil, user.ErrRefreshTokenReused
+		}
+		return nil, user.ErrInvalidToken
+	}
+	if current.IsExpired() {
+		return nil, user.ErrTokenExpired
+	}
+
+	u, err := m.userRepo.FindByID(ctx, current.UserID())
+	if err != nil {
+		return nil, user.ErrUserNotFound
+	}
+	if !u.CanAccessPlatform() {
+		return nil, user.ErrUnauthorized
+	}
+
+	accessToken, err := m.tokenService.GenerateAccessToken(u.ID().String(), u.Email(), string(u.Role()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token")
+	}
+
+	newRefreshToken, err := m.tokenService.GenerateRefreshToken(u.ID().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token")
+	}
+
+	next := current.Rotate(newRefreshToken, client.UserAgent, client.IPAddress, time.Now().Add(refreshTokenTTL))
+	if err := m.sessionRepo.Rotate(ctx, current, next); err != nil {
+		if errors.Is(err, user.ErrRefreshTokenReused) {
+			// Rotated concurrently by someone else holding the same token
+			m.revokeReusedFamily(ctx, current)
+			return nil, err
+		}
+		m.logger.Error("Failed to rotate refresh token", "userId", u.ID(), "error", err)
+		return nil, fmt.Errorf("failed to refresh session")
+	}
+
+	return &SessionTokens{
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+		SessionID:    next.FamilyID(),
+	}, nil
+}
+
+// End revokes the session a refresh token belongs to
+func (m *SessionManager) End(ctx context.Context, refreshToken string) error {
+	token, err := m.sessionRepo.FindByHash(ctx, user.HashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, user.ErrTokenNotFound) {
+			return nil // Nothing to revoke
+		}
+		return err
+	}
+
+	return m.sessionRepo.RevokeFamily(ctx, token.FamilyID(), user.RevokedLogout)
+}
+
+// EndAll revokes every session of a user
+func (m *SessionManager) EndAll(ctx context.Context, userID uuid.UUID, reason string) error {
+	return m.sessionRepo.RevokeAllForUser(ctx, userID, reason)
+}
+
+func (m *SessionManager) revokeReusedFamily(ctx context.Context, token *user.RefreshToken) {
+	m.logger.Warn("Refresh token reuse detected, revoking session",
+		"userId", token.UserID(),
+		"sessionId", token.FamilyID())
+
+	if err := m.sessionRepo.RevokeFamily(ctx, token.FamilyID(), user.RevokedReuseDetected); err != nil {
+		m.logger.Error("Failed to revoke session after token reuse", "sessionId", token.FamilyID(), "error", err)
+	}
+}
@@ -0,0 +1,396 @@
+// path: backend/internal/application/auth/two_factor.go
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// Setup and enable accept either a signed-in user or an enrollment challenge
+// from a login that a team's two-factor requirement blocked.
+
+// resolveEnrollingUser returns the user behind an enrollment request
+func resolveEnrollingUser(ctx context.Context, challenges mfaChallenges, userID uuid.UUID, challengeToken string) (uuid.UUID, error) {
+	if challengeToken == "" {
+		if userID == uuid.Nil {
+			return uuid.Nil, user.ErrUnauthorized
+		}
+		return userID, nil
+	}
+
+	ch, err := challenges.load(ctx, challengeToken)
+	if err != nil || !ch.Enroll {
+		return uuid.Nil, fmt.Errorf("invalid or expired challenge")
+	}
+	return ch.UserID, nil
+}
+
+// ============================================================================
+// TWO-FACTOR STATUS USE CASE
+// ============================================================================
+
+type GetTwoFactorStatusUseCase struct {
+	twoFactorRepo user.TwoFactorRepository
+	teamRepo      team.Repository
+	logger        common.Logger
+}
+
+type GetTwoFactorStatusInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+type TwoFactorStatusOutput struct {
+	Enabled           bool       `json:"enabled"`
+	EnabledAt         *time.Time `json:"enabledAt,omitempty"`
+	RecoveryCodesLeft int        `json:"recoveryCodesLeft"`
+	RequiredByTeam    bool       `json:"requiredByTeam"`
+}
+
+func NewGetTwoFactorStatusUseCase(
+	twoFactorRepo user.TwoFactorRepository,
+	teamRepo team.Repository,
+	logger common.Logger,
+) *GetTwoFactorStatusUseCase {
+	return &GetTwoFactorStatusUseCase{
+		twoFactorRepo: twoFactorRepo,
+		teamRepo:      teamRepo,
+		logger:        logger,
+	}
+}
+
+func (uc *GetTwoFactorStatusUseCase) Execute(ctx context.Context, input GetTwoFactorStatusInput) (*TwoFactorStatusOutput, error) {
+	output := &TwoFactorStatusOutput{}
+
+	tf, err := uc.twoFactorRepo.FindByUserID(ctx, input.UserID)
+	switch {
+	case err == nil && tf.IsEnabled():
+		output.Enabled = true
+		output.EnabledAt = tf.EnabledAt()
+		if output.RecoveryCodesLeft, err = uc.twoFactorRepo.CountRecoveryCodes(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	case err != nil && !errors.Is(err, user.ErrTwoFactorNotEnabled):
+		return nil, err
+	}
+
+	if output.RequiredByTeam, err = teamRequiresTwoFactor(ctx, uc.teamRepo, input.UserID); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ============================================================================
+// SETUP TWO-FACTOR USE CASE
+// ============================================================================
+
+type SetupTwoFactorUseCase struct {
+	userRepo      user.Repository
+	twoFactorRepo user.TwoFactorRepository
+	challenges    mfaChallenges
+	issuer        string
+	logger        common.Logger
+}
+
+type SetupTwoFactorInput struct {
+	UserID         uuid.UUID `json:"-"`
+	ChallengeToken string    `json:"challengeToken,omitempty"`
+}
+
+type SetupTwoFactorOutput struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauthUri"`
+}
+
+func NewSetupTwoFactorUseCase(
+	userRepo user.Repository,
+	twoFactorRepo user.TwoFactorRepository,
+	cacheService common.CacheService,
+	issuer string,
+	logger common.Logger,
+) *SetupTwoFactorUseCase {
+	return &SetupTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		challenges:    mfaChallenges{cache: cacheService},
+		issuer:        issuer,
+		logger:        logger,
+	}
+}
+
+// Execute creates a new secret. It replaces any enrollment that was not
+// confirmed yet.
+func (uc *SetupTwoFactorUseCase) Execute(ctx context.Context, input SetupTwoFactorInput) (*SetupTwoFactorOutput, error) {
+	userID, err := resolveEnrollingUser(ctx, uc.challenges, input.UserID, input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	existing, err := uc.twoFactorRepo.FindByUserID(ctx, userID)
+	if err == nil && existing.IsEnabled() {
+		return nil, user.ErrTwoFactorAlreadyEnabled
+	}
+	if err != nil && !errors.Is(err, user.ErrTwoFactorNotEnabled) {
+		return nil, err
+	}
+
+	tf, err := user.NewTwoFactor(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.Save(ctx, tf); err != nil {
+		uc.logger.Error("Failed to save two-factor enrollment", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to set up two-factor authentication")
+	}
+
+	return &SetupTwoFactorOutput{
+		Secret:     tf.Secret(),
+		OTPAuthURI: tf.ProvisioningURI(uc.issuer, u.Email()),
+	}, nil
+}
+
+// ============================================================================
+// ENABLE TWO-FACTOR USE CASE
+// ============================================================================
+
+type EnableTwoFactorUseCase struct {
+	userRepo      user.Repository
+	twoFactorRepo user.TwoFactorRepository
+	sessions      *SessionManager
+	challenges    mfaChallenges
+	logger        common.Logger
+}
+
+type EnableTwoFactorInput struct {
+	UserID         uuid.UUID  `json:"-"`
+	ChallengeToken string     `json:"challengeToken,omitempty"`
+	Code           string     `json:"code" validate:"required"`
+	Client         ClientInfo `json:"-"`
+}
+
+// EnableTwoFactorOutput returns the recovery codes, shown only once. An
+// enrollment that finishes a blocked login also carries the session.
+type EnableTwoFactorOutput struct {
+	RecoveryCodes []string     `json:"recoveryCodes"`
+	Login         *LoginOutput `json:"login,omitempty"`
+}
+
+func NewEnableTwoFactorUseCase(
+	userRepo user.Repository,
+	twoFactorRepo user.TwoFactorRepository,
+	sessions *SessionManager,
+	cacheService common.CacheService,
+	logger common.Logger,
+) *EnableTwoFactorUseCase {
+	return &EnableTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		sessions:      sessions,
+		challenges:    mfaChallenges{cache: cacheService},
+		logger:        logger,
+	}
+}
+
+func (uc *EnableTwoFactorUseCase) Execute(ctx context.Context, input EnableTwoFactorInput) (*EnableTwoFactorOutput, error) {
+	userID, err := resolveEnrollingUser(ctx, uc.challenges, input.UserID, input.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := uc.twoFactorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tf.Enable(input.Code, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.ConsumeStep(ctx, userID, tf.LastUsedStep()); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := user.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.twoFactorRepo.Save(ctx, tf); err != nil {
+		uc.logger.Error("Failed to enable two-factor authentication", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to enable two-factor authentication")
+	}
+	if err := uc.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		uc.logger.Error("Failed to store recovery codes", "userId", userID, "error", err)
+		return nil, fmt.Errorf("failed to enable two-factor authentication")
+	}
+
+	uc.logger.Info("Two-factor authentication enabled", "userId", userID)
+
+	output := &EnableTwoFactorOutput{RecoveryCodes: codes}
+	if input.ChallengeToken == "" {
+		return output, nil
+	}
+
+	// Finish the login that required enrollment
+	uc.challenges.consume(ctx, input.ChallengeToken)
+
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	tokens, err := uc.sessions.Start(ctx, u, input.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	output.Login = &LoginOutput{
+		User:         mapUserToDTO(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    900, // 15 minutes
+	}
+	return output, nil
+}
+
+// ============================================================================
+// DISABLE TWO-FACTOR USE CASE
+// ============================================================================
+
+type DisableTwoFactorUseCase struct {
+	userRepo      user.Repository
+	twoFactorRepo user.TwoFactorRepository
+	teamRepo      team.Repository
+	logger        common.Logger
+}
+
+type DisableTwoFactorInput struct {
+	UserID   uuid.UUID `json:"-"`
+	Password string    `json:"password" validate:"required"`
+	Code     string    `json:"code" validate:"required"`
+}
+
+type DisableTwoFactorOutput struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func NewDisableTwoFactorUseCase(
+	userRepo user.Repository,
+	twoFactorRepo user.TwoFactorRepository,
+	teamRepo team.Repository,
+	logger common.Logger,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		teamRepo:      teamRepo,
+		logger:        logger,
+	}
+}
+
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, input DisableTwoFactorInput) (*DisableTwoFactorOutput, error) {
+	u, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if !u.VerifyPassword(input.Password) {
+		return nil, user.ErrInvalidCredentials
+	}
+
+	tf, err := uc.twoFactorRepo.FindByUserID(ctx, input.UserID)
+	if err != nil || !tf.IsEnabled() {
+		return nil, user.ErrTwoFactorNotEnabled
+	}
+	if err := tf.Verify(input.Code, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.ConsumeStep(ctx, input.UserID, tf.LastUsedStep()); err != nil {
+		return nil, err
+	}
+
+	required, err := teamRequiresTwoFactor(ctx, uc.teamRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		return nil, user.ErrTwoFactorRequired
+	}
+
+	if err := uc.twoFactorRepo.Delete(ctx, input.UserID); err != nil {
+		uc.logger.Error("Failed to disable two-factor authentication", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to disable two-factor authentication")
+	}
+
+	uc.logger.Info("Two-factor authentication disabled", "userId", input.UserID)
+
+	return &DisableTwoFactorOutput{
+		Success: true,
+		Message: "Two-factor authentication disabled",
+	}, nil
+}
+
+// ============================================================================
+// REGENERATE RECOVERY CODES USE CASE
+// ============================================================================
+
+type RegenerateRecoveryCodesUseCase struct {
+	twoFactorRepo user.TwoFactorRepository
+	logger        common.Logger
+}
+
+type RegenerateRecoveryCodesInput struct {
+	UserID uuid.UUID `json:"-"`
+	Code   string    `json:"code" validate:"required"`
+}
+
+type RegenerateRecoveryCodesOutput struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+func NewRegenerateRecoveryCodesUseCase(
+	twoFactorRepo user.TwoFactorRepository,
+	logger common.Logger,
+) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		twoFactorRepo: twoFactorRepo,
+		logger:        logger,
+	}
+}
+
+// Execute replaces all recovery codes; the old ones stop working
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, input RegenerateRecoveryCodesInput) (*RegenerateRecoveryCodesOutput, error) {
+	tf, err := uc.twoFactorRepo.FindByUserID(ctx, input.UserID)
+	if err != nil || !tf.IsEnabled() {
+		return nil, user.ErrTwoFactorNotEnabled
+	}
+	if err := tf.Verify(input.Code, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactorRepo.ConsumeStep(ctx, input.UserID, tf.LastUsedStep()); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := user.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.twoFactorRepo.ReplaceRecoveryCodes(ctx, input.UserID, hashes); err != nil {
+		uc.logger.Error("Failed to store recovery codes", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to regenerate recovery codes")
+	}
+
+	uc.logger.Info("Recovery codes regenerated", "userId", input.UserID)
+
+	return &RegenerateRecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
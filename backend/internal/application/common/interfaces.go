@@ -3,7 +3,10 @@ package common
 
 import (
 	"context"
+	"encoding/json"
 	"time"
+
+	"github.com/techappsUT/social-queue/internal/domain/event"
 )
 
 // ============================================================================
@@ -16,7 +19,9 @@ type TokenService interface {
 	GenerateRefreshToken(userID string) (string, error)
 	ValidateAccessToken(token string) (*TokenClaims, error)
 	ValidateRefreshToken(token string) (*TokenClaims, error) // ✅ ADD THIS
-	RevokeRefreshToken(ctx context.Context, token string) error
+	// GenerateOAuthAccessToken issues an access token to an OAuth client,
+	// acting as the user in one team with the given scopes
+	GenerateOAuthAccessToken(userID, teamID, clientID string, scopes []string, ttl time.Duration) (string, error)
 }
 
 // TokenClaims represents JWT token claims
@@ -25,6 +30,8 @@ type TokenClaims struct {
 	Email     string    `json:"email"`
 	Role      string    `json:"role"`
 	TeamID    string    `json:"teamId,omitempty"`
+	ClientID  string    `json:"clientId,omitempty"` // set on OAuth access tokens
+	Scopes    []string  `json:"scopes,omitempty"`
 	ExpiresAt time.Time `json:"exp"`
 	IssuedAt  time.Time `json:"iat"`
 }
@@ -35,6 +42,19 @@ type EmailService interface {
 	SendPasswordResetEmail(ctx context.Context, email, token string) error
 	SendWelcomeEmail(ctx context.Context, email, firstName string) error
 	SendInvitationEmail(ctx context.Context, email, teamName, inviteToken string) error
+	SendAccountLockedEmail(ctx context.Context, email string, lockedUntil time.Time) error
+	// SendNotificationDigest emails a user the notifications waiting for
+	// their digest
+	SendNotificationDigest(ctx context.Context, email, firstName string, items []NotificationDigestItem) error
+}
+
+// NotificationDigestItem is one notification in an email digest. Link is a
+// path in the web app.
+type NotificationDigestItem struct {
+	Title string
+	Body  string
+	Link  string
+	At    time.Time
 }
 
 // CacheService handles caching operations
@@ -43,24 +63,31 @@ type CacheService interface {
 	Set(ctx context.Context, key string, value string, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	// Increment atomically adds one to a counter and (re)sets its TTL
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 }
 
-// EventBus handles domain events
+// EventBus handles domain events. Publish writes the event to the outbox,
+// inside the transaction carried by ctx when there is one; subscribers are
+// called later, at least once, with an *event.Envelope.
 type EventBus interface {
 	Publish(ctx context.Context, event Event) error
 	Subscribe(eventType string, handler EventHandler) error
 }
 
 // Event represents a domain event
-type Event interface {
-	Type() string
-	OccurredAt() time.Time
-	AggregateID() string
-}
+type Event = event.Event
 
 // EventHandler processes events
 type EventHandler func(ctx context.Context, event Event) error
 
+// Transactor runs fn in a database transaction carried by the context it
+// is given. Repositories called with that context join the transaction; a
+// nested call joins the outer transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // Logger handles structured logging
 type Logger interface {
 	Debug(msg string, fields ...interface{})
@@ -68,3 +95,300 @@ type Logger interface {
 	Warn(msg string, fields ...interface{})
 	Error(msg string, fields ...interface{})
 }
+
+// ============================================================================
+// BACKGROUND JOBS
+// ============================================================================
+
+// JobScheduler exposes worker job status and manual triggers to the API
+type JobScheduler interface {
+	ListJobs(ctx context.Context) ([]JobStatus, error)
+	TriggerJob(ctx context.Context, name string) error
+}
+
+// JobStatus describes a scheduled worker job as last reported by the worker
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Schedule     string     `json:"schedule"`
+	Singleton    bool       `json:"singleton"`
+	Running      bool       `json:"running"`
+	LastRunAt    *time.Time `json:"lastRunAt,omitempty"`
+	LastDuration string     `json:"lastDuration,omitempty"`
+	LastError    string     `json:"lastError,omitempty"`
+	NextRunAt    *time.Time `json:"nextRunAt,omitempty"`
+}
+
+// ============================================================================
+// PLATFORM RATE LIMITS
+// ============================================================================
+
+// Endpoint classes tracked by PlatformRateLimiter
+const (
+	RateClassPublish = "publish"
+	RateClassRead    = "read"
+)
+
+// PlatformRateLimiter tracks social platform API quotas per
+// (platform, account, endpoint class), shared by the API and worker
+type PlatformRateLimiter interface {
+	// Take consumes one request from the bucket if available
+	Take(ctx context.Context, platform, accountID, class string) (RateLimitDecision, error)
+	// Observe overrides the bucket with what the platform reported
+	Observe(ctx context.Context, platform, accountID, class string, remaining, limit int, resetAt time.Time) error
+	// ObserveUsage overrides the bucket from the share of its budget, 0-100,
+	// a platform reported as used
+	ObserveUsage(ctx context.Context, platform, accountID, class string, usedPercent int, resetAt time.Time) error
+	// Quota returns the current state of every endpoint class for an account
+	Quota(ctx context.Context, platform, accountID string) ([]RateLimitQuota, error)
+}
+
+// RateLimitDecision is the result of PlatformRateLimiter.Take
+type RateLimitDecision struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// RateLimitQuota describes one endpoint class bucket
+type RateLimitQuota struct {
+	Class     string     `json:"class"`
+	Limit     int        `json:"limit"`
+	Remaining int        `json:"remaining"`
+	ResetAt   *time.Time `json:"resetAt,omitempty"`
+}
+
+// ============================================================================
+// PLATFORM CIRCUIT BREAKERS
+// ============================================================================
+
+// Circuit states reported by CircuitBreaker
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half_open"
+)
+
+// CircuitBreaker tracks platform outages per (platform, endpoint class),
+// shared by the API and worker
+type CircuitBreaker interface {
+	// Allow reports whether a call may be made and, if not, when to retry
+	Allow(ctx context.Context, platform, endpoint string) (bool, time.Duration, error)
+	// Record reports the outcome of an allowed call
+	Record(ctx context.Context, platform, endpoint string, failed bool) error
+	// States returns every circuit that is not closed
+	States(ctx context.Context) ([]CircuitState, error)
+}
+
+// CircuitState describes one open or half-open circuit
+type CircuitState struct {
+	Platform string     `json:"platform"`
+	Endpoint string     `json:"endpoint"`
+	State    string     `json:"state"`
+	Failures int        `json:"failures"`
+	RetryAt  *time.Time `json:"retryAt,omitempty"`
+}
+
+// ============================================================================
+// WEBAUTHN
+// ============================================================================
+
+// WebAuthnVerifier checks passkey ceremonies for this relying party
+type WebAuthnVerifier interface {
+	// RelyingParty returns the RP ID (domain) and display name
+	RelyingParty() (id, name string)
+	// VerifyRegistration checks a navigator.credentials.create() response
+	VerifyRegistration(resp WebAuthnRegistration, challenge []byte) (*WebAuthnCredential, error)
+	// VerifyAssertion checks a navigator.credentials.get() response against
+	// a stored COSE public key
+	VerifyAssertion(resp WebAuthnAssertion, challenge, publicKey []byte) (*WebAuthnAssertionResult, error)
+	// ClientDataChallenge returns the challenge a response was made for
+	ClientDataChallenge(clientDataJSON string) ([]byte, error)
+}
+
+// WebAuthnRegistration is the JSON form of a registration PublicKeyCredential
+type WebAuthnRegistration struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports,omitempty"`
+	} `json:"response"`
+}
+
+// WebAuthnAssertion is the JSON form of an authentication PublicKeyCredential
+type WebAuthnAssertion struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle,omitempty"`
+	} `json:"response"`
+}
+
+// WebAuthnCredential is a verified new credential
+type WebAuthnCredential struct {
+	CredentialID []byte
+	PublicKey    []byte // COSE_Key
+	SignCount    uint32
+	AAGUID       []byte
+	Transports   []string
+	UserVerified bool
+}
+
+// WebAuthnAssertionResult is a verified authentication
+type WebAuthnAssertionResult struct {
+	CredentialID []byte
+	SignCount    uint32
+	UserVerified bool
+	UserHandle   []byte
+}
+
+// ============================================================================
+// IDENTITY PROVIDERS
+// ============================================================================
+
+// IdentityProvider signs users in through an external OAuth 2.0 / OpenID
+// Connect provider using the authorization code flow with PKCE
+type IdentityProvider interface {
+	// Name is the provider key used in URLs, e.g. "google"
+	Name() string
+	// AuthCodeURL returns the provider URL to send the browser to.
+	// codeChallenge is the S256 PKCE challenge.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems an authorization code and returns the verified
+	// identity of the signed-in account
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*ExternalIdentity, error)
+}
+
+// ExternalIdentity is an account as asserted by an identity provider
+type ExternalIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	FirstName     string
+	LastName      string
+	AvatarURL     string
+}
+
+// SAMLServiceProvider is this application acting as a SAML 2.0 service
+// provider. Each team has its own entity ID and assertion consumer service
+// URL, keyed by the team ID.
+type SAMLServiceProvider interface {
+	// EntityID and ACSURL are the team's SP identifiers to give the IdP
+	EntityID(teamID string) string
+	ACSURL(teamID string) string
+	// Metadata returns the SP metadata document to upload to the IdP
+	Metadata(teamID string) ([]byte, error)
+	// ParseIdPMetadata reads the entity ID, HTTP-Redirect sign-on URL and
+	// signing certificates from an IdP metadata document
+	ParseIdPMetadata(metadata []byte) (*SAMLIdentityProvider, error)
+	// NormalizeCertificate accepts a PEM or base64 DER certificate and
+	// returns it as base64 DER
+	NormalizeCertificate(certificate string) (string, error)
+	// AuthnRequestURL returns the IdP URL to send the browser to and the
+	// request ID the response must answer
+	AuthnRequestURL(idp *SAMLIdentityProvider, teamID, relayState string) (redirectURL, requestID string, err error)
+	// ParseResponse verifies a base64 SAMLResponse posted to the ACS URL
+	ParseResponse(idp *SAMLIdentityProvider, teamID, samlResponse string) (*SAMLAssertion, error)
+}
+
+// SAMLIdentityProvider is the IdP side of a team's SAML configuration
+type SAMLIdentityProvider struct {
+	EntityID     string
+	SSOURL       string
+	Certificates []string // base64 DER
+}
+
+// SAMLAssertion is the verified content of an IdP response
+type SAMLAssertion struct {
+	NameID       string
+	InResponseTo string
+	SessionIndex string
+	Attributes   map[string][]string
+}
+
+// ============================================================================
+// LOGIN THROTTLING
+// ============================================================================
+
+// LoginThrottle counts failed sign-ins per account and per client IP, and
+// decides when the next attempt may be made
+type LoginThrottle interface {
+	// Check reports whether a sign-in may be attempted now. An empty ip
+	// checks the account alone.
+	Check(ctx context.Context, account, ip string) (LoginThrottleStatus, error)
+	// RecordFailure counts a failed sign-in and returns the new status
+	RecordFailure(ctx context.Context, account, ip string) (LoginThrottleStatus, error)
+	// Reset clears an account's failures and lockout
+	Reset(ctx context.Context, account string) error
+}
+
+// LoginThrottleStatus is the throttling state for a sign-in attempt
+type LoginThrottleStatus struct {
+	Failures        int        `json:"failures"`
+	LockedUntil     *time.Time `json:"lockedUntil,omitempty"`
+	CaptchaRequired bool       `json:"captchaRequired"`
+
+	// RetryAfter is how long until the next attempt is allowed, whether
+	// because of a lockout, a progressive delay or a blocked IP
+	RetryAfter time.Duration `json:"-"`
+	// NewlyLocked is set by the RecordFailure call that locked the account
+	NewlyLocked bool `json:"-"`
+}
+
+// ============================================================================
+// OUTGOING WEBHOOKS
+// ============================================================================
+
+// WebhookSender posts webhook payloads to team endpoints
+type WebhookSender interface {
+	// Send posts body to url. An error means no response was received; any
+	// response, including non-2xx, is returned.
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (*WebhookResponse, error)
+}
+
+// WebhookResponse is what an endpoint answered
+type WebhookResponse struct {
+	StatusCode int
+	Body       string // truncated
+	Duration   time.Duration
+}
+
+// ============================================================================
+// REAL-TIME UPDATES
+// ============================================================================
+
+// RealtimeEvent is an update streamed to a team's connected clients
+type RealtimeEvent struct {
+	// ID is the position in the team's stream, which clients send back as
+	// Last-Event-ID to resume
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	TeamID    string          `json:"teamId"`
+	Topic     string          `json:"topic"`
+	AccountID string          `json:"accountId,omitempty"` // the social account it concerns, if any
+	Data      json.RawMessage `json:"data"`
+	At        time.Time       `json:"at"`
+}
+
+// RealtimeFeed carries realtime events to every API replica. Each team
+// keeps a short history for clients resuming after a disconnect.
+type RealtimeFeed interface {
+	// Publish appends the event to the team's history, setting its ID, and
+	// sends it to the subscribers of every replica
+	Publish(ctx context.Context, e *RealtimeEvent) error
+	// Subscribe streams the team's events until ctx is canceled. Events
+	// after lastEventID are replayed first; if they are no longer kept,
+	// the stream starts with RealtimeResetEvent. The channel is closed when
+	// the subscriber falls too far behind.
+	Subscribe(ctx context.Context, teamID, lastEventID string) (<-chan *RealtimeEvent, error)
+}
+
+// RealtimeResetEvent tells a resuming client that events were missed and
+// that it should reload what it shows
+const RealtimeResetEvent = "stream.reset"
@@ -0,0 +1,395 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/conversations.go
+// PURPOSE: Reading, triaging and assigning inbox conversations
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+
+	// conversationMessages is how many of the latest messages are shown
+	conversationMessages = 200
+)
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListConversationsInput struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	AccountID *uuid.UUID
+	State     string // empty for everything but archived conversations
+	Kind      string
+	// AssignedTo filters by assignee; uuid.Nil lists unassigned ones
+	AssignedTo *uuid.UUID
+	Tag        string
+	Before     *time.Time
+	Limit      int
+}
+
+type ListConversationsOutput struct {
+	Conversations []ConversationDTO `json:"conversations"`
+	// NextBefore is the cursor for the next page, nil on the last page
+	NextBefore *time.Time `json:"nextBefore,omitempty"`
+}
+
+type ListConversationsUseCase struct {
+	inboxRepo inbox.Repository
+	guard     *guard
+}
+
+func NewListConversationsUseCase(
+	inboxRepo inbox.Repository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+) *ListConversationsUseCase {
+	return &ListConversationsUseCase{
+		inboxRepo: inboxRepo,
+		guard:     newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+	}
+}
+
+func (uc *ListConversationsUseCase) Execute(ctx context.Context, input ListConversationsInput) (*ListConversationsOutput, error) {
+	access, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxView)
+	if err != nil {
+		return nil, err
+	}
+
+	state := inbox.State(input.State)
+	if state != "" && !state.IsValid() {
+		return nil, inbox.ErrInvalidState
+	}
+	kind := inbox.Kind(input.Kind)
+	if kind != "" && kind != inbox.KindComment && kind != inbox.KindMention && kind != inbox.KindMessage {
+		return nil, fmt.Errorf("invalid conversation kind: %s", input.Kind)
+	}
+	tag := input.Tag
+	if tag != "" {
+		if tag, err = inbox.NormalizeTag(tag); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := input.Limit
+	switch {
+	case limit <= 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	filter := inbox.Filter{
+		TeamID:     input.TeamID,
+		State:      state,
+		Kind:       kind,
+		AssignedTo: input.AssignedTo,
+		Tag:        tag,
+		Before:     input.Before,
+		Limit:      limit + 1,
+	}
+	switch {
+	case input.AccountID != nil:
+		if !access.Allows(*input.AccountID, socialDomain.AccessAnalytics) {
+			return nil, socialDomain.ErrAccountAccessDenied
+		}
+		filter.AccountIDs = []uuid.UUID{*input.AccountID}
+	case access.IsRestricted():
+		filter.AccountIDs = make([]uuid.UUID, 0, len(access.Grants()))
+		for _, g := range access.Grants() {
+			filter.AccountIDs = append(filter.AccountIDs, g.AccountID)
+		}
+	}
+
+	conversations, err := uc.inboxRepo.ListConversations(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	output := &ListConversationsOutput{Conversations: make([]ConversationDTO, 0, len(conversations))}
+	if len(conversations) > limit {
+		conversations = conversations[:limit]
+		next := conversations[limit-1].LastMessageAt()
+		output.NextBefore = &next
+	}
+	for _, c := range conversations {
+		output.Conversations = append(output.Conversations, mapConversationToDTO(c))
+	}
+	return output, nil
+}
+
+// ============================================================================
+// GET
+// ============================================================================
+
+type GetConversationInput struct {
+	TeamID         uuid.UUID
+	UserID         uuid.UUID
+	ConversationID uuid.UUID
+}
+
+type ConversationDetailDTO struct {
+	ConversationDTO
+	Messages []MessageDTO `json:"messages"`
+}
+
+// GetConversationUseCase returns a conversation with its latest messages.
+// Opening an unread conversation marks it read.
+type GetConversationUseCase struct {
+	inboxRepo inbox.Repository
+	guard     *guard
+	logger    common.Logger
+}
+
+func NewGetConversationUseCase(
+	inboxRepo inbox.Repository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	logger common.Logger,
+) *GetConversationUseCase {
+	return &GetConversationUseCase{
+		inboxRepo: inboxRepo,
+		guard:     newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		logger:    logger,
+	}
+}
+
+func (uc *GetConversationUseCase) Execute(ctx context.Context, input GetConversationInput) (*ConversationDetailDTO, error) {
+	access, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxView)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.guard.conversation(ctx, uc.inboxRepo, access, input.TeamID, input.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := uc.inboxRepo.ListMessages(ctx, c.ID(), conversationMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load messages: %w", err)
+	}
+
+	if c.State() == inbox.StateUnread {
+		_ = c.SetState(inbox.StateRead)
+		if err := uc.inboxRepo.UpdateConversation(ctx, c); err != nil {
+			uc.logger.Warn("Failed to mark conversation read", "conversationId", c.ID(), "error", err)
+		}
+	}
+
+	detail := &ConversationDetailDTO{
+		ConversationDTO: mapConversationToDTO(c),
+		Messages:        make([]MessageDTO, 0, len(messages)),
+	}
+	for _, m := range messages {
+		detail.Messages = append(detail.Messages, mapMessageToDTO(m))
+	}
+	return detail, nil
+}
+
+// ============================================================================
+// UPDATE STATE
+// ============================================================================
+
+type UpdateConversationStateInput struct {
+	TeamID         uuid.UUID `json:"-"`
+	UserID         uuid.UUID `json:"-"`
+	ConversationID uuid.UUID `json:"-"`
+	State          string    `json:"state"`
+}
+
+// UpdateConversationStateUseCase marks a conversation read or unread, which
+// anyone who can view the inbox may do, or archives it, which needs
+// inbox.manage. Moving a conversation out of the archive needs it too.
+type UpdateConversationStateUseCase struct {
+	inboxRepo  inbox.Repository
+	guard      *guard
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewUpdateConversationStateUseCase(
+	inboxRepo inbox.Repository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	logger common.Logger,
+) *UpdateConversationStateUseCase {
+	return &UpdateConversationStateUseCase{
+		inboxRepo:  inboxRepo,
+		guard:      newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+func (uc *UpdateConversationStateUseCase) Execute(ctx context.Context, input UpdateConversationStateInput) (*ConversationDTO, error) {
+	state := inbox.State(input.State)
+	if !state.IsValid() {
+		return nil, inbox.ErrInvalidState
+	}
+
+	access, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxView)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.guard.conversation(ctx, uc.inboxRepo, access, input.TeamID, input.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if c.State() == state {
+		dto := mapConversationToDTO(c)
+		return &dto, nil
+	}
+
+	if state == inbox.StateArchived || c.State() == inbox.StateArchived {
+		can, err := uc.authorizer.Can(ctx, input.TeamID, input.UserID, team.PermInboxManage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve team role: %w", err)
+		}
+		if !can {
+			return nil, fmt.Errorf("access denied: %s permission required", team.PermInboxManage)
+		}
+	}
+
+	if err := c.SetState(state); err != nil {
+		return nil, err
+	}
+	if err := uc.inboxRepo.UpdateConversation(ctx, c); err != nil {
+		uc.logger.Error("Failed to update conversation", "conversationId", c.ID(), "error", err)
+		return nil, fmt.Errorf("failed to update conversation")
+	}
+
+	dto := mapConversationToDTO(c)
+	return &dto, nil
+}
+
+// ============================================================================
+// ASSIGN
+// ============================================================================
+
+// AssignConversationInput hands a conversation to a member. A nil
+// AssigneeID unassigns it.
+type AssignConversationInput struct {
+	TeamID         uuid.UUID  `json:"-"`
+	UserID         uuid.UUID  `json:"-"`
+	ConversationID uuid.UUID  `json:"-"`
+	AssigneeID     *uuid.UUID `json:"assigneeId"`
+}
+
+type AssignConversationUseCase struct {
+	inboxRepo  inbox.Repository
+	guard      *guard
+	authorizer *team.Authorizer
+	tx         common.Transactor
+	events     common.EventBus
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewAssignConversationUseCase(
+	inboxRepo inbox.Repository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	tx common.Transactor,
+	events common.EventBus,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *AssignConversationUseCase {
+	return &AssignConversationUseCase{
+		inboxRepo:  inboxRepo,
+		guard:      newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		tx:         tx,
+		events:     events,
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *AssignConversationUseCase) Execute(ctx context.Context, input AssignConversationInput) (*ConversationDTO, error) {
+	access, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.guard.conversation(ctx, uc.inboxRepo, access, input.TeamID, input.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignee := uuid.Nil
+	if input.AssigneeID != nil {
+		assignee = *input.AssigneeID
+	}
+	if assignee != uuid.Nil {
+		can, err := uc.authorizer.Can(ctx, input.TeamID, assignee, team.PermInboxView)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve assignee role: %w", err)
+		}
+		if !can {
+			return nil, inbox.ErrInvalidAssignee
+		}
+	}
+
+	previous := c.AssignedTo()
+	if previous == assignee {
+		dto := mapConversationToDTO(c)
+		return &dto, nil
+	}
+	c.Assign(assignee)
+
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.inboxRepo.UpdateConversation(ctx, c); err != nil {
+			return err
+		}
+		if assignee == uuid.Nil {
+			return nil
+		}
+		return uc.events.Publish(ctx, inbox.NewConversationAssigned(c, input.UserID))
+	})
+	if err != nil {
+		uc.logger.Error("Failed to assign conversation", "conversationId", c.ID(), "error", err)
+		return nil, fmt.Errorf("failed to assign conversation")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionConversationAssigned, audit.TargetConversation, c.ID().String()).
+		WithChanges(audit.Diff(
+			map[string]interface{}{"assignedTo": assigneeField(previous)},
+			map[string]interface{}{"assignedTo": assigneeField(assignee)},
+		)))
+
+	uc.logger.Info("Conversation assigned", "conversationId", c.ID(), "assignee", assignee, "userId", input.UserID)
+	dto := mapConversationToDTO(c)
+	return &dto, nil
+}
+
+func assigneeField(id uuid.UUID) interface{} {
+	if id == uuid.Nil {
+		return nil
+	}
+	return id.String()
+}
@@ -0,0 +1,148 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/dto.go
+// PURPOSE: Inbox DTOs and the access checks shared by the inbox use cases
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type ConversationDTO struct {
+	ID              uuid.UUID  `json:"id"`
+	AccountID       uuid.UUID  `json:"accountId"`
+	Platform        string     `json:"platform"`
+	Kind            string     `json:"kind"`
+	ObjectID        string     `json:"objectId,omitempty"`
+	ParticipantID   string     `json:"participantId,omitempty"`
+	ParticipantName string     `json:"participantName,omitempty"`
+	State           string     `json:"state"`
+	AssignedTo      *uuid.UUID `json:"assignedTo,omitempty"`
+	Tags            []string   `json:"tags"`
+	LastMessageAt   time.Time  `json:"lastMessageAt"`
+	Preview         string     `json:"preview"`
+	MessageCount    int        `json:"messageCount"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+func mapConversationToDTO(c *inbox.Conversation) ConversationDTO {
+	dto := ConversationDTO{
+		ID:              c.ID(),
+		AccountID:       c.AccountID(),
+		Platform:        string(c.Platform()),
+		Kind:            string(c.Kind()),
+		ObjectID:        c.ObjectID(),
+		ParticipantID:   c.ParticipantID(),
+		ParticipantName: c.ParticipantName(),
+		State:           string(c.State()),
+		Tags:            c.Tags(),
+		LastMessageAt:   c.LastMessageAt(),
+		Preview:         c.Preview(),
+		MessageCount:    c.MessageCount(),
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+	if id := c.AssignedTo(); id != uuid.Nil {
+		dto.AssignedTo = &id
+	}
+	return dto
+}
+
+type MessageDTO struct {
+	ID                uuid.UUID  `json:"id"`
+	PlatformMessageID string     `json:"platformMessageId"`
+	Direction         string     `json:"direction"`
+	AuthorID          string     `json:"authorId,omitempty"`
+	AuthorName        string     `json:"authorName,omitempty"`
+	Text              string     `json:"text"`
+	SentBy            *uuid.UUID `json:"sentBy,omitempty"`
+	SentAt            time.Time  `json:"sentAt"`
+}
+
+func mapMessageToDTO(m *inbox.Message) MessageDTO {
+	dto := MessageDTO{
+		ID:                m.ID(),
+		PlatformMessageID: m.PlatformMessageID(),
+		Direction:         string(m.Direction()),
+		AuthorID:          m.AuthorID(),
+		AuthorName:        m.AuthorName(),
+		Text:              m.Text(),
+		SentAt:            m.SentAt(),
+	}
+	if id := m.SentBy(); id != uuid.Nil {
+		dto.SentBy = &id
+	}
+	return dto
+}
+
+// ============================================================================
+// ACCESS
+// ============================================================================
+
+// guard checks a member may use the inbox. Restricted members only see the
+// conversations of the accounts they were granted, and need publish access
+// to an account to reply as it.
+type guard struct {
+	teamRepo   team.Repository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+}
+
+func newGuard(teamRepo team.Repository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, accessRepo socialDomain.AccessRepository) *guard {
+	return &guard{
+		teamRepo:   teamRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+	}
+}
+
+// require checks the permission and the team's plan, and returns the
+// member's account access
+func (g *guard) require(ctx context.Context, teamID, userID uuid.UUID, permission team.Permission) (*socialDomain.AccountAccess, error) {
+	role, err := g.authorizer.Role(ctx, teamID, userID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+	if !role.Allows(permission) {
+		return nil, fmt.Errorf("access denied: %s permission required", permission)
+	}
+
+	t, err := g.teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return nil, team.ErrTeamNotFound
+	}
+	if !t.HasFeature("inbox") {
+		return nil, team.ErrFeatureNotAvailable
+	}
+
+	access, err := g.checker.Resolve(ctx, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check account access: %w", err)
+	}
+	return access, nil
+}
+
+// conversation loads a conversation of the team the member may see.
+// Conversations of other teams or hidden accounts look like missing ones.
+func (g *guard) conversation(ctx context.Context, repo inbox.Repository, access *socialDomain.AccountAccess, teamID, conversationID uuid.UUID) (*inbox.Conversation, error) {
+	c, err := repo.FindConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if c.TeamID() != teamID || !access.Allows(c.AccountID(), socialDomain.AccessAnalytics) {
+		return nil, inbox.ErrConversationNotFound
+	}
+	return c, nil
+}
@@ -0,0 +1,104 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/ingest.go
+// PURPOSE: Filing received comments, mentions and messages into the inbox
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// Ingester files interactions into the conversations of the inbox. It is
+// subscribed to socialDomain.EventInteractionReceived, which both platform
+// webhooks and fetch-based sync raise. Interactions already filed are
+// dropped, so the same one may arrive from both.
+type Ingester struct {
+	inboxRepo inbox.Repository
+	teamRepo  team.Repository
+	tx        common.Transactor
+	events    common.EventBus
+	logger    common.Logger
+}
+
+func NewIngester(
+	inboxRepo inbox.Repository,
+	teamRepo team.Repository,
+	tx common.Transactor,
+	events common.EventBus,
+	logger common.Logger,
+) *Ingester {
+	return &Ingester{
+		inboxRepo: inboxRepo,
+		teamRepo:  teamRepo,
+		tx:        tx,
+		events:    events,
+		logger:    logger,
+	}
+}
+
+// HandleInteraction files one interaction for the team of its account.
+// Teams whose plan has no inbox are skipped.
+func (i *Ingester) HandleInteraction(ctx context.Context, e common.Event) error {
+	var received socialDomain.InteractionReceived
+	if err := event.Decode(e, &received); err != nil {
+		return fmt.Errorf("failed to read interaction: %w", err)
+	}
+
+	kind, ok := inbox.KindOf(received.Kind)
+	if !ok || received.ItemID == "" {
+		return nil
+	}
+
+	t, err := i.teamRepo.FindByID(ctx, received.Team)
+	if errors.Is(err, team.ErrTeamNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !t.HasFeature("inbox") {
+		return nil
+	}
+
+	candidate := inbox.NewConversation(
+		received.Team,
+		received.AccountID,
+		received.Platform,
+		kind,
+		inbox.ThreadKey(kind, received.ObjectID, received.ItemID, received.AuthorID),
+		received.ObjectID,
+		received.AuthorID,
+		received.AuthorName,
+	)
+
+	// The conversation stays locked while the message is added, so
+	// concurrent messages of one thread are counted one after the other
+	return i.tx.WithinTx(ctx, func(ctx context.Context) error {
+		c, err := i.inboxRepo.EnsureConversation(ctx, candidate)
+		if err != nil {
+			return err
+		}
+
+		m := inbox.NewInboundMessage(c.ID(), received.ItemID, received.AuthorID, received.AuthorName, received.Text, received.PostedAt)
+		added, err := i.inboxRepo.AddMessage(ctx, m)
+		if err != nil || !added {
+			return err
+		}
+
+		c.Receive(m)
+		if err := i.inboxRepo.UpdateConversation(ctx, c); err != nil {
+			return err
+		}
+
+		i.logger.Debug("Inbox message received", "conversationId", c.ID(), "kind", kind, "accountId", received.AccountID)
+		return i.events.Publish(ctx, inbox.NewMessageReceived(c, m))
+	})
+}
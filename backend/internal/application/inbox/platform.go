@@ -0,0 +1,110 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/platform.go
+// PURPOSE: Replying and moderating through the platform adapters
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// sender acts as a social account on its platform, within the account's
+// publish quota. Replies sent by members and by rules both go through it.
+type sender struct {
+	adapters map[socialDomain.Platform]social.Adapter
+	limiter  common.PlatformRateLimiter
+	logger   common.Logger
+}
+
+// reply answers a message of the conversation as the account
+func (s *sender) reply(ctx context.Context, account *socialDomain.Account, c *inbox.Conversation, target *inbox.Message, text string) (*social.ReplyResult, error) {
+	replier, ok := s.adapters[account.Platform()].(social.Replier)
+	if !ok {
+		return nil, inbox.ErrReplyNotSupported
+	}
+	if err := s.takeToken(ctx, account); err != nil {
+		return nil, err
+	}
+
+	result, err := replier.Reply(ctx, accountToken(account), &social.ReplyTarget{
+		Kind:        string(c.Kind()),
+		ItemID:      target.PlatformMessageID(),
+		RecipientID: c.ParticipantID(),
+	}, text)
+	if err != nil {
+		return nil, s.platformError(ctx, account, err)
+	}
+	s.observe(ctx, account, result.RateLimit)
+	return result, nil
+}
+
+// hide hides a received comment on the platform
+func (s *sender) hide(ctx context.Context, account *socialDomain.Account, comment *inbox.Message) error {
+	moderator, ok := s.adapters[account.Platform()].(social.CommentModerator)
+	if !ok {
+		return fmt.Errorf("hiding comments is not supported for %s", account.Platform())
+	}
+	if err := s.takeToken(ctx, account); err != nil {
+		return err
+	}
+
+	if err := moderator.HideComment(ctx, accountToken(account), comment.PlatformMessageID()); err != nil {
+		return s.platformError(ctx, account, err)
+	}
+	return nil
+}
+
+// platformError records a platform's rate limit and wraps the error
+func (s *sender) platformError(ctx context.Context, account *socialDomain.Account, err error) error {
+	var rateErr *social.RateLimitError
+	if errors.As(err, &rateErr) {
+		s.observe(ctx, account, rateErr.Limit)
+		return fmt.Errorf("%w, retry after %s", inbox.ErrReplyRateLimited, rateErr.RetryAfter)
+	}
+	return fmt.Errorf("%w: %v", inbox.ErrReplyFailed, err)
+}
+
+func (s *sender) takeToken(ctx context.Context, account *socialDomain.Account) error {
+	if s.limiter == nil {
+		return nil
+	}
+
+	decision, err := s.limiter.Take(ctx, string(account.Platform()), account.ID().String(), common.RateClassPublish)
+	if err != nil {
+		s.logger.Warn("Rate limit check failed", "accountId", account.ID(), "error", err)
+		return nil
+	}
+	if !decision.Allowed {
+		return fmt.Errorf("%w, retry after %s", inbox.ErrReplyRateLimited, decision.RetryAfter)
+	}
+	return nil
+}
+
+func (s *sender) observe(ctx context.Context, account *socialDomain.Account, rl *social.RateLimit) {
+	if s.limiter == nil || rl == nil {
+		return
+	}
+
+	if err := social.ObserveRateLimit(ctx, s.limiter, string(account.Platform()), account.ID().String(), common.RateClassPublish, rl); err != nil {
+		s.logger.Warn("Failed to record platform rate limit", "accountId", account.ID(), "error", err)
+	}
+}
+
+// accountToken returns the adapter token of a social account
+func accountToken(account *socialDomain.Account) *social.Token {
+	credentials := account.Credentials()
+	return &social.Token{
+		AccessToken:    credentials.AccessToken,
+		RefreshToken:   credentials.RefreshToken,
+		ExpiresAt:      credentials.ExpiresAt,
+		Scopes:         credentials.Scope,
+		PlatformUserID: credentials.PlatformUserID,
+	}
+}
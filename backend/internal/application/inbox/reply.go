@@ -0,0 +1,181 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/reply.go
+// PURPOSE: Replying to inbox conversations through the platform adapter
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// replyContext is how many recent messages are searched for the message a
+// reply answers
+const replyContext = 50
+
+// ReplyInput answers a conversation with Text, or with a saved reply
+// rendered for the conversation. MessageID picks the message replied to;
+// by default it is the latest received one.
+type ReplyInput struct {
+	TeamID         uuid.UUID  `json:"-"`
+	UserID         uuid.UUID  `json:"-"`
+	ConversationID uuid.UUID  `json:"-"`
+	MessageID      *uuid.UUID `json:"messageId,omitempty"`
+	Text           string     `json:"text"`
+	SavedReplyID   *uuid.UUID `json:"savedReplyId,omitempty"`
+}
+
+// ReplyUseCase sends a reply as the conversation's social account and
+// records it in the conversation
+type ReplyUseCase struct {
+	inboxRepo   inbox.Repository
+	ruleRepo    inbox.RuleRepository
+	accountRepo socialDomain.AccountRepository
+	sender      *sender
+	guard       *guard
+	tx          common.Transactor
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewReplyUseCase(
+	inboxRepo inbox.Repository,
+	ruleRepo inbox.RuleRepository,
+	accountRepo socialDomain.AccountRepository,
+	adapters map[socialDomain.Platform]social.Adapter,
+	limiter common.PlatformRateLimiter,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	tx common.Transactor,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *ReplyUseCase {
+	return &ReplyUseCase{
+		inboxRepo:   inboxRepo,
+		ruleRepo:    ruleRepo,
+		accountRepo: accountRepo,
+		sender:      &sender{adapters: adapters, limiter: limiter, logger: logger},
+		guard:       newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		tx:          tx,
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+func (uc *ReplyUseCase) Execute(ctx context.Context, input ReplyInput) (*MessageDTO, error) {
+	// 1. Check permission, plan and access to the account
+	access, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxReply)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.guard.conversation(ctx, uc.inboxRepo, access, input.TeamID, input.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Allows(c.AccountID(), socialDomain.AccessPublish) {
+		return nil, socialDomain.ErrAccountAccessDenied
+	}
+
+	// 2. Resolve the account replying
+	account, err := uc.accountRepo.FindByID(ctx, c.AccountID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load social account: %w", err)
+	}
+	if account.Status() != socialDomain.StatusActive {
+		return nil, socialDomain.ErrAccountNotActive
+	}
+
+	text := input.Text
+	if input.SavedReplyID != nil {
+		saved, err := findTeamSavedReply(ctx, uc.ruleRepo, input.TeamID, *input.SavedReplyID)
+		if err != nil {
+			return nil, err
+		}
+		text = renderSavedReply(saved, c, account)
+	}
+	text, err = inbox.ValidateReply(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Find the message replied to
+	target, err := uc.target(ctx, c, input.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Send within the account's quota
+	result, err := uc.sender.reply(ctx, account, c, target, text)
+	if err != nil {
+		if errors.Is(err, inbox.ErrReplyFailed) {
+			uc.logger.Warn("Reply rejected by platform", "conversationId", c.ID(), "platform", account.Platform(), "error", err)
+		}
+		return nil, err
+	}
+
+	// 5. Record the reply; it is on the platform whatever happens next
+	reply := inbox.NewReply(c.ID(), input.UserID, result.PlatformMessageID, text, result.SentAt)
+	if err := recordReply(ctx, uc.tx, uc.inboxRepo, c, reply); err != nil {
+		uc.logger.Error("Failed to record sent reply", "conversationId", c.ID(), "platformMessageId", result.PlatformMessageID, "error", err)
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionConversationReplied, audit.TargetConversation, c.ID().String()).
+		WithMetadata("platform", string(account.Platform())).
+		WithMetadata("platformMessageId", result.PlatformMessageID))
+
+	uc.logger.Info("Inbox reply sent", "conversationId", c.ID(), "platform", account.Platform(), "userId", input.UserID)
+	dto := mapMessageToDTO(reply)
+	return &dto, nil
+}
+
+// recordReply adds a sent reply to its conversation
+func recordReply(ctx context.Context, tx common.Transactor, repo inbox.Repository, c *inbox.Conversation, reply *inbox.Message) error {
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		locked, err := repo.EnsureConversation(ctx, c)
+		if err != nil {
+			return err
+		}
+		if _, err := repo.AddMessage(ctx, reply); err != nil {
+			return err
+		}
+		locked.RecordReply(reply)
+		return repo.UpdateConversation(ctx, locked)
+	})
+}
+
+// target returns the message a reply answers
+func (uc *ReplyUseCase) target(ctx context.Context, c *inbox.Conversation, messageID *uuid.UUID) (*inbox.Message, error) {
+	if messageID != nil {
+		m, err := uc.inboxRepo.FindMessage(ctx, c.ID(), *messageID)
+		if err != nil {
+			return nil, err
+		}
+		if m.Direction() != inbox.DirectionInbound {
+			return nil, fmt.Errorf("only received messages can be replied to")
+		}
+		return m, nil
+	}
+
+	messages, err := uc.inboxRepo.ListMessages(ctx, c.ID(), replyContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load messages: %w", err)
+	}
+	m := inbox.LatestInbound(messages)
+	if m == nil {
+		return nil, inbox.ErrMessageNotFound
+	}
+	return m, nil
+}
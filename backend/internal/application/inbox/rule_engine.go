@@ -0,0 +1,308 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/rule_engine.go
+// PURPOSE: Applying the inbox auto-response rules to received messages
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// ruleBatch is how many received messages one pass claims
+const ruleBatch = 50
+
+// RuleEngine runs the team's rules on every received message once.
+//
+// The run of a rule on a message is recorded together with the tag and
+// assignment it makes, so a retried message is not tagged twice. Hiding
+// and replying happen on the platform after the run is recorded: they are
+// done at most once, and a failure is logged rather than retried.
+type RuleEngine struct {
+	inboxRepo   inbox.Repository
+	queue       inbox.RuleQueue
+	ruleRepo    inbox.RuleRepository
+	teamRepo    team.Repository
+	accountRepo socialDomain.AccountRepository
+	authorizer  *team.Authorizer
+	sender      *sender
+	tx          common.Transactor
+	events      common.EventBus
+	logger      common.Logger
+}
+
+func NewRuleEngine(
+	inboxRepo inbox.Repository,
+	queue inbox.RuleQueue,
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accountRepo socialDomain.AccountRepository,
+	adapters map[socialDomain.Platform]social.Adapter,
+	limiter common.PlatformRateLimiter,
+	tx common.Transactor,
+	events common.EventBus,
+	logger common.Logger,
+) *RuleEngine {
+	return &RuleEngine{
+		inboxRepo:   inboxRepo,
+		queue:       queue,
+		ruleRepo:    ruleRepo,
+		teamRepo:    teamRepo,
+		accountRepo: accountRepo,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+		sender:      &sender{adapters: adapters, limiter: limiter, logger: logger},
+		tx:          tx,
+		events:      events,
+		logger:      logger,
+	}
+}
+
+// ProcessDue runs the rules on the received messages waiting for them and
+// returns how many were claimed
+func (e *RuleEngine) ProcessDue(ctx context.Context) (int, error) {
+	messages, err := e.queue.ClaimRuleEvaluations(ctx, ruleBatch, inbox.RuleEvaluationLease, inbox.RuleMaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rules are loaded once per team and pass
+	rules := make(map[uuid.UUID][]*inbox.Rule)
+	for _, m := range messages {
+		if ctx.Err() != nil {
+			break // the lease brings the rest back
+		}
+
+		if err := e.evaluate(ctx, m, rules); err != nil {
+			e.logger.Warn("Inbox rule evaluation failed", "messageId", m.ID(), "error", err)
+			continue
+		}
+		if err := e.queue.CompleteRuleEvaluation(ctx, m.ID()); err != nil {
+			e.logger.Error("Failed to complete rule evaluation", "messageId", m.ID(), "error", err)
+		}
+	}
+	return len(messages), nil
+}
+
+// platformAction is a hide or reply left for after a rule's run is recorded
+type platformAction struct {
+	rule   *inbox.Rule
+	action inbox.Action
+}
+
+func (e *RuleEngine) evaluate(ctx context.Context, m *inbox.Message, cache map[uuid.UUID][]*inbox.Rule) error {
+	if time.Since(m.SentAt()) > inbox.RuleMaxMessageAge {
+		return nil
+	}
+
+	c, err := e.inboxRepo.FindConversation(ctx, m.ConversationID())
+	if errors.Is(err, inbox.ErrConversationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	rules, err := e.teamRules(ctx, c.TeamID(), cache)
+	if err != nil {
+		return err
+	}
+	matches := inbox.MatchRules(rules, c.AccountID(), c.Kind(), m.Text())
+	if len(matches) == 0 {
+		return nil
+	}
+
+	tracker := inbox.NewActionTracker(c.Kind())
+	var pending []platformAction
+	for _, match := range matches {
+		actions, err := e.run(ctx, c, m, match, tracker)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, actions...)
+	}
+
+	if len(pending) > 0 {
+		e.applyOnPlatform(ctx, c, m, pending)
+	}
+	return nil
+}
+
+// teamRules returns the rules of a team whose plan has the inbox
+func (e *RuleEngine) teamRules(ctx context.Context, teamID uuid.UUID, cache map[uuid.UUID][]*inbox.Rule) ([]*inbox.Rule, error) {
+	if rules, ok := cache[teamID]; ok {
+		return rules, nil
+	}
+
+	t, err := e.teamRepo.FindByID(ctx, teamID)
+	if err != nil && !errors.Is(err, team.ErrTeamNotFound) {
+		return nil, err
+	}
+
+	var rules []*inbox.Rule
+	if err == nil && t.HasFeature("inbox") {
+		if rules, err = e.ruleRepo.ListRules(ctx, teamID); err != nil {
+			return nil, err
+		}
+	}
+	cache[teamID] = rules
+	return rules, nil
+}
+
+// run records a matching rule's run on the message and makes its changes
+// to the conversation, unless the rule is over its rate limit. Hides and
+// replies are returned to be done once the run is recorded.
+func (e *RuleEngine) run(ctx context.Context, c *inbox.Conversation, m *inbox.Message, match inbox.RuleMatch, tracker *inbox.ActionTracker) ([]platformAction, error) {
+	r := match.Rule
+	var pending []platformAction
+
+	err := e.tx.WithinTx(ctx, func(ctx context.Context) error {
+		pending = nil
+
+		// The rule stays locked until the run is recorded, so concurrent
+		// workers count each other's runs
+		count, err := e.ruleRepo.CountRuleRuns(ctx, r.ID(), time.Now().UTC().Add(-inbox.RuleRateWindow))
+		if err != nil {
+			return err
+		}
+		added, err := e.ruleRepo.AddRuleRun(ctx, r.ID(), m.ID())
+		if err != nil {
+			return err
+		}
+		if !added {
+			// Ran on an earlier attempt; its actions still count as taken
+			tracker.Take(r.Actions())
+			return nil
+		}
+		if count >= r.RateLimit() {
+			e.logger.Warn("Inbox rule over its rate limit", "ruleId", r.ID(), "teamId", r.TeamID(), "limit", r.RateLimit())
+			return errRuleLimited
+		}
+
+		locked, err := e.inboxRepo.EnsureConversation(ctx, c)
+		if err != nil {
+			return err
+		}
+		changed, assigned := false, false
+		for _, a := range tracker.Take(r.Actions()) {
+			switch a.Type {
+			case inbox.ActionTag:
+				if locked.AddTag(a.Tag) {
+					changed = true
+				}
+			case inbox.ActionAssign:
+				ok, err := e.assignable(ctx, locked, *a.AssigneeID)
+				if err != nil {
+					return err
+				}
+				if ok {
+					locked.Assign(*a.AssigneeID)
+					changed, assigned = true, true
+				}
+			default:
+				pending = append(pending, platformAction{rule: r, action: a})
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+		if err := e.inboxRepo.UpdateConversation(ctx, locked); err != nil {
+			return err
+		}
+		if assigned {
+			return e.events.Publish(ctx, inbox.NewConversationAssigned(locked, uuid.Nil))
+		}
+		return nil
+	})
+	if errors.Is(err, errRuleLimited) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", r.ID(), err)
+	}
+
+	e.logger.Info("Inbox rule matched", "ruleId", r.ID(), "conversationId", c.ID(), "messageId", m.ID(), "keyword", match.Keyword)
+	return pending, nil
+}
+
+// errRuleLimited rolls back the run of a rule over its rate limit
+var errRuleLimited = errors.New("inbox rule rate limited")
+
+// assignable reports whether a rule may assign the conversation to a
+// member. Conversations someone already took are left alone, as are
+// members who can no longer see the inbox.
+func (e *RuleEngine) assignable(ctx context.Context, c *inbox.Conversation, assignee uuid.UUID) (bool, error) {
+	if c.AssignedTo() != uuid.Nil {
+		return false, nil
+	}
+
+	can, err := e.authorizer.Can(ctx, c.TeamID(), assignee, team.PermInboxView)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve assignee role: %w", err)
+	}
+	if !can {
+		e.logger.Warn("Inbox rule assignee cannot view the inbox", "conversationId", c.ID(), "assignee", assignee)
+	}
+	return can, nil
+}
+
+// applyOnPlatform hides and answers the message as the conversation's
+// account. Failures are logged; the rule's run is already recorded.
+func (e *RuleEngine) applyOnPlatform(ctx context.Context, c *inbox.Conversation, m *inbox.Message, pending []platformAction) {
+	account, err := e.accountRepo.FindByID(ctx, c.AccountID())
+	if err != nil {
+		e.logger.Error("Failed to load social account for inbox rule", "accountId", c.AccountID(), "error", err)
+		return
+	}
+	if account.Status() != socialDomain.StatusActive {
+		e.logger.Warn("Inbox rule skipped for inactive account", "accountId", account.ID(), "status", account.Status())
+		return
+	}
+
+	for _, p := range pending {
+		var err error
+		switch p.action.Type {
+		case inbox.ActionHide:
+			err = e.sender.hide(ctx, account, m)
+		case inbox.ActionReply:
+			err = e.reply(ctx, account, c, m, *p.action.SavedReplyID)
+		}
+		if err != nil {
+			e.logger.Warn("Inbox rule action failed", "ruleId", p.rule.ID(), "action", p.action.Type, "conversationId", c.ID(), "error", err)
+		}
+	}
+}
+
+// reply answers the message with a saved reply of the team
+func (e *RuleEngine) reply(ctx context.Context, account *socialDomain.Account, c *inbox.Conversation, m *inbox.Message, savedReplyID uuid.UUID) error {
+	saved, err := findTeamSavedReply(ctx, e.ruleRepo, c.TeamID(), savedReplyID)
+	if err != nil {
+		return err
+	}
+	text, err := inbox.ValidateReply(renderSavedReply(saved, c, account))
+	if err != nil {
+		return err
+	}
+
+	result, err := e.sender.reply(ctx, account, c, m, text)
+	if err != nil {
+		return err
+	}
+
+	reply := inbox.NewReply(c.ID(), uuid.Nil, result.PlatformMessageID, text, result.SentAt)
+	if err := recordReply(ctx, e.tx, e.inboxRepo, c, reply); err != nil {
+		e.logger.Error("Failed to record sent reply", "conversationId", c.ID(), "platformMessageId", result.PlatformMessageID, "error", err)
+	}
+	return nil
+}
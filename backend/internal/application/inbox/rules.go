@@ -0,0 +1,520 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/rules.go
+// PURPOSE: Inbox auto-response rule management and dry runs
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type RuleDTO struct {
+	ID         uuid.UUID      `json:"id"`
+	Name       string         `json:"name"`
+	Enabled    bool           `json:"enabled"`
+	Keywords   []string       `json:"keywords"`
+	Kinds      []inbox.Kind   `json:"kinds"`
+	AccountIDs []uuid.UUID    `json:"accountIds"`
+	Actions    []inbox.Action `json:"actions"`
+	RateLimit  int            `json:"rateLimit"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+func mapRuleToDTO(r *inbox.Rule) RuleDTO {
+	return RuleDTO{
+		ID:         r.ID(),
+		Name:       r.Name(),
+		Enabled:    r.IsEnabled(),
+		Keywords:   r.Keywords(),
+		Kinds:      r.Kinds(),
+		AccountIDs: r.AccountIDs(),
+		Actions:    r.Actions(),
+		RateLimit:  r.RateLimit(),
+		CreatedAt:  r.CreatedAt(),
+		UpdatedAt:  r.UpdatedAt(),
+	}
+}
+
+// ruleFields is what the audit log compares when a rule changes
+func ruleFields(r *inbox.Rule) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       r.Name(),
+		"enabled":    r.IsEnabled(),
+		"keywords":   r.Keywords(),
+		"kinds":      r.Kinds(),
+		"accountIds": r.AccountIDs(),
+		"actions":    r.Actions(),
+		"rateLimit":  r.RateLimit(),
+	}
+}
+
+// findTeamRule loads a rule of the team. Rules of other teams look the
+// same as missing ones.
+func findTeamRule(ctx context.Context, repo inbox.RuleRepository, teamID, id uuid.UUID) (*inbox.Rule, error) {
+	r, err := repo.FindRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.TeamID() != teamID {
+		return nil, inbox.ErrRuleNotFound
+	}
+	return r, nil
+}
+
+// checkRuleActions checks what the actions refer to: assignees must be
+// able to view the inbox and saved replies must be the team's
+func checkRuleActions(ctx context.Context, authorizer *team.Authorizer, repo inbox.RuleRepository, teamID uuid.UUID, actions []inbox.Action) error {
+	for _, a := range actions {
+		switch a.Type {
+		case inbox.ActionAssign:
+			can, err := authorizer.Can(ctx, teamID, *a.AssigneeID, team.PermInboxView)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignee role: %w", err)
+			}
+			if !can {
+				return inbox.ErrInvalidAssignee
+			}
+		case inbox.ActionReply:
+			if _, err := findTeamSavedReply(ctx, repo, teamID, *a.SavedReplyID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RuleInput is what a rule matches and does, as sent by clients
+type RuleInput struct {
+	Name       string         `json:"name"`
+	Keywords   []string       `json:"keywords"`
+	Kinds      []inbox.Kind   `json:"kinds"`
+	AccountIDs []uuid.UUID    `json:"accountIds"`
+	Actions    []inbox.Action `json:"actions"`
+	RateLimit  int            `json:"rateLimit"`
+}
+
+func (in RuleInput) spec() inbox.RuleSpec {
+	return inbox.RuleSpec{
+		Name:       in.Name,
+		Keywords:   in.Keywords,
+		Kinds:      in.Kinds,
+		AccountIDs: in.AccountIDs,
+		Actions:    in.Actions,
+		RateLimit:  in.RateLimit,
+	}
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListRulesInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListRulesUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+}
+
+func NewListRulesUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+) *ListRulesUseCase {
+	return &ListRulesUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+	}
+}
+
+// Execute returns the team's rules in the order they are applied
+func (uc *ListRulesUseCase) Execute(ctx context.Context, input ListRulesInput) ([]RuleDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxView); err != nil {
+		return nil, err
+	}
+
+	rules, err := uc.ruleRepo.ListRules(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]RuleDTO, 0, len(rules))
+	for _, r := range rules {
+		dtos = append(dtos, mapRuleToDTO(r))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateRuleInput struct {
+	TeamID uuid.UUID `json:"-"`
+	UserID uuid.UUID `json:"-"`
+	RuleInput
+}
+
+type CreateRuleUseCase struct {
+	ruleRepo   inbox.RuleRepository
+	guard      *guard
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewCreateRuleUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *CreateRuleUseCase {
+	return &CreateRuleUseCase{
+		ruleRepo:   ruleRepo,
+		guard:      newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *CreateRuleUseCase) Execute(ctx context.Context, input CreateRuleInput) (*RuleDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return nil, err
+	}
+
+	r, err := inbox.NewRule(input.TeamID, input.UserID, input.spec())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRuleActions(ctx, uc.authorizer, uc.ruleRepo, input.TeamID, r.Actions()); err != nil {
+		return nil, err
+	}
+	if err := uc.ruleRepo.CreateRule(ctx, r); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionInboxRuleCreated, audit.TargetInboxRule, r.ID().String()).
+		WithChanges(audit.Diff(nil, ruleFields(r))))
+
+	uc.logger.Info("Inbox rule created", "ruleId", r.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	dto := mapRuleToDTO(r)
+	return &dto, nil
+}
+
+// ============================================================================
+// UPDATE
+// ============================================================================
+
+// UpdateRuleInput changes the fields that are set. Lists replace the
+// rule's lists.
+type UpdateRuleInput struct {
+	TeamID     uuid.UUID      `json:"-"`
+	UserID     uuid.UUID      `json:"-"`
+	RuleID     uuid.UUID      `json:"-"`
+	Name       *string        `json:"name,omitempty"`
+	Enabled    *bool          `json:"enabled,omitempty"`
+	Keywords   []string       `json:"keywords,omitempty"`
+	Kinds      []inbox.Kind   `json:"kinds,omitempty"`
+	AccountIDs []uuid.UUID    `json:"accountIds,omitempty"`
+	Actions    []inbox.Action `json:"actions,omitempty"`
+	RateLimit  *int           `json:"rateLimit,omitempty"`
+}
+
+type UpdateRuleUseCase struct {
+	ruleRepo   inbox.RuleRepository
+	guard      *guard
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewUpdateRuleUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *UpdateRuleUseCase {
+	return &UpdateRuleUseCase{
+		ruleRepo:   ruleRepo,
+		guard:      newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *UpdateRuleUseCase) Execute(ctx context.Context, input UpdateRuleInput) (*RuleDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return nil, err
+	}
+
+	r, err := findTeamRule(ctx, uc.ruleRepo, input.TeamID, input.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	before := ruleFields(r)
+
+	spec := r.Spec()
+	if input.Name != nil {
+		spec.Name = *input.Name
+	}
+	if input.Keywords != nil {
+		spec.Keywords = input.Keywords
+	}
+	if input.Kinds != nil {
+		spec.Kinds = input.Kinds
+	}
+	if input.AccountIDs != nil {
+		spec.AccountIDs = input.AccountIDs
+	}
+	if input.Actions != nil {
+		spec.Actions = input.Actions
+	}
+	if input.RateLimit != nil {
+		spec.RateLimit = *input.RateLimit
+	}
+	if err := r.Update(spec); err != nil {
+		return nil, err
+	}
+	if input.Actions != nil {
+		if err := checkRuleActions(ctx, uc.authorizer, uc.ruleRepo, input.TeamID, r.Actions()); err != nil {
+			return nil, err
+		}
+	}
+	if input.Enabled != nil {
+		r.SetEnabled(*input.Enabled)
+	}
+
+	if err := uc.ruleRepo.UpdateRule(ctx, r); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionInboxRuleUpdated, audit.TargetInboxRule, r.ID().String()).
+		WithChanges(audit.Diff(before, ruleFields(r))))
+
+	uc.logger.Info("Inbox rule updated", "ruleId", r.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	dto := mapRuleToDTO(r)
+	return &dto, nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteRuleInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+	RuleID uuid.UUID
+}
+
+type DeleteRuleUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+	recorder *auditlog.Recorder
+	logger   common.Logger
+}
+
+func NewDeleteRuleUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+func (uc *DeleteRuleUseCase) Execute(ctx context.Context, input DeleteRuleInput) error {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return err
+	}
+
+	r, err := findTeamRule(ctx, uc.ruleRepo, input.TeamID, input.RuleID)
+	if err != nil {
+		return err
+	}
+	if err := uc.ruleRepo.DeleteRule(ctx, r.ID()); err != nil {
+		return err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionInboxRuleDeleted, audit.TargetInboxRule, r.ID().String()).
+		WithChanges(audit.Diff(ruleFields(r), nil)))
+
+	uc.logger.Info("Inbox rule deleted", "ruleId", r.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	return nil
+}
+
+// ============================================================================
+// DRY RUN
+// ============================================================================
+
+const maxTestSamples = 50
+
+// SampleMessage is a made-up message to test rules against
+type SampleMessage struct {
+	Text       string     `json:"text"`
+	Kind       inbox.Kind `json:"kind"`
+	AccountID  uuid.UUID  `json:"accountId"`
+	AuthorName string     `json:"authorName"`
+}
+
+// TestRulesInput tests the unsaved Rule if set, the saved rule RuleID if
+// set, and otherwise every enabled rule of the team
+type TestRulesInput struct {
+	TeamID  uuid.UUID       `json:"-"`
+	UserID  uuid.UUID       `json:"-"`
+	RuleID  *uuid.UUID      `json:"ruleId,omitempty"`
+	Rule    *RuleInput      `json:"rule,omitempty"`
+	Samples []SampleMessage `json:"samples"`
+}
+
+type ActionPreviewDTO struct {
+	inbox.Action
+	ReplyText string `json:"replyText,omitempty"`
+}
+
+type RuleMatchDTO struct {
+	RuleID   *uuid.UUID         `json:"ruleId,omitempty"`
+	RuleName string             `json:"ruleName"`
+	Keyword  string             `json:"keyword"`
+	Actions  []ActionPreviewDTO `json:"actions"`
+}
+
+type SampleResultDTO struct {
+	Sample  SampleMessage  `json:"sample"`
+	Matches []RuleMatchDTO `json:"matches"`
+}
+
+// TestRulesUseCase shows what rules would do to sample messages, without
+// doing it. Rate limits are not applied.
+type TestRulesUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+}
+
+func NewTestRulesUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+) *TestRulesUseCase {
+	return &TestRulesUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+	}
+}
+
+func (uc *TestRulesUseCase) Execute(ctx context.Context, input TestRulesInput) ([]SampleResultDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return nil, err
+	}
+	if len(input.Samples) == 0 || len(input.Samples) > maxTestSamples {
+		return nil, fmt.Errorf("between 1 and %d samples are required", maxTestSamples)
+	}
+
+	rules, unsaved, err := uc.rules(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[uuid.UUID]*inbox.SavedReply)
+	results := make([]SampleResultDTO, 0, len(input.Samples))
+	for _, sample := range input.Samples {
+		if sample.Kind == "" {
+			sample.Kind = inbox.KindComment
+		}
+
+		result := SampleResultDTO{Sample: sample, Matches: []RuleMatchDTO{}}
+		tracker := inbox.NewActionTracker(sample.Kind)
+		for _, match := range inbox.MatchRules(rules, sample.AccountID, sample.Kind, sample.Text) {
+			dto := RuleMatchDTO{RuleName: match.Rule.Name(), Keyword: match.Keyword, Actions: []ActionPreviewDTO{}}
+			if !unsaved {
+				id := match.Rule.ID()
+				dto.RuleID = &id
+			}
+
+			for _, a := range tracker.Take(match.Rule.Actions()) {
+				preview := ActionPreviewDTO{Action: a}
+				if a.Type == inbox.ActionReply {
+					text, err := uc.render(ctx, templates, input.TeamID, *a.SavedReplyID, sample.AuthorName)
+					if err != nil {
+						return nil, err
+					}
+					preview.ReplyText = text
+				}
+				dto.Actions = append(dto.Actions, preview)
+			}
+			result.Matches = append(result.Matches, dto)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// rules returns the rules to test and whether they are unsaved
+func (uc *TestRulesUseCase) rules(ctx context.Context, input TestRulesInput) ([]*inbox.Rule, bool, error) {
+	switch {
+	case input.Rule != nil:
+		r, err := inbox.NewRule(input.TeamID, input.UserID, input.Rule.spec())
+		if err != nil {
+			return nil, false, err
+		}
+		return []*inbox.Rule{r}, true, nil
+	case input.RuleID != nil:
+		r, err := findTeamRule(ctx, uc.ruleRepo, input.TeamID, *input.RuleID)
+		if err != nil {
+			return nil, false, err
+		}
+		// A disabled rule is tested as if it were on
+		r.SetEnabled(true)
+		return []*inbox.Rule{r}, false, nil
+	default:
+		rules, err := uc.ruleRepo.ListRules(ctx, input.TeamID)
+		return rules, false, err
+	}
+}
+
+// render renders a saved reply for a sample. The account is left as a
+// variable, as samples are not sent from one.
+func (uc *TestRulesUseCase) render(ctx context.Context, cache map[uuid.UUID]*inbox.SavedReply, teamID, id uuid.UUID, authorName string) (string, error) {
+	s, ok := cache[id]
+	if !ok {
+		var err error
+		s, err = findTeamSavedReply(ctx, uc.ruleRepo, teamID, id)
+		if err != nil {
+			return "", err
+		}
+		cache[id] = s
+	}
+	return s.Render(inbox.VarsFor(authorName, "{{"+inbox.VarAccount+"}}")), nil
+}
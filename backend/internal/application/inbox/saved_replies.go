@@ -0,0 +1,306 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/saved_replies.go
+// PURPOSE: Team-managed reply templates for the inbox
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type SavedReplyDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func mapSavedReplyToDTO(s *inbox.SavedReply) SavedReplyDTO {
+	return SavedReplyDTO{
+		ID:        s.ID(),
+		Name:      s.Name(),
+		Body:      s.Body(),
+		CreatedAt: s.CreatedAt(),
+		UpdatedAt: s.UpdatedAt(),
+	}
+}
+
+// savedReplyFields is what the audit log compares when a template changes
+func savedReplyFields(s *inbox.SavedReply) map[string]interface{} {
+	return map[string]interface{}{
+		"name": s.Name(),
+		"body": s.Body(),
+	}
+}
+
+// findTeamSavedReply loads a template of the team. Templates of other
+// teams look the same as missing ones.
+func findTeamSavedReply(ctx context.Context, repo inbox.RuleRepository, teamID, id uuid.UUID) (*inbox.SavedReply, error) {
+	s, err := repo.FindSavedReply(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.TeamID() != teamID {
+		return nil, inbox.ErrSavedReplyNotFound
+	}
+	return s, nil
+}
+
+// renderSavedReply renders a template for the participant of a
+// conversation, signed as the account
+func renderSavedReply(s *inbox.SavedReply, c *inbox.Conversation, account *socialDomain.Account) string {
+	accountName := account.DisplayName()
+	if accountName == "" {
+		accountName = account.Username()
+	}
+	return s.Render(inbox.VarsFor(c.ParticipantName(), accountName))
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListSavedRepliesInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListSavedRepliesUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+}
+
+func NewListSavedRepliesUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+) *ListSavedRepliesUseCase {
+	return &ListSavedRepliesUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+	}
+}
+
+func (uc *ListSavedRepliesUseCase) Execute(ctx context.Context, input ListSavedRepliesInput) ([]SavedReplyDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxView); err != nil {
+		return nil, err
+	}
+
+	replies, err := uc.ruleRepo.ListSavedReplies(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]SavedReplyDTO, 0, len(replies))
+	for _, s := range replies {
+		dtos = append(dtos, mapSavedReplyToDTO(s))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateSavedReplyInput struct {
+	TeamID uuid.UUID `json:"-"`
+	UserID uuid.UUID `json:"-"`
+	Name   string    `json:"name"`
+	Body   string    `json:"body"`
+}
+
+type CreateSavedReplyUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+	recorder *auditlog.Recorder
+	logger   common.Logger
+}
+
+func NewCreateSavedReplyUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *CreateSavedReplyUseCase {
+	return &CreateSavedReplyUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+func (uc *CreateSavedReplyUseCase) Execute(ctx context.Context, input CreateSavedReplyInput) (*SavedReplyDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return nil, err
+	}
+
+	s, err := inbox.NewSavedReply(input.TeamID, input.UserID, input.Name, input.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ruleRepo.CreateSavedReply(ctx, s); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionSavedReplyCreated, audit.TargetSavedReply, s.ID().String()).
+		WithChanges(audit.Diff(nil, savedReplyFields(s))))
+
+	uc.logger.Info("Saved reply created", "savedReplyId", s.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	dto := mapSavedReplyToDTO(s)
+	return &dto, nil
+}
+
+// ============================================================================
+// UPDATE
+// ============================================================================
+
+// UpdateSavedReplyInput changes the fields that are set
+type UpdateSavedReplyInput struct {
+	TeamID       uuid.UUID `json:"-"`
+	UserID       uuid.UUID `json:"-"`
+	SavedReplyID uuid.UUID `json:"-"`
+	Name         *string   `json:"name,omitempty"`
+	Body         *string   `json:"body,omitempty"`
+}
+
+type UpdateSavedReplyUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+	recorder *auditlog.Recorder
+	logger   common.Logger
+}
+
+func NewUpdateSavedReplyUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *UpdateSavedReplyUseCase {
+	return &UpdateSavedReplyUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+func (uc *UpdateSavedReplyUseCase) Execute(ctx context.Context, input UpdateSavedReplyInput) (*SavedReplyDTO, error) {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return nil, err
+	}
+
+	s, err := findTeamSavedReply(ctx, uc.ruleRepo, input.TeamID, input.SavedReplyID)
+	if err != nil {
+		return nil, err
+	}
+	before := savedReplyFields(s)
+
+	name, body := s.Name(), s.Body()
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Body != nil {
+		body = *input.Body
+	}
+	if err := s.Update(name, body); err != nil {
+		return nil, err
+	}
+	if err := uc.ruleRepo.UpdateSavedReply(ctx, s); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionSavedReplyUpdated, audit.TargetSavedReply, s.ID().String()).
+		WithChanges(audit.Diff(before, savedReplyFields(s))))
+
+	uc.logger.Info("Saved reply updated", "savedReplyId", s.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	dto := mapSavedReplyToDTO(s)
+	return &dto, nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteSavedReplyInput struct {
+	TeamID       uuid.UUID
+	UserID       uuid.UUID
+	SavedReplyID uuid.UUID
+}
+
+// DeleteSavedReplyUseCase deletes a template. Rules still replying with it
+// must be changed first.
+type DeleteSavedReplyUseCase struct {
+	ruleRepo inbox.RuleRepository
+	guard    *guard
+	recorder *auditlog.Recorder
+	logger   common.Logger
+}
+
+func NewDeleteSavedReplyUseCase(
+	ruleRepo inbox.RuleRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *DeleteSavedReplyUseCase {
+	return &DeleteSavedReplyUseCase{
+		ruleRepo: ruleRepo,
+		guard:    newGuard(teamRepo, memberRepo, roleRepo, accessRepo),
+		recorder: recorder,
+		logger:   logger,
+	}
+}
+
+func (uc *DeleteSavedReplyUseCase) Execute(ctx context.Context, input DeleteSavedReplyInput) error {
+	if _, err := uc.guard.require(ctx, input.TeamID, input.UserID, team.PermInboxManage); err != nil {
+		return err
+	}
+
+	s, err := findTeamSavedReply(ctx, uc.ruleRepo, input.TeamID, input.SavedReplyID)
+	if err != nil {
+		return err
+	}
+
+	rules, err := uc.ruleRepo.ListRules(ctx, input.TeamID)
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		for _, a := range r.Actions() {
+			if a.Type == inbox.ActionReply && *a.SavedReplyID == s.ID() {
+				return inbox.ErrSavedReplyInUse
+			}
+		}
+	}
+
+	if err := uc.ruleRepo.DeleteSavedReply(ctx, s.ID()); err != nil {
+		return err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionSavedReplyDeleted, audit.TargetSavedReply, s.ID().String()).
+		WithChanges(audit.Diff(savedReplyFields(s), nil)))
+
+	uc.logger.Info("Saved reply deleted", "savedReplyId", s.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	return nil
+}
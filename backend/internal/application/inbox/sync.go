@@ -0,0 +1,178 @@
+// ============================================================================
+// FILE: backend/internal/application/inbox/sync.go
+// PURPOSE: Fetch-based inbox sync for platforms that send no webhooks
+// ============================================================================
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	// syncLookback is how far back an account is read on its first sync
+	syncLookback = 24 * time.Hour
+
+	// syncOverlap reads a little before the cursor again, for items the
+	// platform lists late. Duplicates are dropped when filed.
+	syncOverlap = 5 * time.Minute
+
+	syncPageSize = 100
+)
+
+// Syncer polls the accounts of platforms without webhooks for new
+// comments, mentions and messages. What it finds is raised as
+// socialDomain.InteractionReceived, exactly like a webhook, and filed by
+// the Ingester.
+type Syncer struct {
+	accountRepo socialDomain.AccountRepository
+	teamRepo    team.Repository
+	cursors     inbox.SyncCursorRepository
+	adapters    map[socialDomain.Platform]social.Adapter
+	webhooks    socialDomain.WebhookParsers
+	limiter     common.PlatformRateLimiter
+	tx          common.Transactor
+	events      common.EventBus
+	logger      common.Logger
+}
+
+func NewSyncer(
+	accountRepo socialDomain.AccountRepository,
+	teamRepo team.Repository,
+	cursors inbox.SyncCursorRepository,
+	adapters map[socialDomain.Platform]social.Adapter,
+	webhooks socialDomain.WebhookParsers,
+	limiter common.PlatformRateLimiter,
+	tx common.Transactor,
+	events common.EventBus,
+	logger common.Logger,
+) *Syncer {
+	return &Syncer{
+		accountRepo: accountRepo,
+		teamRepo:    teamRepo,
+		cursors:     cursors,
+		adapters:    adapters,
+		webhooks:    webhooks,
+		limiter:     limiter,
+		tx:          tx,
+		events:      events,
+		logger:      logger,
+	}
+}
+
+// SyncAll syncs every active account of the polled platforms whose team
+// has the inbox, and returns how many were synced. A failing account is
+// logged and tried again on the next run.
+func (s *Syncer) SyncAll(ctx context.Context) (int, error) {
+	inboxTeams := make(map[uuid.UUID]bool)
+	synced := 0
+
+	for platform, adapter := range s.adapters {
+		lister, ok := adapter.(social.InteractionLister)
+		if !ok {
+			continue
+		}
+		if _, err := s.webhooks.Get(platform); err == nil {
+			continue // delivered by webhook
+		}
+
+		for offset := 0; ctx.Err() == nil; offset += syncPageSize {
+			accounts, err := s.accountRepo.FindByPlatform(ctx, platform, offset, syncPageSize)
+			if err != nil {
+				return synced, fmt.Errorf("failed to list %s accounts: %w", platform, err)
+			}
+
+			for _, account := range accounts {
+				if ctx.Err() != nil {
+					break
+				}
+				if account.Status() != socialDomain.StatusActive || !s.hasInbox(ctx, inboxTeams, account.TeamID()) {
+					continue
+				}
+				if err := s.syncAccount(ctx, lister, account); err != nil {
+					s.logger.Warn("Inbox sync failed", "accountId", account.ID(), "platform", platform, "error", err)
+					continue
+				}
+				synced++
+			}
+
+			if len(accounts) < syncPageSize {
+				break
+			}
+		}
+	}
+	return synced, nil
+}
+
+// hasInbox reports whether the team's plan has the inbox, once per run
+func (s *Syncer) hasInbox(ctx context.Context, cache map[uuid.UUID]bool, teamID uuid.UUID) bool {
+	if has, ok := cache[teamID]; ok {
+		return has
+	}
+	t, err := s.teamRepo.FindByID(ctx, teamID)
+	has := err == nil && t.HasFeature("inbox")
+	cache[teamID] = has
+	return has
+}
+
+func (s *Syncer) syncAccount(ctx context.Context, lister social.InteractionLister, account *socialDomain.Account) error {
+	startedAt := time.Now().UTC()
+
+	since, err := s.cursors.SyncedUntil(ctx, account.ID())
+	if err != nil {
+		return err
+	}
+	if since.IsZero() {
+		since = startedAt.Add(-syncLookback)
+	} else {
+		since = since.Add(-syncOverlap)
+	}
+
+	if s.limiter != nil {
+		decision, err := s.limiter.Take(ctx, string(account.Platform()), account.ID().String(), common.RateClassRead)
+		if err == nil && !decision.Allowed {
+			s.logger.Debug("Inbox sync skipped, read quota exhausted", "accountId", account.ID())
+			return nil
+		}
+	}
+
+	interactions, err := lister.ListInteractions(ctx, accountToken(account), since)
+	if err != nil {
+		var rateErr *social.RateLimitError
+		if errors.As(err, &rateErr) {
+			_ = social.ObserveRateLimit(ctx, s.limiter, string(account.Platform()), account.ID().String(), common.RateClassRead, rateErr.Limit)
+		}
+		return err
+	}
+
+	// The cursor moves together with the events, so a failed run reads the
+	// same window again
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		for _, i := range interactions {
+			err := s.events.Publish(ctx, socialDomain.NewInteractionReceived(account, socialDomain.PlatformEvent{
+				Kind:           socialDomain.PlatformEventKind(i.Kind),
+				Platform:       account.Platform(),
+				PlatformUserID: account.Credentials().PlatformUserID,
+				ObjectID:       i.ObjectID,
+				ItemID:         i.ItemID,
+				AuthorID:       i.AuthorID,
+				AuthorName:     i.AuthorName,
+				Text:           i.Text,
+				OccurredAt:     i.CreatedAt,
+			}))
+			if err != nil {
+				return err
+			}
+		}
+		return s.cursors.SaveSyncedUntil(ctx, account.ID(), startedAt)
+	})
+}
@@ -0,0 +1,140 @@
+// ============================================================================
+// FILE: backend/internal/application/notification/digest.go
+// PURPOSE: Emailing users their pending notifications in digests
+// ============================================================================
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/notification"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const (
+	digestBatchSize = 50
+	// digestMaxItems bounds one email; the rest wait for the next digest
+	digestMaxItems = 50
+)
+
+// DigestSender emails each user their pending notifications, at most as
+// often as their digest preference allows
+type DigestSender struct {
+	repo     notification.Repository
+	prefRepo notification.PreferenceRepository
+	userRepo user.Repository
+	email    common.EmailService
+	tx       common.Transactor
+	logger   common.Logger
+}
+
+func NewDigestSender(
+	repo notification.Repository,
+	prefRepo notification.PreferenceRepository,
+	userRepo user.Repository,
+	email common.EmailService,
+	tx common.Transactor,
+	logger common.Logger,
+) *DigestSender {
+	return &DigestSender{
+		repo:     repo,
+		prefRepo: prefRepo,
+		userRepo: userRepo,
+		email:    email,
+		tx:       tx,
+		logger:   logger,
+	}
+}
+
+// SendDue emails the digests that are due and returns how many were sent.
+// A failed email leaves its notifications pending for the next run.
+func (s *DigestSender) SendDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	recipients, err := s.repo.DigestRecipients(ctx, now, digestBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, userID := range recipients {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		ok, err := s.send(ctx, userID, now)
+		if err != nil {
+			s.logger.Error("Failed to send notification digest", "userId", userID, "error", err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// send claims the user's pending notifications and emails them in one
+// transaction, so they are only marked emailed once the email went out
+func (s *DigestSender) send(ctx context.Context, userID uuid.UUID, now time.Time) (bool, error) {
+	sent := false
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		prefs, err := s.prefRepo.Find(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !prefs.DigestDue(now) {
+			return nil
+		}
+
+		claimed, err := s.repo.ClaimDigest(ctx, userID, now, digestMaxItems)
+		if err != nil || len(claimed) == 0 {
+			return err
+		}
+
+		// Deleted and deactivated users get no email; their notifications
+		// are dropped from the queue all the same
+		u, err := s.userRepo.FindByID(ctx, userID)
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !u.IsActive() {
+			return nil
+		}
+
+		// Kinds whose email was turned off since are skipped
+		items := make([]common.NotificationDigestItem, 0, len(claimed))
+		for _, n := range claimed {
+			if !prefs.Channels(n.Kind()).Email {
+				continue
+			}
+			content := n.Content()
+			items = append(items, common.NotificationDigestItem{
+				Title: content.Title,
+				Body:  content.Body,
+				Link:  content.Link,
+				At:    n.CreatedAt(),
+			})
+		}
+		if len(items) == 0 {
+			return nil
+		}
+
+		if err := s.email.SendNotificationDigest(ctx, u.Email(), u.FirstName(), items); err != nil {
+			return fmt.Errorf("failed to email digest: %w", err)
+		}
+		prefs.MarkDigestSent(now)
+		if err := s.prefRepo.Save(ctx, prefs); err != nil {
+			return err
+		}
+		sent = true
+		return nil
+	})
+	return sent, err
+}
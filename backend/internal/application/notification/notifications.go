@@ -0,0 +1,248 @@
+// ============================================================================
+// FILE: backend/internal/application/notification/notifications.go
+// PURPOSE: A user's notification list, read state and preferences
+// ============================================================================
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/notification"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// NotificationDTO is a notification as listed in the app
+type NotificationDTO struct {
+	ID        uuid.UUID  `json:"id"`
+	Kind      string     `json:"kind"`
+	TeamID    *uuid.UUID `json:"teamId,omitempty"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	Link      string     `json:"link,omitempty"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func mapNotificationToDTO(n *notification.Notification) NotificationDTO {
+	content := n.Content()
+	dto := NotificationDTO{
+		ID:        n.ID(),
+		Kind:      string(n.Kind()),
+		Title:     content.Title,
+		Body:      content.Body,
+		Link:      content.Link,
+		Read:      n.IsRead(),
+		ReadAt:    n.ReadAt(),
+		CreatedAt: n.CreatedAt(),
+	}
+	if teamID := n.TeamID(); teamID != uuid.Nil {
+		dto.TeamID = &teamID
+	}
+	return dto
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListNotificationsInput struct {
+	UserID     uuid.UUID
+	UnreadOnly bool
+	Before     *time.Time
+	Limit      int
+}
+
+type ListNotificationsOutput struct {
+	Notifications []NotificationDTO `json:"notifications"`
+	UnreadCount   int               `json:"unreadCount"`
+	// NextBefore is the cursor for the next page, nil on the last page
+	NextBefore *time.Time `json:"nextBefore,omitempty"`
+}
+
+type ListNotificationsUseCase struct {
+	repo notification.Repository
+}
+
+func NewListNotificationsUseCase(repo notification.Repository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{repo: repo}
+}
+
+// Execute lists the user's in-app notifications, newest first
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, input ListNotificationsInput) (*ListNotificationsOutput, error) {
+	limit := input.Limit
+	switch {
+	case limit <= 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	notifications, err := uc.repo.List(ctx, notification.Filter{
+		UserID:     input.UserID,
+		UnreadOnly: input.UnreadOnly,
+		Before:     input.Before,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	unread, err := uc.repo.CountUnread(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	output := &ListNotificationsOutput{
+		Notifications: make([]NotificationDTO, 0, len(notifications)),
+		UnreadCount:   unread,
+	}
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		next := notifications[limit-1].CreatedAt()
+		output.NextBefore = &next
+	}
+	for _, n := range notifications {
+		output.Notifications = append(output.Notifications, mapNotificationToDTO(n))
+	}
+	return output, nil
+}
+
+// ============================================================================
+// READ STATE
+// ============================================================================
+
+type MarkNotificationReadInput struct {
+	UserID         uuid.UUID
+	NotificationID uuid.UUID
+}
+
+type MarkNotificationReadUseCase struct {
+	repo   notification.Repository
+	logger common.Logger
+}
+
+func NewMarkNotificationReadUseCase(repo notification.Repository, logger common.Logger) *MarkNotificationReadUseCase {
+	return &MarkNotificationReadUseCase{repo: repo, logger: logger}
+}
+
+// Execute marks one of the user's notifications read
+func (uc *MarkNotificationReadUseCase) Execute(ctx context.Context, input MarkNotificationReadInput) (*NotificationDTO, error) {
+	n, err := uc.repo.Find(ctx, input.NotificationID)
+	if errors.Is(err, notification.ErrNotificationNotFound) || (err == nil && (n.UserID() != input.UserID || !n.InApp())) {
+		return nil, notification.ErrNotificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification: %w", err)
+	}
+
+	if n.MarkRead() {
+		if err := uc.repo.Update(ctx, n); err != nil {
+			uc.logger.Error("Failed to mark notification read", "notificationId", n.ID(), "error", err)
+			return nil, fmt.Errorf("failed to update notification")
+		}
+	}
+
+	dto := mapNotificationToDTO(n)
+	return &dto, nil
+}
+
+type MarkAllNotificationsReadOutput struct {
+	Marked int `json:"marked"`
+}
+
+type MarkAllNotificationsReadUseCase struct {
+	repo notification.Repository
+}
+
+func NewMarkAllNotificationsReadUseCase(repo notification.Repository) *MarkAllNotificationsReadUseCase {
+	return &MarkAllNotificationsReadUseCase{repo: repo}
+}
+
+// Execute marks every unread notification of the user read
+func (uc *MarkAllNotificationsReadUseCase) Execute(ctx context.Context, userID uuid.UUID) (*MarkAllNotificationsReadOutput, error) {
+	marked, err := uc.repo.MarkAllRead(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return &MarkAllNotificationsReadOutput{Marked: marked}, nil
+}
+
+// ============================================================================
+// PREFERENCES
+// ============================================================================
+
+// PreferencesDTO lists the channels of every kind of notification
+type PreferencesDTO struct {
+	Kinds       map[notification.Kind]notification.ChannelSettings `json:"kinds"`
+	EmailDigest notification.Digest                                `json:"emailDigest"`
+	UpdatedAt   time.Time                                          `json:"updatedAt"`
+}
+
+func mapPreferencesToDTO(p *notification.Preferences) *PreferencesDTO {
+	return &PreferencesDTO{Kinds: p.Kinds(), EmailDigest: p.Digest(), UpdatedAt: p.UpdatedAt()}
+}
+
+type GetPreferencesUseCase struct {
+	prefRepo notification.PreferenceRepository
+}
+
+func NewGetPreferencesUseCase(prefRepo notification.PreferenceRepository) *GetPreferencesUseCase {
+	return &GetPreferencesUseCase{prefRepo: prefRepo}
+}
+
+func (uc *GetPreferencesUseCase) Execute(ctx context.Context, userID uuid.UUID) (*PreferencesDTO, error) {
+	prefs, err := uc.prefRepo.Find(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+	return mapPreferencesToDTO(prefs), nil
+}
+
+// UpdatePreferencesInput changes the kinds it names and the digest if set
+type UpdatePreferencesInput struct {
+	UserID      uuid.UUID                                          `json:"-"`
+	Kinds       map[notification.Kind]notification.ChannelSettings `json:"kinds"`
+	EmailDigest *notification.Digest                               `json:"emailDigest"`
+}
+
+type UpdatePreferencesUseCase struct {
+	prefRepo notification.PreferenceRepository
+	logger   common.Logger
+}
+
+func NewUpdatePreferencesUseCase(prefRepo notification.PreferenceRepository, logger common.Logger) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{prefRepo: prefRepo, logger: logger}
+}
+
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, input UpdatePreferencesInput) (*PreferencesDTO, error) {
+	prefs, err := uc.prefRepo.Find(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+
+	for kind, settings := range input.Kinds {
+		if err := prefs.Set(kind, settings); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, kind)
+		}
+	}
+	if input.EmailDigest != nil {
+		if err := prefs.SetDigest(*input.EmailDigest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.prefRepo.Save(ctx, prefs); err != nil {
+		uc.logger.Error("Failed to save notification preferences", "userId", input.UserID, "error", err)
+		return nil, fmt.Errorf("failed to save notification preferences")
+	}
+	return mapPreferencesToDTO(prefs), nil
+}
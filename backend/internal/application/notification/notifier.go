@@ -0,0 +1,382 @@
+// ============================================================================
+// FILE: backend/internal/application/notification/notifier.go
+// PURPOSE: Raises notifications from domain events and scheduled checks
+// ============================================================================
+
+// Package notification raises users' notifications, lists them and emails
+// their digests
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+	"github.com/techappsUT/social-queue/internal/domain/notification"
+	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	// quotaWarningPercent of a plan limit in use warns the team's billing
+	// managers, at most once a day
+	quotaWarningPercent = 80
+	// tokenExpiryWarningDays before an account's token expires its
+	// managers are told to reconnect it
+	tokenExpiryWarningDays = 3
+
+	excerptLength = 80
+)
+
+// EventTypes returns the domain events the notifier handles
+func EventTypes() []string {
+	return []string{
+		postDomain.EventPostFailed,
+		postDomain.EventApprovalRequested,
+		postDomain.EventPostScheduled,
+		team.EventMemberInvited,
+	}
+}
+
+// Notifier decides who is told about what. Teams with notifications
+// disabled in their settings raise none; users only get the kinds and
+// channels their preferences enable.
+type Notifier struct {
+	repo       notification.Repository
+	prefRepo   notification.PreferenceRepository
+	teamRepo   team.Repository
+	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	access     *socialDomain.AccessChecker
+	postRepo   postDomain.Repository
+	socialRepo socialDomain.AccountRepository
+	logger     common.Logger
+}
+
+func NewNotifier(
+	repo notification.Repository,
+	prefRepo notification.PreferenceRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	postRepo postDomain.Repository,
+	socialRepo socialDomain.AccountRepository,
+	logger common.Logger,
+) *Notifier {
+	return &Notifier{
+		repo:       repo,
+		prefRepo:   prefRepo,
+		teamRepo:   teamRepo,
+		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		access:     socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		postRepo:   postRepo,
+		socialRepo: socialRepo,
+		logger:     logger,
+	}
+}
+
+// HandleEvent raises the notifications of one event. Notifications are
+// keyed by the event, so a redelivered event notifies nobody twice.
+func (n *Notifier) HandleEvent(ctx context.Context, e common.Event) error {
+	envelope, ok := e.(*event.Envelope)
+	if !ok {
+		return fmt.Errorf("notifications need an event envelope, got %T", e)
+	}
+
+	switch envelope.EventType {
+	case postDomain.EventPostFailed:
+		return n.postFailed(ctx, envelope)
+	case postDomain.EventApprovalRequested:
+		return n.approvalRequested(ctx, envelope)
+	case postDomain.EventPostScheduled:
+		return n.checkScheduledQuota(ctx, envelope)
+	case team.EventMemberInvited:
+		return n.memberInvited(ctx, envelope)
+	}
+	return nil
+}
+
+// postFailed tells the author their post could not be published
+func (n *Notifier) postFailed(ctx context.Context, envelope *event.Envelope) error {
+	var failed postDomain.PostFailed
+	if err := event.Decode(envelope, &failed); err != nil {
+		return err
+	}
+	t, post, err := n.teamPost(ctx, envelope.Team, failed.PostID)
+	if t == nil || err != nil {
+		return err
+	}
+
+	isMember, err := n.authorizer.Can(ctx, t.ID(), post.CreatedBy(), team.PermPostsView)
+	if err != nil || !isMember {
+		return err
+	}
+
+	_, err = n.notify(ctx, t, notification.KindPostFailed, notification.Content{
+		Title: "A post failed to publish",
+		Body:  fmt.Sprintf("%q could not be published: %s", excerpt(post.Content().Text), failed.Reason),
+		Link:  "/posts/" + post.ID().String(),
+	}, "post_failed:"+envelope.ID.String(), post.CreatedBy())
+	return err
+}
+
+// approvalRequested tells the members who can publish to the post's
+// account that the post waits for their approval
+func (n *Notifier) approvalRequested(ctx context.Context, envelope *event.Envelope) error {
+	var requested postDomain.ApprovalRequested
+	if err := event.Decode(envelope, &requested); err != nil {
+		return err
+	}
+	t, post, err := n.teamPost(ctx, envelope.Team, requested.PostID)
+	if t == nil || err != nil {
+		return err
+	}
+
+	approvers, err := n.membersWith(ctx, t.ID(), team.PermPostsPublish, requested.RequestedBy)
+	if err != nil {
+		return err
+	}
+	approvers, err = n.withAccountAccess(ctx, t.ID(), post.SocialAccountID(), socialDomain.AccessPublish, approvers)
+	if err != nil {
+		return err
+	}
+	_, err = n.notify(ctx, t, notification.KindApprovalRequested, notification.Content{
+		Title: "A post is waiting for approval",
+		Body:  excerpt(post.Content().Text),
+		Link:  "/posts/" + post.ID().String(),
+	}, "approval_requested:"+envelope.ID.String(), approvers...)
+	return err
+}
+
+// checkScheduledQuota warns the billing managers when the team has almost
+// used up the scheduled posts its plan allows
+func (n *Notifier) checkScheduledQuota(ctx context.Context, envelope *event.Envelope) error {
+	t, err := n.team(ctx, envelope.Team)
+	if t == nil || err != nil {
+		return err
+	}
+
+	limit := t.Limits().MaxScheduledPosts
+	if limit <= 0 {
+		return nil // unlimited
+	}
+	scheduled, err := n.postRepo.CountScheduledByTeam(ctx, t.ID())
+	if err != nil {
+		return err
+	}
+	if int(scheduled)*100 < limit*quotaWarningPercent {
+		return nil
+	}
+
+	managers, err := n.membersWith(ctx, t.ID(), team.PermBillingManage, uuid.Nil)
+	if err != nil {
+		return err
+	}
+	day := time.Now().UTC().Format("2006-01-02")
+	_, err = n.notify(ctx, t, notification.KindQuotaNearLimit, notification.Content{
+		Title: "Scheduled posts are almost at the plan limit",
+		Body:  fmt.Sprintf("%s has %d of %d scheduled posts. Upgrade the plan to schedule more.", t.Name(), scheduled, limit),
+		Link:  "/settings/billing",
+	}, "quota_near_limit:scheduled_posts:"+t.ID().String()+":"+day, managers...)
+	return err
+}
+
+// memberInvited tells the invited user about the invitation
+func (n *Notifier) memberInvited(ctx context.Context, envelope *event.Envelope) error {
+	var invited team.MemberInvited
+	if err := event.Decode(envelope, &invited); err != nil {
+		return err
+	}
+	t, err := n.team(ctx, envelope.Team)
+	if t == nil || err != nil {
+		return err
+	}
+
+	_, err = n.notify(ctx, t, notification.KindInviteReceived, notification.Content{
+		Title: fmt.Sprintf("You're invited to join %s", t.Name()),
+		Body:  "Accept the invitation to start working with the team.",
+		Link:  "/invitations",
+	}, "invite_received:"+envelope.ID.String(), invited.UserID)
+	return err
+}
+
+// NotifyExpiringTokens tells the members managing accounts about tokens
+// that expire soon, once per token, and returns how many notifications
+// were raised
+func (n *Notifier) NotifyExpiringTokens(ctx context.Context) (int, error) {
+	accounts, err := n.socialRepo.FindExpiringAccounts(ctx, tokenExpiryWarningDays)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expiring accounts: %w", err)
+	}
+
+	total := 0
+	for _, account := range accounts {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+		expiresAt := account.ExpiresAt()
+		if expiresAt == nil {
+			continue
+		}
+		t, err := n.team(ctx, account.TeamID())
+		if err != nil {
+			return total, err
+		}
+		if t == nil {
+			continue
+		}
+
+		managers, err := n.membersWith(ctx, t.ID(), team.PermAccountsManage, uuid.Nil)
+		if err != nil {
+			return total, err
+		}
+		managers, err = n.withAccountAccess(ctx, t.ID(), account.ID(), socialDomain.AccessAnalytics, managers)
+		if err != nil {
+			return total, err
+		}
+		name := accountName(account)
+		created, err := n.notify(ctx, t, notification.KindTokenExpiring, notification.Content{
+			Title: fmt.Sprintf("Reconnect %s before its access expires", name),
+			Body: fmt.Sprintf("The %s connection of %s expires on %s. Reconnect it to keep publishing.",
+				account.Platform(), name, expiresAt.UTC().Format("Jan 2, 2006 15:04 MST")),
+			Link: "/accounts",
+		}, fmt.Sprintf("token_expiring:%s:%d", account.ID(), expiresAt.Unix()), managers...)
+		total += created
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// notify raises a notification for each user and returns how many were
+// new
+func (n *Notifier) notify(ctx context.Context, t *team.Team, kind notification.Kind, content notification.Content, dedupeKey string, userIDs ...uuid.UUID) (int, error) {
+	if !t.Settings().EnableNotifications {
+		return 0, nil
+	}
+
+	created := 0
+	for _, userID := range userIDs {
+		prefs, err := n.prefRepo.Find(ctx, userID)
+		if err != nil {
+			return created, err
+		}
+		note, err := notification.New(userID, t.ID(), kind, content, dedupeKey, prefs)
+		if errors.Is(err, notification.ErrMuted) {
+			continue
+		}
+		if err != nil {
+			return created, err
+		}
+
+		isNew, err := n.repo.Create(ctx, note)
+		if err != nil {
+			return created, err
+		}
+		if isNew {
+			created++
+			n.logger.Debug("Notification raised", "kind", kind, "userId", userID, "teamId", t.ID())
+		}
+	}
+	return created, nil
+}
+
+// team returns nil for deleted teams, whose events are dropped
+func (n *Notifier) team(ctx context.Context, teamID uuid.UUID) (*team.Team, error) {
+	if teamID == uuid.Nil {
+		return nil, nil
+	}
+	t, err := n.teamRepo.FindByID(ctx, teamID)
+	if errors.Is(err, team.ErrTeamNotFound) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// teamPost returns a nil team if the team or the post is gone
+func (n *Notifier) teamPost(ctx context.Context, teamID, postID uuid.UUID) (*team.Team, *postDomain.Post, error) {
+	t, err := n.team(ctx, teamID)
+	if t == nil || err != nil {
+		return nil, nil, err
+	}
+	post, err := n.postRepo.FindByID(ctx, postID)
+	if errors.Is(err, postDomain.ErrPostNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, post, nil
+}
+
+// membersWith returns the team's active members holding a permission, but
+// the one excluded
+func (n *Notifier) membersWith(ctx context.Context, teamID uuid.UUID, permission team.Permission, exclude uuid.UUID) ([]uuid.UUID, error) {
+	members, err := n.memberRepo.FindTeamMembers(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	var userIDs []uuid.UUID
+	for _, m := range members {
+		if m.UserID() == exclude || !m.IsActive() {
+			continue
+		}
+		allowed, err := n.authorizer.Can(ctx, teamID, m.UserID(), permission)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			userIDs = append(userIDs, m.UserID())
+		}
+	}
+	return userIDs, nil
+}
+
+// withAccountAccess keeps the users whose account access allows the level
+// on the account; restricted members don't hear about other accounts
+func (n *Notifier) withAccountAccess(ctx context.Context, teamID, accountID uuid.UUID, level socialDomain.AccessLevel, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var allowed []uuid.UUID
+	for _, userID := range userIDs {
+		access, err := n.access.Resolve(ctx, teamID, userID)
+		if errors.Is(err, team.ErrMemberNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve account access: %w", err)
+		}
+		if access.Allows(accountID, level) {
+			allowed = append(allowed, userID)
+		}
+	}
+	return allowed, nil
+}
+
+func accountName(a *socialDomain.Account) string {
+	if a.DisplayName() != "" {
+		return a.DisplayName()
+	}
+	if a.Username() != "" {
+		return "@" + a.Username()
+	}
+	return string(a.Platform())
+}
+
+// excerpt shortens post text to one line for a notification
+func excerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= excerptLength {
+		return text
+	}
+	return string([]rune(text)[:excerptLength-1]) + "…"
+}
@@ -0,0 +1,78 @@
+// ============================================================================
+// FILE: backend/internal/application/post/access.go
+// PURPOSE: Team permission checks for post use cases
+// ============================================================================
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// teamRole resolves the user's role, refusing anyone who is not an active
+// member
+func teamRole(ctx context.Context, authorizer *team.Authorizer, teamID, userID uuid.UUID) (*team.Role, error) {
+	role, err := authorizer.Role(ctx, teamID, userID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	return role, nil
+}
+
+// requireTeamPermission checks one permission in the team
+func requireTeamPermission(ctx context.Context, authorizer *team.Authorizer, teamID, userID uuid.UUID, permission team.Permission) error {
+	role, err := teamRole(ctx, authorizer, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.Allows(permission) {
+		return fmt.Errorf("access denied: %s permission required", permission)
+	}
+	return nil
+}
+
+// canManagePost lets authors who may still create posts act on their own
+// posts; anyone else needs the permission over all of the team's posts
+func canManagePost(ctx context.Context, authorizer *team.Authorizer, p *postDomain.Post, userID uuid.UUID, permission team.Permission) (bool, error) {
+	role, err := teamRole(ctx, authorizer, p.TeamID(), userID)
+	if err != nil {
+		return false, err
+	}
+	if p.CreatedBy() == userID && role.Allows(team.PermPostsCreate) {
+		return true, nil
+	}
+	return role.Allows(permission), nil
+}
+
+// requireAccountAccess checks the user may work with the post's social
+// account at the level. Restricted members must always target one of their
+// granted accounts.
+func requireAccountAccess(ctx context.Context, checker *socialDomain.AccessChecker, teamID, accountID, userID uuid.UUID, level socialDomain.AccessLevel) error {
+	access, err := checker.Resolve(ctx, teamID, userID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check account access: %w", err)
+	}
+
+	if accountID == uuid.Nil {
+		if access.IsRestricted() {
+			return socialDomain.ErrAccountRequired
+		}
+		return nil
+	}
+	if !access.Allows(accountID, level) {
+		return socialDomain.ErrAccountAccessDenied
+	}
+	return nil
+}
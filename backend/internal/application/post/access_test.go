@@ -0,0 +1,241 @@
+// path: backend/internal/application/post/access_test.go
+package post
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memoryPosts struct {
+	postDomain.Repository
+	posts map[uuid.UUID]*postDomain.Post
+}
+
+func (r *memoryPosts) FindByID(ctx context.Context, id uuid.UUID) (*postDomain.Post, error) {
+	if p, ok := r.posts[id]; ok {
+		return p, nil
+	}
+	return nil, postDomain.ErrPostNotFound
+}
+
+func (r *memoryPosts) Create(ctx context.Context, p *postDomain.Post) error {
+	r.posts[p.ID()] = p
+	return nil
+}
+
+func (r *memoryPosts) Update(ctx context.Context, p *postDomain.Post) error {
+	r.posts[p.ID()] = p
+	return nil
+}
+
+func (r *memoryPosts) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(r.posts, id)
+	return nil
+}
+
+type teamAccounts struct {
+	socialDomain.AccountRepository
+	teamID uuid.UUID
+}
+
+func (r teamAccounts) FindByID(ctx context.Context, id uuid.UUID) (*socialDomain.Account, error) {
+	now := time.Now()
+	return socialDomain.Reconstruct(id, r.teamID, uuid.New(), socialDomain.PlatformLinkedIn, socialDomain.AccountType("profile"),
+		"", "", "", "", socialDomain.Credentials{}, socialDomain.AccountMetadata{}, socialDomain.StatusActive,
+		socialDomain.RateLimits{}, nil, now, nil, now, now, nil), nil
+}
+
+type editors struct {
+	team.MemberRepository
+}
+
+func (editors) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	joined := time.Now()
+	return team.ReconstructMember(uuid.New(), teamID, userID, team.MemberRoleEditor, team.MemberStatusActive, userID, joined, &joined, nil), nil
+}
+
+type editorPosts struct {
+	team.RoleRepository
+}
+
+func (editorPosts) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	return team.ReconstructRole(uuid.New(), teamID, name, "", []team.Permission{"posts.*", team.PermAccountsView}, true, time.Now(), time.Now()), nil
+}
+
+// grantsByUser restricts the listed users; everyone else is unrestricted
+type grantsByUser struct {
+	access map[uuid.UUID]*socialDomain.AccountAccess
+}
+
+func (r grantsByUser) FindAccess(ctx context.Context, teamID, userID uuid.UUID) (*socialDomain.AccountAccess, error) {
+	if a, ok := r.access[userID]; ok {
+		return a, nil
+	}
+	return socialDomain.ReconstructAccountAccess(teamID, userID, uuid.Nil, false, nil, time.Time{}), nil
+}
+
+func (r grantsByUser) SaveAccess(ctx context.Context, a *socialDomain.AccountAccess) error {
+	r.access[a.UserID()] = a
+	return nil
+}
+
+type inlineTx struct{}
+
+func (inlineTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type discardEvents struct{}
+
+func (discardEvents) Publish(ctx context.Context, e common.Event) error             { return nil }
+func (discardEvents) Subscribe(eventType string, handler common.EventHandler) error { return nil }
+
+// accessFixture has one editor restricted to drafting on one account and
+// publishing on another
+type accessFixture struct {
+	teamID, writer           uuid.UUID
+	drafts, publishes, other uuid.UUID
+	posts                    *memoryPosts
+	access                   grantsByUser
+}
+
+func newAccessFixture(t *testing.T) *accessFixture {
+	t.Helper()
+
+	f := &accessFixture{
+		teamID: uuid.New(), writer: uuid.New(),
+		drafts: uuid.New(), publishes: uuid.New(), other: uuid.New(),
+		posts:  &memoryPosts{posts: map[uuid.UUID]*postDomain.Post{}},
+		access: grantsByUser{access: map[uuid.UUID]*socialDomain.AccountAccess{}},
+	}
+	access, err := socialDomain.NewAccountAccess(f.teamID, f.writer, uuid.New(), true, []socialDomain.AccountGrant{
+		{AccountID: f.drafts, Level: socialDomain.AccessDraft},
+		{AccountID: f.publishes, Level: socialDomain.AccessPublish},
+	})
+	if err != nil {
+		t.Fatalf("NewAccountAccess: %v", err)
+	}
+	f.access.access[f.writer] = access
+	return f
+}
+
+// addPost stores a post by the writer on the account
+func (f *accessFixture) addPost(accountID uuid.UUID, status postDomain.Status) uuid.UUID {
+	now := time.Now()
+	var scheduled *time.Time
+	if status == postDomain.StatusScheduled {
+		at := now.Add(time.Hour)
+		scheduled = &at
+	}
+	p := postDomain.Reconstruct(uuid.New(), f.teamID, f.writer, accountID, postDomain.Content{Text: "Launch day"},
+		[]postDomain.Platform{postDomain.PlatformLinkedIn}, scheduled, nil, status, postDomain.Priority(0),
+		postDomain.Metadata{}, nil, now, now, nil)
+	f.posts.posts[p.ID()] = p
+	return p.ID()
+}
+
+func TestCreateDraftAccountAccess(t *testing.T) {
+	f := newAccessFixture(t)
+	uc := NewCreateDraftUseCase(f.posts, nil, teamAccounts{teamID: f.teamID}, editors{}, editorPosts{}, f.access, services.NewLogger())
+	ctx := context.Background()
+	input := func(accountID *uuid.UUID) CreateDraftInput {
+		return CreateDraftInput{TeamID: f.teamID, AuthorID: f.writer, Content: "Launch day",
+			Platforms: []postDomain.Platform{postDomain.PlatformLinkedIn}, SocialAccountID: accountID}
+	}
+
+	if _, err := uc.Execute(ctx, input(&f.drafts)); err != nil {
+		t.Errorf("granted account: %v", err)
+	}
+	if _, err := uc.Execute(ctx, input(&f.other)); !errors.Is(err, socialDomain.ErrAccountAccessDenied) {
+		t.Errorf("other account: err = %v, want ErrAccountAccessDenied", err)
+	}
+	if _, err := uc.Execute(ctx, input(nil)); !errors.Is(err, socialDomain.ErrAccountRequired) {
+		t.Errorf("no account: err = %v, want ErrAccountRequired", err)
+	}
+	if len(f.posts.posts) != 1 {
+		t.Errorf("saved %d drafts, want 1", len(f.posts.posts))
+	}
+
+	// Unrestricted members may leave the account for later
+	out, err := uc.Execute(ctx, CreateDraftInput{TeamID: f.teamID, AuthorID: uuid.New(), Content: "Launch day",
+		Platforms: []postDomain.Platform{postDomain.PlatformLinkedIn}})
+	if err != nil || out.Post == nil {
+		t.Errorf("unrestricted member: %v", err)
+	}
+}
+
+func TestPostUseCasesAccountAccess(t *testing.T) {
+	f := newAccessFixture(t)
+	logger := services.NewLogger()
+	ctx := context.Background()
+
+	schedule := NewSchedulePostUseCase(f.posts, editors{}, editorPosts{}, f.access, inlineTx{}, discardEvents{}, logger)
+	publish := NewPublishNowUseCase(f.posts, editors{}, editorPosts{}, f.access, nil, logger)
+	update := NewUpdatePostUseCase(f.posts, editors{}, editorPosts{}, f.access, logger)
+	remove := NewDeletePostUseCase(f.posts, editors{}, editorPosts{}, f.access, nil, logger)
+
+	run := map[string]func(postID uuid.UUID) error{
+		"schedule": func(postID uuid.UUID) error {
+			_, err := schedule.Execute(ctx, SchedulePostInput{PostID: postID, UserID: f.writer, ScheduledAt: time.Now().Add(time.Hour)})
+			return err
+		},
+		"publish": func(postID uuid.UUID) error {
+			_, err := publish.Execute(ctx, PublishNowInput{PostID: postID, UserID: f.writer})
+			return err
+		},
+		"update": func(postID uuid.UUID) error {
+			text := "Launch week"
+			_, err := update.Execute(ctx, UpdatePostInput{PostID: postID, UserID: f.writer, Content: &text})
+			return err
+		},
+		"delete": func(postID uuid.UUID) error {
+			return remove.Execute(ctx, DeletePostInput{PostID: postID, UserID: f.writer})
+		},
+	}
+
+	tests := []struct {
+		useCase string
+		account uuid.UUID
+		status  postDomain.Status
+		want    error
+	}{
+		{"schedule", f.publishes, postDomain.StatusDraft, nil},
+		{"schedule", f.drafts, postDomain.StatusDraft, socialDomain.ErrAccountAccessDenied},
+		{"schedule", uuid.Nil, postDomain.StatusDraft, socialDomain.ErrAccountRequired},
+		{"publish", f.publishes, postDomain.StatusScheduled, nil},
+		{"publish", f.drafts, postDomain.StatusScheduled, socialDomain.ErrAccountAccessDenied},
+		{"publish", uuid.Nil, postDomain.StatusScheduled, socialDomain.ErrAccountRequired},
+		{"update", f.drafts, postDomain.StatusDraft, nil},
+		{"update", f.other, postDomain.StatusDraft, socialDomain.ErrAccountAccessDenied},
+		{"update", uuid.Nil, postDomain.StatusDraft, socialDomain.ErrAccountRequired},
+		{"delete", f.drafts, postDomain.StatusDraft, nil},
+		{"delete", f.other, postDomain.StatusDraft, socialDomain.ErrAccountAccessDenied},
+		{"delete", uuid.Nil, postDomain.StatusDraft, socialDomain.ErrAccountRequired},
+	}
+	for _, tt := range tests {
+		postID := f.addPost(tt.account, tt.status)
+		status, text := f.posts.posts[postID].Status(), f.posts.posts[postID].Content().Text
+		err := run[tt.useCase](postID)
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("%s on a granted account: %v", tt.useCase, err)
+			}
+			continue
+		}
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s on %s: err = %v, want %v", tt.useCase, tt.account, err, tt.want)
+		}
+		if after, ok := f.posts.posts[postID]; !ok || after.Status() != status || after.Content().Text != text {
+			t.Errorf("%s on %s: post changed after the request was refused", tt.useCase, tt.account)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
@@ -19,6 +20,10 @@ type CreateDraftInput struct {
 	Content     string                `json:"content" validate:"required"`
 	Platforms   []postDomain.Platform `json:"platforms" validate:"required,min=1"`
 	Attachments []string              `json:"attachments,omitempty"`
+
+	// SocialAccountID is the account the post goes out on; required for
+	// members restricted to specific accounts
+	SocialAccountID *uuid.UUID `json:"socialAccountId,omitempty"`
 }
 
 type CreateDraftOutput struct {
@@ -28,32 +33,35 @@ type CreateDraftOutput struct {
 type CreateDraftUseCase struct {
 	postRepo   postDomain.Repository
 	teamRepo   team.Repository
-	memberRepo team.MemberRepository
+	socialRepo socialDomain.AccountRepository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
 	logger     common.Logger
 }
 
 func NewCreateDraftUseCase(
 	postRepo postDomain.Repository,
 	teamRepo team.Repository,
+	socialRepo socialDomain.AccountRepository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
 	logger common.Logger,
 ) *CreateDraftUseCase {
 	return &CreateDraftUseCase{
 		postRepo:   postRepo,
 		teamRepo:   teamRepo,
-		memberRepo: memberRepo,
+		socialRepo: socialRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
 		logger:     logger,
 	}
 }
 
 func (uc *CreateDraftUseCase) Execute(ctx context.Context, input CreateDraftInput) (*CreateDraftOutput, error) {
-	// 1. Validate author is team member
-	isMember, err := uc.memberRepo.IsMember(ctx, input.TeamID, input.AuthorID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check membership: %w", err)
-	}
-	if !isMember {
-		return nil, fmt.Errorf("author is not a team member")
+	// 1. Validate author can create posts in the team
+	if err := requireTeamPermission(ctx, uc.authorizer, input.TeamID, input.AuthorID, team.PermPostsCreate); err != nil {
+		return nil, err
 	}
 
 	// 2. Validate content
@@ -84,7 +92,22 @@ func (uc *CreateDraftUseCase) Execute(ctx context.Context, input CreateDraftInpu
 		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
 
-	// 6. Save to repository
+	// 6. Target account, which restricted members must have been granted
+	accountID := uuid.Nil
+	if input.SocialAccountID != nil {
+		accountID = *input.SocialAccountID
+		if err := uc.checkAccountInTeam(ctx, input.TeamID, accountID); err != nil {
+			return nil, err
+		}
+		if err := post.AssignSocialAccount(accountID); err != nil {
+			return nil, err
+		}
+	}
+	if err := requireAccountAccess(ctx, uc.checker, input.TeamID, accountID, input.AuthorID, socialDomain.AccessDraft); err != nil {
+		return nil, err
+	}
+
+	// 7. Save to repository
 	if err := uc.postRepo.Create(ctx, post); err != nil {
 		uc.logger.Error("Failed to create draft", "error", err)
 		return nil, fmt.Errorf("failed to save post")
@@ -97,6 +120,17 @@ func (uc *CreateDraftUseCase) Execute(ctx context.Context, input CreateDraftInpu
 	}, nil
 }
 
+func (uc *CreateDraftUseCase) checkAccountInTeam(ctx context.Context, teamID, accountID uuid.UUID) error {
+	if uc.socialRepo == nil {
+		return fmt.Errorf("social accounts are not available")
+	}
+	account, err := uc.socialRepo.FindByID(ctx, accountID)
+	if err != nil || account.TeamID() != teamID {
+		return socialDomain.ErrAccountNotInTeam
+	}
+	return nil
+}
+
 func isValidPlatform(p postDomain.Platform) bool {
 	validPlatforms := []postDomain.Platform{
 		postDomain.PlatformTwitter,
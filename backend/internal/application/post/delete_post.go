@@ -8,8 +8,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
@@ -20,18 +23,25 @@ type DeletePostInput struct {
 
 type DeletePostUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+	recorder   *auditlog.Recorder
 	logger     common.Logger
 }
 
 func NewDeletePostUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *DeletePostUseCase {
 	return &DeletePostUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		recorder:   recorder,
 		logger:     logger,
 	}
 }
@@ -44,19 +54,20 @@ func (uc *DeletePostUseCase) Execute(ctx context.Context, input DeletePostInput)
 	}
 
 	// 2. Check authorization
-	member, err := uc.memberRepo.FindMember(ctx, post.TeamID(), input.UserID)
+	canDelete, err := canManagePost(ctx, uc.authorizer, post, input.UserID, team.PermPostsDelete)
 	if err != nil {
-		return fmt.Errorf("access denied: not a team member")
+		return err
 	}
 
-	canDelete := post.CreatedBy() == input.UserID ||
-		member.Role() == team.MemberRoleOwner ||
-		member.Role() == team.MemberRoleAdmin
-
 	if !canDelete {
 		return fmt.Errorf("access denied: cannot delete this post")
 	}
 
+	// Restricted members only work with their granted accounts
+	if err := requireAccountAccess(ctx, uc.checker, post.TeamID(), post.SocialAccountID(), input.UserID, socialDomain.AccessDraft); err != nil {
+		return err
+	}
+
 	// 3. Cancel if scheduled
 	if post.IsScheduled() {
 		if err := post.Cancel(); err != nil {
@@ -73,6 +84,9 @@ func (uc *DeletePostUseCase) Execute(ctx context.Context, input DeletePostInput)
 		return fmt.Errorf("failed to delete post")
 	}
 
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, post.TeamID(), input.UserID, audit.ActionPostDeleted, audit.TargetPost, input.PostID.String()).
+		WithMetadata("status", string(post.Status())))
+
 	uc.logger.Info("Post deleted", "postId", input.PostID)
 
 	return nil
@@ -11,17 +11,18 @@ import (
 )
 
 type PostDTO struct {
-	ID          uuid.UUID  `json:"id"`
-	TeamID      uuid.UUID  `json:"teamId"`
-	CreatedBy   uuid.UUID  `json:"createdBy"`
-	Content     string     `json:"content"`
-	Platforms   []string   `json:"platforms"`
-	MediaURLs   []string   `json:"mediaUrls,omitempty"`
-	Status      string     `json:"status"`
-	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
-	PublishedAt *time.Time `json:"publishedAt,omitempty"`
-	CreatedAt   time.Time  `json:"createdAt"`
-	UpdatedAt   time.Time  `json:"updatedAt"`
+	ID              uuid.UUID  `json:"id"`
+	TeamID          uuid.UUID  `json:"teamId"`
+	CreatedBy       uuid.UUID  `json:"createdBy"`
+	Content         string     `json:"content"`
+	Platforms       []string   `json:"platforms"`
+	SocialAccountID *uuid.UUID `json:"socialAccountId,omitempty"`
+	MediaURLs       []string   `json:"mediaUrls,omitempty"`
+	Status          string     `json:"status"`
+	ScheduledAt     *time.Time `json:"scheduledAt,omitempty"`
+	PublishedAt     *time.Time `json:"publishedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
 }
 
 func MapPostToDTO(p *postDomain.Post) *PostDTO {
@@ -34,17 +35,24 @@ func MapPostToDTO(p *postDomain.Post) *PostDTO {
 		platforms = append(platforms, string(platform))
 	}
 
+	var accountID *uuid.UUID
+	if p.SocialAccountID() != uuid.Nil {
+		id := p.SocialAccountID()
+		accountID = &id
+	}
+
 	return &PostDTO{
-		ID:          p.ID(),
-		TeamID:      p.TeamID(),
-		CreatedBy:   p.CreatedBy(),
-		Content:     p.Content().Text,
-		Platforms:   platforms,
-		MediaURLs:   p.Content().MediaURLs,
-		Status:      string(p.Status()),
-		ScheduledAt: p.ScheduleTime(),
-		PublishedAt: p.PublishedAt(),
-		CreatedAt:   p.CreatedAt(),
-		UpdatedAt:   p.UpdatedAt(),
+		ID:              p.ID(),
+		SocialAccountID: accountID,
+		TeamID:          p.TeamID(),
+		CreatedBy:       p.CreatedBy(),
+		Content:         p.Content().Text,
+		Platforms:       platforms,
+		MediaURLs:       p.Content().MediaURLs,
+		Status:          string(p.Status()),
+		ScheduledAt:     p.ScheduleTime(),
+		PublishedAt:     p.PublishedAt(),
+		CreatedAt:       p.CreatedAt(),
+		UpdatedAt:       p.UpdatedAt(),
 	}
 }
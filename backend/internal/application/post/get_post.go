@@ -5,7 +5,6 @@ package post
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
@@ -24,18 +23,19 @@ type GetPostOutput struct {
 
 type GetPostUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
 	logger     common.Logger
 }
 
 func NewGetPostUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
 	logger common.Logger,
 ) *GetPostUseCase {
 	return &GetPostUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
 		logger:     logger,
 	}
 }
@@ -47,13 +47,9 @@ func (uc *GetPostUseCase) Execute(ctx context.Context, input GetPostInput) (*Get
 		return nil, postDomain.ErrPostNotFound
 	}
 
-	// 2. Check user is team member
-	isMember, err := uc.memberRepo.IsMember(ctx, post.TeamID(), input.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check membership: %w", err)
-	}
-	if !isMember {
-		return nil, fmt.Errorf("access denied: not a team member")
+	// 2. Check user can view the team's posts
+	if err := requireTeamPermission(ctx, uc.authorizer, post.TeamID(), input.UserID, team.PermPostsView); err != nil {
+		return nil, err
 	}
 
 	return &GetPostOutput{
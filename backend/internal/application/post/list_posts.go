@@ -28,30 +28,27 @@ type ListPostsOutput struct {
 
 type ListPostsUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
 	logger     common.Logger
 }
 
 func NewListPostsUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
 	logger common.Logger,
 ) *ListPostsUseCase {
 	return &ListPostsUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
 		logger:     logger,
 	}
 }
 
 func (uc *ListPostsUseCase) Execute(ctx context.Context, input ListPostsInput) (*ListPostsOutput, error) {
-	// 1. Check user is team member
-	isMember, err := uc.memberRepo.IsMember(ctx, input.TeamID, input.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check membership: %w", err)
-	}
-	if !isMember {
-		return nil, fmt.Errorf("access denied: not a team member")
+	// 1. Check user can view the team's posts
+	if err := requireTeamPermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermPostsView); err != nil {
+		return nil, err
 	}
 
 	// 2. Set default pagination
@@ -60,7 +57,10 @@ func (uc *ListPostsUseCase) Execute(ctx context.Context, input ListPostsInput) (
 	}
 
 	// 3. Get posts
-	var posts []*postDomain.Post
+	var (
+		posts []*postDomain.Post
+		err   error
+	)
 	if input.Status != nil {
 		posts, err = uc.postRepo.FindByStatus(ctx, *input.Status, input.Offset, input.Limit)
 	} else {
@@ -8,8 +8,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
@@ -24,18 +27,25 @@ type PublishNowOutput struct {
 
 type PublishNowUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+	recorder   *auditlog.Recorder
 	logger     common.Logger
 }
 
 func NewPublishNowUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *PublishNowUseCase {
 	return &PublishNowUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		recorder:   recorder,
 		logger:     logger,
 	}
 }
@@ -48,19 +58,20 @@ func (uc *PublishNowUseCase) Execute(ctx context.Context, input PublishNowInput)
 	}
 
 	// 2. Check authorization
-	member, err := uc.memberRepo.FindMember(ctx, post.TeamID(), input.UserID)
+	canPublish, err := canManagePost(ctx, uc.authorizer, post, input.UserID, team.PermPostsPublish)
 	if err != nil {
-		return nil, fmt.Errorf("access denied: not a team member")
+		return nil, err
 	}
 
-	canPublish := post.CreatedBy() == input.UserID ||
-		member.Role() == team.MemberRoleOwner ||
-		member.Role() == team.MemberRoleAdmin
-
 	if !canPublish {
 		return nil, fmt.Errorf("access denied: cannot publish this post")
 	}
 
+	// Restricted members only work with their granted accounts
+	if err := requireAccountAccess(ctx, uc.checker, post.TeamID(), post.SocialAccountID(), input.UserID, socialDomain.AccessPublish); err != nil {
+		return nil, err
+	}
+
 	// 3. Validate post can be published
 	if post.Status() == postDomain.StatusPublished {
 		return nil, fmt.Errorf("post is already published")
@@ -81,6 +92,9 @@ func (uc *PublishNowUseCase) Execute(ctx context.Context, input PublishNowInput)
 		return nil, fmt.Errorf("failed to queue post")
 	}
 
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, post.TeamID(), input.UserID, audit.ActionPostPublished, audit.TargetPost, input.PostID.String()).
+		WithMetadata("mode", "immediate"))
+
 	uc.logger.Info("Post queued for immediate publishing", "postId", input.PostID)
 
 	return &PublishNowOutput{
@@ -0,0 +1,397 @@
+// ============================================================================
+// FILE: backend/internal/application/post/publish_to_platform.go
+// PURPOSE: Idempotent publishing of a post to its target social account
+// ============================================================================
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+const (
+	// Window for warning about the same content on the same account
+	duplicateWindow = 24 * time.Hour
+
+	// Slack for clock differences when reading back platform posts
+	reconcileClockSkew = 2 * time.Minute
+
+	// Delay before retrying a post after a platform error or timeout
+	outageRetryDelay = time.Minute
+
+	// How long a post whose last attempt can't be checked is held for
+	// someone to verify it on the platform before it is looked at again
+	unknownOutcomeHold = 24 * time.Hour
+)
+
+// DuplicateFinder is the part of postDomain.AdvancedRepository used for
+// duplicate content warnings
+type DuplicateFinder interface {
+	FindDuplicates(ctx context.Context, teamID uuid.UUID, content string, timeWindow time.Duration) ([]*postDomain.Post, error)
+}
+
+type PublishToPlatformInput struct {
+	Post *postDomain.Post
+}
+
+type PublishToPlatformOutput struct {
+	PlatformPostID string `json:"platformPostId"`
+	URL            string `json:"url"`
+	// Reconciled is true when an earlier interrupted attempt was found on
+	// the platform (or already recorded) and nothing new was posted
+	Reconciled bool `json:"reconciled"`
+}
+
+// PublishToPlatformUseCase publishes a post at most once per target account.
+// An idempotency key is persisted before calling the platform. Only an
+// attempt the platform rejected is posted again as is; on retry a pending or
+// unknown attempt is reconciled against the platform's recent posts. On
+// platforms that can't list them the post is held, not failed, so it can be
+// checked on the platform and canceled if it already went out.
+type PublishToPlatformUseCase struct {
+	attemptRepo postDomain.PublishAttemptRepository
+	duplicates  DuplicateFinder
+	socialRepo  socialDomain.AccountRepository
+	adapters    map[socialDomain.Platform]social.Adapter
+	limiter     common.PlatformRateLimiter
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewPublishToPlatformUseCase(
+	attemptRepo postDomain.PublishAttemptRepository,
+	duplicates DuplicateFinder,
+	socialRepo socialDomain.AccountRepository,
+	adapters map[socialDomain.Platform]social.Adapter,
+	limiter common.PlatformRateLimiter,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *PublishToPlatformUseCase {
+	return &PublishToPlatformUseCase{
+		attemptRepo: attemptRepo,
+		duplicates:  duplicates,
+		socialRepo:  socialRepo,
+		adapters:    adapters,
+		limiter:     limiter,
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+func (uc *PublishToPlatformUseCase) Execute(ctx context.Context, input PublishToPlatformInput) (*PublishToPlatformOutput, error) {
+	post := input.Post
+	text := post.Content().Text
+
+	// 1. Resolve target account and adapter
+	if post.SocialAccountID() == uuid.Nil {
+		return nil, postDomain.ErrPlatformNotConnected
+	}
+
+	account, err := uc.socialRepo.FindByID(ctx, post.SocialAccountID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load social account: %w", err)
+	}
+
+	if account.Status() != socialDomain.StatusActive {
+		return nil, postDomain.ErrAccountDisconnected
+	}
+
+	adapter, ok := uc.adapters[account.Platform()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported platform %s", account.Platform())
+	}
+
+	credentials := account.Credentials()
+	token := &social.Token{
+		AccessToken:    credentials.AccessToken,
+		RefreshToken:   credentials.RefreshToken,
+		ExpiresAt:      credentials.ExpiresAt,
+		Scopes:         credentials.Scope,
+		PlatformUserID: credentials.PlatformUserID,
+	}
+
+	// 2. Look up a previous attempt for this (post, account)
+	key := postDomain.IdempotencyKey(post.ID(), account.ID())
+	attempt, err := uc.attemptRepo.FindByKey(ctx, key)
+	if err != nil && !errors.Is(err, postDomain.ErrPublishAttemptNotFound) {
+		return nil, err
+	}
+
+	if attempt != nil {
+		if attempt.Status() == postDomain.AttemptSucceeded {
+			return &PublishToPlatformOutput{
+				PlatformPostID: attempt.PlatformPostID(),
+				URL:            attempt.PlatformPostURL(),
+				Reconciled:     true,
+			}, nil
+		}
+
+		// 3. The previous call may have reached the platform; check first
+		found, err := uc.reconcile(ctx, adapter, token, account, attempt)
+		if err != nil {
+			return nil, err
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+
+	// 4. Defer instead of failing when the account's quota is used up
+	if err := uc.takeToken(ctx, account, common.RateClassPublish); err != nil {
+		return nil, err
+	}
+
+	if attempt != nil {
+		attempt.Retry(text)
+		if err := uc.attemptRepo.Update(ctx, attempt); err != nil {
+			return nil, err
+		}
+	} else {
+		attempt = postDomain.NewPublishAttempt(post.ID(), account.ID(), text)
+		if err := uc.attemptRepo.Create(ctx, attempt); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Warn about near-identical content recently sent to the same account
+	uc.warnDuplicates(ctx, post)
+
+	// 6. Publish; the pending attempt is already persisted
+	result, err := adapter.PublishPost(ctx, token, &social.PostContent{
+		Text:      text,
+		MediaURLs: post.Content().MediaURLs,
+		Link:      post.Content().Link,
+	})
+	if err != nil {
+		return nil, uc.publishFailed(ctx, post, account, attempt, err)
+	}
+	uc.observe(ctx, account, common.RateClassPublish, result.RateLimit)
+
+	attempt.MarkSucceeded(result.PlatformPostID, result.URL)
+	if err := uc.attemptRepo.Update(ctx, attempt); err != nil {
+		// The post exists on the platform; a retry will reconcile it
+		uc.logger.Error("Failed to record successful publish attempt", "postId", post.ID(), "error", err)
+	}
+
+	// Published by the system on the schedule, so there is no actor
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, post.TeamID(), uuid.Nil, audit.ActionPostPublished, audit.TargetPost, post.ID().String()).
+		WithMetadata("platform", string(account.Platform())).
+		WithMetadata("platformPostId", result.PlatformPostID))
+
+	uc.logger.Info("Post published",
+		"postId", post.ID(),
+		"platform", account.Platform(),
+		"platformPostId", result.PlatformPostID)
+
+	return &PublishToPlatformOutput{
+		PlatformPostID: result.PlatformPostID,
+		URL:            result.URL,
+	}, nil
+}
+
+// publishFailed records a failed platform call and returns the error for
+// the post. Only a call the platform definitely refused, or never received,
+// is recorded as failed. After an outage or any other ambiguous error the
+// attempt is left unknown, so the deferred retry reconciles it before
+// anything is posted again.
+func (uc *PublishToPlatformUseCase) publishFailed(
+	ctx context.Context,
+	post *postDomain.Post,
+	account *socialDomain.Account,
+	attempt *postDomain.PublishAttempt,
+	cause error,
+) error {
+	var (
+		rateErr *social.RateLimitError
+		open    *social.CircuitOpenError
+	)
+	definitive := errors.As(cause, &rateErr) || errors.As(cause, &open) || social.IsRejected(cause)
+	if definitive {
+		attempt.MarkFailed(cause.Error())
+	} else {
+		attempt.MarkUnknown(cause.Error())
+	}
+	// Recorded even if the worker is stopping, or the retry would take the
+	// attempt for pending
+	if err := uc.attemptRepo.Update(context.WithoutCancel(ctx), attempt); err != nil {
+		uc.logger.Error("Failed to record failed publish attempt", "postId", post.ID(), "error", err)
+	}
+
+	if rateErr != nil {
+		return uc.deferForRateLimit(ctx, account, common.RateClassPublish, rateErr)
+	}
+	if deferred := deferForOutage(ctx, account, cause); deferred != nil {
+		return deferred
+	}
+	if !definitive {
+		return fmt.Errorf("%w: %v", postDomain.ErrPublishOutcomeUnknown, cause)
+	}
+	return fmt.Errorf("%w: %v", postDomain.ErrPublishFailed, cause)
+}
+
+// reconcile looks for the post of an interrupted attempt on the platform.
+// It returns nil output when the post is not there and it is safe to post.
+func (uc *PublishToPlatformUseCase) reconcile(
+	ctx context.Context,
+	adapter social.Adapter,
+	token *social.Token,
+	account *socialDomain.Account,
+	attempt *postDomain.PublishAttempt,
+) (*PublishToPlatformOutput, error) {
+	// Platform rejected the last try: safe to post again
+	if attempt.Status() == postDomain.AttemptFailed {
+		return nil, nil
+	}
+
+	lister, ok := adapter.(social.RecentPostLister)
+	if !ok {
+		// Pending or unknown and we can't check: don't risk a duplicate
+		uc.logger.Warn("Holding post with an unverifiable publish attempt",
+			"postId", attempt.PostID(),
+			"platform", account.Platform())
+		return nil, &postDomain.DeferredError{
+			Until:  time.Now().Add(unknownOutcomeHold),
+			Reason: fmt.Sprintf("%s cannot list recent posts: %v", account.Platform(), postDomain.ErrPublishOutcomeUnknown),
+		}
+	}
+
+	if err := uc.takeToken(ctx, account, common.RateClassRead); err != nil {
+		return nil, err
+	}
+
+	recent, err := lister.ListRecentPosts(ctx, token, attempt.StartedAt().Add(-reconcileClockSkew))
+	if err != nil {
+		var rateErr *social.RateLimitError
+		if errors.As(err, &rateErr) {
+			return nil, uc.deferForRateLimit(ctx, account, common.RateClassRead, rateErr)
+		}
+		if deferred := deferForOutage(ctx, account, err); deferred != nil {
+			return nil, deferred
+		}
+		return nil, fmt.Errorf("failed to reconcile publish attempt: %w", err)
+	}
+
+	for _, candidate := range recent {
+		if postDomain.ContentHash(candidate.Text) != attempt.ContentHash() {
+			continue
+		}
+
+		attempt.MarkSucceeded(candidate.PlatformPostID, candidate.URL)
+		if err := uc.attemptRepo.Update(ctx, attempt); err != nil {
+			return nil, err
+		}
+
+		uc.logger.Info("Reconciled interrupted publish attempt",
+			"postId", attempt.PostID(),
+			"platformPostId", candidate.PlatformPostID)
+
+		return &PublishToPlatformOutput{
+			PlatformPostID: candidate.PlatformPostID,
+			URL:            candidate.URL,
+			Reconciled:     true,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// takeToken consumes one request from the account's bucket, returning a
+// DeferredError when the bucket is empty. Limiter failures don't block
+// publishing.
+func (uc *PublishToPlatformUseCase) takeToken(ctx context.Context, account *socialDomain.Account, class string) error {
+	if uc.limiter == nil {
+		return nil
+	}
+
+	decision, err := uc.limiter.Take(ctx, string(account.Platform()), account.ID().String(), class)
+	if err != nil {
+		uc.logger.Warn("Rate limit check failed", "accountId", account.ID(), "error", err)
+		return nil
+	}
+	if decision.Allowed {
+		return nil
+	}
+
+	return &postDomain.DeferredError{
+		Until:  time.Now().Add(decision.RetryAfter),
+		Reason: fmt.Sprintf("%s %s quota exhausted for account", account.Platform(), class),
+	}
+}
+
+// observe records the quota a platform reported on a response
+func (uc *PublishToPlatformUseCase) observe(ctx context.Context, account *socialDomain.Account, class string, rl *social.RateLimit) {
+	if uc.limiter == nil || rl == nil {
+		return
+	}
+
+	if err := social.ObserveRateLimit(ctx, uc.limiter, string(account.Platform()), account.ID().String(), class, rl); err != nil {
+		uc.logger.Warn("Failed to record platform rate limit", "accountId", account.ID(), "error", err)
+	}
+}
+
+// deferForRateLimit empties the bucket until the platform's reset and
+// returns the matching DeferredError
+func (uc *PublishToPlatformUseCase) deferForRateLimit(ctx context.Context, account *socialDomain.Account, class string, rateErr *social.RateLimitError) error {
+	uc.observe(ctx, account, class, rateErr.Limit)
+
+	return &postDomain.DeferredError{
+		Until:  time.Now().Add(rateErr.RetryAfter),
+		Reason: fmt.Sprintf("%s rate limited the account", account.Platform()),
+	}
+}
+
+// deferForOutage returns a DeferredError if err means the platform is down
+// or its circuit is open, nil otherwise. It doesn't touch the attempt:
+// callers record it as unknown unless the call never reached the platform.
+func deferForOutage(ctx context.Context, account *socialDomain.Account, err error) error {
+	var open *social.CircuitOpenError
+	if errors.As(err, &open) {
+		return &postDomain.DeferredError{
+			Until:  time.Now().Add(open.RetryAfter),
+			Reason: open.Error(),
+		}
+	}
+
+	if social.IsOutage(ctx, err) {
+		return &postDomain.DeferredError{
+			Until:  time.Now().Add(outageRetryDelay),
+			Reason: fmt.Sprintf("%s unavailable: %v", account.Platform(), err),
+		}
+	}
+
+	return nil
+}
+
+// warnDuplicates logs other posts with the same content published to the
+// same account within the duplicate window
+func (uc *PublishToPlatformUseCase) warnDuplicates(ctx context.Context, post *postDomain.Post) {
+	if uc.duplicates == nil {
+		return
+	}
+
+	matches, err := uc.duplicates.FindDuplicates(ctx, post.TeamID(), post.Content().Text, duplicateWindow)
+	if err != nil {
+		uc.logger.Warn("Duplicate check failed", "postId", post.ID(), "error", err)
+		return
+	}
+
+	for _, match := range matches {
+		if match.ID() == post.ID() || match.SocialAccountID() != post.SocialAccountID() {
+			continue
+		}
+		uc.logger.Warn("Near-identical content was recently published to this account",
+			"postId", post.ID(),
+			"duplicateOf", match.ID(),
+			"socialAccountId", post.SocialAccountID())
+	}
+}
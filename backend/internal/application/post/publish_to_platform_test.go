@@ -0,0 +1,201 @@
+// path: backend/internal/application/post/publish_to_platform_test.go
+package post
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memoryAttempts struct {
+	attempts map[string]*postDomain.PublishAttempt
+}
+
+func (r *memoryAttempts) Create(ctx context.Context, a *postDomain.PublishAttempt) error {
+	r.attempts[a.IdempotencyKey()] = a
+	return nil
+}
+
+func (r *memoryAttempts) Update(ctx context.Context, a *postDomain.PublishAttempt) error {
+	r.attempts[a.IdempotencyKey()] = a
+	return nil
+}
+
+func (r *memoryAttempts) FindByKey(ctx context.Context, key string) (*postDomain.PublishAttempt, error) {
+	if a, ok := r.attempts[key]; ok {
+		return a, nil
+	}
+	return nil, postDomain.ErrPublishAttemptNotFound
+}
+
+type singleAccount struct {
+	socialDomain.AccountRepository
+	account *socialDomain.Account
+}
+
+func (r *singleAccount) FindByID(ctx context.Context, id uuid.UUID) (*socialDomain.Account, error) {
+	return r.account, nil
+}
+
+// scriptedAdapter answers PublishPost with errs in order, then succeeds
+type scriptedAdapter struct {
+	social.Adapter
+	errs  []error
+	calls int
+}
+
+func (a *scriptedAdapter) PublishPost(ctx context.Context, token *social.Token, content *social.PostContent) (*social.PublishResult, error) {
+	a.calls++
+	if len(a.errs) > 0 {
+		err := a.errs[0]
+		a.errs = a.errs[1:]
+		return nil, err
+	}
+	return &social.PublishResult{PlatformPostID: "new", URL: "https://example.com/new"}, nil
+}
+
+// listingAdapter can also read back the account's posts
+type listingAdapter struct {
+	*scriptedAdapter
+	recent []*social.RecentPost
+}
+
+func (a *listingAdapter) ListRecentPosts(ctx context.Context, token *social.Token, since time.Time) ([]*social.RecentPost, error) {
+	return a.recent, nil
+}
+
+func newPublishFixture(t *testing.T, adapter social.Adapter) (*PublishToPlatformUseCase, *memoryAttempts, *postDomain.Post) {
+	t.Helper()
+
+	now := time.Now()
+	account := socialDomain.Reconstruct(uuid.New(), uuid.New(), uuid.New(), socialDomain.PlatformLinkedIn,
+		socialDomain.AccountType("profile"), "ana", "Ana", "", "", socialDomain.Credentials{AccessToken: "token"},
+		socialDomain.AccountMetadata{}, socialDomain.StatusActive, socialDomain.RateLimits{}, nil, now, nil, now, now, nil)
+	post := postDomain.Reconstruct(uuid.New(), account.TeamID(), account.UserID(), account.ID(),
+		postDomain.Content{Text: "Launch day"}, []postDomain.Platform{postDomain.PlatformLinkedIn},
+		nil, nil, postDomain.StatusPublishing, postDomain.Priority(0), postDomain.Metadata{}, nil, now, now, nil)
+
+	attempts := &memoryAttempts{attempts: map[string]*postDomain.PublishAttempt{}}
+	uc := NewPublishToPlatformUseCase(attempts, nil, &singleAccount{account: account},
+		map[socialDomain.Platform]social.Adapter{socialDomain.PlatformLinkedIn: adapter},
+		nil, nil, services.NewLogger())
+	return uc, attempts, post
+}
+
+func attemptOf(attempts *memoryAttempts, post *postDomain.Post) *postDomain.PublishAttempt {
+	return attempts.attempts[postDomain.IdempotencyKey(post.ID(), post.SocialAccountID())]
+}
+
+func TestPublishToPlatformOutageIsNotRepostedBlindly(t *testing.T) {
+	ctx := context.Background()
+	timeout := &url.Error{Op: "Post", URL: "https://api.linkedin.com/v2/ugcPosts", Err: context.DeadlineExceeded}
+	adapter := &scriptedAdapter{errs: []error{timeout}}
+	uc, attempts, post := newPublishFixture(t, adapter)
+
+	_, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	var deferred *postDomain.DeferredError
+	if !errors.As(err, &deferred) {
+		t.Fatalf("timeout: err = %v, want a DeferredError", err)
+	}
+	if got := attemptOf(attempts, post).Status(); got != postDomain.AttemptUnknown {
+		t.Fatalf("timeout: attempt = %s, want %s", got, postDomain.AttemptUnknown)
+	}
+
+	// The platform can't be asked whether the post went out, so the post is
+	// held for someone to check rather than failed or posted again
+	_, err = uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	if !errors.As(err, &deferred) {
+		t.Fatalf("retry: err = %v, want a DeferredError", err)
+	}
+	if time.Until(deferred.Until) < unknownOutcomeHold-time.Minute {
+		t.Errorf("retry: held until %s, want about %s from now", deferred.Until, unknownOutcomeHold)
+	}
+	if adapter.calls != 1 {
+		t.Errorf("retry: PublishPost called %d times, want 1", adapter.calls)
+	}
+	if got := attemptOf(attempts, post).Status(); got != postDomain.AttemptUnknown {
+		t.Errorf("retry: attempt = %s, want %s", got, postDomain.AttemptUnknown)
+	}
+}
+
+func TestPublishToPlatformInterruptedAttemptIsHeldWithoutLister(t *testing.T) {
+	ctx := context.Background()
+	adapter := &scriptedAdapter{}
+	uc, attempts, post := newPublishFixture(t, adapter)
+
+	// A worker crashed between recording the attempt and hearing back
+	pending := postDomain.NewPublishAttempt(post.ID(), post.SocialAccountID(), post.Content().Text)
+	attempts.attempts[pending.IdempotencyKey()] = pending
+
+	for i := 0; i < 2; i++ {
+		_, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+		var deferred *postDomain.DeferredError
+		if !errors.As(err, &deferred) {
+			t.Fatalf("run %d: err = %v, want a DeferredError", i, err)
+		}
+	}
+	if adapter.calls != 0 {
+		t.Errorf("PublishPost called %d times, want 0", adapter.calls)
+	}
+	if got := attemptOf(attempts, post).Status(); got != postDomain.AttemptPending {
+		t.Errorf("attempt = %s, want %s", got, postDomain.AttemptPending)
+	}
+}
+
+func TestPublishToPlatformOutageIsReconciled(t *testing.T) {
+	ctx := context.Background()
+	adapter := &listingAdapter{scriptedAdapter: &scriptedAdapter{errs: []error{&social.UnavailableError{StatusCode: 504}}}}
+	uc, attempts, post := newPublishFixture(t, adapter)
+
+	_, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	var deferred *postDomain.DeferredError
+	if !errors.As(err, &deferred) {
+		t.Fatalf("504: err = %v, want a DeferredError", err)
+	}
+
+	// The 504 came after the platform created the post
+	adapter.recent = []*social.RecentPost{{PlatformPostID: "existing", Text: "Launch  day", CreatedAt: time.Now()}}
+	out, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if !out.Reconciled || out.PlatformPostID != "existing" {
+		t.Errorf("retry: got %+v, want the existing post reconciled", out)
+	}
+	if adapter.calls != 1 {
+		t.Errorf("retry: PublishPost called %d times, want 1", adapter.calls)
+	}
+	if got := attemptOf(attempts, post).Status(); got != postDomain.AttemptSucceeded {
+		t.Errorf("retry: attempt = %s, want %s", got, postDomain.AttemptSucceeded)
+	}
+}
+
+func TestPublishToPlatformRejectionIsRetried(t *testing.T) {
+	ctx := context.Background()
+	adapter := &scriptedAdapter{errs: []error{&social.RejectedError{StatusCode: 422, Body: "duplicate"}}}
+	uc, attempts, post := newPublishFixture(t, adapter)
+
+	_, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	if !errors.Is(err, postDomain.ErrPublishFailed) {
+		t.Fatalf("422: err = %v, want ErrPublishFailed", err)
+	}
+	if got := attemptOf(attempts, post).Status(); got != postDomain.AttemptFailed {
+		t.Fatalf("422: attempt = %s, want %s", got, postDomain.AttemptFailed)
+	}
+
+	out, err := uc.Execute(ctx, PublishToPlatformInput{Post: post})
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if out.Reconciled || adapter.calls != 2 {
+		t.Errorf("retry: reconciled = %v after %d calls, want a new post", out.Reconciled, adapter.calls)
+	}
+}
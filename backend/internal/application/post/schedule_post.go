@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
@@ -27,18 +28,28 @@ type SchedulePostOutput struct {
 
 type SchedulePostUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+	tx         common.Transactor
+	events     common.EventBus
 	logger     common.Logger
 }
 
 func NewSchedulePostUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	tx common.Transactor,
+	events common.EventBus,
 	logger common.Logger,
 ) *SchedulePostUseCase {
 	return &SchedulePostUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		tx:         tx,
+		events:     events,
 		logger:     logger,
 	}
 }
@@ -50,20 +61,21 @@ func (uc *SchedulePostUseCase) Execute(ctx context.Context, input SchedulePostIn
 		return nil, postDomain.ErrPostNotFound
 	}
 
-	// 2. Check authorization (author or admin)
-	member, err := uc.memberRepo.FindMember(ctx, post.TeamID(), input.UserID)
+	// 2. Check authorization (author or posts.schedule)
+	canSchedule, err := canManagePost(ctx, uc.authorizer, post, input.UserID, team.PermPostsSchedule)
 	if err != nil {
-		return nil, fmt.Errorf("access denied: not a team member")
+		return nil, err
 	}
 
-	canSchedule := post.CreatedBy() == input.UserID ||
-		member.Role() == team.MemberRoleOwner ||
-		member.Role() == team.MemberRoleAdmin
-
 	if !canSchedule {
 		return nil, fmt.Errorf("access denied: cannot schedule this post")
 	}
 
+	// Restricted members only work with their granted accounts
+	if err := requireAccountAccess(ctx, uc.checker, post.TeamID(), post.SocialAccountID(), input.UserID, socialDomain.AccessPublish); err != nil {
+		return nil, err
+	}
+
 	// 3. Validate schedule time
 	if input.ScheduledAt.Before(time.Now()) {
 		return nil, postDomain.ErrScheduleTimeInPast
@@ -74,8 +86,19 @@ func (uc *SchedulePostUseCase) Execute(ctx context.Context, input SchedulePostIn
 		return nil, err
 	}
 
-	// 5. Save changes
-	if err := uc.postRepo.Update(ctx, post); err != nil {
+	// 5. Save changes together with the event
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.postRepo.Update(ctx, post); err != nil {
+			return err
+		}
+		if post.NeedsApproval() {
+			if err := uc.events.Publish(ctx, postDomain.NewApprovalRequested(post, input.UserID)); err != nil {
+				return err
+			}
+		}
+		return uc.events.Publish(ctx, postDomain.NewPostScheduled(post))
+	})
+	if err != nil {
 		uc.logger.Error("Failed to schedule post", "postId", input.PostID, "error", err)
 		return nil, fmt.Errorf("failed to update post")
 	}
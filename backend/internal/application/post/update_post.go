@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
 	postDomain "github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
@@ -27,18 +28,22 @@ type UpdatePostOutput struct {
 
 type UpdatePostUseCase struct {
 	postRepo   postDomain.Repository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
 	logger     common.Logger
 }
 
 func NewUpdatePostUseCase(
 	postRepo postDomain.Repository,
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
 	logger common.Logger,
 ) *UpdatePostUseCase {
 	return &UpdatePostUseCase{
 		postRepo:   postRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
 		logger:     logger,
 	}
 }
@@ -51,19 +56,20 @@ func (uc *UpdatePostUseCase) Execute(ctx context.Context, input UpdatePostInput)
 	}
 
 	// 2. Check authorization
-	member, err := uc.memberRepo.FindMember(ctx, post.TeamID(), input.UserID)
+	canEdit, err := canManagePost(ctx, uc.authorizer, post, input.UserID, team.PermPostsEdit)
 	if err != nil {
-		return nil, fmt.Errorf("access denied: not a team member")
+		return nil, err
 	}
 
-	canEdit := post.CreatedBy() == input.UserID ||
-		member.Role() == team.MemberRoleOwner ||
-		member.Role() == team.MemberRoleAdmin
-
 	if !canEdit {
 		return nil, fmt.Errorf("access denied: cannot edit this post")
 	}
 
+	// Restricted members only work with their granted accounts
+	if err := requireAccountAccess(ctx, uc.checker, post.TeamID(), post.SocialAccountID(), input.UserID, socialDomain.AccessDraft); err != nil {
+		return nil, err
+	}
+
 	// 3. Check if post can be edited
 	if post.Status() == postDomain.StatusPublished {
 		return nil, postDomain.ErrCannotEditPublished
@@ -0,0 +1,111 @@
+// ============================================================================
+// FILE: backend/internal/application/realtime/projector.go
+// PURPOSE: Turns domain events into realtime updates for connected clients
+// ============================================================================
+
+// Package realtime streams a team's post, inbox and account updates to its
+// connected clients
+package realtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	"github.com/techappsUT/social-queue/internal/domain/post"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// Topics group realtime events by the permission needed to see them
+const (
+	TopicPosts    = "posts"
+	TopicInbox    = "inbox"
+	TopicAccounts = "accounts"
+)
+
+// eventTopics are the domain events streamed to clients, by topic
+var eventTopics = map[string]string{
+	post.EventPostScheduled:               TopicPosts,
+	post.EventPostPublished:               TopicPosts,
+	post.EventPostFailed:                  TopicPosts,
+	inbox.EventMessageReceived:            TopicInbox,
+	inbox.EventConversationAssigned:       TopicInbox,
+	socialDomain.EventAccountExpired:      TopicAccounts,
+	socialDomain.EventAccountRevoked:      TopicAccounts,
+	socialDomain.EventAccountDisconnected: TopicAccounts,
+}
+
+// EventTypes returns the domain events the projector handles
+func EventTypes() []string {
+	types := make([]string, 0, len(eventTopics))
+	for t := range eventTopics {
+		types = append(types, t)
+	}
+	return types
+}
+
+// Projector forwards domain events to the realtime feed. It is subscribed
+// to EventTypes on the API's event bus, which relays the events of the
+// worker too; the feed fans them out to every replica.
+type Projector struct {
+	feed   common.RealtimeFeed
+	logger common.Logger
+}
+
+func NewProjector(feed common.RealtimeFeed, logger common.Logger) *Projector {
+	return &Projector{feed: feed, logger: logger}
+}
+
+// HandleEvent publishes one domain event as it was raised. Events of no
+// team are dropped.
+func (p *Projector) HandleEvent(ctx context.Context, e common.Event) error {
+	topic, ok := eventTopics[e.Type()]
+	if !ok {
+		return nil
+	}
+	teamID := event.TeamOf(e)
+	if teamID == uuid.Nil {
+		return nil
+	}
+
+	envelope, ok := e.(*event.Envelope)
+	if !ok {
+		var err error
+		if envelope, err = event.Seal(e); err != nil {
+			return err
+		}
+	}
+
+	// Post events name the account socialAccountId, the others accountId
+	var ref struct {
+		AccountID       uuid.UUID `json:"accountId"`
+		SocialAccountID uuid.UUID `json:"socialAccountId"`
+	}
+	if err := event.Decode(envelope, &ref); err != nil {
+		return fmt.Errorf("failed to read realtime event: %w", err)
+	}
+	accountID := ref.AccountID
+	if accountID == uuid.Nil {
+		accountID = ref.SocialAccountID
+	}
+
+	update := &common.RealtimeEvent{
+		Type:   e.Type(),
+		TeamID: teamID.String(),
+		Topic:  topic,
+		Data:   envelope.Payload,
+		At:     e.OccurredAt(),
+	}
+	if accountID != uuid.Nil {
+		update.AccountID = accountID.String()
+	}
+	if err := p.feed.Publish(ctx, update); err != nil {
+		return err
+	}
+
+	p.logger.Debug("Realtime event published", "type", update.Type, "teamId", update.TeamID)
+	return nil
+}
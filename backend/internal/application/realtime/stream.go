@@ -0,0 +1,217 @@
+// ============================================================================
+// FILE: backend/internal/application/realtime/stream.go
+// PURPOSE: Authorizing and filtering a member's realtime stream
+// ============================================================================
+package realtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	// TicketTTL is how long a stream ticket can be redeemed
+	TicketTTL       = 30 * time.Second
+	ticketKeyPrefix = "realtime:ticket:"
+)
+
+// ErrInvalidTicket is returned for unknown, used or expired tickets
+var ErrInvalidTicket = errors.New("invalid or expired stream ticket")
+
+// topicPermissions is what a member needs to receive each topic
+var topicPermissions = map[string]team.Permission{
+	TopicPosts:    team.PermPostsView,
+	TopicInbox:    team.PermInboxView,
+	TopicAccounts: team.PermAccountsView,
+}
+
+// ============================================================================
+// STREAM
+// ============================================================================
+
+type StreamInput struct {
+	TeamID      uuid.UUID
+	UserID      uuid.UUID
+	LastEventID string
+}
+
+// StreamUseCase opens a member's stream of team updates. The member only
+// receives the topics their role allows, and restricted members only the
+// updates of the accounts they were granted. Both are decided when the
+// stream opens and apply until the client reconnects.
+type StreamUseCase struct {
+	feed       common.RealtimeFeed
+	teamRepo   team.Repository
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+	logger     common.Logger
+}
+
+func NewStreamUseCase(
+	feed common.RealtimeFeed,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	logger common.Logger,
+) *StreamUseCase {
+	return &StreamUseCase{
+		feed:       feed,
+		teamRepo:   teamRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		logger:     logger,
+	}
+}
+
+// Execute returns the member's updates until ctx is canceled. The channel
+// is closed early if the client falls too far behind.
+func (uc *StreamUseCase) Execute(ctx context.Context, input StreamInput) (<-chan *common.RealtimeEvent, error) {
+	role, err := uc.authorizer.Role(ctx, input.TeamID, input.UserID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+
+	t, err := uc.teamRepo.FindByID(ctx, input.TeamID)
+	if err != nil {
+		return nil, team.ErrTeamNotFound
+	}
+
+	topics := make(map[string]bool, len(topicPermissions))
+	for topic, permission := range topicPermissions {
+		if topic == TopicInbox && !t.HasFeature("inbox") {
+			continue
+		}
+		if role.Allows(permission) {
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("access denied: no realtime updates available")
+	}
+
+	access, err := uc.checker.Resolve(ctx, input.TeamID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check account access: %w", err)
+	}
+
+	events, err := uc.feed.Subscribe(ctx, input.TeamID.String(), input.LastEventID)
+	if err != nil {
+		uc.logger.Error("Failed to subscribe to realtime feed", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to open realtime stream")
+	}
+
+	out := make(chan *common.RealtimeEvent)
+	go func() {
+		defer close(out)
+		for e := range events {
+			if !visible(e, topics, access) {
+				continue
+			}
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// visible reports whether a member with the topics and account access
+// may see an update
+func visible(e *common.RealtimeEvent, topics map[string]bool, access *socialDomain.AccountAccess) bool {
+	if e.Type == common.RealtimeResetEvent {
+		return true
+	}
+	if !topics[e.Topic] {
+		return false
+	}
+	if e.AccountID == "" {
+		return true
+	}
+	accountID, err := uuid.Parse(e.AccountID)
+	return err == nil && access.Allows(accountID, socialDomain.AccessAnalytics)
+}
+
+// ============================================================================
+// TICKETS
+// ============================================================================
+
+// TicketOutput is a single-use credential for opening a stream where no
+// Authorization header can be sent, as with EventSource and WebSocket in
+// browsers
+type TicketOutput struct {
+	Ticket    string    `json:"ticket"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type IssueTicketInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+// TicketUseCase issues stream tickets and redeems them
+type TicketUseCase struct {
+	cache      common.CacheService
+	authorizer *team.Authorizer
+}
+
+func NewTicketUseCase(cache common.CacheService, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *TicketUseCase {
+	return &TicketUseCase{cache: cache, authorizer: team.NewAuthorizer(memberRepo, roleRepo)}
+}
+
+// Issue returns a ticket opening the member's stream of the team
+func (uc *TicketUseCase) Issue(ctx context.Context, input IssueTicketInput) (*TicketOutput, error) {
+	if _, err := uc.authorizer.Role(ctx, input.TeamID, input.UserID); err != nil {
+		if errors.Is(err, team.ErrMemberNotFound) {
+			return nil, fmt.Errorf("access denied: not a team member")
+		}
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate stream ticket: %w", err)
+	}
+	ticket := base64.RawURLEncoding.EncodeToString(raw)
+
+	value := input.UserID.String() + ":" + input.TeamID.String()
+	if err := uc.cache.Set(ctx, ticketKeyPrefix+ticket, value, TicketTTL); err != nil {
+		return nil, fmt.Errorf("failed to store stream ticket: %w", err)
+	}
+	return &TicketOutput{Ticket: ticket, ExpiresAt: time.Now().UTC().Add(TicketTTL)}, nil
+}
+
+// Redeem uses up a ticket of the team and returns the member it was
+// issued to
+func (uc *TicketUseCase) Redeem(ctx context.Context, teamID uuid.UUID, ticket string) (uuid.UUID, error) {
+	key := ticketKeyPrefix + ticket
+	value, err := uc.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return uuid.Nil, ErrInvalidTicket
+	}
+	if err := uc.cache.Delete(ctx, key); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to redeem stream ticket: %w", err)
+	}
+
+	userPart, teamPart, _ := strings.Cut(value, ":")
+	userID, err := uuid.Parse(userPart)
+	if err != nil || teamPart != teamID.String() {
+		return uuid.Nil, ErrInvalidTicket
+	}
+	return userID, nil
+}
@@ -0,0 +1,30 @@
+// ============================================================================
+// FILE: backend/internal/application/social/access.go
+// PURPOSE: Per-account access checks shared by the social use cases
+// ============================================================================
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// requireAccountAccess checks the user may use the account at the level
+func requireAccountAccess(ctx context.Context, checker *socialDomain.AccessChecker, account *socialDomain.Account, userID uuid.UUID, level socialDomain.AccessLevel) error {
+	err := checker.Authorize(ctx, account.TeamID(), userID, account.ID(), level)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, team.ErrMemberNotFound):
+		return fmt.Errorf("access denied: not a team member")
+	case errors.Is(err, socialDomain.ErrAccountAccessDenied):
+		return err
+	default:
+		return fmt.Errorf("failed to check account access: %w", err)
+	}
+}
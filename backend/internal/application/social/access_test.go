@@ -0,0 +1,215 @@
+// path: backend/internal/application/social/access_test.go
+package social
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/adapters/social"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memoryAccounts struct {
+	socialDomain.AccountRepository
+	accounts []*socialDomain.Account
+}
+
+func (r *memoryAccounts) FindByID(ctx context.Context, id uuid.UUID) (*socialDomain.Account, error) {
+	for _, a := range r.accounts {
+		if a.ID() == id {
+			return a, nil
+		}
+	}
+	return nil, socialDomain.ErrAccountNotFound
+}
+
+func (r *memoryAccounts) FindByTeamID(ctx context.Context, teamID uuid.UUID) ([]*socialDomain.Account, error) {
+	var accounts []*socialDomain.Account
+	for _, a := range r.accounts {
+		if a.TeamID() == teamID {
+			accounts = append(accounts, a)
+		}
+	}
+	return accounts, nil
+}
+
+// membersOf makes every listed user an active member with the role
+type membersOf struct {
+	team.MemberRepository
+	roles map[uuid.UUID]team.MemberRole
+}
+
+func (r membersOf) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	role, ok := r.roles[userID]
+	if !ok {
+		return nil, team.ErrMemberNotFound
+	}
+	joined := time.Now()
+	return team.ReconstructMember(uuid.New(), teamID, userID, role, team.MemberStatusActive, userID, joined, &joined, nil), nil
+}
+
+type adminManagesAccounts struct {
+	team.RoleRepository
+}
+
+func (adminManagesAccounts) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	permissions := []team.Permission{"posts.*", team.PermAccountsView}
+	if name == team.MemberRoleAdmin {
+		permissions = append(permissions, team.PermAccountsManage)
+	}
+	return team.ReconstructRole(uuid.New(), teamID, name, "", permissions, true, time.Now(), time.Now()), nil
+}
+
+type grantsByUser map[uuid.UUID]*socialDomain.AccountAccess
+
+func (r grantsByUser) FindAccess(ctx context.Context, teamID, userID uuid.UUID) (*socialDomain.AccountAccess, error) {
+	if a, ok := r[userID]; ok {
+		return a, nil
+	}
+	return socialDomain.ReconstructAccountAccess(teamID, userID, uuid.Nil, false, nil, time.Time{}), nil
+}
+
+func (r grantsByUser) SaveAccess(ctx context.Context, a *socialDomain.AccountAccess) error {
+	r[a.UserID()] = a
+	return nil
+}
+
+// countingAdapter succeeds and counts calls to the platform
+type countingAdapter struct {
+	social.Adapter
+	calls int
+}
+
+func (a *countingAdapter) PublishPost(ctx context.Context, token *social.Token, content *social.PostContent) (*social.PublishResult, error) {
+	a.calls++
+	return &social.PublishResult{PlatformPostID: "p1", URL: "https://example.com/p1", PublishedAt: time.Now()}, nil
+}
+
+func (a *countingAdapter) GetPostAnalytics(ctx context.Context, token *social.Token, postID string) (*social.Analytics, error) {
+	a.calls++
+	return &social.Analytics{Impressions: 10}, nil
+}
+
+// accountAccessFixture has an admin and an editor who may see one account
+// and publish to another; a third account is hidden from the editor
+type accountAccessFixture struct {
+	teamID, admin, editor        uuid.UUID
+	viewable, publishable, other *socialDomain.Account
+	accounts                     *memoryAccounts
+	members                      membersOf
+	access                       grantsByUser
+	adapter                      *countingAdapter
+}
+
+func newAccountAccessFixture(t *testing.T) *accountAccessFixture {
+	t.Helper()
+
+	f := &accountAccessFixture{teamID: uuid.New(), admin: uuid.New(), editor: uuid.New(), adapter: &countingAdapter{}}
+	account := func() *socialDomain.Account {
+		now := time.Now()
+		return socialDomain.Reconstruct(uuid.New(), f.teamID, f.admin, socialDomain.PlatformLinkedIn, socialDomain.AccountType("profile"),
+			"", "", "", "", socialDomain.Credentials{AccessToken: "token"}, socialDomain.AccountMetadata{}, socialDomain.StatusActive,
+			socialDomain.RateLimits{}, nil, now, nil, now, now, nil)
+	}
+	f.viewable, f.publishable, f.other = account(), account(), account()
+	f.accounts = &memoryAccounts{accounts: []*socialDomain.Account{f.viewable, f.publishable, f.other}}
+	f.members = membersOf{roles: map[uuid.UUID]team.MemberRole{f.admin: team.MemberRoleAdmin, f.editor: team.MemberRoleEditor}}
+
+	access, err := socialDomain.NewAccountAccess(f.teamID, f.editor, f.admin, true, []socialDomain.AccountGrant{
+		{AccountID: f.viewable.ID(), Level: socialDomain.AccessAnalytics},
+		{AccountID: f.publishable.ID(), Level: socialDomain.AccessPublish},
+	})
+	if err != nil {
+		t.Fatalf("NewAccountAccess: %v", err)
+	}
+	f.access = grantsByUser{f.editor: access}
+	return f
+}
+
+func TestPublishPostAccountAccess(t *testing.T) {
+	f := newAccountAccessFixture(t)
+	uc := NewPublishPostUseCase(f.accounts, f.members, adminManagesAccounts{}, f.access,
+		map[socialDomain.Platform]social.Adapter{socialDomain.PlatformLinkedIn: f.adapter}, nil, services.NewLogger())
+	publish := func(account *socialDomain.Account, userID uuid.UUID) error {
+		_, err := uc.Execute(context.Background(), PublishPostInput{AccountID: account.ID(), UserID: userID, Content: "Launch day"})
+		return err
+	}
+
+	if err := publish(f.publishable, f.editor); err != nil {
+		t.Errorf("publish grant: %v", err)
+	}
+	if err := publish(f.other, f.admin); err != nil {
+		t.Errorf("admin: %v", err)
+	}
+	for name, account := range map[string]*socialDomain.Account{"analytics grant": f.viewable, "no grant": f.other} {
+		if err := publish(account, f.editor); !errors.Is(err, socialDomain.ErrAccountAccessDenied) {
+			t.Errorf("%s: err = %v, want ErrAccountAccessDenied", name, err)
+		}
+	}
+	if err := publish(f.publishable, uuid.New()); err == nil || !strings.HasPrefix(err.Error(), "access denied") {
+		t.Errorf("outsider: err = %v, want access denied", err)
+	}
+	if f.adapter.calls != 2 {
+		t.Errorf("published %d times, want 2", f.adapter.calls)
+	}
+}
+
+func TestReadAccountAccess(t *testing.T) {
+	f := newAccountAccessFixture(t)
+	logger := services.NewLogger()
+	analytics := NewGetAnalyticsUseCase(f.accounts, f.members, adminManagesAccounts{}, f.access,
+		map[socialDomain.Platform]social.Adapter{socialDomain.PlatformLinkedIn: f.adapter}, services.NewInMemoryCacheService(), logger)
+	quota := NewGetAccountQuotaUseCase(f.accounts, f.members, adminManagesAccounts{}, f.access, nil, logger)
+	ctx := context.Background()
+
+	for _, account := range []*socialDomain.Account{f.viewable, f.publishable} {
+		if _, err := analytics.Execute(ctx, GetAnalyticsInput{AccountID: account.ID(), PostID: "p1", UserID: f.editor}); err != nil {
+			t.Errorf("analytics on a granted account: %v", err)
+		}
+		if _, err := quota.Execute(ctx, GetAccountQuotaInput{AccountID: account.ID(), UserID: f.editor}); err != nil {
+			t.Errorf("quota on a granted account: %v", err)
+		}
+	}
+
+	if _, err := analytics.Execute(ctx, GetAnalyticsInput{AccountID: f.other.ID(), PostID: "p1", UserID: f.editor}); !errors.Is(err, socialDomain.ErrAccountAccessDenied) {
+		t.Errorf("analytics without a grant: err = %v, want ErrAccountAccessDenied", err)
+	}
+	if _, err := quota.Execute(ctx, GetAccountQuotaInput{AccountID: f.other.ID(), UserID: f.editor}); !errors.Is(err, socialDomain.ErrAccountAccessDenied) {
+		t.Errorf("quota without a grant: err = %v, want ErrAccountAccessDenied", err)
+	}
+	if _, err := quota.Execute(ctx, GetAccountQuotaInput{AccountID: f.other.ID(), UserID: f.admin}); err != nil {
+		t.Errorf("admin quota: %v", err)
+	}
+}
+
+func TestListAccountsFiltersByAccess(t *testing.T) {
+	f := newAccountAccessFixture(t)
+	uc := NewListAccountsUseCase(f.accounts, f.members, adminManagesAccounts{}, f.access, services.NewLogger())
+	ctx := context.Background()
+
+	out, err := uc.Execute(ctx, ListAccountsInput{TeamID: f.teamID, UserID: f.editor})
+	if err != nil {
+		t.Fatalf("editor: %v", err)
+	}
+	if len(out.Accounts) != 2 {
+		t.Errorf("editor sees %d accounts, want the 2 granted", len(out.Accounts))
+	}
+	for _, a := range out.Accounts {
+		if a.ID == f.other.ID() {
+			t.Error("editor sees an account without a grant")
+		}
+	}
+
+	if out, err := uc.Execute(ctx, ListAccountsInput{TeamID: f.teamID, UserID: f.admin}); err != nil || len(out.Accounts) != 3 {
+		t.Errorf("admin: got %v, %v; want all 3 accounts", out, err)
+	}
+	if _, err := uc.Execute(ctx, ListAccountsInput{TeamID: f.teamID, UserID: uuid.New()}); err == nil {
+		t.Error("outsider: listed accounts")
+	}
+}
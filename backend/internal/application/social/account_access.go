@@ -0,0 +1,186 @@
+// ============================================================================
+// FILE: backend/internal/application/social/account_access.go
+// PURPOSE: View and change which social accounts a team member may use
+// ============================================================================
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type AccountAccessDTO struct {
+	UserID     uuid.UUID                   `json:"userId"`
+	Restricted bool                        `json:"restricted"`
+	Grants     []socialDomain.AccountGrant `json:"grants"`
+	UpdatedAt  *time.Time                  `json:"updatedAt,omitempty"`
+}
+
+func mapAccountAccessToDTO(a *socialDomain.AccountAccess) *AccountAccessDTO {
+	grants := a.Grants()
+	if grants == nil {
+		grants = []socialDomain.AccountGrant{}
+	}
+	dto := &AccountAccessDTO{
+		UserID:     a.UserID(),
+		Restricted: a.IsRestricted(),
+		Grants:     grants,
+	}
+	if !a.UpdatedAt().IsZero() {
+		updatedAt := a.UpdatedAt()
+		dto.UpdatedAt = &updatedAt
+	}
+	return dto
+}
+
+// ============================================================================
+// GET
+// ============================================================================
+
+type GetAccountAccessInput struct {
+	TeamID   uuid.UUID
+	UserID   uuid.UUID // Who is asking
+	MemberID uuid.UUID
+}
+
+type GetAccountAccessUseCase struct {
+	authorizer *team.Authorizer
+	checker    *socialDomain.AccessChecker
+}
+
+func NewGetAccountAccessUseCase(
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+) *GetAccountAccessUseCase {
+	return &GetAccountAccessUseCase{
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+	}
+}
+
+// Execute returns the member's effective access. Members may look up their
+// own; anyone else needs accounts.manage.
+func (uc *GetAccountAccessUseCase) Execute(ctx context.Context, input GetAccountAccessInput) (*AccountAccessDTO, error) {
+	if input.MemberID != input.UserID {
+		if err := requireAccountsManage(ctx, uc.authorizer, input.TeamID, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	access, err := uc.checker.Resolve(ctx, input.TeamID, input.MemberID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("member not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account access: %w", err)
+	}
+	return mapAccountAccessToDTO(access), nil
+}
+
+// ============================================================================
+// SET
+// ============================================================================
+
+type SetAccountAccessInput struct {
+	TeamID     uuid.UUID                   `json:"-"`
+	UserID     uuid.UUID                   `json:"-"` // Who is changing access
+	MemberID   uuid.UUID                   `json:"-"`
+	Restricted bool                        `json:"restricted"`
+	Grants     []socialDomain.AccountGrant `json:"grants"`
+}
+
+type SetAccountAccessUseCase struct {
+	socialRepo socialDomain.AccountRepository
+	accessRepo socialDomain.AccessRepository
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewSetAccountAccessUseCase(
+	socialRepo socialDomain.AccountRepository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	logger common.Logger,
+) *SetAccountAccessUseCase {
+	return &SetAccountAccessUseCase{
+		socialRepo: socialRepo,
+		accessRepo: accessRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+// Execute replaces the member's restriction and grants; lifting the
+// restriction drops the grants
+func (uc *SetAccountAccessUseCase) Execute(ctx context.Context, input SetAccountAccessInput) (*AccountAccessDTO, error) {
+	// 1. Check authorization
+	if err := requireAccountsManage(ctx, uc.authorizer, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// 2. Members who manage accounts see all of them anyway
+	role, err := uc.authorizer.Role(ctx, input.TeamID, input.MemberID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("member not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve member role: %w", err)
+	}
+	if input.Restricted && role.Allows(team.PermAccountsManage) {
+		return nil, socialDomain.ErrAccessNotRestrictable
+	}
+
+	// 3. Every granted account must belong to the team
+	for _, g := range input.Grants {
+		account, err := uc.socialRepo.FindByID(ctx, g.AccountID)
+		if err != nil || account.TeamID() != input.TeamID {
+			return nil, socialDomain.ErrAccountNotInTeam
+		}
+	}
+
+	access, err := socialDomain.NewAccountAccess(input.TeamID, input.MemberID, input.UserID, input.Restricted, input.Grants)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Persist
+	if err := uc.accessRepo.SaveAccess(ctx, access); err != nil {
+		uc.logger.Error("Failed to save account access",
+			"teamId", input.TeamID,
+			"memberId", input.MemberID,
+			"error", err)
+		return nil, fmt.Errorf("failed to save account access")
+	}
+
+	uc.logger.Info("Member account access updated",
+		"teamId", input.TeamID,
+		"memberId", input.MemberID,
+		"restricted", input.Restricted,
+		"grants", len(input.Grants),
+		"updatedBy", input.UserID)
+
+	return mapAccountAccessToDTO(access), nil
+}
+
+func requireAccountsManage(ctx context.Context, authorizer *team.Authorizer, teamID, userID uuid.UUID) error {
+	err := authorizer.Authorize(ctx, teamID, userID, team.PermAccountsManage)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, team.ErrMemberNotFound):
+		return fmt.Errorf("access denied: not a team member")
+	case errors.Is(err, team.ErrInsufficientPermissions):
+		return fmt.Errorf("access denied: %s permission required", team.PermAccountsManage)
+	default:
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+}
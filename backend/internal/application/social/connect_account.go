@@ -11,7 +11,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
 	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
@@ -29,31 +31,38 @@ type ConnectAccountOutput struct {
 
 type ConnectAccountUseCase struct {
 	socialRepo socialDomain.AccountRepository // FIXED: Use AccountRepository
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
 	adapters   map[socialDomain.Platform]social.Adapter
+	recorder   *auditlog.Recorder
 	logger     common.Logger
 }
 
 func NewConnectAccountUseCase(
 	socialRepo socialDomain.AccountRepository, // FIXED
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
 	adapters map[socialDomain.Platform]social.Adapter,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *ConnectAccountUseCase {
 	return &ConnectAccountUseCase{
 		socialRepo: socialRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
 		adapters:   adapters,
+		recorder:   recorder,
 		logger:     logger,
 	}
 }
 
 func (uc *ConnectAccountUseCase) Execute(ctx context.Context, input ConnectAccountInput) (*ConnectAccountOutput, error) {
-	// 1. Verify user is team member
-	_, err := uc.memberRepo.FindMember(ctx, input.TeamID, input.UserID)
+	// 1. Verify user may connect accounts for the team
+	role, err := uc.authorizer.Role(ctx, input.TeamID, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("access denied: not a team member")
 	}
+	if !role.Allows(team.PermAccountsConnect) {
+		return nil, fmt.Errorf("access denied: %s permission required", team.PermAccountsConnect)
+	}
 
 	// 2. Get platform adapter
 	adapter, ok := uc.adapters[input.Platform]
@@ -150,6 +159,9 @@ func (uc *ConnectAccountUseCase) Execute(ctx context.Context, input ConnectAccou
 		return nil, fmt.Errorf("failed to save account")
 	}
 
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionAccountConnected, audit.TargetSocialAccount, account.ID().String()).
+		WithMetadata("platform", string(input.Platform)))
+
 	uc.logger.Info("Social account connected",
 		"teamId", input.TeamID,
 		"platform", input.Platform,
@@ -8,7 +8,9 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
 	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/domain/team"
 )
@@ -20,18 +22,28 @@ type DisconnectAccountInput struct {
 
 type DisconnectAccountUseCase struct {
 	socialRepo socialDomain.AccountRepository // FIXED
-	memberRepo team.MemberRepository
+	authorizer *team.Authorizer
+	tx         common.Transactor
+	events     common.EventBus
+	recorder   *auditlog.Recorder
 	logger     common.Logger
 }
 
 func NewDisconnectAccountUseCase(
 	socialRepo socialDomain.AccountRepository, // FIXED
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	tx common.Transactor,
+	events common.EventBus,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *DisconnectAccountUseCase {
 	return &DisconnectAccountUseCase{
 		socialRepo: socialRepo,
-		memberRepo: memberRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		tx:         tx,
+		events:     events,
+		recorder:   recorder,
 		logger:     logger,
 	}
 }
@@ -43,18 +55,30 @@ func (uc *DisconnectAccountUseCase) Execute(ctx context.Context, input Disconnec
 		return fmt.Errorf("account not found")
 	}
 
-	// 2. Verify user is team member
-	_, err = uc.memberRepo.FindMember(ctx, account.TeamID(), input.UserID)
+	// 2. Verify user may manage the team's accounts
+	role, err := uc.authorizer.Role(ctx, account.TeamID(), input.UserID)
 	if err != nil {
 		return fmt.Errorf("access denied: not a team member")
 	}
+	if !role.Allows(team.PermAccountsManage) {
+		return fmt.Errorf("access denied: %s permission required", team.PermAccountsManage)
+	}
 
-	// 3. Soft delete account
-	if err := uc.socialRepo.Delete(ctx, input.AccountID); err != nil {
+	// 3. Soft delete account together with the event
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.socialRepo.Delete(ctx, input.AccountID); err != nil {
+			return err
+		}
+		return uc.events.Publish(ctx, socialDomain.NewAccountDisconnected(account, input.UserID))
+	})
+	if err != nil {
 		uc.logger.Error("Failed to disconnect account", "accountId", input.AccountID, "error", err)
 		return fmt.Errorf("failed to disconnect account")
 	}
 
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, account.TeamID(), input.UserID, audit.ActionAccountDisconnected, audit.TargetSocialAccount, input.AccountID.String()).
+		WithMetadata("platform", string(account.Platform())))
+
 	uc.logger.Info("Social account disconnected", "accountId", input.AccountID)
 	return nil
 }
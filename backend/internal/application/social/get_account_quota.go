@@ -0,0 +1,86 @@
+// ============================================================================
+// FILE: backend/internal/application/social/get_account_quota.go
+// PURPOSE: Current platform API quota of a social account
+// ============================================================================
+package social
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type GetAccountQuotaInput struct {
+	AccountID uuid.UUID `json:"accountId" validate:"required"`
+	UserID    uuid.UUID `json:"userId" validate:"required"`
+}
+
+type GetAccountQuotaOutput struct {
+	AccountID uuid.UUID               `json:"accountId"`
+	Platform  string                  `json:"platform"`
+	Quotas    []common.RateLimitQuota `json:"quotas"`
+	// Plan limits tracked on the account; -1 means unlimited
+	HourlyRemaining int `json:"hourlyRemaining"`
+	DailyRemaining  int `json:"dailyRemaining"`
+}
+
+type GetAccountQuotaUseCase struct {
+	socialRepo socialDomain.AccountRepository
+	checker    *socialDomain.AccessChecker
+	limiter    common.PlatformRateLimiter
+	logger     common.Logger
+}
+
+func NewGetAccountQuotaUseCase(
+	socialRepo socialDomain.AccountRepository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
+	limiter common.PlatformRateLimiter,
+	logger common.Logger,
+) *GetAccountQuotaUseCase {
+	return &GetAccountQuotaUseCase{
+		socialRepo: socialRepo,
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
+		limiter:    limiter,
+		logger:     logger,
+	}
+}
+
+func (uc *GetAccountQuotaUseCase) Execute(ctx context.Context, input GetAccountQuotaInput) (*GetAccountQuotaOutput, error) {
+	// 1. Get account
+	account, err := uc.socialRepo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found")
+	}
+
+	// 2. Verify user may see the account
+	if err := requireAccountAccess(ctx, uc.checker, account, input.UserID, socialDomain.AccessAnalytics); err != nil {
+		return nil, err
+	}
+
+	hourly, daily := account.GetRemainingPosts()
+	output := &GetAccountQuotaOutput{
+		AccountID:       account.ID(),
+		Platform:        string(account.Platform()),
+		Quotas:          []common.RateLimitQuota{},
+		HourlyRemaining: hourly,
+		DailyRemaining:  daily,
+	}
+
+	// 3. Platform API quotas (shared across API and worker replicas)
+	if uc.limiter != nil {
+		quotas, err := uc.limiter.Quota(ctx, string(account.Platform()), account.ID().String())
+		if err != nil {
+			uc.logger.Error("Failed to read account quota", "accountId", input.AccountID, "error", err)
+			return nil, fmt.Errorf("failed to read account quota")
+		}
+		output.Quotas = quotas
+	}
+
+	return output, nil
+}
@@ -14,6 +14,7 @@ import (
 	socialAdapter "github.com/techappsUT/social-queue/internal/adapters/social" // FIX: Import adapter package
 	"github.com/techappsUT/social-queue/internal/application/common"
 	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
 type GetAnalyticsInput struct {
@@ -28,6 +29,7 @@ type GetAnalyticsOutput struct {
 
 type GetAnalyticsUseCase struct {
 	accountRepo socialDomain.AccountRepository
+	checker     *socialDomain.AccessChecker
 	adapters    map[socialDomain.Platform]socialAdapter.Adapter // FIX: Use adapter.Adapter type
 	cache       common.CacheService
 	logger      common.Logger
@@ -35,12 +37,16 @@ type GetAnalyticsUseCase struct {
 
 func NewGetAnalyticsUseCase(
 	accountRepo socialDomain.AccountRepository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
 	adapters map[socialDomain.Platform]socialAdapter.Adapter, // FIX: Use adapter.Adapter type
 	cache common.CacheService,
 	logger common.Logger,
 ) *GetAnalyticsUseCase {
 	return &GetAnalyticsUseCase{
 		accountRepo: accountRepo,
+		checker:     socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
 		adapters:    adapters,
 		cache:       cache,
 		logger:      logger,
@@ -48,10 +54,21 @@ func NewGetAnalyticsUseCase(
 }
 
 func (uc *GetAnalyticsUseCase) Execute(ctx context.Context, input GetAnalyticsInput) (*GetAnalyticsOutput, error) {
-	// 1. Build cache key
+	// 1. Get account
+	account, err := uc.accountRepo.FindByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found")
+	}
+
+	// 2. Authorization check, before anything is served from cache
+	if err := requireAccountAccess(ctx, uc.checker, account, input.UserID, socialDomain.AccessAnalytics); err != nil {
+		return nil, err
+	}
+
+	// 3. Build cache key
 	cacheKey := fmt.Sprintf("analytics:%s:%s", input.AccountID.String(), input.PostID)
 
-	// 2. Check cache (cache stores JSON string)
+	// 4. Check cache (cache stores JSON string)
 	cached, err := uc.cache.Get(ctx, cacheKey)
 	if err == nil && cached != "" {
 		// Unmarshal the JSON string to AnalyticsDTO
@@ -64,17 +81,6 @@ func (uc *GetAnalyticsUseCase) Execute(ctx context.Context, input GetAnalyticsIn
 		uc.logger.Warn("Failed to unmarshal cached analytics", "error", err)
 	}
 
-	// 3. Get account
-	account, err := uc.accountRepo.FindByID(ctx, input.AccountID)
-	if err != nil {
-		return nil, fmt.Errorf("account not found")
-	}
-
-	// 4. Authorization check
-	if account.UserID() != input.UserID {
-		return nil, fmt.Errorf("unauthorized access to account")
-	}
-
 	// 5. Get adapter
 	adapter, ok := uc.adapters[account.Platform()]
 	if !ok {
@@ -25,25 +25,27 @@ type ListAccountsOutput struct {
 
 type ListAccountsUseCase struct {
 	socialRepo socialDomain.AccountRepository // FIXED
-	memberRepo team.MemberRepository
+	checker    *socialDomain.AccessChecker
 	logger     common.Logger
 }
 
 func NewListAccountsUseCase(
 	socialRepo socialDomain.AccountRepository, // FIXED
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
 	logger common.Logger,
 ) *ListAccountsUseCase {
 	return &ListAccountsUseCase{
 		socialRepo: socialRepo,
-		memberRepo: memberRepo,
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
 		logger:     logger,
 	}
 }
 
 func (uc *ListAccountsUseCase) Execute(ctx context.Context, input ListAccountsInput) (*ListAccountsOutput, error) {
-	// 1. Verify user is team member
-	_, err := uc.memberRepo.FindMember(ctx, input.TeamID, input.UserID)
+	// 1. Verify user is team member and resolve their account access
+	access, err := uc.checker.Resolve(ctx, input.TeamID, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("access denied: not a team member")
 	}
@@ -55,7 +57,10 @@ func (uc *ListAccountsUseCase) Execute(ctx context.Context, input ListAccountsIn
 		return nil, fmt.Errorf("failed to list accounts")
 	}
 
-	// 3. Map to DTOs
+	// 3. Restricted members only see the accounts granted to them
+	accounts = access.Filter(accounts)
+
+	// 4. Map to DTOs
 	accountDTOs := make([]*SocialAccountDTO, 0, len(accounts))
 	for _, account := range accounts {
 		accountDTOs = append(accountDTOs, MapAccountToDTO(account))
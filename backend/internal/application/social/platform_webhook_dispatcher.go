@@ -0,0 +1,181 @@
+// ============================================================================
+// FILE: backend/internal/application/social/platform_webhook_dispatcher.go
+// PURPOSE: Processes logged platform webhooks
+// ============================================================================
+package social
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// platformWebhookBatch is how many logged webhooks one pass claims
+const platformWebhookBatch = 100
+
+// PlatformWebhookDispatcher turns logged webhooks into changes for every
+// team that connected the account they concern:
+//   - a revoked token marks the account revoked
+//   - comments, mentions and messages are published for the inbox
+//   - insights are merged into the published post's metrics
+//
+// Failed entries are retried on WebhookRetrySchedule. A retry handles the
+// whole payload again, so every handler is idempotent.
+type PlatformWebhookDispatcher struct {
+	parsers     socialDomain.WebhookParsers
+	webhookRepo socialDomain.WebhookRepository
+	accountRepo socialDomain.AccountRepository
+	metricsRepo socialDomain.PostMetricsRepository
+	tx          common.Transactor
+	events      common.EventBus
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewPlatformWebhookDispatcher(
+	parsers socialDomain.WebhookParsers,
+	webhookRepo socialDomain.WebhookRepository,
+	accountRepo socialDomain.AccountRepository,
+	metricsRepo socialDomain.PostMetricsRepository,
+	tx common.Transactor,
+	events common.EventBus,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *PlatformWebhookDispatcher {
+	return &PlatformWebhookDispatcher{
+		parsers:     parsers,
+		webhookRepo: webhookRepo,
+		accountRepo: accountRepo,
+		metricsRepo: metricsRepo,
+		tx:          tx,
+		events:      events,
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+// ProcessDue handles the logged webhooks that are due and returns how many
+// were claimed
+func (d *PlatformWebhookDispatcher) ProcessDue(ctx context.Context) (int, error) {
+	entries, err := d.webhookRepo.ClaimDueEvents(ctx, platformWebhookBatch, socialDomain.WebhookProcessLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			break // the lease brings the rest back
+		}
+
+		err := d.process(ctx, e)
+		now := time.Now().UTC()
+		switch {
+		case err == nil:
+			e.MarkProcessed(now)
+		case errors.Is(err, socialDomain.ErrInvalidWebhookPayload), errors.Is(err, socialDomain.ErrPlatformNotSupported):
+			e.Abandon(err, now)
+			d.logger.Warn("Dropped unprocessable webhook", "webhookId", e.ID, "platform", e.Platform, "error", err)
+		case e.RecordFailure(err, now):
+			d.logger.Warn("Webhook processing failed, will retry", "webhookId", e.ID, "attempts", e.Attempts, "error", err)
+		default:
+			d.logger.Error("Webhook processing failed, giving up", "webhookId", e.ID, "attempts", e.Attempts, "error", err)
+		}
+
+		if err := d.webhookRepo.UpdateEvent(ctx, e); err != nil {
+			d.logger.Error("Failed to update webhook log", "webhookId", e.ID, "error", err)
+		}
+	}
+	return len(entries), nil
+}
+
+func (d *PlatformWebhookDispatcher) process(ctx context.Context, e *socialDomain.WebhookEvent) error {
+	parser, err := d.parsers.Get(e.Platform)
+	if err != nil {
+		return err
+	}
+
+	events, err := parser.Parse(e.Payload)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, pe := range events {
+		if err := d.dispatch(ctx, pe); err != nil {
+			errs = append(errs, fmt.Errorf("%s for %s: %w", pe.Kind, pe.PlatformUserID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *PlatformWebhookDispatcher) dispatch(ctx context.Context, pe socialDomain.PlatformEvent) error {
+	accounts, err := d.accountRepo.FindAllByPlatformUserID(ctx, pe.Platform, pe.PlatformUserID)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		d.logger.Debug("Webhook for an account no team has connected", "platform", pe.Platform, "platformUserId", pe.PlatformUserID)
+		return nil
+	}
+
+	for _, account := range accounts {
+		var err error
+		switch pe.Kind {
+		case socialDomain.PlatformEventTokenRevoked:
+			err = d.revoke(ctx, account)
+		case socialDomain.PlatformEventComment, socialDomain.PlatformEventMention, socialDomain.PlatformEventMessage:
+			err = d.events.Publish(ctx, socialDomain.NewInteractionReceived(account, pe))
+		case socialDomain.PlatformEventInsights:
+			err = d.recordInsights(ctx, account, pe)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revoke marks the account revoked together with its event. Publishing
+// then fails fast and the team is asked to reconnect.
+func (d *PlatformWebhookDispatcher) revoke(ctx context.Context, account *socialDomain.Account) error {
+	if account.Status() == socialDomain.StatusRevoked {
+		return nil
+	}
+	if err := account.MarkRevoked(); err != nil {
+		return err
+	}
+
+	err := d.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := d.accountRepo.BulkUpdateStatus(ctx, []uuid.UUID{account.ID()}, socialDomain.StatusRevoked); err != nil {
+			return err
+		}
+		return d.events.Publish(ctx, socialDomain.NewAccountRevoked(account))
+	})
+	if err != nil {
+		return err
+	}
+
+	d.recorder.Record(ctx, audit.NewEntry(ctx, account.TeamID(), uuid.Nil, audit.ActionAccountRevoked, audit.TargetSocialAccount, account.ID().String()).
+		WithMetadata("platform", string(account.Platform())))
+
+	d.logger.Info("Social account revoked by platform", "accountId", account.ID(), "platform", account.Platform())
+	return nil
+}
+
+func (d *PlatformWebhookDispatcher) recordInsights(ctx context.Context, account *socialDomain.Account, pe socialDomain.PlatformEvent) error {
+	found, err := d.metricsRepo.MergePostMetrics(ctx, account.ID(), pe.ObjectID, pe.Metrics, pe.OccurredAt)
+	if err != nil {
+		return err
+	}
+	if !found {
+		d.logger.Debug("Insights for a post not published here", "accountId", account.ID(), "platformPostId", pe.ObjectID)
+	}
+	return nil
+}
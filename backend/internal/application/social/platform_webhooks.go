@@ -0,0 +1,176 @@
+// ============================================================================
+// FILE: backend/internal/application/social/platform_webhooks.go
+// PURPOSE: Receiving inbound platform webhooks and replaying logged ones
+// ============================================================================
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// ============================================================================
+// VERIFY SUBSCRIPTION
+// ============================================================================
+
+type VerifyWebhookSubscriptionInput struct {
+	Platform socialDomain.Platform
+	Query    url.Values
+}
+
+// VerifyWebhookSubscriptionUseCase answers the handshake a platform sends
+// when its webhook is registered
+type VerifyWebhookSubscriptionUseCase struct {
+	parsers socialDomain.WebhookParsers
+	logger  common.Logger
+}
+
+func NewVerifyWebhookSubscriptionUseCase(parsers socialDomain.WebhookParsers, logger common.Logger) *VerifyWebhookSubscriptionUseCase {
+	return &VerifyWebhookSubscriptionUseCase{parsers: parsers, logger: logger}
+}
+
+func (uc *VerifyWebhookSubscriptionUseCase) Execute(ctx context.Context, input VerifyWebhookSubscriptionInput) (*socialDomain.WebhookChallenge, error) {
+	parser, err := uc.parsers.Get(input.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := parser.Challenge(input.Query)
+	if err != nil {
+		uc.logger.Warn("Rejected webhook verification", "platform", input.Platform, "error", err)
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// ============================================================================
+// RECEIVE
+// ============================================================================
+
+type ReceivePlatformWebhookInput struct {
+	Platform  socialDomain.Platform
+	Signature string
+	Body      []byte
+}
+
+// ReceivePlatformWebhookUseCase verifies a webhook and logs it for the
+// worker. Nothing else happens on the request, so platforms get their
+// acknowledgement quickly.
+type ReceivePlatformWebhookUseCase struct {
+	parsers     socialDomain.WebhookParsers
+	webhookRepo socialDomain.WebhookRepository
+	logger      common.Logger
+}
+
+func NewReceivePlatformWebhookUseCase(
+	parsers socialDomain.WebhookParsers,
+	webhookRepo socialDomain.WebhookRepository,
+	logger common.Logger,
+) *ReceivePlatformWebhookUseCase {
+	return &ReceivePlatformWebhookUseCase{
+		parsers:     parsers,
+		webhookRepo: webhookRepo,
+		logger:      logger,
+	}
+}
+
+// SignatureHeader names the header carrying the platform's signature
+func (uc *ReceivePlatformWebhookUseCase) SignatureHeader(platform socialDomain.Platform) (string, error) {
+	parser, err := uc.parsers.Get(platform)
+	if err != nil {
+		return "", err
+	}
+	return parser.SignatureHeader(), nil
+}
+
+func (uc *ReceivePlatformWebhookUseCase) Execute(ctx context.Context, input ReceivePlatformWebhookInput) error {
+	parser, err := uc.parsers.Get(input.Platform)
+	if err != nil {
+		return err
+	}
+
+	if !parser.VerifySignature(input.Body, input.Signature) {
+		uc.logger.Warn("Rejected webhook with invalid signature", "platform", input.Platform)
+		return socialDomain.ErrInvalidWebhookSignature
+	}
+	if !json.Valid(input.Body) {
+		return socialDomain.ErrInvalidWebhookPayload
+	}
+
+	e := socialDomain.NewWebhookEvent(input.Platform, parser.EventType(input.Body), input.Body)
+	if err := uc.webhookRepo.SaveEvent(ctx, e); err != nil {
+		uc.logger.Error("Failed to log webhook", "platform", input.Platform, "error", err)
+		return fmt.Errorf("failed to store webhook")
+	}
+	return nil
+}
+
+// ============================================================================
+// REPLAY
+// ============================================================================
+
+type ReplayPlatformWebhooksInput struct {
+	Platform   socialDomain.Platform `json:"platform"` // empty for all platforms
+	Since      time.Time             `json:"since"`
+	Until      time.Time             `json:"until"` // defaults to now
+	FailedOnly bool                  `json:"failedOnly"`
+}
+
+type ReplayPlatformWebhooksOutput struct {
+	Requeued int `json:"requeued"`
+}
+
+// ReplayPlatformWebhooksUseCase queues logged webhooks to be processed
+// again, for example after a fix for entries that were given up. Handlers
+// are idempotent, so processed entries can be replayed too.
+type ReplayPlatformWebhooksUseCase struct {
+	parsers     socialDomain.WebhookParsers
+	webhookRepo socialDomain.WebhookRepository
+	logger      common.Logger
+}
+
+func NewReplayPlatformWebhooksUseCase(
+	parsers socialDomain.WebhookParsers,
+	webhookRepo socialDomain.WebhookRepository,
+	logger common.Logger,
+) *ReplayPlatformWebhooksUseCase {
+	return &ReplayPlatformWebhooksUseCase{
+		parsers:     parsers,
+		webhookRepo: webhookRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *ReplayPlatformWebhooksUseCase) Execute(ctx context.Context, input ReplayPlatformWebhooksInput) (*ReplayPlatformWebhooksOutput, error) {
+	if input.Since.IsZero() {
+		return nil, fmt.Errorf("since is required")
+	}
+	if !input.Until.IsZero() && !input.Until.After(input.Since) {
+		return nil, fmt.Errorf("until must be after since")
+	}
+	if input.Platform != "" {
+		if _, err := uc.parsers.Get(input.Platform); err != nil {
+			return nil, err
+		}
+	}
+
+	n, err := uc.webhookRepo.RequeueEvents(ctx, socialDomain.WebhookReplayFilter{
+		Platform:   input.Platform,
+		Since:      input.Since,
+		Until:      input.Until,
+		FailedOnly: input.FailedOnly,
+	})
+	if err != nil {
+		uc.logger.Error("Failed to requeue webhooks", "error", err)
+		return nil, fmt.Errorf("failed to replay webhooks")
+	}
+
+	uc.logger.Info("Requeued platform webhooks", "count", n, "platform", input.Platform, "since", input.Since)
+	return &ReplayPlatformWebhooksOutput{Requeued: n}, nil
+}
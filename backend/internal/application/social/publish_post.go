@@ -5,6 +5,7 @@ package social
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -30,21 +31,26 @@ type PublishPostOutput struct {
 
 type PublishPostUseCase struct {
 	socialRepo socialDomain.AccountRepository // FIXED
-	memberRepo team.MemberRepository
+	checker    *socialDomain.AccessChecker
 	adapters   map[socialDomain.Platform]social.Adapter
+	limiter    common.PlatformRateLimiter
 	logger     common.Logger
 }
 
 func NewPublishPostUseCase(
 	socialRepo socialDomain.AccountRepository, // FIXED
 	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	accessRepo socialDomain.AccessRepository,
 	adapters map[socialDomain.Platform]social.Adapter,
+	limiter common.PlatformRateLimiter,
 	logger common.Logger,
 ) *PublishPostUseCase {
 	return &PublishPostUseCase{
 		socialRepo: socialRepo,
-		memberRepo: memberRepo,
+		checker:    socialDomain.NewAccessChecker(memberRepo, roleRepo, accessRepo),
 		adapters:   adapters,
+		limiter:    limiter,
 		logger:     logger,
 	}
 }
@@ -56,10 +62,9 @@ func (uc *PublishPostUseCase) Execute(ctx context.Context, input PublishPostInpu
 		return nil, fmt.Errorf("account not found")
 	}
 
-	// 2. Verify user is team member
-	_, err = uc.memberRepo.FindMember(ctx, account.TeamID(), input.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("access denied")
+	// 2. Verify user may publish to the account
+	if err := requireAccountAccess(ctx, uc.checker, account, input.UserID, socialDomain.AccessPublish); err != nil {
+		return nil, err
 	}
 
 	// 3. Check account is active
@@ -89,8 +94,24 @@ func (uc *PublishPostUseCase) Execute(ctx context.Context, input PublishPostInpu
 		MediaURLs: input.MediaURLs,
 	}
 
-	// 7. Publish to platform
+	// 7. Respect the account's platform quota
+	platform, accountID := string(account.Platform()), account.ID().String()
+	if uc.limiter != nil {
+		decision, err := uc.limiter.Take(ctx, platform, accountID, common.RateClassPublish)
+		if err != nil {
+			uc.logger.Warn("Rate limit check failed", "accountId", input.AccountID, "error", err)
+		} else if !decision.Allowed {
+			return nil, fmt.Errorf("%w: retry after %s", common.ErrRateLimitExceeded, decision.RetryAfter.Round(time.Second))
+		}
+	}
+
+	// 8. Publish to platform
 	result, err := adapter.PublishPost(ctx, token, content)
+	if rl := rateLimitOf(result, err); rl != nil && uc.limiter != nil {
+		if err := social.ObserveRateLimit(ctx, uc.limiter, platform, accountID, common.RateClassPublish, rl); err != nil {
+			uc.logger.Warn("Failed to record platform rate limit", "accountId", input.AccountID, "error", err)
+		}
+	}
 	if err != nil {
 		uc.logger.Error("Failed to publish post",
 			"accountId", input.AccountID,
@@ -110,3 +131,15 @@ func (uc *PublishPostUseCase) Execute(ctx context.Context, input PublishPostInpu
 		PublishedAt:    result.PublishedAt,
 	}, nil
 }
+
+// rateLimitOf returns the quota reported by a publish call, if any
+func rateLimitOf(result *social.PublishResult, err error) *social.RateLimit {
+	var rateErr *social.RateLimitError
+	if errors.As(err, &rateErr) {
+		return rateErr.Limit
+	}
+	if result != nil {
+		return result.RateLimit
+	}
+	return nil
+}
@@ -25,17 +25,23 @@ type RefreshTokensOutput struct {
 type RefreshTokensUseCase struct {
 	socialRepo socialDomain.AccountRepository // FIXED
 	adapters   map[socialDomain.Platform]social.Adapter
+	tx         common.Transactor
+	events     common.EventBus
 	logger     common.Logger
 }
 
 func NewRefreshTokensUseCase(
 	socialRepo socialDomain.AccountRepository, // FIXED
 	adapters map[socialDomain.Platform]social.Adapter,
+	tx common.Transactor,
+	events common.EventBus,
 	logger common.Logger,
 ) *RefreshTokensUseCase {
 	return &RefreshTokensUseCase{
 		socialRepo: socialRepo,
 		adapters:   adapters,
+		tx:         tx,
+		events:     events,
 		logger:     logger,
 	}
 }
@@ -64,9 +70,18 @@ func (uc *RefreshTokensUseCase) Execute(ctx context.Context, input RefreshTokens
 	newToken, err := adapter.RefreshToken(ctx, credentials.RefreshToken)
 	if err != nil {
 		uc.logger.Error("Token refresh failed", "accountId", input.AccountID, "error", err)
-		// Mark account as needing reconnection
-		account.MarkExpired()
-		uc.socialRepo.Update(ctx, account)
+		// Mark account as needing reconnection; the event is raised once
+		if account.MarkExpired() == nil {
+			err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+				if err := uc.socialRepo.Update(ctx, account); err != nil {
+					return err
+				}
+				return uc.events.Publish(ctx, socialDomain.NewAccountExpired(account))
+			})
+			if err != nil {
+				uc.logger.Error("Failed to mark account expired", "accountId", input.AccountID, "error", err)
+			}
+		}
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
 	}
 
@@ -0,0 +1,351 @@
+// ============================================================================
+// FILE: backend/internal/application/team/api_keys.go
+// PURPOSE: Personal access tokens and team API keys
+// ============================================================================
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+type APIKeyDTO struct {
+	ID         uuid.UUID         `json:"id"`
+	TeamID     *uuid.UUID        `json:"teamId,omitempty"`
+	UserID     uuid.UUID         `json:"userId"`
+	Name       string            `json:"name"`
+	Prefix     string            `json:"prefix"`
+	Scopes     []team.Permission `json:"scopes"`
+	ExpiresAt  *time.Time        `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time        `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time        `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time         `json:"createdAt"`
+}
+
+func mapAPIKeyToDTO(k *team.APIKey) APIKeyDTO {
+	dto := APIKeyDTO{
+		ID:         k.ID(),
+		UserID:     k.UserID(),
+		Name:       k.Name(),
+		Prefix:     k.Prefix(),
+		Scopes:     k.Scopes(),
+		ExpiresAt:  k.ExpiresAt(),
+		LastUsedAt: k.LastUsedAt(),
+		RevokedAt:  k.RevokedAt(),
+		CreatedAt:  k.CreatedAt(),
+	}
+	if !k.IsPersonal() {
+		teamID := k.TeamID()
+		dto.TeamID = &teamID
+	}
+	if dto.Scopes == nil {
+		dto.Scopes = []team.Permission{}
+	}
+	return dto
+}
+
+// requireAPIAccess checks the team's plan includes API access
+func requireAPIAccess(ctx context.Context, teamRepo team.Repository, teamID uuid.UUID) error {
+	t, err := teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return team.ErrTeamNotFound
+	}
+	if !t.HasFeature("api_access") {
+		return team.ErrFeatureNotAvailable
+	}
+	return nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+// CreateAPIKeyInput creates a team key when TeamID is set and a personal
+// access token otherwise
+type CreateAPIKeyInput struct {
+	TeamID    uuid.UUID         `json:"-"`
+	UserID    uuid.UUID         `json:"-"`
+	Name      string            `json:"name"`
+	Scopes    []team.Permission `json:"scopes"`
+	ExpiresAt *time.Time        `json:"expiresAt,omitempty"`
+}
+
+// CreateAPIKeyOutput carries the plaintext key, which is only shown once
+type CreateAPIKeyOutput struct {
+	APIKeyDTO
+	Key string `json:"key"`
+}
+
+type CreateAPIKeyUseCase struct {
+	keyRepo    team.APIKeyRepository
+	teamRepo   team.Repository
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewCreateAPIKeyUseCase(
+	keyRepo team.APIKeyRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		keyRepo:    keyRepo,
+		teamRepo:   teamRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, input CreateAPIKeyInput) (*CreateAPIKeyOutput, error) {
+	// 1. Team keys need team.manage, the plan feature, and may only carry
+	// scopes the creator holds
+	if input.TeamID != uuid.Nil {
+		role, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage)
+		if err != nil {
+			return nil, err
+		}
+		if err := requireAPIAccess(ctx, uc.teamRepo, input.TeamID); err != nil {
+			return nil, err
+		}
+		for _, scope := range input.Scopes {
+			if scope.IsKnown() && !role.Allows(scope) {
+				return nil, fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+			}
+		}
+	}
+
+	// 2. Create
+	key, raw, err := team.NewAPIKey(input.TeamID, input.UserID, input.Name, input.Scopes, input.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.keyRepo.Create(ctx, key); err != nil {
+		uc.logger.Error("Failed to create API key", "userId", input.UserID, "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to create api key")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionAPIKeyCreated, audit.TargetAPIKey, key.ID().String()).
+		WithMetadata("name", key.Name()).
+		WithMetadata("scopes", joinScopes(key.Scopes())))
+
+	uc.logger.Info("API key created",
+		"keyId", key.ID(),
+		"userId", input.UserID,
+		"teamId", input.TeamID,
+		"scopes", len(key.Scopes()))
+
+	return &CreateAPIKeyOutput{APIKeyDTO: mapAPIKeyToDTO(key), Key: raw}, nil
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+// ListAPIKeysInput lists the team's keys when TeamID is set and the user's
+// personal access tokens otherwise
+type ListAPIKeysInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListAPIKeysUseCase struct {
+	keyRepo    team.APIKeyRepository
+	authorizer *team.Authorizer
+}
+
+func NewListAPIKeysUseCase(keyRepo team.APIKeyRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		keyRepo:    keyRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, input ListAPIKeysInput) ([]APIKeyDTO, error) {
+	var (
+		keys []*team.APIKey
+		err  error
+	)
+	if input.TeamID != uuid.Nil {
+		if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+			return nil, err
+		}
+		keys, err = uc.keyRepo.ListByTeam(ctx, input.TeamID)
+	} else {
+		keys, err = uc.keyRepo.ListPersonal(ctx, input.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]APIKeyDTO, 0, len(keys))
+	for _, k := range keys {
+		dtos = append(dtos, mapAPIKeyToDTO(k))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// REVOKE
+// ============================================================================
+
+type RevokeAPIKeyInput struct {
+	TeamID uuid.UUID // uuid.Nil for personal access tokens
+	UserID uuid.UUID
+	KeyID  uuid.UUID
+}
+
+type RevokeAPIKeyUseCase struct {
+	keyRepo    team.APIKeyRepository
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewRevokeAPIKeyUseCase(keyRepo team.APIKeyRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, recorder *auditlog.Recorder, logger common.Logger) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		keyRepo:    keyRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, input RevokeAPIKeyInput) error {
+	if input.TeamID != uuid.Nil {
+		if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+			return err
+		}
+	}
+
+	// Keys of other users and other teams look the same as missing ones
+	key, err := uc.keyRepo.FindByID(ctx, input.KeyID)
+	if err != nil {
+		return err
+	}
+	if key.TeamID() != input.TeamID || (key.IsPersonal() && key.UserID() != input.UserID) {
+		return team.ErrAPIKeyNotFound
+	}
+
+	if err := uc.keyRepo.Revoke(ctx, key.ID(), time.Now().UTC()); err != nil {
+		return err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionAPIKeyRevoked, audit.TargetAPIKey, key.ID().String()).
+		WithMetadata("name", key.Name()))
+
+	uc.logger.Info("API key revoked", "keyId", key.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	return nil
+}
+
+// ============================================================================
+// AUTHENTICATE
+// ============================================================================
+
+// AuthenticateAPIKeyUseCase resolves a bearer API key to the key, whose
+// owner and scope the request then runs with
+type AuthenticateAPIKeyUseCase struct {
+	keyRepo  team.APIKeyRepository
+	teamRepo team.Repository
+	userRepo user.Repository
+	recorder *auditlog.Recorder
+	logger   common.Logger
+}
+
+func NewAuthenticateAPIKeyUseCase(keyRepo team.APIKeyRepository, teamRepo team.Repository, userRepo user.Repository, recorder *auditlog.Recorder, logger common.Logger) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{keyRepo: keyRepo, teamRepo: teamRepo, userRepo: userRepo, recorder: recorder, logger: logger}
+}
+
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, rawKey string) (*team.APIKey, error) {
+	if !team.IsAPIKey(rawKey) {
+		return nil, team.ErrAPIKeyNotFound
+	}
+
+	key, err := uc.keyRepo.FindByHash(ctx, team.HashAPIKey(rawKey))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := key.Validate(now); err != nil {
+		return nil, err
+	}
+
+	// The key acts as its owner, so it stops working with the owner's
+	// account: suspended, deactivated or deleted
+	owner, err := uc.userRepo.FindByID(ctx, key.UserID())
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, team.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	if !owner.CanAccessPlatform() {
+		return nil, user.ErrUnauthorized
+	}
+
+	// A downgraded team keeps its keys but cannot use them. Personal tokens
+	// only reach the owner's teams whose plan includes API access.
+	if key.IsPersonal() {
+		teamIDs, err := uc.apiTeams(ctx, owner.ID())
+		if err != nil {
+			return nil, err
+		}
+		key.LimitToTeams(teamIDs)
+	} else if err := requireAPIAccess(ctx, uc.teamRepo, key.TeamID()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.keyRepo.Touch(ctx, key.ID(), now); err != nil {
+		uc.logger.Warn("Failed to update API key usage", "keyId", key.ID(), "error", err)
+	}
+
+	entry := audit.NewEntry(ctx, key.TeamID(), key.UserID(), audit.ActionAPIKeyUsed, audit.TargetAPIKey, key.ID().String())
+	if req, ok := audit.RequestFromContext(ctx); ok {
+		entry.WithMetadata("method", req.Method).WithMetadata("path", req.Path)
+	}
+	uc.recorder.Record(ctx, entry)
+
+	return key, nil
+}
+
+// apiTeams returns the user's teams whose plan includes API access, or
+// ErrFeatureNotAvailable when there are none
+func (uc *AuthenticateAPIKeyUseCase) apiTeams(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	teams, err := uc.teamRepo.FindByMemberID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs := make([]uuid.UUID, 0, len(teams))
+	for _, t := range teams {
+		if t.HasFeature("api_access") {
+			teamIDs = append(teamIDs, t.ID())
+		}
+	}
+	if len(teamIDs) == 0 {
+		return nil, team.ErrFeatureNotAvailable
+	}
+	return teamIDs, nil
+}
+
+func joinScopes(scopes []team.Permission) string {
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = string(s)
+	}
+	return strings.Join(names, ",")
+}
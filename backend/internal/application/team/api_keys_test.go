@@ -0,0 +1,233 @@
+// path: backend/internal/application/team/api_keys_test.go
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memoryKeys struct {
+	team.APIKeyRepository
+	keys map[string]*team.APIKey
+}
+
+func (r *memoryKeys) FindByHash(ctx context.Context, keyHash string) (*team.APIKey, error) {
+	if k, ok := r.keys[keyHash]; ok {
+		return k, nil
+	}
+	return nil, team.ErrAPIKeyNotFound
+}
+
+func (r *memoryKeys) Touch(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	return nil
+}
+
+type memoryTeams struct {
+	team.Repository
+	teams   map[uuid.UUID]*team.Team
+	members map[uuid.UUID][]uuid.UUID // user ID to team IDs
+}
+
+func (r *memoryTeams) FindByID(ctx context.Context, id uuid.UUID) (*team.Team, error) {
+	if t, ok := r.teams[id]; ok {
+		return t, nil
+	}
+	return nil, team.ErrTeamNotFound
+}
+
+func (r *memoryTeams) FindByMemberID(ctx context.Context, userID uuid.UUID) ([]*team.Team, error) {
+	var teams []*team.Team
+	for _, id := range r.members[userID] {
+		teams = append(teams, r.teams[id])
+	}
+	return teams, nil
+}
+
+type memoryUsers struct {
+	user.Repository
+	users map[uuid.UUID]*user.User
+}
+
+func (r *memoryUsers) FindByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	if u, ok := r.users[id]; ok {
+		return u, nil
+	}
+	return nil, user.ErrUserNotFound
+}
+
+type activeMembers struct {
+	team.MemberRepository
+	teams *memoryTeams
+}
+
+func (r activeMembers) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	for _, id := range r.teams.members[userID] {
+		if id == teamID {
+			joined := time.Now()
+			return team.ReconstructMember(uuid.New(), teamID, userID, team.MemberRoleEditor, team.MemberStatusActive, userID, joined, &joined, nil), nil
+		}
+	}
+	return nil, team.ErrMemberNotFound
+}
+
+type editorRole struct {
+	team.RoleRepository
+}
+
+func (r editorRole) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	return team.ReconstructRole(uuid.New(), teamID, name, "", []team.Permission{team.PermPostsView, team.PermPostsCreate}, true, time.Now(), time.Now()), nil
+}
+
+type apiKeyFixture struct {
+	owner *user.User
+	teams *memoryTeams
+	keys  *memoryKeys
+	auth  *AuthenticateAPIKeyUseCase
+}
+
+func newAPIKeyFixture(t *testing.T) *apiKeyFixture {
+	t.Helper()
+
+	owner, err := user.NewExternalUser("ana@example.com", "ana", "Ana", "Lima", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	teams := &memoryTeams{teams: map[uuid.UUID]*team.Team{}, members: map[uuid.UUID][]uuid.UUID{}}
+	keys := &memoryKeys{keys: map[string]*team.APIKey{}}
+	users := &memoryUsers{users: map[uuid.UUID]*user.User{owner.ID(): owner}}
+
+	return &apiKeyFixture{
+		owner: owner,
+		teams: teams,
+		keys:  keys,
+		auth:  NewAuthenticateAPIKeyUseCase(keys, teams, users, nil, services.NewLogger()),
+	}
+}
+
+// addTeam makes the owner a member of a new team on the plan
+func (f *apiKeyFixture) addTeam(plan team.Plan) uuid.UUID {
+	now := time.Now()
+	t := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", f.owner.ID(), plan, team.StatusActive, team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	f.teams.teams[t.ID()] = t
+	f.teams.members[f.owner.ID()] = append(f.teams.members[f.owner.ID()], t.ID())
+	return t.ID()
+}
+
+func (f *apiKeyFixture) newKey(t *testing.T, teamID uuid.UUID, scopes ...team.Permission) string {
+	t.Helper()
+
+	key, raw, err := team.NewAPIKey(teamID, f.owner.ID(), "ci", scopes, nil)
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	f.keys.keys[key.KeyHash()] = key
+	return raw
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	teamID := f.addTeam(team.PlanEnterprise)
+	raw := f.newKey(t, teamID, team.PermPostsView)
+
+	key, err := f.auth.Execute(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if key.UserID() != f.owner.ID() || key.TeamID() != teamID {
+		t.Errorf("key acts as %s in %s, want %s in %s", key.UserID(), key.TeamID(), f.owner.ID(), teamID)
+	}
+
+	for name, rawKey := range map[string]string{
+		"unknown key": team.TeamKeyPrefix + "unknown",
+		"session jwt": "eyJhbGciOiJIUzI1NiJ9.e30.sig",
+	} {
+		if _, err := f.auth.Execute(context.Background(), rawKey); !errors.Is(err, team.ErrAPIKeyNotFound) {
+			t.Errorf("%s: err = %v, want ErrAPIKeyNotFound", name, err)
+		}
+	}
+
+	key.Revoke()
+	if _, err := f.auth.Execute(context.Background(), raw); err == nil {
+		t.Error("revoked key authenticated")
+	}
+}
+
+func TestAPIKeyScopeNarrowsRole(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	teamID := f.addTeam(team.PlanEnterprise)
+	otherID := f.addTeam(team.PlanEnterprise)
+	authorizer := team.NewAuthorizer(activeMembers{teams: f.teams}, editorRole{})
+
+	key, err := f.auth.Execute(context.Background(), f.newKey(t, teamID, team.PermPostsView))
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	ctx := team.WithScope(context.Background(), key.Scope())
+
+	role, err := authorizer.Role(ctx, teamID, f.owner.ID())
+	if err != nil {
+		t.Fatalf("Role: %v", err)
+	}
+	if !role.Allows(team.PermPostsView) || role.Allows(team.PermPostsCreate) {
+		t.Error("key role is not limited to the key's scopes")
+	}
+
+	// The owner is an editor in the other team too, but a team key only
+	// reaches its own
+	if _, err := authorizer.Role(ctx, otherID, f.owner.ID()); !errors.Is(err, team.ErrMemberNotFound) {
+		t.Errorf("other team: err = %v, want ErrMemberNotFound", err)
+	}
+}
+
+func TestAPIKeyStopsWorkingWithItsOwner(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	raw := f.newKey(t, f.addTeam(team.PlanEnterprise), team.PermPostsView)
+
+	if err := f.owner.Suspend(); err != nil {
+		t.Fatalf("Suspend: %v", err)
+	}
+	if _, err := f.auth.Execute(context.Background(), raw); !errors.Is(err, user.ErrUnauthorized) {
+		t.Fatalf("suspended owner: err = %v, want ErrUnauthorized", err)
+	}
+
+	key, _, err := team.NewAPIKey(uuid.Nil, uuid.New(), "orphan", []team.Permission{team.PermPostsView}, nil)
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	orphan := team.PersonalTokenPrefix + "orphan"
+	f.keys.keys[team.HashAPIKey(orphan)] = key
+	if _, err := f.auth.Execute(context.Background(), orphan); !errors.Is(err, team.ErrAPIKeyNotFound) {
+		t.Errorf("deleted owner: err = %v, want ErrAPIKeyNotFound", err)
+	}
+}
+
+func TestAPIKeyNeedsAPIAccessPlan(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	freeID := f.addTeam(team.PlanFree)
+
+	if _, err := f.auth.Execute(context.Background(), f.newKey(t, freeID, team.PermPostsView)); !errors.Is(err, team.ErrFeatureNotAvailable) {
+		t.Fatalf("team key on free plan: err = %v, want ErrFeatureNotAvailable", err)
+	}
+	personal := f.newKey(t, uuid.Nil, team.PermPostsView)
+	if _, err := f.auth.Execute(context.Background(), personal); !errors.Is(err, team.ErrFeatureNotAvailable) {
+		t.Fatalf("personal token without an API plan: err = %v, want ErrFeatureNotAvailable", err)
+	}
+
+	// With one team on an API plan, the token works there and only there
+	enterpriseID := f.addTeam(team.PlanEnterprise)
+	key, err := f.auth.Execute(context.Background(), personal)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	scope := key.Scope()
+	if !scope.Reaches(enterpriseID) || scope.Reaches(freeID) {
+		t.Errorf("personal token reaches %v, want only %s", scope.Teams, enterpriseID)
+	}
+}
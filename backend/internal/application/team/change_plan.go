@@ -0,0 +1,77 @@
+// ============================================================================
+// path: backend/internal/application/team/change_plan.go
+// ============================================================================
+package team
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// ChangeTeamPlanInput moves a team to another plan. Plans are changed by
+// platform operators; self-service billing is not part of the API.
+type ChangeTeamPlanInput struct {
+	TeamID  uuid.UUID `json:"-"`
+	AdminID uuid.UUID `json:"-"`
+	Plan    string    `json:"plan"`
+}
+
+type ChangeTeamPlanOutput struct {
+	TeamID  uuid.UUID `json:"teamId"`
+	OldPlan string    `json:"oldPlan"`
+	Plan    string    `json:"plan"`
+}
+
+// ChangeTeamPlanUseCase changes a team's plan, raises PlanChanged with the
+// change and records it in the team's audit log, all in one transaction
+type ChangeTeamPlanUseCase struct {
+	teamService *team.Service
+	tx          common.Transactor
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewChangeTeamPlanUseCase(teamService *team.Service, tx common.Transactor, recorder *auditlog.Recorder, logger common.Logger) *ChangeTeamPlanUseCase {
+	return &ChangeTeamPlanUseCase{teamService: teamService, tx: tx, recorder: recorder, logger: logger}
+}
+
+func (uc *ChangeTeamPlanUseCase) Execute(ctx context.Context, input ChangeTeamPlanInput) (*ChangeTeamPlanOutput, error) {
+	var (
+		t       *team.Team
+		oldPlan team.Plan
+	)
+	err := uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		t, oldPlan, err = uc.teamService.ChangeTeamPlan(ctx, input.TeamID, team.Plan(input.Plan), input.AdminID)
+		if err != nil {
+			return err
+		}
+
+		entry := audit.NewEntry(ctx, t.ID(), input.AdminID, audit.ActionPlanChanged, audit.TargetTeam, t.ID().String()).
+			WithChanges(audit.Diff(
+				map[string]interface{}{"plan": string(oldPlan)},
+				map[string]interface{}{"plan": string(t.Plan())},
+			))
+		if err := uc.recorder.Append(ctx, entry); err != nil {
+			return fmt.Errorf("failed to record plan change: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Team plan changed", "teamId", t.ID(), "from", oldPlan, "to", t.Plan(), "by", input.AdminID)
+
+	return &ChangeTeamPlanOutput{
+		TeamID:  t.ID(),
+		OldPlan: string(oldPlan),
+		Plan:    string(t.Plan()),
+	}, nil
+}
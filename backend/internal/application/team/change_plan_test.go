@@ -0,0 +1,93 @@
+// path: backend/internal/application/team/change_plan_test.go
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+func (r *memoryTeams) Update(ctx context.Context, t *team.Team) error {
+	r.teams[t.ID()] = t
+	return nil
+}
+
+type txKey struct{}
+
+// recordingTx marks the context of the work it runs and keeps its result,
+// which a database transaction would commit or roll back
+type recordingTx struct {
+	result error
+}
+
+func (tx *recordingTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx.result = fn(context.WithValue(ctx, txKey{}, tx))
+	return tx.result
+}
+
+// txAuditLog only accepts entries written inside a transaction
+type txAuditLog struct {
+	audit.Repository
+	entries []*audit.Entry
+	err     error
+}
+
+func (r *txAuditLog) Append(ctx context.Context, e *audit.Entry) error {
+	if ctx.Value(txKey{}) == nil {
+		return errors.New("audit entry written outside the transaction")
+	}
+	if r.err != nil {
+		return r.err
+	}
+	r.entries = append(r.entries, e)
+	return nil
+}
+
+func TestChangeTeamPlanIsAudited(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	teamID := f.addTeam(team.PlanFree)
+	adminID := uuid.New()
+	tx, log := &recordingTx{}, &txAuditLog{}
+	uc := NewChangeTeamPlanUseCase(team.NewService(f.teams, nil, nil, nil), tx, auditlog.NewRecorder(log, services.NewLogger()), services.NewLogger())
+
+	out, err := uc.Execute(context.Background(), ChangeTeamPlanInput{TeamID: teamID, AdminID: adminID, Plan: string(team.PlanEnterprise)})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if out.OldPlan != string(team.PlanFree) || out.Plan != string(team.PlanEnterprise) {
+		t.Errorf("got %+v", out)
+	}
+
+	if len(log.entries) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(log.entries))
+	}
+	e := log.entries[0]
+	if e.Action() != audit.ActionPlanChanged || e.TeamID() != teamID || e.ActorID() != adminID {
+		t.Errorf("entry = %s by %s in %s", e.Action(), e.ActorID(), e.TeamID())
+	}
+	if c := e.Changes()["plan"]; c.From != string(team.PlanFree) || c.To != string(team.PlanEnterprise) {
+		t.Errorf("changes = %+v, want free to enterprise", e.Changes())
+	}
+}
+
+func TestChangeTeamPlanFailsWithoutAuditEntry(t *testing.T) {
+	f := newAPIKeyFixture(t)
+	teamID := f.addTeam(team.PlanFree)
+	tx, log := &recordingTx{}, &txAuditLog{err: errors.New("audit_log unavailable")}
+	uc := NewChangeTeamPlanUseCase(team.NewService(f.teams, nil, nil, nil), tx, auditlog.NewRecorder(log, services.NewLogger()), services.NewLogger())
+
+	_, err := uc.Execute(context.Background(), ChangeTeamPlanInput{TeamID: teamID, AdminID: uuid.New(), Plan: string(team.PlanEnterprise)})
+	if !errors.Is(err, log.err) {
+		t.Fatalf("Execute: err = %v, want %v", err, log.err)
+	}
+	// The transaction sees the failure, so the plan change is rolled back
+	if !errors.Is(tx.result, log.err) {
+		t.Errorf("transaction result = %v, want the audit failure", tx.result)
+	}
+}
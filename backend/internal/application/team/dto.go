@@ -29,6 +29,7 @@ type TeamSettingsDTO struct {
 	EnableNotifications bool   `json:"enableNotifications"`
 	EnableAnalytics     bool   `json:"enableAnalytics"`
 	RequireApproval     bool   `json:"requireApproval"`
+	RequireTwoFactor    bool   `json:"requireTwoFactor"`
 	AutoSchedule        bool   `json:"autoSchedule"`
 	Language            string `json:"language"`
 	DateFormat          string `json:"dateFormat"`
@@ -86,6 +87,7 @@ func MapTeamToDTO(t *team.Team, members []*team.Member, userRepo user.Repository
 		EnableNotifications: settings.EnableNotifications,
 		EnableAnalytics:     settings.EnableAnalytics,
 		RequireApproval:     settings.RequireApproval,
+		RequireTwoFactor:    settings.RequireTwoFactor,
 		AutoSchedule:        settings.AutoSchedule,
 		Language:            settings.Language,
 		DateFormat:          settings.DateFormat,
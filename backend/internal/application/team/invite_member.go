@@ -31,24 +31,35 @@ type InviteMemberUseCase struct {
 	validator    *validator.Validate
 	teamRepo     teamDomain.Repository
 	memberRepo   teamDomain.MemberRepository
+	roleRepo     teamDomain.RoleRepository
+	authorizer   *teamDomain.Authorizer
 	userRepo     user.Repository
 	emailService common.EmailService
+	tx           common.Transactor
+	events       common.EventBus
 	logger       common.Logger
 }
 
 func NewInviteMemberUseCase(
 	teamRepo teamDomain.Repository,
 	memberRepo teamDomain.MemberRepository,
+	roleRepo teamDomain.RoleRepository,
 	userRepo user.Repository,
 	emailService common.EmailService,
+	tx common.Transactor,
+	events common.EventBus,
 	logger common.Logger,
 ) *InviteMemberUseCase {
 	return &InviteMemberUseCase{
 		validator:    validator.New(), // ✅ FIX: Initialize validator
 		teamRepo:     teamRepo,
 		memberRepo:   memberRepo,
+		roleRepo:     roleRepo,
+		authorizer:   teamDomain.NewAuthorizer(memberRepo, roleRepo),
 		userRepo:     userRepo,
 		emailService: emailService,
+		tx:           tx,
+		events:       events,
 		logger:       logger,
 	}
 }
@@ -60,19 +71,15 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberIn
 		return nil, fmt.Errorf("validation failed: %v", fields)
 	}
 
-	// 2. Validate role
-	if !uc.isValidRole(input.Role) {
-		return nil, fmt.Errorf("invalid role: must be owner, admin, editor, or viewer")
-	}
-
-	// 3. Check inviter authorization (must be admin or owner)
-	inviter, err := uc.memberRepo.FindMember(ctx, input.TeamID, input.InviterID)
+	// 2. Check inviter authorization
+	inviter, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.InviterID, teamDomain.PermMembersInvite)
 	if err != nil {
-		return nil, fmt.Errorf("access denied: not a team member")
+		return nil, err
 	}
 
-	if inviter.Role() != teamDomain.MemberRoleOwner && inviter.Role() != teamDomain.MemberRoleAdmin {
-		return nil, fmt.Errorf("access denied: only admins and owners can invite members")
+	// 3. The role must exist and grant nothing beyond the inviter's own
+	if err := requireRoleGrant(ctx, uc.roleRepo, inviter, input.TeamID, input.Role); err != nil {
+		return nil, err
 	}
 
 	// 4. Get team to check limits
@@ -125,7 +132,13 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberIn
 	}
 
 	// 9. Add member to repository (in pending status)
-	if err := uc.memberRepo.AddMember(ctx, member); err != nil {
+	err = uc.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.memberRepo.AddMember(ctx, member); err != nil {
+			return err
+		}
+		return uc.events.Publish(ctx, teamDomain.NewMemberInvited(member))
+	})
+	if err != nil {
 		uc.logger.Error("Failed to add member", "teamId", input.TeamID, "error", err)
 		return nil, fmt.Errorf("failed to create invitation")
 	}
@@ -154,21 +167,3 @@ func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberIn
 		Member: memberDTO,
 	}, nil
 }
-
-// isValidRole checks if the role is valid
-func (uc *InviteMemberUseCase) isValidRole(role teamDomain.MemberRole) bool {
-	validRoles := []teamDomain.MemberRole{
-		teamDomain.MemberRoleOwner,
-		teamDomain.MemberRoleAdmin,
-		teamDomain.MemberRoleEditor,
-		teamDomain.MemberRoleViewer,
-	}
-
-	for _, validRole := range validRoles {
-		if role == validRole {
-			return true
-		}
-	}
-
-	return false
-}
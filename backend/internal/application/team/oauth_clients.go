@@ -0,0 +1,199 @@
+// ============================================================================
+// FILE: backend/internal/application/team/oauth_clients.go
+// PURPOSE: Registration of third-party OAuth clients by a team
+// ============================================================================
+package team
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type OAuthClientDTO struct {
+	ID           uuid.UUID         `json:"id"`
+	ClientID     string            `json:"clientId"`
+	Name         string            `json:"name"`
+	RedirectURIs []string          `json:"redirectUris"`
+	Scopes       []team.Permission `json:"scopes"`
+	Confidential bool              `json:"confidential"`
+	CreatedAt    time.Time         `json:"createdAt"`
+}
+
+func mapOAuthClientToDTO(c *team.OAuthClient) OAuthClientDTO {
+	redirectURIs := c.RedirectURIs()
+	if redirectURIs == nil {
+		redirectURIs = []string{}
+	}
+	return OAuthClientDTO{
+		ID:           c.ID(),
+		ClientID:     c.ClientID(),
+		Name:         c.Name(),
+		RedirectURIs: redirectURIs,
+		Scopes:       c.Scopes(),
+		Confidential: c.IsConfidential(),
+		CreatedAt:    c.CreatedAt(),
+	}
+}
+
+// ============================================================================
+// REGISTER
+// ============================================================================
+
+type RegisterOAuthClientInput struct {
+	TeamID       uuid.UUID         `json:"-"`
+	UserID       uuid.UUID         `json:"-"`
+	Name         string            `json:"name"`
+	RedirectURIs []string          `json:"redirectUris"`
+	Scopes       []team.Permission `json:"scopes"`
+	Confidential bool              `json:"confidential"`
+}
+
+// RegisterOAuthClientOutput carries the client secret, which is only shown
+// once
+type RegisterOAuthClientOutput struct {
+	OAuthClientDTO
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+type RegisterOAuthClientUseCase struct {
+	oauthRepo  team.OAuthRepository
+	teamRepo   team.Repository
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewRegisterOAuthClientUseCase(
+	oauthRepo team.OAuthRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	logger common.Logger,
+) *RegisterOAuthClientUseCase {
+	return &RegisterOAuthClientUseCase{
+		oauthRepo:  oauthRepo,
+		teamRepo:   teamRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+func (uc *RegisterOAuthClientUseCase) Execute(ctx context.Context, input RegisterOAuthClientInput) (*RegisterOAuthClientOutput, error) {
+	// 1. Check authorization and plan
+	role, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireAPIAccess(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	// 2. Client credentials act as the registering member, so a confidential
+	// client may not be given more than the member holds
+	if input.Confidential {
+		for _, scope := range input.Scopes {
+			if scope.IsKnown() && !role.Allows(scope) {
+				return nil, fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+			}
+		}
+	}
+
+	// 3. Register
+	client, secret, err := team.NewOAuthClient(input.TeamID, input.UserID, input.Name, input.RedirectURIs, input.Scopes, input.Confidential)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.oauthRepo.CreateClient(ctx, client); err != nil {
+		uc.logger.Error("Failed to register OAuth client", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to register oauth client")
+	}
+
+	uc.logger.Info("OAuth client registered",
+		"teamId", input.TeamID,
+		"clientId", client.ClientID(),
+		"confidential", client.IsConfidential(),
+		"userId", input.UserID)
+
+	return &RegisterOAuthClientOutput{OAuthClientDTO: mapOAuthClientToDTO(client), ClientSecret: secret}, nil
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListOAuthClientsInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListOAuthClientsUseCase struct {
+	oauthRepo  team.OAuthRepository
+	authorizer *team.Authorizer
+}
+
+func NewListOAuthClientsUseCase(oauthRepo team.OAuthRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *ListOAuthClientsUseCase {
+	return &ListOAuthClientsUseCase{
+		oauthRepo:  oauthRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+func (uc *ListOAuthClientsUseCase) Execute(ctx context.Context, input ListOAuthClientsInput) ([]OAuthClientDTO, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+
+	clients, err := uc.oauthRepo.ListClients(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]OAuthClientDTO, 0, len(clients))
+	for _, c := range clients {
+		dtos = append(dtos, mapOAuthClientToDTO(c))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteOAuthClientInput struct {
+	TeamID   uuid.UUID
+	UserID   uuid.UUID
+	ClientID uuid.UUID
+}
+
+type DeleteOAuthClientUseCase struct {
+	oauthRepo  team.OAuthRepository
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewDeleteOAuthClientUseCase(oauthRepo team.OAuthRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, logger common.Logger) *DeleteOAuthClientUseCase {
+	return &DeleteOAuthClientUseCase{
+		oauthRepo:  oauthRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+// Execute deletes the client with its refresh tokens. Access tokens already
+// issued stay valid until they expire.
+func (uc *DeleteOAuthClientUseCase) Execute(ctx context.Context, input DeleteOAuthClientInput) error {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return err
+	}
+
+	if err := uc.oauthRepo.DeleteClient(ctx, input.TeamID, input.ClientID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("OAuth client deleted", "teamId", input.TeamID, "clientId", input.ClientID, "userId", input.UserID)
+	return nil
+}
@@ -21,30 +21,31 @@ type RemoveMemberInput struct {
 type RemoveMemberUseCase struct {
 	teamRepo   teamDomain.Repository
 	memberRepo teamDomain.MemberRepository
+	roleRepo   teamDomain.RoleRepository
+	authorizer *teamDomain.Authorizer
 	logger     common.Logger
 }
 
 func NewRemoveMemberUseCase(
 	teamRepo teamDomain.Repository,
 	memberRepo teamDomain.MemberRepository,
+	roleRepo teamDomain.RoleRepository,
 	logger common.Logger,
 ) *RemoveMemberUseCase {
 	return &RemoveMemberUseCase{
 		teamRepo:   teamRepo,
 		memberRepo: memberRepo,
+		roleRepo:   roleRepo,
+		authorizer: teamDomain.NewAuthorizer(memberRepo, roleRepo),
 		logger:     logger,
 	}
 }
 
 func (uc *RemoveMemberUseCase) Execute(ctx context.Context, input RemoveMemberInput) error {
-	// 1. Check remover authorization (must be admin or owner)
-	remover, err := uc.memberRepo.FindMember(ctx, input.TeamID, input.RemoverID)
+	// 1. Check remover authorization
+	remover, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.RemoverID, teamDomain.PermMembersManage)
 	if err != nil {
-		return fmt.Errorf("access denied: not a team member")
-	}
-
-	if remover.Role() != teamDomain.MemberRoleOwner && remover.Role() != teamDomain.MemberRoleAdmin {
-		return fmt.Errorf("access denied: only admins and owners can remove members")
+		return err
 	}
 
 	// 2. Check if user trying to remove themselves
@@ -90,12 +91,9 @@ func (uc *RemoveMemberUseCase) Execute(ctx context.Context, input RemoveMemberIn
 		}
 	}
 
-	// 6. Non-owners can only remove members with lower or equal roles
-	if remover.Role() != teamDomain.MemberRoleOwner {
-		// Admin can only remove editors and viewers
-		if member.Role() == teamDomain.MemberRoleAdmin || member.Role() == teamDomain.MemberRoleOwner {
-			return fmt.Errorf("insufficient permissions: admins cannot remove other admins or owners")
-		}
+	// 6. Members can only remove members whose role theirs covers
+	if err := requireRoleGrant(ctx, uc.roleRepo, remover, input.TeamID, member.Role()); err != nil {
+		return err
 	}
 
 	// 7. Remove member (soft delete)
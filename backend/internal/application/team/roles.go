@@ -0,0 +1,329 @@
+// ============================================================================
+// FILE: backend/internal/application/team/roles.go
+// PURPOSE: Team custom roles and the permission checks shared by member
+// management
+// ============================================================================
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type RoleDTO struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Permissions []team.Permission `json:"permissions"`
+	IsSystem    bool              `json:"isSystem"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+func mapRoleToDTO(r *team.Role) *RoleDTO {
+	permissions := r.Permissions()
+	if permissions == nil {
+		permissions = []team.Permission{}
+	}
+	return &RoleDTO{
+		Name:        string(r.Name()),
+		Description: r.Description(),
+		Permissions: permissions,
+		IsSystem:    r.IsSystem(),
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   r.UpdatedAt(),
+	}
+}
+
+// requirePermission resolves the user's role and checks one permission
+func requirePermission(ctx context.Context, authorizer *team.Authorizer, teamID, userID uuid.UUID, permission team.Permission) (*team.Role, error) {
+	role, err := authorizer.Role(ctx, teamID, userID)
+	if errors.Is(err, team.ErrMemberNotFound) {
+		return nil, fmt.Errorf("access denied: not a team member")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve team role: %w", err)
+	}
+	if !role.Allows(permission) {
+		return nil, fmt.Errorf("access denied: %s permission required", permission)
+	}
+	return role, nil
+}
+
+// requireRoleGrant checks that the actor may hand out the named role: the
+// role must exist and grant nothing the actor's own role does not
+func requireRoleGrant(ctx context.Context, roleRepo team.RoleRepository, actor *team.Role, teamID uuid.UUID, name team.MemberRole) error {
+	role, err := roleRepo.FindByName(ctx, teamID, name)
+	if errors.Is(err, team.ErrRoleNotFound) {
+		return fmt.Errorf("invalid role: %s does not exist", name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find role: %w", err)
+	}
+	if !actor.Covers(role) {
+		return fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+	}
+	return nil
+}
+
+// requireCustomRoles checks the team's plan includes custom roles
+func requireCustomRoles(ctx context.Context, teamRepo team.Repository, teamID uuid.UUID) error {
+	t, err := teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return team.ErrTeamNotFound
+	}
+	if !t.HasFeature("custom_roles") {
+		return team.ErrFeatureNotAvailable
+	}
+	return nil
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListRolesInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListRolesOutput struct {
+	Roles       []*RoleDTO            `json:"roles"`
+	Permissions []team.PermissionInfo `json:"permissions"`
+}
+
+type ListRolesUseCase struct {
+	roleRepo   team.RoleRepository
+	authorizer *team.Authorizer
+	logger     common.Logger
+}
+
+func NewListRolesUseCase(memberRepo team.MemberRepository, roleRepo team.RoleRepository, logger common.Logger) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		roleRepo:   roleRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		logger:     logger,
+	}
+}
+
+// Execute returns the team's roles together with the permission registry
+func (uc *ListRolesUseCase) Execute(ctx context.Context, input ListRolesInput) (*ListRolesOutput, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermMembersView); err != nil {
+		return nil, err
+	}
+
+	roles, err := uc.roleRepo.List(ctx, input.TeamID)
+	if err != nil {
+		uc.logger.Error("Failed to list roles", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to list roles")
+	}
+
+	dtos := make([]*RoleDTO, 0, len(roles))
+	for _, r := range roles {
+		dtos = append(dtos, mapRoleToDTO(r))
+	}
+	return &ListRolesOutput{Roles: dtos, Permissions: team.Permissions()}, nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateRoleInput struct {
+	TeamID      uuid.UUID         `json:"-"`
+	UserID      uuid.UUID         `json:"-"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []team.Permission `json:"permissions"`
+}
+
+type CreateRoleUseCase struct {
+	teamRepo   team.Repository
+	roleRepo   team.RoleRepository
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewCreateRoleUseCase(teamRepo team.Repository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, recorder *auditlog.Recorder, logger common.Logger) *CreateRoleUseCase {
+	return &CreateRoleUseCase{
+		teamRepo:   teamRepo,
+		roleRepo:   roleRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, input CreateRoleInput) (*RoleDTO, error) {
+	actor, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireCustomRoles(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	role, err := team.NewCustomRole(input.TeamID, team.MemberRole(input.Name), input.Description, input.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Covers(role) {
+		return nil, fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+	}
+
+	if err := uc.roleRepo.Create(ctx, role); err != nil {
+		if errors.Is(err, team.ErrRoleExists) {
+			return nil, err
+		}
+		uc.logger.Error("Failed to create role", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to create role")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionRoleCreated, audit.TargetRole, string(role.Name())).
+		WithChanges(audit.Diff(nil, roleFields(role))))
+
+	uc.logger.Info("Custom role created", "teamId", input.TeamID, "role", role.Name(), "userId", input.UserID)
+	return mapRoleToDTO(role), nil
+}
+
+// ============================================================================
+// UPDATE
+// ============================================================================
+
+type UpdateRoleInput struct {
+	TeamID      uuid.UUID         `json:"-"`
+	UserID      uuid.UUID         `json:"-"`
+	Name        string            `json:"-"`
+	Description string            `json:"description"`
+	Permissions []team.Permission `json:"permissions"`
+}
+
+type UpdateRoleUseCase struct {
+	teamRepo   team.Repository
+	roleRepo   team.RoleRepository
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewUpdateRoleUseCase(teamRepo team.Repository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, recorder *auditlog.Recorder, logger common.Logger) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{
+		teamRepo:   teamRepo,
+		roleRepo:   roleRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+func (uc *UpdateRoleUseCase) Execute(ctx context.Context, input UpdateRoleInput) (*RoleDTO, error) {
+	actor, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireCustomRoles(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	role, err := uc.roleRepo.FindByName(ctx, input.TeamID, team.MemberRole(input.Name))
+	if err != nil {
+		return nil, err
+	}
+	// Members may not widen a role beyond, or edit one above, their own
+	if !actor.Covers(role) {
+		return nil, fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+	}
+	before := roleFields(role)
+	if err := role.Update(input.Description, input.Permissions); err != nil {
+		return nil, err
+	}
+	if !actor.Covers(role) {
+		return nil, fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+	}
+
+	if err := uc.roleRepo.Update(ctx, role); err != nil {
+		uc.logger.Error("Failed to update role", "teamId", input.TeamID, "role", role.Name(), "error", err)
+		return nil, fmt.Errorf("failed to update role")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionRoleUpdated, audit.TargetRole, string(role.Name())).
+		WithChanges(audit.Diff(before, roleFields(role))))
+
+	uc.logger.Info("Custom role updated", "teamId", input.TeamID, "role", role.Name(), "userId", input.UserID)
+	return mapRoleToDTO(role), nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteRoleInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+	Name   string
+}
+
+type DeleteRoleUseCase struct {
+	roleRepo   team.RoleRepository
+	authorizer *team.Authorizer
+	recorder   *auditlog.Recorder
+	logger     common.Logger
+}
+
+func NewDeleteRoleUseCase(memberRepo team.MemberRepository, roleRepo team.RoleRepository, recorder *auditlog.Recorder, logger common.Logger) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{
+		roleRepo:   roleRepo,
+		authorizer: team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:   recorder,
+		logger:     logger,
+	}
+}
+
+// Execute deletes a custom role; roles still held by members are refused
+func (uc *DeleteRoleUseCase) Execute(ctx context.Context, input DeleteRoleInput) error {
+	actor, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermRolesManage)
+	if err != nil {
+		return err
+	}
+
+	role, err := uc.roleRepo.FindByName(ctx, input.TeamID, team.MemberRole(input.Name))
+	if err != nil {
+		return err
+	}
+	if role.IsSystem() {
+		return team.ErrSystemRoleReadOnly
+	}
+	if !actor.Covers(role) {
+		return fmt.Errorf("access denied: %w", team.ErrPermissionEscalation)
+	}
+
+	if err := uc.roleRepo.Delete(ctx, input.TeamID, role.Name()); err != nil {
+		if errors.Is(err, team.ErrRoleInUse) || errors.Is(err, team.ErrRoleNotFound) {
+			return err
+		}
+		uc.logger.Error("Failed to delete role", "teamId", input.TeamID, "role", role.Name(), "error", err)
+		return fmt.Errorf("failed to delete role")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionRoleDeleted, audit.TargetRole, string(role.Name())).
+		WithChanges(audit.Diff(roleFields(role), nil)))
+
+	uc.logger.Info("Custom role deleted", "teamId", input.TeamID, "role", role.Name(), "userId", input.UserID)
+	return nil
+}
+
+// roleFields is what the audit log compares when a role changes
+func roleFields(r *team.Role) map[string]interface{} {
+	return map[string]interface{}{
+		"description": r.Description(),
+		"permissions": r.Permissions(),
+	}
+}
@@ -0,0 +1,95 @@
+// path: backend/internal/application/team/roles_test.go
+package team
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// memoryRoles holds the built-in roles plus the team's custom roles
+type memoryRoles struct {
+	team.RoleRepository
+	roles map[team.MemberRole]*team.Role
+}
+
+func newMemoryRoles() *memoryRoles {
+	builtIn := func(name team.MemberRole, permissions ...team.Permission) *team.Role {
+		return team.ReconstructRole(uuid.New(), uuid.Nil, name, "", permissions, true, time.Now(), time.Now())
+	}
+	return &memoryRoles{roles: map[team.MemberRole]*team.Role{
+		team.MemberRoleOwner:  builtIn(team.MemberRoleOwner, team.PermissionAll),
+		team.MemberRoleAdmin:  builtIn(team.MemberRoleAdmin, "posts.*", "members.*", team.PermRolesManage, team.PermAccountsView),
+		team.MemberRoleEditor: builtIn(team.MemberRoleEditor, "posts.*", team.PermAccountsView),
+	}}
+}
+
+func (r *memoryRoles) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	if role, ok := r.roles[name]; ok {
+		return role, nil
+	}
+	return nil, team.ErrRoleNotFound
+}
+
+func (r *memoryRoles) Create(ctx context.Context, role *team.Role) error {
+	if _, ok := r.roles[role.Name()]; ok {
+		return team.ErrRoleExists
+	}
+	r.roles[role.Name()] = role
+	return nil
+}
+
+func TestCreateRole(t *testing.T) {
+	f := newSCIMFixture()
+	admin := f.addMember(t, "ana", team.MemberRoleAdmin)
+	editor := f.addMember(t, "bob", team.MemberRoleEditor)
+	now := time.Now()
+	teams := &memoryTeams{teams: map[uuid.UUID]*team.Team{
+		f.teamID: team.Reconstruct(f.teamID, "Acme", "acme", "", "", uuid.New(), team.PlanProfessional, team.StatusActive,
+			team.TeamSettings{}, team.TeamLimits{}, now, now, nil),
+	}}
+	roles := newMemoryRoles()
+	uc := NewCreateRoleUseCase(teams, f.members, roles, nil, services.NewLogger())
+	ctx := context.Background()
+
+	out, err := uc.Execute(ctx, CreateRoleInput{TeamID: f.teamID, UserID: admin, Name: "writer", Permissions: []team.Permission{team.PermPostsCreate, team.PermAccountsView}})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if out.Name != "writer" || out.IsSystem || roles.roles["writer"] == nil {
+		t.Errorf("got %+v", out)
+	}
+
+	if _, err := uc.Execute(ctx, CreateRoleInput{TeamID: f.teamID, UserID: admin, Name: "writer"}); !errors.Is(err, team.ErrRoleExists) {
+		t.Errorf("duplicate: err = %v, want ErrRoleExists", err)
+	}
+
+	// An admin can't create a role with billing access it doesn't have
+	_, err = uc.Execute(ctx, CreateRoleInput{TeamID: f.teamID, UserID: admin, Name: "billing", Permissions: []team.Permission{team.PermBillingManage}})
+	if !errors.Is(err, team.ErrPermissionEscalation) {
+		t.Errorf("escalation: err = %v, want ErrPermissionEscalation", err)
+	}
+	if _, ok := roles.roles["billing"]; ok {
+		t.Error("escalation: role was created")
+	}
+
+	for name, userID := range map[string]uuid.UUID{"editor": editor, "outsider": uuid.New()} {
+		_, err := uc.Execute(ctx, CreateRoleInput{TeamID: f.teamID, UserID: userID, Name: "reviewer", Permissions: []team.Permission{team.PermPostsView}})
+		if err == nil || !strings.HasPrefix(err.Error(), "access denied") {
+			t.Errorf("%s: err = %v, want access denied", name, err)
+		}
+	}
+
+	teams.teams[f.teamID] = team.Reconstruct(f.teamID, "Acme", "acme", "", "", uuid.New(), team.PlanFree, team.StatusActive,
+		team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	_, err = uc.Execute(ctx, CreateRoleInput{TeamID: f.teamID, UserID: admin, Name: "reviewer", Permissions: []team.Permission{team.PermPostsView}})
+	if !errors.Is(err, team.ErrFeatureNotAvailable) {
+		t.Errorf("free plan: err = %v, want ErrFeatureNotAvailable", err)
+	}
+}
@@ -0,0 +1,529 @@
+// path: backend/internal/application/team/scim.go
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// SCIM 2.0 schema URNs (RFC 7643, RFC 7644)
+const (
+	SCIMUserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMGroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMPatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// ErrSCIMInvalidFilter is returned for filters other than `attr eq "value"`
+var ErrSCIMInvalidFilter = errors.New("unsupported filter")
+
+// ============================================================================
+// RESOURCES
+// ============================================================================
+
+type SCIMMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMReference points from a user to a group or from a group to a user
+type SCIMReference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMUserResource is a team member as seen by the identity provider. The
+// id is the user ID and userName is the email address.
+type SCIMUserResource struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	UserName    string          `json:"userName"`
+	Name        *SCIMName       `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Emails      []SCIMEmail     `json:"emails,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Groups      []SCIMReference `json:"groups,omitempty"`
+	Meta        *SCIMMeta       `json:"meta,omitempty"`
+}
+
+// email returns the primary address, falling back to userName
+func (r *SCIMUserResource) email() string {
+	for _, e := range r.Emails {
+		if e.Primary && e.Value != "" {
+			return strings.ToLower(strings.TrimSpace(e.Value))
+		}
+	}
+	for _, e := range r.Emails {
+		if e.Value != "" {
+			return strings.ToLower(strings.TrimSpace(e.Value))
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(r.UserName))
+}
+
+type SCIMGroupResource struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	DisplayName string          `json:"displayName"`
+	Members     []SCIMReference `json:"members"`
+	Meta        *SCIMMeta       `json:"meta,omitempty"`
+}
+
+// SCIMListResponse is a page of resources
+type SCIMListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// SCIMPatchRequest is a PATCH body. Values are kept raw because identity
+// providers disagree on their shape (Azure AD sends booleans as strings).
+type SCIMPatchRequest struct {
+	Schemas    []string `json:"schemas"`
+	Operations []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path,omitempty"`
+		Value json.RawMessage `json:"value,omitempty"`
+	} `json:"Operations"`
+}
+
+// SCIMListInput selects a page of resources. StartIndex is 1-based.
+type SCIMListInput struct {
+	TeamID     uuid.UUID
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// scimFilter is a parsed `attribute eq "value"` filter, the only form
+// identity providers use to look up resources
+type scimFilter struct {
+	attribute string
+	value     string
+}
+
+func parseSCIMFilter(filter string) (*scimFilter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(filter, " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return nil, ErrSCIMInvalidFilter
+	}
+	value := strings.TrimSpace(parts[2])
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return nil, ErrSCIMInvalidFilter
+	}
+	return &scimFilter{attribute: strings.ToLower(parts[0]), value: value[1 : len(value)-1]}, nil
+}
+
+// page applies SCIM pagination to a filtered result
+func scimPage[T any](items []T, input SCIMListInput) *SCIMListResponse {
+	start := input.StartIndex
+	if start < 1 {
+		start = 1
+	}
+	count := input.Count
+	if count <= 0 || count > 200 {
+		count = 200
+	}
+
+	page := make([]T, 0)
+	if start <= len(items) {
+		end := start - 1 + count
+		if end > len(items) {
+			end = len(items)
+		}
+		page = items[start-1 : end]
+	}
+
+	return &SCIMListResponse{
+		Schemas:      []string{SCIMListResponseSchema},
+		TotalResults: len(items),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// ============================================================================
+// TOKENS
+// ============================================================================
+
+type SCIMTokenDTO struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+func mapSCIMTokenToDTO(t *team.SCIMToken) SCIMTokenDTO {
+	return SCIMTokenDTO{
+		ID:         t.ID(),
+		Name:       t.Name(),
+		CreatedAt:  t.CreatedAt(),
+		LastUsedAt: t.LastUsedAt(),
+	}
+}
+
+type CreateSCIMTokenInput struct {
+	TeamID uuid.UUID `json:"-"`
+	UserID uuid.UUID `json:"-"`
+	Name   string    `json:"name"`
+}
+
+// CreateSCIMTokenOutput carries the plaintext token, which is only shown
+// once
+type CreateSCIMTokenOutput struct {
+	SCIMTokenDTO
+	Token string `json:"token"`
+}
+
+type CreateSCIMTokenUseCase struct {
+	scimRepo   team.SCIMRepository
+	teamRepo   team.Repository
+	memberRepo team.MemberRepository
+	logger     common.Logger
+}
+
+func NewCreateSCIMTokenUseCase(
+	scimRepo team.SCIMRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	logger common.Logger,
+) *CreateSCIMTokenUseCase {
+	return &CreateSCIMTokenUseCase{
+		scimRepo:   scimRepo,
+		teamRepo:   teamRepo,
+		memberRepo: memberRepo,
+		logger:     logger,
+	}
+}
+
+func (uc *CreateSCIMTokenUseCase) Execute(ctx context.Context, input CreateSCIMTokenInput) (*CreateSCIMTokenOutput, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+	t, err := uc.teamRepo.FindByID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.HasFeature("scim") {
+		return nil, team.ErrFeatureNotAvailable
+	}
+
+	token, raw, err := team.NewSCIMToken(input.TeamID, input.UserID, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.scimRepo.CreateToken(ctx, token); err != nil {
+		uc.logger.Error("Failed to create SCIM token", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to create token")
+	}
+
+	uc.logger.Info("SCIM token created", "teamId", input.TeamID, "tokenId", token.ID(), "userId", input.UserID)
+
+	return &CreateSCIMTokenOutput{SCIMTokenDTO: mapSCIMTokenToDTO(token), Token: raw}, nil
+}
+
+type ListSCIMTokensInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListSCIMTokensUseCase struct {
+	scimRepo   team.SCIMRepository
+	memberRepo team.MemberRepository
+}
+
+func NewListSCIMTokensUseCase(scimRepo team.SCIMRepository, memberRepo team.MemberRepository) *ListSCIMTokensUseCase {
+	return &ListSCIMTokensUseCase{scimRepo: scimRepo, memberRepo: memberRepo}
+}
+
+func (uc *ListSCIMTokensUseCase) Execute(ctx context.Context, input ListSCIMTokensInput) ([]SCIMTokenDTO, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.scimRepo.ListTokens(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]SCIMTokenDTO, 0, len(tokens))
+	for _, t := range tokens {
+		dtos = append(dtos, mapSCIMTokenToDTO(t))
+	}
+	return dtos, nil
+}
+
+type RevokeSCIMTokenInput struct {
+	TeamID  uuid.UUID
+	UserID  uuid.UUID
+	TokenID uuid.UUID
+}
+
+type RevokeSCIMTokenUseCase struct {
+	scimRepo   team.SCIMRepository
+	memberRepo team.MemberRepository
+	logger     common.Logger
+}
+
+func NewRevokeSCIMTokenUseCase(scimRepo team.SCIMRepository, memberRepo team.MemberRepository, logger common.Logger) *RevokeSCIMTokenUseCase {
+	return &RevokeSCIMTokenUseCase{scimRepo: scimRepo, memberRepo: memberRepo, logger: logger}
+}
+
+func (uc *RevokeSCIMTokenUseCase) Execute(ctx context.Context, input RevokeSCIMTokenInput) error {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return err
+	}
+
+	if err := uc.scimRepo.DeleteToken(ctx, input.TeamID, input.TokenID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("SCIM token revoked", "teamId", input.TeamID, "tokenId", input.TokenID, "userId", input.UserID)
+	return nil
+}
+
+// AuthenticateSCIMUseCase resolves a bearer token to the team it serves
+type AuthenticateSCIMUseCase struct {
+	scimRepo team.SCIMRepository
+	teamRepo team.Repository
+	logger   common.Logger
+}
+
+func NewAuthenticateSCIMUseCase(scimRepo team.SCIMRepository, teamRepo team.Repository, logger common.Logger) *AuthenticateSCIMUseCase {
+	return &AuthenticateSCIMUseCase{scimRepo: scimRepo, teamRepo: teamRepo, logger: logger}
+}
+
+func (uc *AuthenticateSCIMUseCase) Execute(ctx context.Context, rawToken string) (uuid.UUID, error) {
+	if !strings.HasPrefix(rawToken, team.SCIMTokenPrefix) {
+		return uuid.Nil, team.ErrSCIMTokenNotFound
+	}
+
+	token, err := uc.scimRepo.FindTokenByHash(ctx, team.HashSCIMToken(rawToken))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// A downgraded team keeps its tokens but cannot use them
+	t, err := uc.teamRepo.FindByID(ctx, token.TeamID())
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !t.HasFeature("scim") {
+		return uuid.Nil, team.ErrFeatureNotAvailable
+	}
+
+	if err := uc.scimRepo.TouchToken(ctx, token.ID(), time.Now().UTC()); err != nil {
+		uc.logger.Warn("Failed to update SCIM token usage", "tokenId", token.ID(), "error", err)
+	}
+	return token.TeamID(), nil
+}
+
+// ============================================================================
+// PROVISIONING
+// ============================================================================
+
+// scimProvisioner holds what the SCIM user and group use cases share:
+// rendering users, deprovisioning and keeping roles in line with groups
+type scimProvisioner struct {
+	scimRepo     team.SCIMRepository
+	memberRepo   team.MemberRepository
+	userRepo     user.Repository
+	identityRepo user.IdentityRepository
+	logger       common.Logger
+}
+
+// scimProvider names the identity that stores a user's externalId
+func scimProvider(teamID uuid.UUID) string {
+	return "scim:" + teamID.String()
+}
+
+// findMember returns a member of the team, active or not
+func (p *scimProvisioner) findMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, *user.User, error) {
+	member, err := p.memberRepo.FindMember(ctx, teamID, userID)
+	if err != nil {
+		return nil, nil, team.ErrMemberNotFound
+	}
+	u, err := p.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, team.ErrMemberNotFound
+	}
+	return member, u, nil
+}
+
+func (p *scimProvisioner) userResource(ctx context.Context, m *team.Member, u *user.User) (*SCIMUserResource, error) {
+	teamID := m.TeamID()
+
+	externalID := ""
+	identities, err := p.identityRepo.ListByUser(ctx, u.ID())
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.Provider() == scimProvider(teamID) {
+			externalID = identity.Subject()
+		}
+	}
+
+	groups, err := p.scimRepo.ListGroupsByMember(ctx, teamID, u.ID())
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]SCIMReference, 0, len(groups))
+	for _, g := range groups {
+		refs = append(refs, SCIMReference{Value: g.ID().String(), Display: g.DisplayName()})
+	}
+
+	active := m.IsActive()
+	return &SCIMUserResource{
+		Schemas:    []string{SCIMUserSchema},
+		ID:         u.ID().String(),
+		ExternalID: externalID,
+		UserName:   u.Email(),
+		Name: &SCIMName{
+			Formatted:  strings.TrimSpace(u.FullName()),
+			GivenName:  u.FirstName(),
+			FamilyName: u.LastName(),
+		},
+		DisplayName: strings.TrimSpace(u.FullName()),
+		Emails:      []SCIMEmail{{Value: u.Email(), Type: "work", Primary: true}},
+		Active:      &active,
+		Groups:      refs,
+		Meta: &SCIMMeta{
+			ResourceType: "User",
+			Created:      m.InvitedAt(),
+			LastModified: u.UpdatedAt(),
+		},
+	}, nil
+}
+
+// setExternalID links the identity provider's ID for the user, replacing
+// an earlier one
+func (p *scimProvisioner) setExternalID(ctx context.Context, teamID uuid.UUID, u *user.User, externalID string) error {
+	provider := scimProvider(teamID)
+
+	identities, err := p.identityRepo.ListByUser(ctx, u.ID())
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		if identity.Provider() != provider {
+			continue
+		}
+		if identity.Subject() == externalID {
+			return nil
+		}
+		if err := p.identityRepo.Delete(ctx, u.ID(), provider); err != nil {
+			return err
+		}
+	}
+
+	if externalID == "" {
+		return nil
+	}
+	return p.identityRepo.Create(ctx, user.NewIdentity(u.ID(), provider, externalID, u.Email()))
+}
+
+// deprovision deactivates the membership and ends the user's sessions.
+// Owners cannot be deprovisioned.
+func (p *scimProvisioner) deprovision(ctx context.Context, m *team.Member, sessionRepo user.SessionRepository) error {
+	if m.Role() == team.MemberRoleOwner {
+		return team.ErrCannotRemoveOwner
+	}
+	if !m.IsActive() {
+		return nil
+	}
+
+	if err := m.Deactivate(); err != nil {
+		return err
+	}
+	if err := p.memberRepo.UpdateMember(ctx, m); err != nil {
+		p.logger.Error("Failed to deactivate member", "teamId", m.TeamID(), "userId", m.UserID(), "error", err)
+		return fmt.Errorf("failed to deactivate member")
+	}
+	if err := sessionRepo.RevokeAllForUser(ctx, m.UserID(), "scim_deprovisioned"); err != nil {
+		p.logger.Error("Failed to revoke sessions", "userId", m.UserID(), "error", err)
+		return fmt.Errorf("failed to revoke sessions")
+	}
+
+	p.logger.Info("Member deprovisioned by SCIM", "teamId", m.TeamID(), "userId", m.UserID())
+	return nil
+}
+
+// syncRoles gives each user the highest role of their groups. Teams that
+// have not mapped any group to a role keep managing roles by hand.
+func (p *scimProvisioner) syncRoles(ctx context.Context, teamID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	all, err := p.scimRepo.ListGroups(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	mapped := false
+	for _, g := range all {
+		if g.Role() != "" {
+			mapped = true
+			break
+		}
+	}
+	if !mapped {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		member, err := p.memberRepo.FindMember(ctx, teamID, userID)
+		if err != nil || member.Role() == team.MemberRoleOwner {
+			continue
+		}
+		groups, err := p.scimRepo.ListGroupsByMember(ctx, teamID, userID)
+		if err != nil {
+			return err
+		}
+
+		err = member.ChangeRole(team.ProvisionedRole(groups), uuid.Nil)
+		if errors.Is(err, team.ErrSameRole) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.memberRepo.UpdateMember(ctx, member); err != nil {
+			p.logger.Error("Failed to sync member role", "teamId", teamID, "userId", userID, "error", err)
+			return fmt.Errorf("failed to update member role")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,467 @@
+// path: backend/internal/application/team/scim_groups.go
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+func (p *scimProvisioner) groupResource(ctx context.Context, g *team.SCIMGroup) *SCIMGroupResource {
+	members := make([]SCIMReference, 0, len(g.MemberIDs()))
+	for _, id := range g.MemberIDs() {
+		ref := SCIMReference{Value: id.String()}
+		if u, err := p.userRepo.FindByID(ctx, id); err == nil {
+			ref.Display = u.Email()
+		}
+		members = append(members, ref)
+	}
+
+	return &SCIMGroupResource{
+		Schemas:     []string{SCIMGroupSchema},
+		ID:          g.ID().String(),
+		ExternalID:  g.ExternalID(),
+		DisplayName: g.DisplayName(),
+		Members:     members,
+		Meta: &SCIMMeta{
+			ResourceType: "Group",
+			Created:      g.CreatedAt(),
+			LastModified: g.UpdatedAt(),
+		},
+	}
+}
+
+// memberIDs parses member references; each must be a member of the team
+func (p *scimProvisioner) memberIDs(ctx context.Context, teamID uuid.UUID, refs []SCIMReference) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(refs))
+	for _, ref := range refs {
+		id, err := uuid.Parse(ref.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid member %q", ref.Value)
+		}
+		if _, err := p.memberRepo.FindMember(ctx, teamID, id); err != nil {
+			return nil, fmt.Errorf("user %s is not a member of the team", ref.Value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ============================================================================
+// LIST / GET
+// ============================================================================
+
+type ListSCIMGroupsUseCase struct {
+	scimProvisioner
+}
+
+func NewListSCIMGroupsUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *ListSCIMGroupsUseCase {
+	return &ListSCIMGroupsUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *ListSCIMGroupsUseCase) Execute(ctx context.Context, input SCIMListInput) (*SCIMListResponse, error) {
+	filter, err := parseSCIMFilter(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := uc.scimRepo.ListGroups(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*SCIMGroupResource, 0, len(groups))
+	for _, g := range groups {
+		if filter != nil {
+			switch filter.attribute {
+			case "displayname":
+				if !strings.EqualFold(g.DisplayName(), filter.value) {
+					continue
+				}
+			case "externalid":
+				if g.ExternalID() != filter.value {
+					continue
+				}
+			case "id":
+				if g.ID().String() != filter.value {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		resources = append(resources, uc.groupResource(ctx, g))
+	}
+
+	return scimPage(resources, input), nil
+}
+
+type GetSCIMGroupInput struct {
+	TeamID  uuid.UUID
+	GroupID uuid.UUID
+}
+
+type GetSCIMGroupUseCase struct {
+	scimProvisioner
+}
+
+func NewGetSCIMGroupUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *GetSCIMGroupUseCase {
+	return &GetSCIMGroupUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *GetSCIMGroupUseCase) Execute(ctx context.Context, input GetSCIMGroupInput) (*SCIMGroupResource, error) {
+	g, err := uc.scimRepo.FindGroup(ctx, input.TeamID, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.groupResource(ctx, g), nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateSCIMGroupInput struct {
+	TeamID   uuid.UUID
+	Resource SCIMGroupResource
+}
+
+type CreateSCIMGroupUseCase struct {
+	scimProvisioner
+}
+
+func NewCreateSCIMGroupUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *CreateSCIMGroupUseCase {
+	return &CreateSCIMGroupUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *CreateSCIMGroupUseCase) Execute(ctx context.Context, input CreateSCIMGroupInput) (*SCIMGroupResource, error) {
+	g, err := team.NewSCIMGroup(input.TeamID, input.Resource.DisplayName, input.Resource.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := uc.memberIDs(ctx, input.TeamID, input.Resource.Members)
+	if err != nil {
+		return nil, err
+	}
+	g.SetMembers(members)
+
+	if err := uc.scimRepo.CreateGroup(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := uc.syncRoles(ctx, input.TeamID, g.MemberIDs()); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("SCIM group created", "teamId", input.TeamID, "groupId", g.ID())
+	return uc.groupResource(ctx, g), nil
+}
+
+// ============================================================================
+// UPDATE (PUT and PATCH)
+// ============================================================================
+
+// UpdateSCIMGroupInput carries either a full resource (PUT) or a patch
+type UpdateSCIMGroupInput struct {
+	TeamID   uuid.UUID
+	GroupID  uuid.UUID
+	Resource *SCIMGroupResource
+	Patch    *SCIMPatchRequest
+}
+
+type UpdateSCIMGroupUseCase struct {
+	scimProvisioner
+}
+
+func NewUpdateSCIMGroupUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *UpdateSCIMGroupUseCase {
+	return &UpdateSCIMGroupUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *UpdateSCIMGroupUseCase) Execute(ctx context.Context, input UpdateSCIMGroupInput) (*SCIMGroupResource, error) {
+	g, err := uc.scimRepo.FindGroup(ctx, input.TeamID, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	before := append([]uuid.UUID(nil), g.MemberIDs()...)
+
+	switch {
+	case input.Resource != nil:
+		if err := g.Rename(input.Resource.DisplayName); err != nil {
+			return nil, err
+		}
+		g.SetExternalID(input.Resource.ExternalID)
+		members, err := uc.memberIDs(ctx, input.TeamID, input.Resource.Members)
+		if err != nil {
+			return nil, err
+		}
+		g.SetMembers(members)
+	case input.Patch != nil:
+		if err := uc.applyPatch(ctx, g, input.Patch); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.scimRepo.UpdateGroup(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := uc.syncRoles(ctx, input.TeamID, append(before, g.MemberIDs()...)); err != nil {
+		return nil, err
+	}
+
+	return uc.groupResource(ctx, g), nil
+}
+
+func (uc *UpdateSCIMGroupUseCase) applyPatch(ctx context.Context, g *team.SCIMGroup, patch *SCIMPatchRequest) error {
+	for _, op := range patch.Operations {
+		opName := strings.ToLower(op.Op)
+		path := strings.ToLower(op.Path)
+
+		switch {
+		// Azure AD: {"op": "remove", "path": "members[value eq \"<id>\"]"}
+		case opName == "remove" && strings.HasPrefix(path, "members["):
+			filter, err := parseSCIMFilter(strings.TrimSuffix(op.Path[len("members["):], "]"))
+			if err != nil || filter == nil || filter.attribute != "value" {
+				return ErrSCIMInvalidFilter
+			}
+			id, err := uuid.Parse(filter.value)
+			if err != nil {
+				return fmt.Errorf("invalid member %q", filter.value)
+			}
+			g.RemoveMembers([]uuid.UUID{id})
+
+		case path == "members":
+			var refs []SCIMReference
+			if len(op.Value) > 0 {
+				if err := json.Unmarshal(op.Value, &refs); err != nil {
+					return fmt.Errorf("invalid members")
+				}
+			}
+			switch opName {
+			case "remove":
+				if len(refs) == 0 {
+					g.SetMembers(nil)
+					continue
+				}
+				ids := make([]uuid.UUID, 0, len(refs))
+				for _, ref := range refs {
+					if id, err := uuid.Parse(ref.Value); err == nil {
+						ids = append(ids, id)
+					}
+				}
+				g.RemoveMembers(ids)
+			case "add", "replace":
+				ids, err := uc.memberIDs(ctx, g.TeamID(), refs)
+				if err != nil {
+					return err
+				}
+				if opName == "replace" {
+					g.SetMembers(ids)
+				} else {
+					g.AddMembers(ids)
+				}
+			default:
+				return fmt.Errorf("unsupported patch operation %q", op.Op)
+			}
+
+		case opName == "add" || opName == "replace":
+			values := map[string]json.RawMessage{}
+			if path == "" {
+				// Okta: {"op": "replace", "value": {"id": "...", "displayName": "..."}}
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return fmt.Errorf("invalid patch value")
+				}
+			} else {
+				values[op.Path] = op.Value
+			}
+			for attr, value := range values {
+				switch strings.ToLower(attr) {
+				case "displayname":
+					name, err := scimString(value)
+					if err != nil {
+						return err
+					}
+					if err := g.Rename(name); err != nil {
+						return err
+					}
+				case "externalid":
+					externalID, err := scimString(value)
+					if err != nil {
+						return err
+					}
+					g.SetExternalID(externalID)
+				}
+			}
+
+		case opName != "remove":
+			return fmt.Errorf("unsupported patch operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteSCIMGroupInput struct {
+	TeamID  uuid.UUID
+	GroupID uuid.UUID
+}
+
+type DeleteSCIMGroupUseCase struct {
+	scimProvisioner
+}
+
+func NewDeleteSCIMGroupUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *DeleteSCIMGroupUseCase {
+	return &DeleteSCIMGroupUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *DeleteSCIMGroupUseCase) Execute(ctx context.Context, input DeleteSCIMGroupInput) error {
+	g, err := uc.scimRepo.FindGroup(ctx, input.TeamID, input.GroupID)
+	if err != nil {
+		return err
+	}
+	if err := uc.scimRepo.DeleteGroup(ctx, input.TeamID, input.GroupID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("SCIM group deleted", "teamId", input.TeamID, "groupId", input.GroupID)
+	return uc.syncRoles(ctx, input.TeamID, g.MemberIDs())
+}
+
+// ============================================================================
+// ROLE MAPPING (team admins)
+// ============================================================================
+
+type SCIMGroupDTO struct {
+	ID          uuid.UUID `json:"id"`
+	DisplayName string    `json:"displayName"`
+	ExternalID  string    `json:"externalId,omitempty"`
+	Role        string    `json:"role,omitempty"`
+	MemberCount int       `json:"memberCount"`
+}
+
+func mapSCIMGroupToDTO(g *team.SCIMGroup) SCIMGroupDTO {
+	return SCIMGroupDTO{
+		ID:          g.ID(),
+		DisplayName: g.DisplayName(),
+		ExternalID:  g.ExternalID(),
+		Role:        string(g.Role()),
+		MemberCount: len(g.MemberIDs()),
+	}
+}
+
+type ListSCIMGroupRolesInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+// ListSCIMGroupRolesUseCase lists the provisioned groups and their roles
+type ListSCIMGroupRolesUseCase struct {
+	scimRepo   team.SCIMRepository
+	memberRepo team.MemberRepository
+}
+
+func NewListSCIMGroupRolesUseCase(scimRepo team.SCIMRepository, memberRepo team.MemberRepository) *ListSCIMGroupRolesUseCase {
+	return &ListSCIMGroupRolesUseCase{scimRepo: scimRepo, memberRepo: memberRepo}
+}
+
+func (uc *ListSCIMGroupRolesUseCase) Execute(ctx context.Context, input ListSCIMGroupRolesInput) ([]SCIMGroupDTO, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	groups, err := uc.scimRepo.ListGroups(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]SCIMGroupDTO, 0, len(groups))
+	for _, g := range groups {
+		dtos = append(dtos, mapSCIMGroupToDTO(g))
+	}
+	return dtos, nil
+}
+
+type SetSCIMGroupRoleInput struct {
+	TeamID  uuid.UUID `json:"-"`
+	UserID  uuid.UUID `json:"-"`
+	GroupID uuid.UUID `json:"-"`
+	Role    string    `json:"role"` // empty to unmap
+}
+
+// SetSCIMGroupRoleUseCase maps a group to a member role and applies it to
+// the group's members
+type SetSCIMGroupRoleUseCase struct {
+	scimProvisioner
+}
+
+func NewSetSCIMGroupRoleUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *SetSCIMGroupRoleUseCase {
+	return &SetSCIMGroupRoleUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *SetSCIMGroupRoleUseCase) Execute(ctx context.Context, input SetSCIMGroupRoleInput) (*SCIMGroupDTO, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.scimRepo.FindGroup(ctx, input.TeamID, input.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.SetRole(team.MemberRole(input.Role)); err != nil {
+		return nil, err
+	}
+	if err := uc.scimRepo.UpdateGroup(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := uc.syncRoles(ctx, input.TeamID, g.MemberIDs()); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("SCIM group role set", "teamId", input.TeamID, "groupId", g.ID(), "role", input.Role, "userId", input.UserID)
+
+	dto := mapSCIMGroupToDTO(g)
+	return &dto, nil
+}
@@ -0,0 +1,292 @@
+// path: backend/internal/application/team/scim_test.go
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type memberStore struct {
+	team.MemberRepository
+	members map[uuid.UUID]*team.Member // by user ID, all in one team
+}
+
+func (r *memberStore) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	if m, ok := r.members[userID]; ok && m.TeamID() == teamID {
+		return m, nil
+	}
+	return nil, team.ErrMemberNotFound
+}
+
+func (r *memberStore) UpdateMember(ctx context.Context, m *team.Member) error {
+	r.members[m.UserID()] = m
+	return nil
+}
+
+type noIdentities struct {
+	user.IdentityRepository
+}
+
+func (noIdentities) ListByUser(ctx context.Context, userID uuid.UUID) ([]*user.Identity, error) {
+	return nil, nil
+}
+
+type memoryGroups struct {
+	team.SCIMRepository
+	groups []*team.SCIMGroup
+}
+
+func (r *memoryGroups) ListGroups(ctx context.Context, teamID uuid.UUID) ([]*team.SCIMGroup, error) {
+	return r.groups, nil
+}
+
+func (r *memoryGroups) ListGroupsByMember(ctx context.Context, teamID, userID uuid.UUID) ([]*team.SCIMGroup, error) {
+	var groups []*team.SCIMGroup
+	for _, g := range r.groups {
+		if g.HasMember(userID) {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (r *memoryGroups) RemoveMemberFromGroups(ctx context.Context, teamID, userID uuid.UUID) error {
+	for _, g := range r.groups {
+		g.RemoveMembers([]uuid.UUID{userID})
+	}
+	return nil
+}
+
+// revokedSessions records whose sessions were revoked and why
+type revokedSessions struct {
+	user.SessionRepository
+	revoked map[uuid.UUID]string
+}
+
+func (r *revokedSessions) RevokeAllForUser(ctx context.Context, userID uuid.UUID, reason string) error {
+	r.revoked[userID] = reason
+	return nil
+}
+
+type scimFixture struct {
+	teamID   uuid.UUID
+	users    *memoryUsers
+	members  *memberStore
+	groups   *memoryGroups
+	sessions *revokedSessions
+}
+
+func newSCIMFixture() *scimFixture {
+	return &scimFixture{
+		teamID:   uuid.New(),
+		users:    &memoryUsers{users: map[uuid.UUID]*user.User{}},
+		members:  &memberStore{members: map[uuid.UUID]*team.Member{}},
+		groups:   &memoryGroups{},
+		sessions: &revokedSessions{revoked: map[uuid.UUID]string{}},
+	}
+}
+
+// addMember creates an active member with the role
+func (f *scimFixture) addMember(t *testing.T, username string, role team.MemberRole) uuid.UUID {
+	t.Helper()
+
+	u, err := user.NewExternalUser(username+"@acme.com", username, "", "", true)
+	if err != nil {
+		t.Fatalf("NewExternalUser: %v", err)
+	}
+	m, err := team.NewMember(f.teamID, u.ID(), u.ID(), role)
+	if err != nil {
+		t.Fatalf("NewMember: %v", err)
+	}
+	if err := m.AcceptInvitation(); err != nil {
+		t.Fatalf("AcceptInvitation: %v", err)
+	}
+	f.users.users[u.ID()] = u
+	f.members.members[u.ID()] = m
+	return u.ID()
+}
+
+func patch(t *testing.T, body string) *SCIMPatchRequest {
+	t.Helper()
+
+	var p SCIMPatchRequest
+	if err := json.Unmarshal([]byte(body), &p); err != nil {
+		t.Fatalf("patch body: %v", err)
+	}
+	return &p
+}
+
+func TestParseSCIMFilter(t *testing.T) {
+	cases := []struct {
+		filter          string
+		attribute, want string
+	}{
+		{`userName eq "ana@acme.com"`, "username", "ana@acme.com"},
+		{`externalId EQ "00u1 abc"`, "externalid", "00u1 abc"},
+		{`  displayName eq "Marketing"  `, "displayname", "Marketing"},
+	}
+	for _, tc := range cases {
+		f, err := parseSCIMFilter(tc.filter)
+		if err != nil {
+			t.Errorf("%s: %v", tc.filter, err)
+			continue
+		}
+		if f.attribute != tc.attribute || f.value != tc.want {
+			t.Errorf("%s: got %s = %q, want %s = %q", tc.filter, f.attribute, f.value, tc.attribute, tc.want)
+		}
+	}
+
+	if f, err := parseSCIMFilter(""); f != nil || err != nil {
+		t.Errorf("empty filter: got %+v, %v", f, err)
+	}
+	for _, filter := range []string{`userName co "ana"`, `userName eq ana`, `userName eq "`, `userName`} {
+		if _, err := parseSCIMFilter(filter); !errors.Is(err, ErrSCIMInvalidFilter) {
+			t.Errorf("%s: err = %v, want ErrSCIMInvalidFilter", filter, err)
+		}
+	}
+}
+
+func TestParseSCIMUserPatch(t *testing.T) {
+	// Okta sends a value object without a path
+	okta, err := parseSCIMUserPatch(patch(t, `{"Operations":[{"op":"replace","value":{"active":false,"name.givenName":"Ana"}}]}`))
+	if err != nil {
+		t.Fatalf("okta: %v", err)
+	}
+	if okta.active == nil || *okta.active || okta.givenName == nil || *okta.givenName != "Ana" {
+		t.Errorf("okta: got %+v", okta)
+	}
+
+	// Azure AD sends one path per operation and booleans as strings
+	azure, err := parseSCIMUserPatch(patch(t, `{"Operations":[
+		{"op":"Replace","path":"active","value":"False"},
+		{"op":"Add","path":"externalId","value":"a1"},
+		{"op":"Remove","path":"externalId"}
+	]}`))
+	if err != nil {
+		t.Fatalf("azure: %v", err)
+	}
+	if azure.active == nil || *azure.active || azure.externalID == nil || *azure.externalID != "" {
+		t.Errorf("azure: got %+v", azure)
+	}
+
+	for name, body := range map[string]string{
+		"unknown op":    `{"Operations":[{"op":"move","path":"active","value":true}]}`,
+		"bad boolean":   `{"Operations":[{"op":"replace","path":"active","value":"nope"}]}`,
+		"bad value map": `{"Operations":[{"op":"replace","value":"active"}]}`,
+	} {
+		if _, err := parseSCIMUserPatch(patch(t, body)); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
+
+func TestSCIMGroupPatch(t *testing.T) {
+	f := newSCIMFixture()
+	ana := f.addMember(t, "ana", team.MemberRoleEditor)
+	bob := f.addMember(t, "bob", team.MemberRoleEditor)
+	g, err := team.NewSCIMGroup(f.teamID, "Marketing", "")
+	if err != nil {
+		t.Fatalf("NewSCIMGroup: %v", err)
+	}
+	uc := NewUpdateSCIMGroupUseCase(f.groups, f.members, f.users, noIdentities{}, services.NewLogger())
+	ctx := context.Background()
+
+	steps := []struct {
+		body string
+		want []uuid.UUID
+	}{
+		{`{"Operations":[{"op":"add","path":"members","value":[{"value":"` + ana.String() + `"},{"value":"` + bob.String() + `"}]}]}`, []uuid.UUID{ana, bob}},
+		{`{"Operations":[{"op":"remove","path":"members[value eq \"` + ana.String() + `\"]"}]}`, []uuid.UUID{bob}},
+		{`{"Operations":[{"op":"replace","path":"members","value":[{"value":"` + ana.String() + `"}]}]}`, []uuid.UUID{ana}},
+		{`{"Operations":[{"op":"remove","path":"members"}]}`, nil},
+	}
+	for i, step := range steps {
+		if err := uc.applyPatch(ctx, g, patch(t, step.body)); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if len(g.MemberIDs()) != len(step.want) {
+			t.Fatalf("step %d: members = %v, want %v", i, g.MemberIDs(), step.want)
+		}
+		for _, id := range step.want {
+			if !g.HasMember(id) {
+				t.Errorf("step %d: %s missing", i, id)
+			}
+		}
+	}
+
+	if err := uc.applyPatch(ctx, g, patch(t, `{"Operations":[{"op":"replace","value":{"displayName":"Brand","externalId":"g1"}}]}`)); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if g.DisplayName() != "Brand" || g.ExternalID() != "g1" {
+		t.Errorf("rename: got %q / %q", g.DisplayName(), g.ExternalID())
+	}
+
+	for name, body := range map[string]string{
+		"outsider":   `{"Operations":[{"op":"add","path":"members","value":[{"value":"` + uuid.NewString() + `"}]}]}`,
+		"bad filter": `{"Operations":[{"op":"remove","path":"members[display eq \"Ana\"]"}]}`,
+		"unknown op": `{"Operations":[{"op":"move","path":"members","value":[]}]}`,
+	} {
+		if err := uc.applyPatch(ctx, g, patch(t, body)); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
+
+func TestSCIMDeprovisionRevokesSessions(t *testing.T) {
+	f := newSCIMFixture()
+	ana := f.addMember(t, "ana", team.MemberRoleEditor)
+	bob := f.addMember(t, "bob", team.MemberRoleEditor)
+	owner := f.addMember(t, "olga", team.MemberRoleOwner)
+	ctx := context.Background()
+
+	update := NewUpdateSCIMUserUseCase(f.groups, f.members, f.users, noIdentities{}, nil, f.sessions, services.NewLogger())
+	out, err := update.Execute(ctx, UpdateSCIMUserInput{
+		TeamID: f.teamID,
+		UserID: ana,
+		Patch:  patch(t, `{"Operations":[{"op":"replace","path":"active","value":"False"}]}`),
+	})
+	if err != nil {
+		t.Fatalf("PATCH active=false: %v", err)
+	}
+	if *out.Active || f.members.members[ana].IsActive() {
+		t.Error("PATCH active=false: member still active")
+	}
+	if f.sessions.revoked[ana] != "scim_deprovisioned" {
+		t.Errorf("PATCH active=false: sessions revoked = %v", f.sessions.revoked)
+	}
+
+	g, _ := team.NewSCIMGroup(f.teamID, "Marketing", "")
+	g.SetMembers([]uuid.UUID{bob})
+	f.groups.groups = append(f.groups.groups, g)
+	remove := NewDeleteSCIMUserUseCase(f.groups, f.members, f.users, noIdentities{}, f.sessions, services.NewLogger())
+	if err := remove.Execute(ctx, DeleteSCIMUserInput{TeamID: f.teamID, UserID: bob}); err != nil {
+		t.Fatalf("DELETE: %v", err)
+	}
+	if f.members.members[bob].IsActive() || f.sessions.revoked[bob] == "" || g.HasMember(bob) {
+		t.Error("DELETE: member not deactivated, signed out and removed from groups")
+	}
+
+	if err := remove.Execute(ctx, DeleteSCIMUserInput{TeamID: f.teamID, UserID: owner}); !errors.Is(err, team.ErrCannotRemoveOwner) {
+		t.Errorf("owner: err = %v, want ErrCannotRemoveOwner", err)
+	}
+	if _, ok := f.sessions.revoked[owner]; ok {
+		t.Error("owner: sessions revoked")
+	}
+
+	// Reactivation restores the membership without touching sessions again
+	delete(f.sessions.revoked, ana)
+	active := true
+	if _, err := update.Execute(ctx, UpdateSCIMUserInput{TeamID: f.teamID, UserID: ana, Resource: &SCIMUserResource{Active: &active}}); err != nil {
+		t.Fatalf("PUT active=true: %v", err)
+	}
+	if !f.members.members[ana].IsActive() || len(f.sessions.revoked) != 1 {
+		t.Errorf("PUT active=true: active = %v, revoked = %v", f.members.members[ana].IsActive(), f.sessions.revoked)
+	}
+}
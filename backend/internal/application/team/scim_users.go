@@ -0,0 +1,466 @@
+// path: backend/internal/application/team/scim_users.go
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+// ============================================================================
+// LIST / GET
+// ============================================================================
+
+type ListSCIMUsersUseCase struct {
+	scimProvisioner
+}
+
+func NewListSCIMUsersUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *ListSCIMUsersUseCase {
+	return &ListSCIMUsersUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *ListSCIMUsersUseCase) Execute(ctx context.Context, input SCIMListInput) (*SCIMListResponse, error) {
+	filter, err := parseSCIMFilter(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.memberRepo.FindTeamMembers(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*SCIMUserResource, 0, len(members))
+	for _, m := range members {
+		u, err := uc.userRepo.FindByID(ctx, m.UserID())
+		if err != nil {
+			continue
+		}
+		resource, err := uc.userResource(ctx, m, u)
+		if err != nil {
+			return nil, err
+		}
+		if filter != nil && !matchSCIMUser(resource, filter) {
+			continue
+		}
+		resources = append(resources, resource)
+	}
+
+	return scimPage(resources, input), nil
+}
+
+func matchSCIMUser(r *SCIMUserResource, f *scimFilter) bool {
+	switch f.attribute {
+	case "username", "emails.value", "emails":
+		return strings.EqualFold(r.UserName, f.value)
+	case "externalid":
+		return r.ExternalID == f.value
+	case "id":
+		return r.ID == f.value
+	}
+	return false
+}
+
+type GetSCIMUserInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type GetSCIMUserUseCase struct {
+	scimProvisioner
+}
+
+func NewGetSCIMUserUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	logger common.Logger,
+) *GetSCIMUserUseCase {
+	return &GetSCIMUserUseCase{scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger}}
+}
+
+func (uc *GetSCIMUserUseCase) Execute(ctx context.Context, input GetSCIMUserInput) (*SCIMUserResource, error) {
+	member, u, err := uc.findMember(ctx, input.TeamID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.userResource(ctx, member, u)
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateSCIMUserInput struct {
+	TeamID   uuid.UUID
+	Resource SCIMUserResource
+}
+
+// CreateSCIMUserUseCase provisions a team member. Accounts are created for
+// unknown addresses in the team's single sign-on domains; existing accounts
+// are never pulled into a team by its identity provider.
+type CreateSCIMUserUseCase struct {
+	scimProvisioner
+	ssoRepo     team.SSORepository
+	sessionRepo user.SessionRepository
+}
+
+func NewCreateSCIMUserUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	ssoRepo team.SSORepository,
+	sessionRepo user.SessionRepository,
+	logger common.Logger,
+) *CreateSCIMUserUseCase {
+	return &CreateSCIMUserUseCase{
+		scimProvisioner: scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger},
+		ssoRepo:         ssoRepo,
+		sessionRepo:     sessionRepo,
+	}
+}
+
+func (uc *CreateSCIMUserUseCase) Execute(ctx context.Context, input CreateSCIMUserInput) (*SCIMUserResource, error) {
+	// 1. The address must belong to the team
+	email := input.Resource.email()
+	config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+	if err != nil || !config.HandlesEmail(email) {
+		return nil, team.ErrSCIMDomainNotAllowed
+	}
+
+	// 2. Find or create the account
+	u, err := uc.userRepo.FindByEmail(ctx, email)
+	switch {
+	case err == nil:
+		if _, err := uc.memberRepo.FindMember(ctx, input.TeamID, u.ID()); err == nil {
+			return nil, team.ErrSCIMUserExists
+		}
+		return nil, user.ErrAccountLinkRequired
+	case errors.Is(err, user.ErrUserNotFound):
+		if u, err = uc.createUser(ctx, input, email); err != nil {
+			return nil, err
+		}
+	default:
+		uc.logger.Error("Failed to look up user by email", "error", err)
+		return nil, fmt.Errorf("failed to provision user")
+	}
+
+	// 3. Add the membership
+	member, err := team.NewMember(input.TeamID, u.ID(), u.ID(), team.ProvisionedRole(nil))
+	if err == nil {
+		err = member.AcceptInvitation()
+	}
+	if err == nil {
+		err = uc.memberRepo.AddMember(ctx, member)
+	}
+	if err != nil {
+		uc.logger.Error("Failed to provision membership", "userId", u.ID(), "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to provision user")
+	}
+
+	if err := uc.setExternalID(ctx, input.TeamID, u, input.Resource.ExternalID); err != nil {
+		return nil, err
+	}
+	if input.Resource.Active != nil && !*input.Resource.Active {
+		if err := uc.deprovision(ctx, member, uc.sessionRepo); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.logger.Info("User provisioned by SCIM", "userId", u.ID(), "teamId", input.TeamID)
+	return uc.userResource(ctx, member, u)
+}
+
+func (uc *CreateSCIMUserUseCase) createUser(ctx context.Context, input CreateSCIMUserInput, email string) (*user.User, error) {
+	username, err := user.NewService(uc.userRepo).AvailableUsername(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstName, lastName string
+	if input.Resource.Name != nil {
+		firstName, lastName = input.Resource.Name.GivenName, input.Resource.Name.FamilyName
+	}
+
+	u, err := user.NewExternalUser(email, username, firstName, lastName, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.Create(ctx, u); err != nil {
+		uc.logger.Error("Failed to create user", "email", email, "error", err)
+		return nil, fmt.Errorf("failed to create account")
+	}
+	return u, nil
+}
+
+// ============================================================================
+// UPDATE (PUT and PATCH)
+// ============================================================================
+
+// scimUserChanges are the attributes the identity provider may change.
+// userName is the account's email address and is deliberately read-only.
+type scimUserChanges struct {
+	active     *bool
+	givenName  *string
+	familyName *string
+	externalID *string
+}
+
+// UpdateSCIMUserInput carries either a full resource (PUT) or a patch
+type UpdateSCIMUserInput struct {
+	TeamID   uuid.UUID
+	UserID   uuid.UUID
+	Resource *SCIMUserResource
+	Patch    *SCIMPatchRequest
+}
+
+type UpdateSCIMUserUseCase struct {
+	scimProvisioner
+	ssoRepo     team.SSORepository
+	sessionRepo user.SessionRepository
+}
+
+func NewUpdateSCIMUserUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	ssoRepo team.SSORepository,
+	sessionRepo user.SessionRepository,
+	logger common.Logger,
+) *UpdateSCIMUserUseCase {
+	return &UpdateSCIMUserUseCase{
+		scimProvisioner: scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger},
+		ssoRepo:         ssoRepo,
+		sessionRepo:     sessionRepo,
+	}
+}
+
+func (uc *UpdateSCIMUserUseCase) Execute(ctx context.Context, input UpdateSCIMUserInput) (*SCIMUserResource, error) {
+	member, u, err := uc.findMember(ctx, input.TeamID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes scimUserChanges
+	switch {
+	case input.Resource != nil:
+		changes = scimUserChanges{active: input.Resource.Active, externalID: &input.Resource.ExternalID}
+		if input.Resource.Name != nil {
+			changes.givenName = &input.Resource.Name.GivenName
+			changes.familyName = &input.Resource.Name.FamilyName
+		}
+	case input.Patch != nil:
+		if changes, err = parseSCIMUserPatch(input.Patch); err != nil {
+			return nil, err
+		}
+	}
+
+	// Profile: only for accounts in the team's domains
+	if changes.givenName != nil || changes.familyName != nil {
+		config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+		if err == nil && config.HandlesEmail(u.Email()) {
+			firstName, lastName := u.FirstName(), u.LastName()
+			if changes.givenName != nil && *changes.givenName != "" {
+				firstName = *changes.givenName
+			}
+			if changes.familyName != nil && *changes.familyName != "" {
+				lastName = *changes.familyName
+			}
+			if firstName != u.FirstName() || lastName != u.LastName() {
+				if err := u.UpdateProfile(firstName, lastName, u.AvatarURL()); err != nil {
+					return nil, err
+				}
+				if err := uc.userRepo.Update(ctx, u); err != nil {
+					uc.logger.Error("Failed to update user", "userId", u.ID(), "error", err)
+					return nil, fmt.Errorf("failed to update user")
+				}
+			}
+		}
+	}
+
+	if changes.externalID != nil {
+		if err := uc.setExternalID(ctx, input.TeamID, u, *changes.externalID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Activation
+	if changes.active != nil {
+		switch {
+		case !*changes.active:
+			if err := uc.deprovision(ctx, member, uc.sessionRepo); err != nil {
+				return nil, err
+			}
+		case !member.IsActive():
+			if err := member.Reactivate(); err != nil {
+				return nil, err
+			}
+			if err := uc.memberRepo.UpdateMember(ctx, member); err != nil {
+				uc.logger.Error("Failed to reactivate member", "userId", u.ID(), "teamId", input.TeamID, "error", err)
+				return nil, fmt.Errorf("failed to reactivate member")
+			}
+			uc.logger.Info("Member reactivated by SCIM", "userId", u.ID(), "teamId", input.TeamID)
+		}
+	}
+
+	return uc.userResource(ctx, member, u)
+}
+
+func parseSCIMUserPatch(patch *SCIMPatchRequest) (scimUserChanges, error) {
+	var changes scimUserChanges
+	for _, op := range patch.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		case "remove":
+			if strings.EqualFold(op.Path, "externalId") {
+				empty := ""
+				changes.externalID = &empty
+			}
+			continue
+		default:
+			return changes, fmt.Errorf("unsupported patch operation %q", op.Op)
+		}
+
+		if op.Path == "" {
+			// Okta: {"op": "replace", "value": {"active": false}}
+			var values map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &values); err != nil {
+				return changes, fmt.Errorf("invalid patch value")
+			}
+			for path, value := range values {
+				if err := changes.set(path, value); err != nil {
+					return changes, err
+				}
+			}
+			continue
+		}
+		if err := changes.set(op.Path, op.Value); err != nil {
+			return changes, err
+		}
+	}
+	return changes, nil
+}
+
+// set applies one attribute; unknown attributes are ignored
+func (c *scimUserChanges) set(path string, value json.RawMessage) error {
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := scimBool(value)
+		if err != nil {
+			return err
+		}
+		c.active = &active
+	case "externalid":
+		s, err := scimString(value)
+		if err != nil {
+			return err
+		}
+		c.externalID = &s
+	case "name.givenname":
+		s, err := scimString(value)
+		if err != nil {
+			return err
+		}
+		c.givenName = &s
+	case "name.familyname":
+		s, err := scimString(value)
+		if err != nil {
+			return err
+		}
+		c.familyName = &s
+	case "name":
+		var name SCIMName
+		if err := json.Unmarshal(value, &name); err != nil {
+			return fmt.Errorf("invalid name")
+		}
+		c.givenName, c.familyName = &name.GivenName, &name.FamilyName
+	}
+	return nil
+}
+
+// scimBool accepts JSON booleans and Azure AD's "True"/"False" strings
+func scimBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("invalid boolean value")
+}
+
+func scimString(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", fmt.Errorf("invalid string value")
+	}
+	return s, nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteSCIMUserInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+// DeleteSCIMUserUseCase deprovisions a member and drops them from their
+// groups. The membership is kept, inactive, so the history stays intact
+// and the identity provider can reactivate it.
+type DeleteSCIMUserUseCase struct {
+	scimProvisioner
+	sessionRepo user.SessionRepository
+}
+
+func NewDeleteSCIMUserUseCase(
+	scimRepo team.SCIMRepository,
+	memberRepo team.MemberRepository,
+	userRepo user.Repository,
+	identityRepo user.IdentityRepository,
+	sessionRepo user.SessionRepository,
+	logger common.Logger,
+) *DeleteSCIMUserUseCase {
+	return &DeleteSCIMUserUseCase{
+		scimProvisioner: scimProvisioner{scimRepo, memberRepo, userRepo, identityRepo, logger},
+		sessionRepo:     sessionRepo,
+	}
+}
+
+func (uc *DeleteSCIMUserUseCase) Execute(ctx context.Context, input DeleteSCIMUserInput) error {
+	member, _, err := uc.findMember(ctx, input.TeamID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.deprovision(ctx, member, uc.sessionRepo); err != nil {
+		return err
+	}
+	if err := uc.scimRepo.RemoveMemberFromGroups(ctx, input.TeamID, input.UserID); err != nil {
+		uc.logger.Error("Failed to remove member from SCIM groups", "userId", input.UserID, "teamId", input.TeamID, "error", err)
+		return fmt.Errorf("failed to remove member from groups")
+	}
+	return nil
+}
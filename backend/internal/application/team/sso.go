@@ -0,0 +1,377 @@
+// path: backend/internal/application/team/sso.go
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// SSOConfigDTO is a team's SAML configuration together with the service
+// provider values the IdP administrator needs
+type SSOConfigDTO struct {
+	TeamID           uuid.UUID                `json:"teamId"`
+	IdPEntityID      string                   `json:"idpEntityId"`
+	IdPSSOURL        string                   `json:"idpSsoUrl"`
+	IdPCertificates  []string                 `json:"idpCertificates"`
+	AttributeMapping team.SSOAttributeMapping `json:"attributeMapping"`
+	DefaultRole      string                   `json:"defaultRole"`
+	Domains          []SSODomainDTO           `json:"domains"`
+	EnforceSSO       bool                     `json:"enforceSso"`
+	Enabled          bool                     `json:"enabled"`
+
+	SPEntityID string `json:"spEntityId"`
+	SPACSURL   string `json:"spAcsUrl"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SSODomainDTO is a claimed email domain and the TXT record that proves
+// the team controls it
+type SSODomainDTO struct {
+	Domain         string     `json:"domain"`
+	Verified       bool       `json:"verified"`
+	VerifiedAt     *time.Time `json:"verifiedAt,omitempty"`
+	TXTRecordName  string     `json:"txtRecordName"`
+	TXTRecordValue string     `json:"txtRecordValue"`
+}
+
+func mapSSOConfigToDTO(c *team.SSOConfig, sp common.SAMLServiceProvider) *SSOConfigDTO {
+	return &SSOConfigDTO{
+		TeamID:           c.TeamID(),
+		IdPEntityID:      c.IdPEntityID(),
+		IdPSSOURL:        c.IdPSSOURL(),
+		IdPCertificates:  c.IdPCertificates(),
+		AttributeMapping: c.AttributeMapping(),
+		DefaultRole:      string(c.DefaultRole()),
+		Domains:          mapSSODomainsToDTO(c.Domains()),
+		EnforceSSO:       c.EnforceSSO(),
+		Enabled:          c.IsEnabled(),
+		SPEntityID:       sp.EntityID(c.TeamID().String()),
+		SPACSURL:         sp.ACSURL(c.TeamID().String()),
+		UpdatedAt:        c.UpdatedAt(),
+	}
+}
+
+func mapSSODomainsToDTO(domains []team.SSODomain) []SSODomainDTO {
+	dtos := make([]SSODomainDTO, 0, len(domains))
+	for _, d := range domains {
+		dtos = append(dtos, SSODomainDTO{
+			Domain:         d.Domain,
+			Verified:       d.IsVerified(),
+			VerifiedAt:     d.VerifiedAt,
+			TXTRecordName:  d.RecordName(),
+			TXTRecordValue: d.RecordValue(),
+		})
+	}
+	return dtos
+}
+
+// requireTeamAdmin allows owners and admins of the team
+func requireTeamAdmin(ctx context.Context, memberRepo team.MemberRepository, teamID, userID uuid.UUID) error {
+	member, err := memberRepo.FindMember(ctx, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("access denied: not a team member")
+	}
+	if member.Status() != team.MemberStatusActive {
+		return fmt.Errorf("access denied: membership is inactive")
+	}
+	if member.Role() != team.MemberRoleOwner && member.Role() != team.MemberRoleAdmin {
+		return fmt.Errorf("access denied: admin role required")
+	}
+	return nil
+}
+
+// ============================================================================
+// GET
+// ============================================================================
+
+type GetSSOConfigInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type GetSSOConfigUseCase struct {
+	ssoRepo    team.SSORepository
+	memberRepo team.MemberRepository
+	samlSP     common.SAMLServiceProvider
+}
+
+func NewGetSSOConfigUseCase(ssoRepo team.SSORepository, memberRepo team.MemberRepository, samlSP common.SAMLServiceProvider) *GetSSOConfigUseCase {
+	return &GetSSOConfigUseCase{ssoRepo: ssoRepo, memberRepo: memberRepo, samlSP: samlSP}
+}
+
+func (uc *GetSSOConfigUseCase) Execute(ctx context.Context, input GetSSOConfigInput) (*SSOConfigDTO, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	return mapSSOConfigToDTO(config, uc.samlSP), nil
+}
+
+// ============================================================================
+// CONFIGURE
+// ============================================================================
+
+// ConfigureSSOInput creates or updates a team's SAML configuration. The IdP
+// is given either as a metadata document or as entity ID, sign-on URL and
+// certificates; omitted fields keep their current values.
+type ConfigureSSOInput struct {
+	TeamID uuid.UUID `json:"-"`
+	UserID uuid.UUID `json:"-"`
+
+	IdPMetadataXML  string   `json:"idpMetadataXml,omitempty"`
+	IdPEntityID     string   `json:"idpEntityId,omitempty"`
+	IdPSSOURL       string   `json:"idpSsoUrl,omitempty"`
+	IdPCertificates []string `json:"idpCertificates,omitempty"`
+
+	AttributeMapping *team.SSOAttributeMapping `json:"attributeMapping,omitempty"`
+	DefaultRole      *string                   `json:"defaultRole,omitempty"`
+	Domains          []string                  `json:"domains,omitempty"`
+	EnforceSSO       *bool                     `json:"enforceSso,omitempty"`
+	Enabled          *bool                     `json:"enabled,omitempty"`
+}
+
+type ConfigureSSOUseCase struct {
+	ssoRepo    team.SSORepository
+	teamRepo   team.Repository
+	memberRepo team.MemberRepository
+	samlSP     common.SAMLServiceProvider
+	logger     common.Logger
+}
+
+func NewConfigureSSOUseCase(
+	ssoRepo team.SSORepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	samlSP common.SAMLServiceProvider,
+	logger common.Logger,
+) *ConfigureSSOUseCase {
+	return &ConfigureSSOUseCase{
+		ssoRepo:    ssoRepo,
+		teamRepo:   teamRepo,
+		memberRepo: memberRepo,
+		samlSP:     samlSP,
+		logger:     logger,
+	}
+}
+
+func (uc *ConfigureSSOUseCase) Execute(ctx context.Context, input ConfigureSSOInput) (*SSOConfigDTO, error) {
+	// 1. Authorize and check the plan
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+	t, err := uc.teamRepo.FindByID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.HasFeature("sso") {
+		return nil, team.ErrFeatureNotAvailable
+	}
+
+	// 2. Resolve the identity provider
+	idp, err := uc.identityProvider(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Create or update
+	config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+	switch {
+	case errors.Is(err, team.ErrSSONotConfigured):
+		if idp == nil {
+			return nil, fmt.Errorf("%w: identity provider metadata is required", team.ErrInvalidSSOConfig)
+		}
+		config, err = team.NewSSOConfig(input.TeamID, idp.EntityID, idp.SSOURL, idp.Certificates)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case idp != nil:
+		if err := config.SetIdentityProvider(idp.EntityID, idp.SSOURL, idp.Certificates); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.AttributeMapping != nil {
+		config.SetAttributeMapping(*input.AttributeMapping)
+	}
+	if input.DefaultRole != nil {
+		if err := config.SetDefaultRole(team.MemberRole(*input.DefaultRole)); err != nil {
+			return nil, err
+		}
+	}
+	if input.Domains != nil {
+		if err := config.SetDomains(input.Domains); err != nil {
+			return nil, err
+		}
+	}
+	if input.EnforceSSO != nil {
+		config.SetEnforceSSO(*input.EnforceSSO)
+	}
+	if input.Enabled != nil {
+		if err := config.SetEnabled(*input.Enabled); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. Persist; a domain another team has verified is rejected here
+	if err := uc.ssoRepo.Save(ctx, config); err != nil {
+		if errors.Is(err, team.ErrSSODomainTaken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save sso config: %w", err)
+	}
+
+	uc.logger.Info("SSO configured", "teamID", input.TeamID, "by", input.UserID, "enabled", config.IsEnabled(), "enforced", config.EnforceSSO())
+
+	return mapSSOConfigToDTO(config, uc.samlSP), nil
+}
+
+// identityProvider reads the IdP from metadata or the manual fields, or
+// returns nil when the input changes neither
+func (uc *ConfigureSSOUseCase) identityProvider(input ConfigureSSOInput) (*common.SAMLIdentityProvider, error) {
+	if strings.TrimSpace(input.IdPMetadataXML) != "" {
+		idp, err := uc.samlSP.ParseIdPMetadata([]byte(input.IdPMetadataXML))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", team.ErrInvalidSSOConfig, err)
+		}
+		return idp, nil
+	}
+
+	if input.IdPEntityID == "" && input.IdPSSOURL == "" && len(input.IdPCertificates) == 0 {
+		return nil, nil
+	}
+
+	idp := &common.SAMLIdentityProvider{EntityID: input.IdPEntityID, SSOURL: input.IdPSSOURL}
+	for _, c := range input.IdPCertificates {
+		cert, err := uc.samlSP.NormalizeCertificate(c)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", team.ErrInvalidSSOConfig, err)
+		}
+		idp.Certificates = append(idp.Certificates, cert)
+	}
+	return idp, nil
+}
+
+// ============================================================================
+// VERIFY DOMAIN
+// ============================================================================
+
+// TXTResolver looks up DNS TXT records; *net.Resolver satisfies it
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+type VerifySSODomainInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+	Domain string
+}
+
+// VerifySSODomainUseCase checks the TXT record of a claimed domain. Until it
+// passes the domain provisions no accounts and enforces nothing.
+type VerifySSODomainUseCase struct {
+	ssoRepo    team.SSORepository
+	memberRepo team.MemberRepository
+	resolver   TXTResolver
+	samlSP     common.SAMLServiceProvider
+	logger     common.Logger
+}
+
+func NewVerifySSODomainUseCase(
+	ssoRepo team.SSORepository,
+	memberRepo team.MemberRepository,
+	resolver TXTResolver,
+	samlSP common.SAMLServiceProvider,
+	logger common.Logger,
+) *VerifySSODomainUseCase {
+	return &VerifySSODomainUseCase{
+		ssoRepo:    ssoRepo,
+		memberRepo: memberRepo,
+		resolver:   resolver,
+		samlSP:     samlSP,
+		logger:     logger,
+	}
+}
+
+func (uc *VerifySSODomainUseCase) Execute(ctx context.Context, input VerifySSODomainInput) (*SSOConfigDTO, error) {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	config, err := uc.ssoRepo.FindByTeamID(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ToLower(strings.TrimSpace(input.Domain))
+	var records []string
+	for _, d := range config.Domains() {
+		if d.Domain != name {
+			continue
+		}
+		records, err = uc.resolver.LookupTXT(ctx, d.RecordName())
+		var dnsErr *net.DNSError
+		if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+			return nil, fmt.Errorf("failed to look up verification record: %w", err)
+		}
+	}
+
+	if err := config.VerifyDomain(name, records, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.ssoRepo.Save(ctx, config); err != nil {
+		if errors.Is(err, team.ErrSSODomainTaken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save sso config: %w", err)
+	}
+
+	uc.logger.Info("SSO domain verified", "teamID", input.TeamID, "domain", name, "by", input.UserID)
+
+	return mapSSOConfigToDTO(config, uc.samlSP), nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteSSOConfigInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type DeleteSSOConfigUseCase struct {
+	ssoRepo    team.SSORepository
+	memberRepo team.MemberRepository
+	logger     common.Logger
+}
+
+func NewDeleteSSOConfigUseCase(ssoRepo team.SSORepository, memberRepo team.MemberRepository, logger common.Logger) *DeleteSSOConfigUseCase {
+	return &DeleteSSOConfigUseCase{ssoRepo: ssoRepo, memberRepo: memberRepo, logger: logger}
+}
+
+func (uc *DeleteSSOConfigUseCase) Execute(ctx context.Context, input DeleteSSOConfigInput) error {
+	if err := requireTeamAdmin(ctx, uc.memberRepo, input.TeamID, input.UserID); err != nil {
+		return err
+	}
+	if err := uc.ssoRepo.Delete(ctx, input.TeamID); err != nil {
+		return err
+	}
+
+	uc.logger.Info("SSO removed", "teamID", input.TeamID, "by", input.UserID)
+	return nil
+}
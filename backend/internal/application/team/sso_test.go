@@ -0,0 +1,145 @@
+// path: backend/internal/application/team/sso_test.go
+package team
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+// fakeDNS serves TXT records by name; other names don't exist
+type fakeDNS struct {
+	records map[string][]string
+	err     error
+	lookups []string
+}
+
+func (r *fakeDNS) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	r.lookups = append(r.lookups, name)
+	if r.err != nil {
+		return nil, r.err
+	}
+	if txt, ok := r.records[name]; ok {
+		return txt, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+type savedSSO struct {
+	team.SSORepository
+	config  *team.SSOConfig
+	saveErr error
+	saves   int
+}
+
+func (r *savedSSO) FindByTeamID(ctx context.Context, teamID uuid.UUID) (*team.SSOConfig, error) {
+	if r.config == nil || r.config.TeamID() != teamID {
+		return nil, team.ErrSSONotConfigured
+	}
+	return r.config, nil
+}
+
+func (r *savedSSO) Save(ctx context.Context, c *team.SSOConfig) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	r.saves++
+	return nil
+}
+
+type staticSP struct {
+	common.SAMLServiceProvider
+}
+
+func (staticSP) EntityID(teamID string) string { return "https://app.example.com/saml/" + teamID }
+func (staticSP) ACSURL(teamID string) string   { return "https://app.example.com/saml/" + teamID + "/acs" }
+
+func newVerifyFixture(t *testing.T) (*scimFixture, uuid.UUID, *savedSSO, team.SSODomain) {
+	t.Helper()
+
+	f := newSCIMFixture()
+	admin := f.addMember(t, "ana", team.MemberRoleAdmin)
+	config, err := team.NewSSOConfig(f.teamID, "https://idp.acme.com", "https://idp.acme.com/sso", []string{"cert"})
+	if err != nil {
+		t.Fatalf("NewSSOConfig: %v", err)
+	}
+	if err := config.SetDomains([]string{"acme.com"}); err != nil {
+		t.Fatalf("SetDomains: %v", err)
+	}
+	return f, admin, &savedSSO{config: config}, config.Domains()[0]
+}
+
+func TestVerifySSODomain(t *testing.T) {
+	f, admin, sso, domain := newVerifyFixture(t)
+	dns := &fakeDNS{records: map[string][]string{}}
+	uc := NewVerifySSODomainUseCase(sso, f.members, dns, staticSP{}, services.NewLogger())
+	ctx := context.Background()
+	input := VerifySSODomainInput{TeamID: f.teamID, UserID: admin, Domain: " ACME.com "}
+
+	// No record yet, then a record with someone else's token
+	if _, err := uc.Execute(ctx, input); !errors.Is(err, team.ErrSSODomainNotVerified) {
+		t.Fatalf("no record: err = %v, want ErrSSODomainNotVerified", err)
+	}
+	dns.records[domain.RecordName()] = []string{"v=spf1 -all", team.SSODomainRecordValuePrefix + "someone-else"}
+	if _, err := uc.Execute(ctx, input); !errors.Is(err, team.ErrSSODomainNotVerified) {
+		t.Fatalf("wrong token: err = %v, want ErrSSODomainNotVerified", err)
+	}
+	if sso.saves != 0 {
+		t.Fatalf("saved %d times before the record was published", sso.saves)
+	}
+
+	dns.records[domain.RecordName()] = append(dns.records[domain.RecordName()], domain.RecordValue())
+	out, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("published record: %v", err)
+	}
+	if len(out.Domains) != 1 || !out.Domains[0].Verified || sso.saves != 1 {
+		t.Errorf("published record: domains = %+v after %d saves", out.Domains, sso.saves)
+	}
+	for _, name := range dns.lookups {
+		if name != domain.RecordName() {
+			t.Errorf("looked up %q, want only %q", name, domain.RecordName())
+		}
+	}
+}
+
+func TestVerifySSODomainFailures(t *testing.T) {
+	ctx := context.Background()
+
+	f, admin, sso, domain := newVerifyFixture(t)
+	dns := &fakeDNS{records: map[string][]string{domain.RecordName(): {domain.RecordValue()}}}
+	uc := NewVerifySSODomainUseCase(sso, f.members, dns, staticSP{}, services.NewLogger())
+
+	editor := f.addMember(t, "bob", team.MemberRoleEditor)
+	for name, userID := range map[string]uuid.UUID{"editor": editor, "outsider": uuid.New()} {
+		_, err := uc.Execute(ctx, VerifySSODomainInput{TeamID: f.teamID, UserID: userID, Domain: "acme.com"})
+		if err == nil || !strings.HasPrefix(err.Error(), "access denied") {
+			t.Errorf("%s: err = %v, want access denied", name, err)
+		}
+	}
+
+	if _, err := uc.Execute(ctx, VerifySSODomainInput{TeamID: f.teamID, UserID: admin, Domain: "other.com"}); !errors.Is(err, team.ErrSSODomainNotClaimed) {
+		t.Errorf("unclaimed domain: err = %v, want ErrSSODomainNotClaimed", err)
+	}
+
+	sso.saveErr = team.ErrSSODomainTaken
+	if _, err := uc.Execute(ctx, VerifySSODomainInput{TeamID: f.teamID, UserID: admin, Domain: "acme.com"}); !errors.Is(err, team.ErrSSODomainTaken) {
+		t.Errorf("taken domain: err = %v, want ErrSSODomainTaken", err)
+	}
+
+	// A resolver failure is not a missing record
+	f, admin, sso, _ = newVerifyFixture(t)
+	dns = &fakeDNS{err: &net.DNSError{Err: "server misbehaving", IsTemporary: true}}
+	uc = NewVerifySSODomainUseCase(sso, f.members, dns, staticSP{}, services.NewLogger())
+	_, err := uc.Execute(ctx, VerifySSODomainInput{TeamID: f.teamID, UserID: admin, Domain: "acme.com"})
+	if err == nil || errors.Is(err, team.ErrSSODomainNotVerified) {
+		t.Errorf("resolver failure: err = %v, want a lookup error", err)
+	}
+}
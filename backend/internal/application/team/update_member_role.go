@@ -8,7 +8,9 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
 	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
 	"github.com/techappsUT/social-queue/internal/domain/user"
 )
@@ -27,38 +29,42 @@ type UpdateMemberRoleOutput struct {
 type UpdateMemberRoleUseCase struct {
 	teamRepo   teamDomain.Repository
 	memberRepo teamDomain.MemberRepository
+	roleRepo   teamDomain.RoleRepository
+	authorizer *teamDomain.Authorizer
 	userRepo   user.Repository
+	recorder   *auditlog.Recorder
 	logger     common.Logger
 }
 
 func NewUpdateMemberRoleUseCase(
 	teamRepo teamDomain.Repository,
 	memberRepo teamDomain.MemberRepository,
+	roleRepo teamDomain.RoleRepository,
 	userRepo user.Repository,
+	recorder *auditlog.Recorder,
 	logger common.Logger,
 ) *UpdateMemberRoleUseCase {
 	return &UpdateMemberRoleUseCase{
 		teamRepo:   teamRepo,
 		memberRepo: memberRepo,
+		roleRepo:   roleRepo,
+		authorizer: teamDomain.NewAuthorizer(memberRepo, roleRepo),
 		userRepo:   userRepo,
+		recorder:   recorder,
 		logger:     logger,
 	}
 }
 
 func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemberRoleInput) (*UpdateMemberRoleOutput, error) {
-	// 1. Validate new role
-	if !uc.isValidRole(input.NewRole) {
-		return nil, fmt.Errorf("invalid role: must be owner, admin, editor, or viewer")
-	}
-
-	// 2. Check updater authorization (only owner can change roles)
-	isOwner, err := uc.memberRepo.IsOwner(ctx, input.TeamID, input.UpdaterID)
+	// 1. Check updater authorization
+	updater, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UpdaterID, teamDomain.PermMembersManage)
 	if err != nil {
-		return nil, fmt.Errorf("access denied: not a team member")
+		return nil, err
 	}
 
-	if !isOwner {
-		return nil, fmt.Errorf("access denied: only team owner can change member roles")
+	// 2. The new role must exist and grant nothing beyond the updater's own
+	if err := requireRoleGrant(ctx, uc.roleRepo, updater, input.TeamID, input.NewRole); err != nil {
+		return nil, err
 	}
 
 	// 3. Cannot update own role
@@ -77,6 +83,11 @@ func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemb
 		return nil, fmt.Errorf("member already has this role")
 	}
 
+	// Members above the updater cannot be changed by them either
+	if err := requireRoleGrant(ctx, uc.roleRepo, updater, input.TeamID, member.Role()); err != nil {
+		return nil, err
+	}
+
 	// 6. If demoting from owner, ensure there's another owner
 	if member.Role() == teamDomain.MemberRoleOwner && input.NewRole != teamDomain.MemberRoleOwner {
 		ownerCount, err := uc.memberRepo.CountByRole(ctx, input.TeamID, teamDomain.MemberRoleOwner)
@@ -111,6 +122,7 @@ func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemb
 	}
 
 	// 8. Update member role using domain method
+	oldRole := member.Role()
 	if err := member.ChangeRole(input.NewRole, input.UpdaterID); err != nil {
 		return nil, fmt.Errorf("failed to change role: %w", err)
 	}
@@ -133,10 +145,16 @@ func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemb
 	// 11. Map to DTO
 	memberDTO := MapMemberToDTO(member, u)
 
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UpdaterID, audit.ActionMemberRoleChanged, audit.TargetMember, input.UserID.String()).
+		WithChanges(audit.Diff(
+			map[string]interface{}{"role": string(oldRole)},
+			map[string]interface{}{"role": string(input.NewRole)},
+		)))
+
 	uc.logger.Info("Member role updated",
 		"teamId", input.TeamID,
 		"userId", input.UserID,
-		"oldRole", member.Role(),
+		"oldRole", oldRole,
 		"newRole", input.NewRole,
 		"updaterId", input.UpdaterID)
 
@@ -144,21 +162,3 @@ func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemb
 		Member: memberDTO,
 	}, nil
 }
-
-// isValidRole checks if the role is valid
-func (uc *UpdateMemberRoleUseCase) isValidRole(role teamDomain.MemberRole) bool {
-	validRoles := []teamDomain.MemberRole{
-		teamDomain.MemberRoleOwner,
-		teamDomain.MemberRoleAdmin,
-		teamDomain.MemberRoleEditor,
-		teamDomain.MemberRoleViewer,
-	}
-
-	for _, validRole := range validRoles {
-		if role == validRole {
-			return true
-		}
-	}
-
-	return false
-}
@@ -17,6 +17,10 @@ type UpdateTeamInput struct {
 	TeamID uuid.UUID `json:"teamId" validate:"required"`
 	UserID uuid.UUID `json:"userId" validate:"required"`
 	Name   *string   `json:"name,omitempty" validate:"omitempty,min=3,max=100"`
+
+	// RequireTwoFactor makes every member sign in with two-factor
+	// authentication; members without it must enroll at their next login
+	RequireTwoFactor *bool `json:"requireTwoFactor,omitempty"`
 }
 
 type UpdateTeamOutput struct {
@@ -69,6 +73,10 @@ func (uc *UpdateTeamUseCase) Execute(ctx context.Context, input UpdateTeamInput)
 		}
 	}
 
+	if input.RequireTwoFactor != nil {
+		t.SetRequireTwoFactor(*input.RequireTwoFactor)
+	}
+
 	// 4. Persist
 	if err := uc.teamRepo.Update(ctx, t); err != nil {
 		uc.logger.Error("Failed to update team", "teamId", input.TeamID, "error", err)
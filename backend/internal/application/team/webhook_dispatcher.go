@@ -0,0 +1,244 @@
+// ============================================================================
+// FILE: backend/internal/application/team/webhook_dispatcher.go
+// PURPOSE: Delivers domain events to team webhook endpoints
+// ============================================================================
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// webhookRetryBatch is how many due retries one pass claims
+const webhookRetryBatch = 100
+
+// WebhookDispatcher sends events to the endpoints subscribed to them.
+// HandleEvent makes the first attempt when an event arrives; RetryDue
+// retries failed deliveries on WebhookRetrySchedule. Each event reaches an
+// endpoint at most once per attempt, even when the event is redelivered.
+type WebhookDispatcher struct {
+	webhookRepo  team.WebhookRepository
+	deliveryRepo team.WebhookDeliveryRepository
+	teamRepo     team.Repository
+	sender       common.WebhookSender
+	recorder     *auditlog.Recorder
+	logger       common.Logger
+}
+
+func NewWebhookDispatcher(
+	webhookRepo team.WebhookRepository,
+	deliveryRepo team.WebhookDeliveryRepository,
+	teamRepo team.Repository,
+	sender common.WebhookSender,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		teamRepo:     teamRepo,
+		sender:       sender,
+		recorder:     recorder,
+		logger:       logger,
+	}
+}
+
+// HandleEvent is an event handler creating and attempting a delivery for
+// every endpoint of the event's team that subscribes to it
+func (d *WebhookDispatcher) HandleEvent(ctx context.Context, e common.Event) error {
+	envelope, ok := e.(*event.Envelope)
+	if !ok {
+		return fmt.Errorf("webhook dispatch needs an event envelope, got %T", e)
+	}
+	if envelope.Team == uuid.Nil || !team.IsWebhookEventType(envelope.EventType) {
+		return nil
+	}
+
+	webhooks, err := d.webhookRepo.ListSubscribed(ctx, envelope.Team, envelope.EventType)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	// A downgraded team keeps its endpoints but receives nothing
+	t, err := d.teamRepo.FindByID(ctx, envelope.Team)
+	if errors.Is(err, team.ErrTeamNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !t.HasFeature("webhooks") {
+		return nil
+	}
+
+	payload, err := team.NewWebhookPayload(envelope)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range webhooks {
+		delivery := team.NewWebhookDelivery(w, envelope, payload)
+		created, err := d.deliveryRepo.Create(ctx, delivery)
+		if err != nil {
+			return err
+		}
+		if !created {
+			continue // already delivered when the event was seen before
+		}
+		d.attempt(ctx, w, delivery, true)
+	}
+	return nil
+}
+
+// RetryDue attempts the deliveries whose retry is due and returns how many
+// were claimed
+func (d *WebhookDispatcher) RetryDue(ctx context.Context) (int, error) {
+	deliveries, err := d.deliveryRepo.ClaimDue(ctx, webhookRetryBatch, team.WebhookAttemptLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			break // the lease brings the rest back
+		}
+
+		w, err := d.webhookRepo.FindByID(ctx, delivery.WebhookID())
+		if err != nil && !errors.Is(err, team.ErrWebhookNotFound) {
+			d.logger.Warn("Failed to load webhook for retry", "deliveryId", delivery.ID(), "error", err)
+			continue
+		}
+		if w == nil || !w.IsActive() {
+			delivery.Abandon("webhook endpoint is disabled", time.Now().UTC())
+			if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+				d.logger.Warn("Failed to abandon webhook delivery", "deliveryId", delivery.ID(), "error", err)
+			}
+			continue
+		}
+
+		d.attempt(ctx, w, delivery, true)
+	}
+	return len(deliveries), nil
+}
+
+// SendTest delivers a webhook.test event once. Test deliveries are logged
+// but not retried and do not count towards disabling the endpoint.
+func (d *WebhookDispatcher) SendTest(ctx context.Context, w *team.Webhook, actorID uuid.UUID) (*team.WebhookDelivery, error) {
+	data, err := json.Marshal(map[string]string{
+		"webhookId": w.ID().String(),
+		"userId":    actorID.String(),
+		"message":   "This is a test event from Social Queue",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode test event: %w", err)
+	}
+
+	envelope := &event.Envelope{
+		ID:        uuid.New(),
+		EventType: team.WebhookTestEvent,
+		Aggregate: w.ID().String(),
+		Team:      w.TeamID(),
+		At:        time.Now().UTC(),
+		Payload:   data,
+	}
+	payload, err := team.NewWebhookPayload(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := team.NewWebhookDelivery(w, envelope, payload)
+	if _, err := d.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+	d.attempt(ctx, w, delivery, false)
+	return delivery, nil
+}
+
+// attempt sends a delivery once and records the outcome. Live deliveries
+// are retried and tracked in the endpoint's failure streak.
+func (d *WebhookDispatcher) attempt(ctx context.Context, w *team.Webhook, delivery *team.WebhookDelivery, live bool) {
+	timestamp := time.Now().Unix()
+	headers := map[string]string{
+		team.WebhookSignatureHeader: team.SignWebhook(w.Secret(), timestamp, delivery.Payload()),
+		team.WebhookTimestampHeader: strconv.FormatInt(timestamp, 10),
+		team.WebhookEventHeader:     delivery.EventType(),
+		team.WebhookDeliveryHeader:  delivery.ID().String(),
+	}
+
+	var (
+		status   int
+		body     string
+		duration time.Duration
+	)
+	resp, sendErr := d.sender.Send(ctx, w.URL(), headers, delivery.Payload())
+	if resp != nil {
+		status, body, duration = resp.StatusCode, resp.Body, resp.Duration
+	}
+
+	now := time.Now().UTC()
+	succeeded := delivery.RecordAttempt(status, body, sendErr, duration, now, live)
+	if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+		d.logger.Warn("Failed to record webhook delivery", "deliveryId", delivery.ID(), "error", err)
+	}
+
+	if succeeded {
+		d.logger.Debug("Webhook delivered",
+			"webhookId", w.ID(),
+			"deliveryId", delivery.ID(),
+			"type", delivery.EventType(),
+			"status", status)
+	} else {
+		d.logger.Warn("Webhook delivery failed",
+			"webhookId", w.ID(),
+			"deliveryId", delivery.ID(),
+			"type", delivery.EventType(),
+			"attempt", delivery.Attempts(),
+			"status", status,
+			"nextAttemptAt", delivery.NextAttemptAt(),
+			"error", delivery.LastError())
+	}
+
+	if live {
+		d.recordHealth(ctx, w, succeeded, now)
+	}
+}
+
+// recordHealth updates the endpoint's failure streak, disabling it after
+// sustained failures
+func (d *WebhookDispatcher) recordHealth(ctx context.Context, w *team.Webhook, succeeded bool, now time.Time) {
+	disabled := false
+	if succeeded {
+		if w.FailureCount() == 0 {
+			return
+		}
+		w.RecordSuccess()
+	} else {
+		disabled = w.RecordFailure(now)
+	}
+
+	if err := d.webhookRepo.UpdateHealth(ctx, w); err != nil {
+		d.logger.Warn("Failed to update webhook health", "webhookId", w.ID(), "error", err)
+		return
+	}
+
+	if disabled {
+		d.recorder.Record(ctx, audit.NewEntry(ctx, w.TeamID(), uuid.Nil, audit.ActionWebhookDisabled, audit.TargetWebhook, w.ID().String()).
+			WithMetadata("url", w.URL()).
+			WithMetadata("reason", w.DisabledReason()))
+		d.logger.Warn("Webhook disabled after sustained failures",
+			"webhookId", w.ID(),
+			"teamId", w.TeamID(),
+			"failures", w.FailureCount())
+	}
+}
@@ -0,0 +1,475 @@
+// ============================================================================
+// FILE: backend/internal/application/team/webhooks.go
+// PURPOSE: Team webhook endpoint management and delivery logs
+// ============================================================================
+package team
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type WebhookDTO struct {
+	ID             uuid.UUID  `json:"id"`
+	TeamID         uuid.UUID  `json:"teamId"`
+	URL            string     `json:"url"`
+	Description    string     `json:"description"`
+	EventTypes     []string   `json:"eventTypes"`
+	Active         bool       `json:"active"`
+	FailureCount   int        `json:"failureCount"`
+	FailingSince   *time.Time `json:"failingSince,omitempty"`
+	DisabledAt     *time.Time `json:"disabledAt,omitempty"`
+	DisabledReason string     `json:"disabledReason,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+func mapWebhookToDTO(w *team.Webhook) WebhookDTO {
+	return WebhookDTO{
+		ID:             w.ID(),
+		TeamID:         w.TeamID(),
+		URL:            w.URL(),
+		Description:    w.Description(),
+		EventTypes:     w.EventTypes(),
+		Active:         w.IsActive(),
+		FailureCount:   w.FailureCount(),
+		FailingSince:   w.FailingSince(),
+		DisabledAt:     w.DisabledAt(),
+		DisabledReason: w.DisabledReason(),
+		CreatedAt:      w.CreatedAt(),
+		UpdatedAt:      w.UpdatedAt(),
+	}
+}
+
+type WebhookDeliveryDTO struct {
+	ID             uuid.UUID       `json:"id"`
+	WebhookID      uuid.UUID       `json:"webhookId"`
+	EventID        uuid.UUID       `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	ResponseStatus int             `json:"responseStatus,omitempty"`
+	ResponseBody   string          `json:"responseBody,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	DurationMs     int64           `json:"durationMs"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	CompletedAt    *time.Time      `json:"completedAt,omitempty"`
+}
+
+func mapWebhookDeliveryToDTO(d *team.WebhookDelivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		ID:             d.ID(),
+		WebhookID:      d.WebhookID(),
+		EventID:        d.EventID(),
+		EventType:      d.EventType(),
+		Status:         d.Status(),
+		Attempts:       d.Attempts(),
+		NextAttemptAt:  d.NextAttemptAt(),
+		ResponseStatus: d.ResponseStatus(),
+		ResponseBody:   d.ResponseBody(),
+		Error:          d.LastError(),
+		DurationMs:     d.Duration().Milliseconds(),
+		Payload:        d.Payload(),
+		CreatedAt:      d.CreatedAt(),
+		CompletedAt:    d.CompletedAt(),
+	}
+}
+
+// webhookFields is what the audit log compares when an endpoint changes
+func webhookFields(w *team.Webhook) map[string]interface{} {
+	return map[string]interface{}{
+		"url":         w.URL(),
+		"description": w.Description(),
+		"eventTypes":  w.EventTypes(),
+		"active":      w.IsActive(),
+	}
+}
+
+// requireWebhooks checks the team's plan includes webhooks
+func requireWebhooks(ctx context.Context, teamRepo team.Repository, teamID uuid.UUID) error {
+	t, err := teamRepo.FindByID(ctx, teamID)
+	if err != nil {
+		return team.ErrTeamNotFound
+	}
+	if !t.HasFeature("webhooks") {
+		return team.ErrFeatureNotAvailable
+	}
+	return nil
+}
+
+// findTeamWebhook loads an endpoint of the team. Endpoints of other teams
+// look the same as missing ones.
+func findTeamWebhook(ctx context.Context, repo team.WebhookRepository, teamID, webhookID uuid.UUID) (*team.Webhook, error) {
+	w, err := repo.FindByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if w.TeamID() != teamID {
+		return nil, team.ErrWebhookNotFound
+	}
+	return w, nil
+}
+
+// ============================================================================
+// CREATE
+// ============================================================================
+
+type CreateWebhookInput struct {
+	TeamID      uuid.UUID `json:"-"`
+	UserID      uuid.UUID `json:"-"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	EventTypes  []string  `json:"eventTypes"`
+}
+
+// CreateWebhookOutput carries the signing secret, which is only shown once
+type CreateWebhookOutput struct {
+	WebhookDTO
+	Secret string `json:"secret"`
+}
+
+type CreateWebhookUseCase struct {
+	webhookRepo team.WebhookRepository
+	teamRepo    team.Repository
+	authorizer  *team.Authorizer
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewCreateWebhookUseCase(
+	webhookRepo team.WebhookRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{
+		webhookRepo: webhookRepo,
+		teamRepo:    teamRepo,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+func (uc *CreateWebhookUseCase) Execute(ctx context.Context, input CreateWebhookInput) (*CreateWebhookOutput, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+	if err := requireWebhooks(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	w, err := team.NewWebhook(input.TeamID, input.UserID, input.URL, input.Description, input.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.webhookRepo.Create(ctx, w); err != nil {
+		uc.logger.Error("Failed to create webhook", "teamId", input.TeamID, "error", err)
+		return nil, fmt.Errorf("failed to create webhook")
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionWebhookCreated, audit.TargetWebhook, w.ID().String()).
+		WithChanges(audit.Diff(nil, webhookFields(w))))
+
+	uc.logger.Info("Webhook created", "webhookId", w.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	return &CreateWebhookOutput{WebhookDTO: mapWebhookToDTO(w), Secret: w.Secret()}, nil
+}
+
+// ============================================================================
+// LIST
+// ============================================================================
+
+type ListWebhooksInput struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ListWebhooksUseCase struct {
+	webhookRepo team.WebhookRepository
+	authorizer  *team.Authorizer
+}
+
+func NewListWebhooksUseCase(webhookRepo team.WebhookRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		webhookRepo: webhookRepo,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context, input ListWebhooksInput) ([]WebhookDTO, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepo.ListByTeam(ctx, input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]WebhookDTO, 0, len(webhooks))
+	for _, w := range webhooks {
+		dtos = append(dtos, mapWebhookToDTO(w))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// UPDATE
+// ============================================================================
+
+// UpdateWebhookInput changes the fields that are set. Setting Active to
+// true re-enables an endpoint disabled after failures.
+type UpdateWebhookInput struct {
+	TeamID      uuid.UUID `json:"-"`
+	UserID      uuid.UUID `json:"-"`
+	WebhookID   uuid.UUID `json:"-"`
+	URL         *string   `json:"url,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	EventTypes  []string  `json:"eventTypes,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+}
+
+type UpdateWebhookUseCase struct {
+	webhookRepo team.WebhookRepository
+	teamRepo    team.Repository
+	authorizer  *team.Authorizer
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewUpdateWebhookUseCase(
+	webhookRepo team.WebhookRepository,
+	teamRepo team.Repository,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+	recorder *auditlog.Recorder,
+	logger common.Logger,
+) *UpdateWebhookUseCase {
+	return &UpdateWebhookUseCase{
+		webhookRepo: webhookRepo,
+		teamRepo:    teamRepo,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+func (uc *UpdateWebhookUseCase) Execute(ctx context.Context, input UpdateWebhookInput) (*WebhookDTO, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+	if err := requireWebhooks(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	w, err := findTeamWebhook(ctx, uc.webhookRepo, input.TeamID, input.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	before := webhookFields(w)
+
+	url, description, eventTypes := w.URL(), w.Description(), w.EventTypes()
+	if input.URL != nil {
+		url = *input.URL
+	}
+	if input.Description != nil {
+		description = *input.Description
+	}
+	if input.EventTypes != nil {
+		eventTypes = input.EventTypes
+	}
+	if err := w.Update(url, description, eventTypes); err != nil {
+		return nil, err
+	}
+	if input.Active != nil && *input.Active != w.IsActive() {
+		if *input.Active {
+			w.Enable()
+		} else {
+			w.Disable("disabled by a team member")
+		}
+	}
+
+	if err := uc.webhookRepo.Update(ctx, w); err != nil {
+		return nil, err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionWebhookUpdated, audit.TargetWebhook, w.ID().String()).
+		WithChanges(audit.Diff(before, webhookFields(w))))
+
+	uc.logger.Info("Webhook updated", "webhookId", w.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	dto := mapWebhookToDTO(w)
+	return &dto, nil
+}
+
+// ============================================================================
+// DELETE
+// ============================================================================
+
+type DeleteWebhookInput struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	WebhookID uuid.UUID
+}
+
+type DeleteWebhookUseCase struct {
+	webhookRepo team.WebhookRepository
+	authorizer  *team.Authorizer
+	recorder    *auditlog.Recorder
+	logger      common.Logger
+}
+
+func NewDeleteWebhookUseCase(webhookRepo team.WebhookRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository, recorder *auditlog.Recorder, logger common.Logger) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{
+		webhookRepo: webhookRepo,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+		recorder:    recorder,
+		logger:      logger,
+	}
+}
+
+func (uc *DeleteWebhookUseCase) Execute(ctx context.Context, input DeleteWebhookInput) error {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return err
+	}
+
+	w, err := findTeamWebhook(ctx, uc.webhookRepo, input.TeamID, input.WebhookID)
+	if err != nil {
+		return err
+	}
+	if err := uc.webhookRepo.Delete(ctx, w.ID()); err != nil {
+		return err
+	}
+
+	uc.recorder.Record(ctx, audit.NewEntry(ctx, input.TeamID, input.UserID, audit.ActionWebhookDeleted, audit.TargetWebhook, w.ID().String()).
+		WithChanges(audit.Diff(webhookFields(w), nil)))
+
+	uc.logger.Info("Webhook deleted", "webhookId", w.ID(), "teamId", input.TeamID, "userId", input.UserID)
+	return nil
+}
+
+// ============================================================================
+// DELIVERIES
+// ============================================================================
+
+const (
+	defaultDeliveryPageSize = 50
+	maxDeliveryPageSize     = 200
+)
+
+type ListWebhookDeliveriesInput struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	WebhookID uuid.UUID
+	Limit     int
+}
+
+type ListWebhookDeliveriesUseCase struct {
+	webhookRepo  team.WebhookRepository
+	deliveryRepo team.WebhookDeliveryRepository
+	authorizer   *team.Authorizer
+}
+
+func NewListWebhookDeliveriesUseCase(webhookRepo team.WebhookRepository, deliveryRepo team.WebhookDeliveryRepository, memberRepo team.MemberRepository, roleRepo team.RoleRepository) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		authorizer:   team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+// Execute returns the endpoint's latest deliveries, newest first
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, input ListWebhookDeliveriesInput) ([]WebhookDeliveryDTO, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+	if _, err := findTeamWebhook(ctx, uc.webhookRepo, input.TeamID, input.WebhookID); err != nil {
+		return nil, err
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultDeliveryPageSize
+	}
+	if limit > maxDeliveryPageSize {
+		limit = maxDeliveryPageSize
+	}
+
+	deliveries, err := uc.deliveryRepo.ListByWebhook(ctx, input.WebhookID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]WebhookDeliveryDTO, 0, len(deliveries))
+	for _, d := range deliveries {
+		dtos = append(dtos, mapWebhookDeliveryToDTO(d))
+	}
+	return dtos, nil
+}
+
+// ============================================================================
+// SEND TEST EVENT
+// ============================================================================
+
+type SendTestWebhookInput struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	WebhookID uuid.UUID
+}
+
+// SendTestWebhookUseCase delivers a webhook.test event right away and
+// returns the delivery, so the endpoint can be checked before relying on
+// it. Disabled endpoints can be tested too.
+type SendTestWebhookUseCase struct {
+	webhookRepo team.WebhookRepository
+	teamRepo    team.Repository
+	dispatcher  *WebhookDispatcher
+	authorizer  *team.Authorizer
+}
+
+func NewSendTestWebhookUseCase(
+	webhookRepo team.WebhookRepository,
+	teamRepo team.Repository,
+	dispatcher *WebhookDispatcher,
+	memberRepo team.MemberRepository,
+	roleRepo team.RoleRepository,
+) *SendTestWebhookUseCase {
+	return &SendTestWebhookUseCase{
+		webhookRepo: webhookRepo,
+		teamRepo:    teamRepo,
+		dispatcher:  dispatcher,
+		authorizer:  team.NewAuthorizer(memberRepo, roleRepo),
+	}
+}
+
+func (uc *SendTestWebhookUseCase) Execute(ctx context.Context, input SendTestWebhookInput) (*WebhookDeliveryDTO, error) {
+	if _, err := requirePermission(ctx, uc.authorizer, input.TeamID, input.UserID, team.PermTeamManage); err != nil {
+		return nil, err
+	}
+	if err := requireWebhooks(ctx, uc.teamRepo, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	w, err := findTeamWebhook(ctx, uc.webhookRepo, input.TeamID, input.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.dispatcher.SendTest(ctx, w, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	dto := mapWebhookDeliveryToDTO(delivery)
+	return &dto, nil
+}
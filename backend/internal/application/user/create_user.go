@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/techappsUT/social-queue/internal/application/auth"
 	"github.com/techappsUT/social-queue/internal/application/common"
 	"github.com/techappsUT/social-queue/internal/domain/user"
 )
@@ -15,7 +16,7 @@ import (
 type CreateUserUseCase struct {
 	userRepo     user.Repository
 	userService  *user.Service
-	tokenService common.TokenService
+	sessions     *auth.SessionManager
 	emailService common.EmailService
 	logger       common.Logger
 }
@@ -26,6 +27,8 @@ type CreateUserInput struct {
 	Password  string `json:"password" validate:"required,min=8"`
 	FirstName string `json:"firstName" validate:"required"`
 	LastName  string `json:"lastName" validate:"required"`
+
+	Client auth.ClientInfo `json:"-"`
 }
 
 type CreateUserOutput struct {
@@ -48,14 +51,14 @@ type UserDTO struct {
 func NewCreateUserUseCase(
 	userRepo user.Repository,
 	userService *user.Service,
-	tokenService common.TokenService,
+	sessions *auth.SessionManager,
 	emailService common.EmailService,
 	logger common.Logger,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
 		userRepo:     userRepo,
 		userService:  userService,
-		tokenService: tokenService,
+		sessions:     sessions,
 		emailService: emailService,
 		logger:       logger,
 	}
@@ -99,21 +102,11 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInput)
 		"userId", newUser.ID(),
 		"email", newUser.Email())
 
-	// 4. Generate JWT tokens
-	accessToken, err := uc.tokenService.GenerateAccessToken(
-		newUser.ID().String(),
-		newUser.Email(),
-		string(newUser.Role()),
-	)
-	if err != nil {
-		uc.logger.Error("Failed to generate access token", "error", err)
-		return nil, fmt.Errorf("failed to generate access token")
-	}
-
-	refreshToken, err := uc.tokenService.GenerateRefreshToken(newUser.ID().String())
+	// 4. Start the first session
+	tokens, err := uc.sessions.Start(ctx, newUser, input.Client)
 	if err != nil {
-		uc.logger.Error("Failed to generate refresh token", "error", err)
-		return nil, fmt.Errorf("failed to generate refresh token")
+		uc.logger.Error("Failed to start session", "error", err)
+		return nil, err
 	}
 
 	// 5. Send verification email asynchronously
@@ -142,8 +135,8 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, input CreateUserInput)
 
 	return &CreateUserOutput{
 		User:         uc.mapToDTO(newUser),
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
@@ -3,6 +3,7 @@
 package auth
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -10,9 +11,9 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/techappsUT/social-queue/internal/application/common"
 	"github.com/techappsUT/social-queue/internal/dto"
 	"github.com/techappsUT/social-queue/internal/models"
-	"github.com/techappsUT/social-queue/pkg/email"
 )
 
 var (
@@ -27,10 +28,10 @@ var (
 type Service struct {
 	db           *gorm.DB
 	tokenService *TokenService
-	emailService email.Service
+	emailService common.EmailService
 }
 
-func NewService(db *gorm.DB, tokenService *TokenService, emailService email.Service) *Service {
+func NewService(db *gorm.DB, tokenService *TokenService, emailService common.EmailService) *Service {
 	return &Service{
 		db:           db,
 		tokenService: tokenService,
@@ -75,7 +76,7 @@ func (s *Service) Signup(req dto.SignupRequest) (*dto.MessageResponse, error) {
 	}
 
 	// Send verification email (async)
-	go s.emailService.SendVerificationEmail(user.Email, verificationToken)
+	go s.emailService.SendVerificationEmail(context.Background(), user.Email, verificationToken)
 
 	return &dto.MessageResponse{
 		Message: "Account created successfully. Please check your email to verify your account.",
@@ -281,7 +282,7 @@ func (s *Service) ResendVerification(email string) (*dto.MessageResponse, error)
 	}
 
 	// Send verification email (async)
-	go s.emailService.SendVerificationEmail(user.Email, verificationToken)
+	go s.emailService.SendVerificationEmail(context.Background(), user.Email, verificationToken)
 
 	return &dto.MessageResponse{
 		Message: "If an account with that email exists and is unverified, a verification email has been sent.",
@@ -314,7 +315,7 @@ func (s *Service) ForgotPassword(email string) (*dto.MessageResponse, error) {
 	}
 
 	// Send reset email (async)
-	go s.emailService.SendPasswordResetEmail(user.Email, resetToken)
+	go s.emailService.SendPasswordResetEmail(context.Background(), user.Email, resetToken)
 
 	return &dto.MessageResponse{
 		Message: "If an account with that email exists, a password reset link has been sent.",
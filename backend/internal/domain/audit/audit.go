@@ -0,0 +1,234 @@
+// path: backend/internal/domain/audit/audit.go
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action names what happened, as "<area>.<event>"
+type Action string
+
+const (
+	ActionLogin       Action = "auth.login"
+	ActionLoginFailed Action = "auth.login_failed"
+
+	ActionMemberRoleChanged Action = "team.member_role_changed"
+	ActionRoleCreated       Action = "team.role_created"
+	ActionRoleUpdated       Action = "team.role_updated"
+	ActionRoleDeleted       Action = "team.role_deleted"
+
+	ActionAccountConnected    Action = "social.account_connected"
+	ActionAccountDisconnected Action = "social.account_disconnected"
+	ActionAccountRevoked      Action = "social.account_revoked"
+
+	ActionPostPublished Action = "post.published"
+	ActionPostDeleted   Action = "post.deleted"
+
+	ActionPlanChanged Action = "billing.plan_changed"
+
+	ActionAPIKeyCreated Action = "api_key.created"
+	ActionAPIKeyRevoked Action = "api_key.revoked"
+	ActionAPIKeyUsed    Action = "api_key.used"
+
+	ActionWebhookCreated  Action = "webhook.created"
+	ActionWebhookUpdated  Action = "webhook.updated"
+	ActionWebhookDeleted  Action = "webhook.deleted"
+	ActionWebhookDisabled Action = "webhook.disabled"
+
+	ActionConversationAssigned Action = "inbox.conversation_assigned"
+	ActionConversationReplied  Action = "inbox.conversation_replied"
+	ActionSavedReplyCreated    Action = "inbox.saved_reply_created"
+	ActionSavedReplyUpdated    Action = "inbox.saved_reply_updated"
+	ActionSavedReplyDeleted    Action = "inbox.saved_reply_deleted"
+	ActionInboxRuleCreated     Action = "inbox.rule_created"
+	ActionInboxRuleUpdated     Action = "inbox.rule_updated"
+	ActionInboxRuleDeleted     Action = "inbox.rule_deleted"
+)
+
+// Target types
+const (
+	TargetUser          = "user"
+	TargetMember        = "member"
+	TargetRole          = "role"
+	TargetSocialAccount = "social_account"
+	TargetPost          = "post"
+	TargetTeam          = "team"
+	TargetAPIKey        = "api_key"
+	TargetWebhook       = "webhook"
+	TargetConversation  = "inbox_conversation"
+	TargetSavedReply    = "inbox_saved_reply"
+	TargetInboxRule     = "inbox_rule"
+)
+
+// Change is the value of one field before and after an action
+type Change struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Changes maps field names to what changed
+type Changes map[string]Change
+
+// Diff returns the fields whose values differ between before and after.
+// Fields missing on one side are reported with a nil value.
+func Diff(before, after map[string]interface{}) Changes {
+	changes := make(Changes)
+	for field, from := range before {
+		if to, ok := after[field]; !ok || !reflect.DeepEqual(from, to) {
+			changes[field] = Change{From: from, To: after[field]}
+		}
+	}
+	for field, to := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = Change{From: nil, To: to}
+		}
+	}
+	return changes
+}
+
+// Fields returns the changed field names in order
+func (c Changes) Fields() []string {
+	fields := make([]string, 0, len(c))
+	for field := range c {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Entry is one record in the audit log. Entries are never changed or
+// deleted once written.
+type Entry struct {
+	id         uuid.UUID
+	teamID     uuid.UUID // uuid.Nil for account-level actions such as logins
+	actorID    uuid.UUID // uuid.Nil for actions taken by the system
+	action     Action
+	targetType string
+	targetID   string
+	ipAddress  string
+	userAgent  string
+	changes    Changes
+	metadata   map[string]string
+	createdAt  time.Time
+}
+
+// NewEntry creates an entry. The request's IP address and user agent are
+// taken from the context when present.
+func NewEntry(ctx context.Context, teamID, actorID uuid.UUID, action Action, targetType, targetID string) *Entry {
+	e := &Entry{
+		id:         uuid.New(),
+		teamID:     teamID,
+		actorID:    actorID,
+		action:     action,
+		targetType: targetType,
+		targetID:   targetID,
+		createdAt:  time.Now().UTC(),
+	}
+	if req, ok := RequestFromContext(ctx); ok {
+		e.ipAddress = req.IPAddress
+		e.userAgent = req.UserAgent
+	}
+	return e
+}
+
+// ReconstructEntry rebuilds an entry from persistence
+func ReconstructEntry(
+	id, teamID, actorID uuid.UUID,
+	action Action,
+	targetType, targetID, ipAddress, userAgent string,
+	changes Changes,
+	metadata map[string]string,
+	createdAt time.Time,
+) *Entry {
+	return &Entry{
+		id:         id,
+		teamID:     teamID,
+		actorID:    actorID,
+		action:     action,
+		targetType: targetType,
+		targetID:   targetID,
+		ipAddress:  ipAddress,
+		userAgent:  userAgent,
+		changes:    changes,
+		metadata:   metadata,
+		createdAt:  createdAt,
+	}
+}
+
+// WithChanges records the before/after diff
+func (e *Entry) WithChanges(changes Changes) *Entry {
+	e.changes = changes
+	return e
+}
+
+// WithMetadata records extra details
+func (e *Entry) WithMetadata(key, value string) *Entry {
+	if e.metadata == nil {
+		e.metadata = make(map[string]string)
+	}
+	e.metadata[key] = value
+	return e
+}
+
+// WithClient overrides the IP address and user agent taken from the context
+func (e *Entry) WithClient(ipAddress, userAgent string) *Entry {
+	e.ipAddress = ipAddress
+	e.userAgent = userAgent
+	return e
+}
+
+func (e *Entry) ID() uuid.UUID               { return e.id }
+func (e *Entry) TeamID() uuid.UUID           { return e.teamID }
+func (e *Entry) ActorID() uuid.UUID          { return e.actorID }
+func (e *Entry) Action() Action              { return e.action }
+func (e *Entry) TargetType() string          { return e.targetType }
+func (e *Entry) TargetID() string            { return e.targetID }
+func (e *Entry) IPAddress() string           { return e.ipAddress }
+func (e *Entry) UserAgent() string           { return e.userAgent }
+func (e *Entry) Changes() Changes            { return e.changes }
+func (e *Entry) Metadata() map[string]string { return e.metadata }
+func (e *Entry) CreatedAt() time.Time        { return e.createdAt }
+
+// ChangesJSON returns the diff as JSON, or "" when nothing changed
+func (e *Entry) ChangesJSON() string {
+	if len(e.changes) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(e.changes)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// ============================================================================
+// REQUEST CONTEXT
+// ============================================================================
+
+// RequestInfo identifies the client an action came from
+type RequestInfo struct {
+	IPAddress string
+	UserAgent string
+	Method    string
+	Path      string
+}
+
+type requestKey struct{}
+
+// WithRequest stores the client of the current request in the context
+func WithRequest(ctx context.Context, req RequestInfo) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFromContext returns the client stored by WithRequest
+func RequestFromContext(ctx context.Context) (RequestInfo, bool) {
+	req, ok := ctx.Value(requestKey{}).(RequestInfo)
+	return req, ok
+}
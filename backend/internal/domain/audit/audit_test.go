@@ -0,0 +1,40 @@
+// path: backend/internal/domain/audit/audit_test.go
+package audit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	before := map[string]interface{}{
+		"plan":   "free",
+		"name":   "Acme",
+		"slug":   "acme",
+		"scopes": []string{"posts.view"},
+	}
+	after := map[string]interface{}{
+		"plan":   "enterprise",
+		"name":   "Acme",
+		"avatar": "https://example.com/a.png",
+		"scopes": []string{"posts.view"},
+	}
+
+	changes := Diff(before, after)
+
+	want := Changes{
+		"plan":   {From: "free", To: "enterprise"},
+		"slug":   {From: "acme", To: nil},
+		"avatar": {From: nil, To: "https://example.com/a.png"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Diff = %+v, want %+v", changes, want)
+	}
+	if got := changes.Fields(); !reflect.DeepEqual(got, []string{"avatar", "plan", "slug"}) {
+		t.Errorf("Fields = %v, want them sorted", got)
+	}
+
+	if changes := Diff(before, before); len(changes) != 0 {
+		t.Errorf("Diff of equal maps = %+v, want none", changes)
+	}
+}
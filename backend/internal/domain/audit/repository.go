@@ -0,0 +1,32 @@
+// path: backend/internal/domain/audit/repository.go
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Filter selects entries from a team's audit log, newest first
+type Filter struct {
+	TeamID     uuid.UUID
+	ActorID    *uuid.UUID
+	Action     Action // an exact action, or "<area>.*"
+	TargetType string
+	TargetID   string
+	Since      *time.Time
+	Until      *time.Time
+	Before     *time.Time // keyset cursor: entries created before this time
+	Limit      int
+}
+
+// Repository stores the audit log. It is append-only: there is no way to
+// change or remove an entry.
+type Repository interface {
+	Append(ctx context.Context, entry *Entry) error
+	// List returns the team's entries together with account-level entries
+	// (team ID nil), such as logins, of its current members
+	List(ctx context.Context, filter Filter) ([]*Entry, error)
+}
@@ -0,0 +1,107 @@
+// path: backend/internal/domain/event/event.go
+
+// Package event defines the contract shared by domain events. Events are
+// written to a transactional outbox together with the change that raised
+// them and delivered at least once, so handlers must be idempotent.
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is something that happened in the domain, named "<area>.<event>"
+type Event interface {
+	Type() string
+	OccurredAt() time.Time
+	AggregateID() string
+}
+
+// Publisher records events. Publishing inside a transaction carried by the
+// context commits the event together with the transaction.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Meta carries the fields every domain event has. Events embed it.
+type Meta struct {
+	Team uuid.UUID `json:"teamId"`
+	At   time.Time `json:"occurredAt"`
+}
+
+// NewMeta stamps an event of the given team with the current time
+func NewMeta(teamID uuid.UUID) Meta {
+	return Meta{Team: teamID, At: time.Now().UTC()}
+}
+
+func (m Meta) OccurredAt() time.Time { return m.At }
+func (m Meta) TeamID() uuid.UUID     { return m.Team }
+
+// TeamScoped is implemented by events that belong to a team
+type TeamScoped interface {
+	TeamID() uuid.UUID
+}
+
+// TeamOf returns the team of an event, or uuid.Nil
+func TeamOf(e Event) uuid.UUID {
+	if scoped, ok := e.(TeamScoped); ok {
+		return scoped.TeamID()
+	}
+	return uuid.Nil
+}
+
+// Envelope is an event as stored in the outbox and carried on the stream.
+// Handlers receive envelopes and decode the payload with Decode.
+type Envelope struct {
+	ID        uuid.UUID       `json:"id"`
+	EventType string          `json:"type"`
+	Aggregate string          `json:"aggregateId"`
+	Team      uuid.UUID       `json:"teamId"`
+	At        time.Time       `json:"occurredAt"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Seal wraps an event in an envelope with a new ID
+func Seal(e Event) (*Envelope, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", e.Type(), err)
+	}
+	return &Envelope{
+		ID:        uuid.New(),
+		EventType: e.Type(),
+		Aggregate: e.AggregateID(),
+		Team:      TeamOf(e),
+		At:        e.OccurredAt(),
+		Payload:   payload,
+	}, nil
+}
+
+func (e *Envelope) Type() string          { return e.EventType }
+func (e *Envelope) OccurredAt() time.Time { return e.At }
+func (e *Envelope) AggregateID() string   { return e.Aggregate }
+func (e *Envelope) TeamID() uuid.UUID     { return e.Team }
+
+// Decode reads the payload of an event into v, which is usually a pointer
+// to the concrete event type
+func Decode(e Event, v interface{}) error {
+	payload, err := payloadOf(e)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", e.Type(), err)
+	}
+	return nil
+}
+
+func payloadOf(e Event) ([]byte, error) {
+	if env, ok := e.(*Envelope); ok {
+		return env.Payload, nil
+	}
+	return json.Marshal(e)
+}
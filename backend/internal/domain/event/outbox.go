@@ -0,0 +1,30 @@
+// path: backend/internal/domain/event/outbox.go
+
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Pending is an outbox entry awaiting delivery
+type Pending struct {
+	*Envelope
+	Attempts int // deliveries tried so far, including the current one
+}
+
+// Outbox stores events until they are delivered
+type Outbox interface {
+	// Append stores an event, inside the transaction carried by ctx if any
+	Append(ctx context.Context, envelope *Envelope) error
+	// Claim leases up to limit due entries. A claimed entry is not handed
+	// out again until the lease expires, so a crashed relay's entries are
+	// retried.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Pending, error)
+	MarkDispatched(ctx context.Context, id uuid.UUID) error
+	// MarkFailed records a failed delivery. The entry is retried at retryAt,
+	// or given up on when retryAt is nil.
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error
+}
@@ -0,0 +1,350 @@
+// path: backend/internal/domain/inbox/conversation.go
+
+// Package inbox holds the conversations of the social inbox: the comments,
+// mentions and direct messages received by a team's social accounts.
+package inbox
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// Kind is what a conversation is about
+type Kind string
+
+const (
+	KindComment Kind = "comment" // one person's comments on one post
+	KindMention Kind = "mention" // one mention of the account
+	KindMessage Kind = "message" // direct messages with one person
+)
+
+// KindOf returns the conversation kind of a platform interaction
+func KindOf(k social.PlatformEventKind) (Kind, bool) {
+	switch k {
+	case social.PlatformEventComment:
+		return KindComment, true
+	case social.PlatformEventMention:
+		return KindMention, true
+	case social.PlatformEventMessage:
+		return KindMessage, true
+	default:
+		return "", false
+	}
+}
+
+// State is where a conversation is in the team's workflow
+type State string
+
+const (
+	StateUnread   State = "unread"
+	StateRead     State = "read"
+	StateArchived State = "archived"
+)
+
+// IsValid reports whether the state is known
+func (s State) IsValid() bool {
+	return s == StateUnread || s == StateRead || s == StateArchived
+}
+
+// Direction tells received messages from replies sent from the inbox
+type Direction string
+
+const (
+	DirectionInbound  Direction = "inbound"
+	DirectionOutbound Direction = "outbound"
+)
+
+const (
+	// MaxReplyLength caps replies; platforms enforce their own lower limits
+	MaxReplyLength = 8000
+
+	previewLength = 200
+)
+
+// ThreadKey groups interactions into conversations. Comments are grouped
+// by post and author, messages by the other participant, and every mention
+// is a conversation of its own.
+func ThreadKey(kind Kind, objectID, itemID, authorID string) string {
+	switch kind {
+	case KindComment:
+		if objectID == "" {
+			return itemID
+		}
+		return objectID + ":" + authorID
+	case KindMessage:
+		return authorID
+	default:
+		return itemID
+	}
+}
+
+// Conversation is one thread of a social account in the inbox
+type Conversation struct {
+	id              uuid.UUID
+	teamID          uuid.UUID
+	accountID       uuid.UUID
+	platform        social.Platform
+	kind            Kind
+	threadKey       string
+	objectID        string
+	participantID   string
+	participantName string
+	state           State
+	assignedTo      uuid.UUID
+	tags            []string
+	lastMessageAt   time.Time
+	preview         string
+	messageCount    int
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// NewConversation starts an empty conversation of a team's social account
+func NewConversation(teamID, accountID uuid.UUID, platform social.Platform, kind Kind, threadKey, objectID, participantID, participantName string) *Conversation {
+	now := time.Now().UTC()
+	return &Conversation{
+		id:              uuid.New(),
+		teamID:          teamID,
+		accountID:       accountID,
+		platform:        platform,
+		kind:            kind,
+		threadKey:       threadKey,
+		objectID:        objectID,
+		participantID:   participantID,
+		participantName: participantName,
+		state:           StateUnread,
+		tags:            []string{},
+		lastMessageAt:   now,
+		createdAt:       now,
+		updatedAt:       now,
+	}
+}
+
+// ReconstructConversation recreates a conversation from persistence
+func ReconstructConversation(
+	id, teamID, accountID uuid.UUID,
+	platform social.Platform,
+	kind Kind,
+	threadKey, objectID, participantID, participantName string,
+	state State,
+	assignedTo uuid.UUID,
+	tags []string,
+	lastMessageAt time.Time,
+	preview string,
+	messageCount int,
+	createdAt, updatedAt time.Time,
+) *Conversation {
+	return &Conversation{
+		id:              id,
+		teamID:          teamID,
+		accountID:       accountID,
+		platform:        platform,
+		kind:            kind,
+		threadKey:       threadKey,
+		objectID:        objectID,
+		participantID:   participantID,
+		participantName: participantName,
+		state:           state,
+		assignedTo:      assignedTo,
+		tags:            tags,
+		lastMessageAt:   lastMessageAt,
+		preview:         preview,
+		messageCount:    messageCount,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// Getters
+func (c *Conversation) ID() uuid.UUID             { return c.id }
+func (c *Conversation) TeamID() uuid.UUID         { return c.teamID }
+func (c *Conversation) AccountID() uuid.UUID      { return c.accountID }
+func (c *Conversation) Platform() social.Platform { return c.platform }
+func (c *Conversation) Kind() Kind                { return c.kind }
+func (c *Conversation) ThreadKey() string         { return c.threadKey }
+func (c *Conversation) ObjectID() string          { return c.objectID }
+func (c *Conversation) ParticipantID() string     { return c.participantID }
+func (c *Conversation) ParticipantName() string   { return c.participantName }
+func (c *Conversation) State() State              { return c.state }
+func (c *Conversation) AssignedTo() uuid.UUID     { return c.assignedTo }
+func (c *Conversation) Tags() []string            { return c.tags }
+func (c *Conversation) LastMessageAt() time.Time  { return c.lastMessageAt }
+func (c *Conversation) Preview() string           { return c.preview }
+func (c *Conversation) MessageCount() int         { return c.messageCount }
+func (c *Conversation) CreatedAt() time.Time      { return c.createdAt }
+func (c *Conversation) UpdatedAt() time.Time      { return c.updatedAt }
+
+// Receive adds a new inbound message. The conversation becomes unread,
+// archived ones included, unless the message is older than what it has.
+func (c *Conversation) Receive(m *Message) {
+	c.messageCount++
+	if m.authorName != "" {
+		c.participantName = m.authorName
+	}
+	if c.messageCount == 1 || !m.sentAt.Before(c.lastMessageAt) {
+		c.lastMessageAt = m.sentAt
+		c.preview = preview(m.text)
+		c.state = StateUnread
+	}
+	c.updatedAt = time.Now().UTC()
+}
+
+// RecordReply adds a reply sent from the inbox, which marks the
+// conversation read
+func (c *Conversation) RecordReply(m *Message) {
+	c.messageCount++
+	c.lastMessageAt = m.sentAt
+	c.preview = preview(m.text)
+	if c.state == StateUnread {
+		c.state = StateRead
+	}
+	c.updatedAt = time.Now().UTC()
+}
+
+// SetState marks the conversation read, unread or archived
+func (c *Conversation) SetState(state State) error {
+	if !state.IsValid() {
+		return ErrInvalidState
+	}
+	c.state = state
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Assign hands the conversation to a team member, or to nobody with
+// uuid.Nil
+func (c *Conversation) Assign(userID uuid.UUID) {
+	c.assignedTo = userID
+	c.updatedAt = time.Now().UTC()
+}
+
+// AddTag tags the conversation and reports whether the tag is new. Tags
+// beyond MaxTags are dropped.
+func (c *Conversation) AddTag(tag string) bool {
+	tag, err := NormalizeTag(tag)
+	if err != nil || containsString(c.tags, tag) || len(c.tags) >= MaxTags {
+		return false
+	}
+	c.tags = append(c.tags, tag)
+	c.updatedAt = time.Now().UTC()
+	return true
+}
+
+// LatestInbound returns the last received message of a conversation's
+// messages in order, which is what a reply answers by default
+func LatestInbound(messages []*Message) *Message {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].direction == DirectionInbound {
+			return messages[i]
+		}
+	}
+	return nil
+}
+
+func preview(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= previewLength {
+		return text
+	}
+	return string([]rune(text)[:previewLength-1]) + "…"
+}
+
+// ============================================================================
+// MESSAGE
+// ============================================================================
+
+// Message is one comment, mention or direct message in a conversation, or
+// a reply sent from the inbox
+type Message struct {
+	id                uuid.UUID
+	conversationID    uuid.UUID
+	platformMessageID string
+	direction         Direction
+	authorID          string
+	authorName        string
+	text              string
+	sentBy            uuid.UUID
+	sentAt            time.Time
+	createdAt         time.Time
+}
+
+// NewInboundMessage records a message received from the platform
+func NewInboundMessage(conversationID uuid.UUID, platformMessageID, authorID, authorName, text string, sentAt time.Time) *Message {
+	now := time.Now().UTC()
+	if sentAt.IsZero() {
+		sentAt = now
+	}
+	return &Message{
+		id:                uuid.New(),
+		conversationID:    conversationID,
+		platformMessageID: platformMessageID,
+		direction:         DirectionInbound,
+		authorID:          authorID,
+		authorName:        authorName,
+		text:              text,
+		sentAt:            sentAt.UTC(),
+		createdAt:         now,
+	}
+}
+
+// NewReply records a reply a team member sent through the platform
+func NewReply(conversationID, sentBy uuid.UUID, platformMessageID, text string, sentAt time.Time) *Message {
+	now := time.Now().UTC()
+	if sentAt.IsZero() {
+		sentAt = now
+	}
+	return &Message{
+		id:                uuid.New(),
+		conversationID:    conversationID,
+		platformMessageID: platformMessageID,
+		direction:         DirectionOutbound,
+		text:              text,
+		sentBy:            sentBy,
+		sentAt:            sentAt.UTC(),
+		createdAt:         now,
+	}
+}
+
+// ValidateReply checks the text of a reply
+func ValidateReply(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", ErrEmptyReply
+	}
+	if utf8.RuneCountInString(text) > MaxReplyLength {
+		return "", ErrReplyTooLong
+	}
+	return text, nil
+}
+
+// ReconstructMessage recreates a message from persistence
+func ReconstructMessage(id, conversationID uuid.UUID, platformMessageID string, direction Direction, authorID, authorName, text string, sentBy uuid.UUID, sentAt, createdAt time.Time) *Message {
+	return &Message{
+		id:                id,
+		conversationID:    conversationID,
+		platformMessageID: platformMessageID,
+		direction:         direction,
+		authorID:          authorID,
+		authorName:        authorName,
+		text:              text,
+		sentBy:            sentBy,
+		sentAt:            sentAt,
+		createdAt:         createdAt,
+	}
+}
+
+// Getters
+func (m *Message) ID() uuid.UUID             { return m.id }
+func (m *Message) ConversationID() uuid.UUID { return m.conversationID }
+func (m *Message) PlatformMessageID() string { return m.platformMessageID }
+func (m *Message) Direction() Direction      { return m.direction }
+func (m *Message) AuthorID() string          { return m.authorID }
+func (m *Message) AuthorName() string        { return m.authorName }
+func (m *Message) Text() string              { return m.text }
+func (m *Message) SentBy() uuid.UUID         { return m.sentBy }
+func (m *Message) SentAt() time.Time         { return m.sentAt }
+func (m *Message) CreatedAt() time.Time      { return m.createdAt }
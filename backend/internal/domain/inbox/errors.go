@@ -0,0 +1,31 @@
+// path: backend/internal/domain/inbox/errors.go
+
+package inbox
+
+import "errors"
+
+var (
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrMessageNotFound      = errors.New("message not found")
+	ErrInvalidState         = errors.New("invalid conversation state")
+	ErrEmptyReply           = errors.New("reply text is required")
+	ErrReplyTooLong         = errors.New("reply text is too long")
+	ErrReplyNotSupported    = errors.New("replying is not supported for this platform")
+	ErrReplyRateLimited     = errors.New("platform rate limit reached for this account")
+	ErrReplyFailed          = errors.New("platform rejected the reply")
+	ErrInvalidAssignee      = errors.New("assignee must be a team member who can view the inbox")
+	ErrInvalidTag           = errors.New("tags must be 1 to 50 characters")
+
+	ErrSavedReplyNotFound      = errors.New("saved reply not found")
+	ErrInvalidSavedReplyName   = errors.New("saved reply name must be 1 to 100 characters")
+	ErrUnknownTemplateVariable = errors.New("unknown template variable")
+	ErrSavedReplyInUse         = errors.New("saved reply is used by an inbox rule")
+
+	ErrRuleNotFound         = errors.New("inbox rule not found")
+	ErrInvalidRuleName      = errors.New("rule name must be 1 to 100 characters")
+	ErrInvalidKeyword       = errors.New("rules need 1 to 50 keywords of at most 100 characters")
+	ErrInvalidRuleKind      = errors.New("invalid conversation kind")
+	ErrRuleActionsMissing   = errors.New("rules need at least one action")
+	ErrInvalidRuleAction    = errors.New("invalid rule action")
+	ErrInvalidRuleRateLimit = errors.New("rule rate limit must be between 1 and 1000 per hour")
+)
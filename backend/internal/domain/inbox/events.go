@@ -0,0 +1,66 @@
+// path: backend/internal/domain/inbox/events.go
+
+package inbox
+
+import (
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+const (
+	EventMessageReceived      = "inbox.message_received"
+	EventConversationAssigned = "inbox.conversation_assigned"
+)
+
+// MessageReceived is raised when a new message lands in a conversation
+type MessageReceived struct {
+	event.Meta
+	ConversationID uuid.UUID `json:"conversationId"`
+	MessageID      uuid.UUID `json:"messageId"`
+	AccountID      uuid.UUID `json:"accountId"`
+	Kind           Kind      `json:"kind"`
+	AuthorID       string    `json:"authorId,omitempty"`
+	AuthorName     string    `json:"authorName,omitempty"`
+	Text           string    `json:"text"`
+	AssignedTo     uuid.UUID `json:"assignedTo"`
+}
+
+func NewMessageReceived(c *Conversation, m *Message) MessageReceived {
+	return MessageReceived{
+		Meta:           event.NewMeta(c.TeamID()),
+		ConversationID: c.ID(),
+		MessageID:      m.ID(),
+		AccountID:      c.AccountID(),
+		Kind:           c.Kind(),
+		AuthorID:       m.AuthorID(),
+		AuthorName:     m.AuthorName(),
+		Text:           m.Text(),
+		AssignedTo:     c.AssignedTo(),
+	}
+}
+
+func (e MessageReceived) Type() string        { return EventMessageReceived }
+func (e MessageReceived) AggregateID() string { return e.ConversationID.String() }
+
+// ConversationAssigned is raised when a conversation is handed to a member.
+// AssignedBy is uuid.Nil when an inbox rule assigned it.
+type ConversationAssigned struct {
+	event.Meta
+	ConversationID uuid.UUID `json:"conversationId"`
+	AccountID      uuid.UUID `json:"accountId"`
+	AssignedTo     uuid.UUID `json:"assignedTo"`
+	AssignedBy     uuid.UUID `json:"assignedBy"`
+}
+
+func NewConversationAssigned(c *Conversation, by uuid.UUID) ConversationAssigned {
+	return ConversationAssigned{
+		Meta:           event.NewMeta(c.TeamID()),
+		ConversationID: c.ID(),
+		AccountID:      c.AccountID(),
+		AssignedTo:     c.AssignedTo(),
+		AssignedBy:     by,
+	}
+}
+
+func (e ConversationAssigned) Type() string        { return EventConversationAssigned }
+func (e ConversationAssigned) AggregateID() string { return e.ConversationID.String() }
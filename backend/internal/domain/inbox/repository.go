@@ -0,0 +1,81 @@
+// path: backend/internal/domain/inbox/repository.go
+
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Filter selects a team's conversations, most recent activity first
+type Filter struct {
+	TeamID     uuid.UUID
+	AccountIDs []uuid.UUID // nil for every account
+	State      State       // empty for every state but archived
+	Kind       Kind
+	AssignedTo *uuid.UUID // uuid.Nil for unassigned conversations
+	Tag        string
+	Before     *time.Time // keyset cursor on the last message time
+	Limit      int
+}
+
+// Repository stores inbox conversations and their messages
+type Repository interface {
+	// EnsureConversation stores the conversation unless its thread already
+	// has one, and returns the stored conversation. Inside a transaction
+	// the conversation stays locked until it ends.
+	EnsureConversation(ctx context.Context, c *Conversation) (*Conversation, error)
+	FindConversation(ctx context.Context, id uuid.UUID) (*Conversation, error)
+	UpdateConversation(ctx context.Context, c *Conversation) error
+	ListConversations(ctx context.Context, filter Filter) ([]*Conversation, error)
+
+	// AddMessage stores a message and reports false if the conversation
+	// already has the platform message
+	AddMessage(ctx context.Context, m *Message) (bool, error)
+	// ListMessages returns the latest messages of a conversation, oldest
+	// first
+	ListMessages(ctx context.Context, conversationID uuid.UUID, limit int) ([]*Message, error)
+	FindMessage(ctx context.Context, conversationID, id uuid.UUID) (*Message, error)
+}
+
+// RuleQueue hands received messages to the rule processor once
+type RuleQueue interface {
+	// ClaimRuleEvaluations returns messages waiting for the rules and hides
+	// them for the lease. A message's last attempt is claimed for good, so
+	// one failing every attempt is not returned again.
+	ClaimRuleEvaluations(ctx context.Context, limit int, lease time.Duration, maxAttempts int) ([]*Message, error)
+	CompleteRuleEvaluation(ctx context.Context, messageID uuid.UUID) error
+}
+
+// RuleRepository stores a team's auto-response rules and saved replies
+type RuleRepository interface {
+	CreateRule(ctx context.Context, r *Rule) error
+	FindRule(ctx context.Context, id uuid.UUID) (*Rule, error)
+	UpdateRule(ctx context.Context, r *Rule) error
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	// ListRules returns the team's rules, oldest first
+	ListRules(ctx context.Context, teamID uuid.UUID) ([]*Rule, error)
+
+	// CountRuleRuns counts the messages a rule acted on since a time.
+	// Inside a transaction the rule stays locked until it ends.
+	CountRuleRuns(ctx context.Context, ruleID uuid.UUID, since time.Time) (int, error)
+	// AddRuleRun records a rule acted on a message, and reports false if
+	// it already had
+	AddRuleRun(ctx context.Context, ruleID, messageID uuid.UUID) (bool, error)
+
+	CreateSavedReply(ctx context.Context, s *SavedReply) error
+	FindSavedReply(ctx context.Context, id uuid.UUID) (*SavedReply, error)
+	UpdateSavedReply(ctx context.Context, s *SavedReply) error
+	DeleteSavedReply(ctx context.Context, id uuid.UUID) error
+	// ListSavedReplies returns the team's saved replies by name
+	ListSavedReplies(ctx context.Context, teamID uuid.UUID) ([]*SavedReply, error)
+}
+
+// SyncCursorRepository remembers how far fetch-based sync read each account
+type SyncCursorRepository interface {
+	// SyncedUntil returns the zero time for accounts never synced
+	SyncedUntil(ctx context.Context, accountID uuid.UUID) (time.Time, error)
+	SaveSyncedUntil(ctx context.Context, accountID uuid.UUID, at time.Time) error
+}
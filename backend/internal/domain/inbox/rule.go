@@ -0,0 +1,390 @@
+// path: backend/internal/domain/inbox/rule.go
+
+package inbox
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ActionType is what a rule does to a matching message
+type ActionType string
+
+const (
+	ActionTag    ActionType = "tag"    // tag the conversation
+	ActionAssign ActionType = "assign" // assign the conversation to a member
+	ActionHide   ActionType = "hide"   // hide the comment on the platform
+	ActionReply  ActionType = "reply"  // answer with a saved reply
+)
+
+// Action is one step of a rule. Tag, AssigneeID and SavedReplyID are set
+// for the action types that use them.
+type Action struct {
+	Type         ActionType `json:"type"`
+	Tag          string     `json:"tag,omitempty"`
+	AssigneeID   *uuid.UUID `json:"assigneeId,omitempty"`
+	SavedReplyID *uuid.UUID `json:"savedReplyId,omitempty"`
+}
+
+const (
+	// DefaultRuleRateLimit is how many messages a rule acts on per
+	// RuleRateWindow unless set otherwise
+	DefaultRuleRateLimit = 30
+	MaxRuleRateLimit     = 1000
+	RuleRateWindow       = time.Hour
+
+	// RuleMaxMessageAge keeps rules from acting on old messages, such as
+	// those read by the first sync of an account
+	RuleMaxMessageAge = 6 * time.Hour
+
+	// RuleEvaluationLease hides a message being evaluated from other
+	// workers; RuleMaxAttempts is how often it is tried
+	RuleEvaluationLease = 2 * time.Minute
+	RuleMaxAttempts     = 5
+
+	maxRuleNameLength = 100
+	maxRuleKeywords   = 50
+	maxKeywordLength  = 100
+	maxTagLength      = 50
+	MaxTags           = 20
+)
+
+// Rule acts on received messages that contain one of its keywords
+type Rule struct {
+	id         uuid.UUID
+	teamID     uuid.UUID
+	name       string
+	enabled    bool
+	keywords   []string
+	kinds      []Kind
+	accountIDs []uuid.UUID
+	actions    []Action
+	rateLimit  int
+	createdBy  uuid.UUID
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// RuleSpec is what a rule matches and does. Empty Kinds or AccountIDs
+// match every kind or account; a zero RateLimit is DefaultRuleRateLimit.
+type RuleSpec struct {
+	Name       string
+	Keywords   []string
+	Kinds      []Kind
+	AccountIDs []uuid.UUID
+	Actions    []Action
+	RateLimit  int
+}
+
+// NewRule creates an enabled rule of a team
+func NewRule(teamID, createdBy uuid.UUID, spec RuleSpec) (*Rule, error) {
+	r := &Rule{
+		id:        uuid.New(),
+		teamID:    teamID,
+		enabled:   true,
+		createdBy: createdBy,
+	}
+	if err := r.Update(spec); err != nil {
+		return nil, err
+	}
+	r.createdAt = r.updatedAt
+	return r, nil
+}
+
+// ReconstructRule recreates a rule from persistence
+func ReconstructRule(id, teamID uuid.UUID, name string, enabled bool, keywords []string, kinds []Kind, accountIDs []uuid.UUID, actions []Action, rateLimit int, createdBy uuid.UUID, createdAt, updatedAt time.Time) *Rule {
+	return &Rule{
+		id:         id,
+		teamID:     teamID,
+		name:       name,
+		enabled:    enabled,
+		keywords:   keywords,
+		kinds:      kinds,
+		accountIDs: accountIDs,
+		actions:    actions,
+		rateLimit:  rateLimit,
+		createdBy:  createdBy,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Getters
+func (r *Rule) ID() uuid.UUID           { return r.id }
+func (r *Rule) TeamID() uuid.UUID       { return r.teamID }
+func (r *Rule) Name() string            { return r.name }
+func (r *Rule) IsEnabled() bool         { return r.enabled }
+func (r *Rule) Keywords() []string      { return r.keywords }
+func (r *Rule) Kinds() []Kind           { return r.kinds }
+func (r *Rule) AccountIDs() []uuid.UUID { return r.accountIDs }
+func (r *Rule) Actions() []Action       { return r.actions }
+func (r *Rule) RateLimit() int          { return r.rateLimit }
+func (r *Rule) CreatedBy() uuid.UUID    { return r.createdBy }
+func (r *Rule) CreatedAt() time.Time    { return r.createdAt }
+func (r *Rule) UpdatedAt() time.Time    { return r.updatedAt }
+
+// Spec returns what the rule matches and does
+func (r *Rule) Spec() RuleSpec {
+	return RuleSpec{
+		Name:       r.name,
+		Keywords:   r.keywords,
+		Kinds:      r.kinds,
+		AccountIDs: r.accountIDs,
+		Actions:    r.actions,
+		RateLimit:  r.rateLimit,
+	}
+}
+
+// Update replaces what the rule matches and does
+func (r *Rule) Update(spec RuleSpec) error {
+	name := strings.TrimSpace(spec.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxRuleNameLength {
+		return ErrInvalidRuleName
+	}
+
+	keywords, err := cleanKeywords(spec.Keywords)
+	if err != nil {
+		return err
+	}
+
+	kinds := make([]Kind, 0, len(spec.Kinds))
+	for _, k := range spec.Kinds {
+		if k != KindComment && k != KindMention && k != KindMessage {
+			return fmt.Errorf("%w: %s", ErrInvalidRuleKind, k)
+		}
+		if !containsKind(kinds, k) {
+			kinds = append(kinds, k)
+		}
+	}
+
+	actions, err := cleanActions(spec.Actions)
+	if err != nil {
+		return err
+	}
+
+	rateLimit := spec.RateLimit
+	if rateLimit == 0 {
+		rateLimit = DefaultRuleRateLimit
+	}
+	if rateLimit < 1 || rateLimit > MaxRuleRateLimit {
+		return ErrInvalidRuleRateLimit
+	}
+
+	accountIDs := spec.AccountIDs
+	if accountIDs == nil {
+		accountIDs = []uuid.UUID{}
+	}
+
+	r.name = name
+	r.keywords = keywords
+	r.kinds = kinds
+	r.accountIDs = accountIDs
+	r.actions = actions
+	r.rateLimit = rateLimit
+	r.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetEnabled turns the rule on or off
+func (r *Rule) SetEnabled(enabled bool) {
+	r.enabled = enabled
+	r.updatedAt = time.Now().UTC()
+}
+
+// Match returns the first keyword the text contains, if the rule applies
+// to the account and kind at all. Keywords match whole words, ignoring
+// case.
+func (r *Rule) Match(accountID uuid.UUID, kind Kind, text string) (string, bool) {
+	if len(r.kinds) > 0 && !containsKind(r.kinds, kind) {
+		return "", false
+	}
+	if len(r.accountIDs) > 0 && !containsID(r.accountIDs, accountID) {
+		return "", false
+	}
+
+	text = strings.ToLower(strings.Join(strings.Fields(text), " "))
+	for _, keyword := range r.keywords {
+		if containsWord(text, keyword) {
+			return keyword, true
+		}
+	}
+	return "", false
+}
+
+// RuleMatch is a rule matching a message and the keyword it matched on
+type RuleMatch struct {
+	Rule    *Rule
+	Keyword string
+}
+
+// MatchRules returns the enabled rules matching a message, in order
+func MatchRules(rules []*Rule, accountID uuid.UUID, kind Kind, text string) []RuleMatch {
+	var matches []RuleMatch
+	for _, r := range rules {
+		if !r.enabled {
+			continue
+		}
+		if keyword, ok := r.Match(accountID, kind, text); ok {
+			matches = append(matches, RuleMatch{Rule: r, Keyword: keyword})
+		}
+	}
+	return matches
+}
+
+// ActionTracker decides which actions of the matching rules are taken on a
+// message. Every matching rule tags the conversation, but it is assigned,
+// hidden and answered once, by the first rule to do so. Only comments are
+// hidden.
+type ActionTracker struct {
+	kind  Kind
+	taken map[ActionType]bool
+}
+
+// NewActionTracker tracks the actions taken on a message of a kind
+func NewActionTracker(kind Kind) *ActionTracker {
+	return &ActionTracker{kind: kind, taken: make(map[ActionType]bool)}
+}
+
+// Take returns the actions of a rule still to be taken and marks them taken
+func (t *ActionTracker) Take(actions []Action) []Action {
+	var take []Action
+	for _, a := range actions {
+		if a.Type == ActionHide && t.kind != KindComment {
+			continue
+		}
+		if a.Type != ActionTag && t.taken[a.Type] {
+			continue
+		}
+		t.taken[a.Type] = true
+		take = append(take, a)
+	}
+	return take
+}
+
+// NormalizeTag returns a tag as stored: trimmed and lower case
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+func cleanKeywords(keywords []string) ([]string, error) {
+	cleaned := make([]string, 0, len(keywords))
+	for _, k := range keywords {
+		k = strings.ToLower(strings.Join(strings.Fields(k), " "))
+		if k == "" {
+			continue
+		}
+		if utf8.RuneCountInString(k) > maxKeywordLength {
+			return nil, ErrInvalidKeyword
+		}
+		if !containsString(cleaned, k) {
+			cleaned = append(cleaned, k)
+		}
+	}
+	if len(cleaned) == 0 || len(cleaned) > maxRuleKeywords {
+		return nil, ErrInvalidKeyword
+	}
+	return cleaned, nil
+}
+
+// cleanActions checks every action has what it needs. A rule does each
+// kind of action at most once.
+func cleanActions(actions []Action) ([]Action, error) {
+	if len(actions) == 0 {
+		return nil, ErrRuleActionsMissing
+	}
+
+	seen := make(map[ActionType]bool, len(actions))
+	cleaned := make([]Action, 0, len(actions))
+	for _, a := range actions {
+		if seen[a.Type] {
+			return nil, fmt.Errorf("%w: %s is repeated", ErrInvalidRuleAction, a.Type)
+		}
+		seen[a.Type] = true
+
+		switch a.Type {
+		case ActionTag:
+			tag, err := NormalizeTag(a.Tag)
+			if err != nil {
+				return nil, err
+			}
+			cleaned = append(cleaned, Action{Type: ActionTag, Tag: tag})
+		case ActionAssign:
+			if a.AssigneeID == nil || *a.AssigneeID == uuid.Nil {
+				return nil, fmt.Errorf("%w: assign needs an assigneeId", ErrInvalidRuleAction)
+			}
+			cleaned = append(cleaned, Action{Type: ActionAssign, AssigneeID: a.AssigneeID})
+		case ActionHide:
+			cleaned = append(cleaned, Action{Type: ActionHide})
+		case ActionReply:
+			if a.SavedReplyID == nil || *a.SavedReplyID == uuid.Nil {
+				return nil, fmt.Errorf("%w: reply needs a savedReplyId", ErrInvalidRuleAction)
+			}
+			cleaned = append(cleaned, Action{Type: ActionReply, SavedReplyID: a.SavedReplyID})
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRuleAction, a.Type)
+		}
+	}
+	return cleaned, nil
+}
+
+// containsWord reports whether text holds the phrase with no letter or
+// digit right before or after it. Both are lower case.
+func containsWord(text, phrase string) bool {
+	for start := 0; start <= len(text)-len(phrase); {
+		i := strings.Index(text[start:], phrase)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(phrase)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:i])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (i == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		start = i + size
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func containsKind(kinds []Kind, k Kind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
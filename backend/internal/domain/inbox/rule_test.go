@@ -0,0 +1,66 @@
+package inbox
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRuleMatch(t *testing.T) {
+	account := uuid.New()
+	r, err := NewRule(uuid.New(), uuid.New(), RuleSpec{
+		Name:     "Pricing",
+		Keywords: []string{"  Price ", "how much", "price"},
+		Kinds:    []Kind{KindComment},
+		Actions:  []Action{{Type: ActionTag, Tag: " Sales "}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Keywords(); len(got) != 2 || got[0] != "price" || got[1] != "how much" {
+		t.Errorf("keywords = %q", got)
+	}
+	if got := r.Actions()[0].Tag; got != "sales" {
+		t.Errorf("tag = %q", got)
+	}
+
+	tests := []struct {
+		text string
+		kind Kind
+		want string
+	}{
+		{"What's the PRICE?", KindComment, "price"},
+		{"How   much\nis it", KindComment, "how much"},
+		{"priceless work", KindComment, ""},
+		{"great price", KindMessage, ""},
+		{"über-price", KindComment, "price"},
+		{"überprice", KindComment, ""},
+	}
+	for _, tt := range tests {
+		got, ok := r.Match(account, tt.kind, tt.text)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Match(%q, %s) = %q, %v; want %q", tt.text, tt.kind, got, ok, tt.want)
+		}
+	}
+}
+
+func TestActionTrackerTakesActionsOnce(t *testing.T) {
+	assignee, reply := uuid.New(), uuid.New()
+	first := []Action{{Type: ActionTag, Tag: "a"}, {Type: ActionAssign, AssigneeID: &assignee}, {Type: ActionHide}}
+	second := []Action{{Type: ActionTag, Tag: "b"}, {Type: ActionAssign, AssigneeID: &assignee}, {Type: ActionReply, SavedReplyID: &reply}}
+
+	comment := NewActionTracker(KindComment)
+	if got := comment.Take(first); len(got) != 3 {
+		t.Errorf("first rule on a comment took %d actions, want 3", len(got))
+	}
+	got := comment.Take(second)
+	if len(got) != 2 || got[0].Type != ActionTag || got[1].Type != ActionReply {
+		t.Errorf("second rule on a comment took %v, want tag and reply", got)
+	}
+
+	// Messages cannot be hidden
+	message := NewActionTracker(KindMessage)
+	if got := message.Take(first); len(got) != 2 {
+		t.Errorf("first rule on a message took %d actions, want 2", len(got))
+	}
+}
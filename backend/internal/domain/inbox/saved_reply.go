@@ -0,0 +1,124 @@
+// path: backend/internal/domain/inbox/saved_reply.go
+
+package inbox
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Variables a saved reply can use, written as {{name}}
+const (
+	VarFirstName = "first_name" // first name of the person replied to
+	VarAccount   = "account"    // name of the social account replying
+)
+
+const maxSavedReplyNameLength = 100
+
+var templateVariable = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// TemplateVars are the values a saved reply is rendered with
+type TemplateVars struct {
+	FirstName string
+	Account   string
+}
+
+// VarsFor returns the variables for replying to a participant as an account
+func VarsFor(participantName, accountName string) TemplateVars {
+	firstName := ""
+	if fields := strings.Fields(participantName); len(fields) > 0 {
+		firstName = fields[0]
+	}
+	return TemplateVars{FirstName: firstName, Account: accountName}
+}
+
+// SavedReply is a reply template a team keeps for answering the inbox
+type SavedReply struct {
+	id        uuid.UUID
+	teamID    uuid.UUID
+	name      string
+	body      string
+	createdBy uuid.UUID
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewSavedReply creates a template of a team
+func NewSavedReply(teamID, createdBy uuid.UUID, name, body string) (*SavedReply, error) {
+	s := &SavedReply{
+		id:        uuid.New(),
+		teamID:    teamID,
+		createdBy: createdBy,
+	}
+	if err := s.Update(name, body); err != nil {
+		return nil, err
+	}
+	s.createdAt = s.updatedAt
+	return s, nil
+}
+
+// ReconstructSavedReply recreates a template from persistence
+func ReconstructSavedReply(id, teamID uuid.UUID, name, body string, createdBy uuid.UUID, createdAt, updatedAt time.Time) *SavedReply {
+	return &SavedReply{
+		id:        id,
+		teamID:    teamID,
+		name:      name,
+		body:      body,
+		createdBy: createdBy,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Getters
+func (s *SavedReply) ID() uuid.UUID        { return s.id }
+func (s *SavedReply) TeamID() uuid.UUID    { return s.teamID }
+func (s *SavedReply) Name() string         { return s.name }
+func (s *SavedReply) Body() string         { return s.body }
+func (s *SavedReply) CreatedBy() uuid.UUID { return s.createdBy }
+func (s *SavedReply) CreatedAt() time.Time { return s.createdAt }
+func (s *SavedReply) UpdatedAt() time.Time { return s.updatedAt }
+
+// Update changes the name and the text of the template. Only the known
+// variables may be used.
+func (s *SavedReply) Update(name, body string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxSavedReplyNameLength {
+		return ErrInvalidSavedReplyName
+	}
+
+	body, err := ValidateReply(body)
+	if err != nil {
+		return err
+	}
+	for _, match := range templateVariable.FindAllStringSubmatch(body, -1) {
+		if v := match[1]; v != VarFirstName && v != VarAccount {
+			return fmt.Errorf("%w: %s", ErrUnknownTemplateVariable, v)
+		}
+	}
+
+	s.name = name
+	s.body = body
+	s.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Render fills in the variables; one without a value renders empty
+func (s *SavedReply) Render(vars TemplateVars) string {
+	text := templateVariable.ReplaceAllStringFunc(s.body, func(match string) string {
+		switch templateVariable.FindStringSubmatch(match)[1] {
+		case VarFirstName:
+			return vars.FirstName
+		case VarAccount:
+			return vars.Account
+		default:
+			return match
+		}
+	})
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,76 @@
+// path: backend/internal/domain/mail/mail.go
+
+package mail
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid email address")
+	// ErrPermanent marks delivery failures that retrying cannot fix, such
+	// as a rejected recipient
+	ErrPermanent = errors.New("permanent delivery failure")
+)
+
+// Message is a rendered email. Template and Locale record what it was
+// rendered from.
+type Message struct {
+	ID        uuid.UUID
+	From      string // "Name <address>"
+	To        string
+	Subject   string
+	HTML      string
+	Text      string
+	Template  string
+	Locale    string
+	CreatedAt time.Time
+}
+
+// NewMessage validates the addresses of a rendered email
+func NewMessage(from, to, subject, html, text, template, locale string) (*Message, error) {
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, ErrInvalidAddress
+	}
+	addr, err := mail.ParseAddress(strings.TrimSpace(to))
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	return &Message{
+		ID:        uuid.New(),
+		From:      from,
+		To:        addr.Address,
+		Subject:   subject,
+		HTML:      html,
+		Text:      text,
+		Template:  template,
+		Locale:    locale,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Pending is an outbox email awaiting delivery
+type Pending struct {
+	*Message
+	Attempts int // deliveries tried so far, including the current one
+}
+
+// Outbox stores emails until they are delivered
+type Outbox interface {
+	// Enqueue stores an email, inside the transaction carried by ctx if
+	// any, so it is only sent if the change that caused it commits
+	Enqueue(ctx context.Context, msg *Message) error
+	// Claim leases up to limit due emails. A claimed email is not handed
+	// out again until the lease expires.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Pending, error)
+	MarkSent(ctx context.Context, id uuid.UUID) error
+	// MarkFailed records a failed delivery. The email is retried at retryAt,
+	// or given up on when retryAt is nil.
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error
+}
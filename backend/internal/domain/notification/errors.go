@@ -0,0 +1,12 @@
+// path: backend/internal/domain/notification/errors.go
+
+package notification
+
+import "errors"
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrInvalidKind          = errors.New("invalid notification kind")
+	ErrInvalidDigest        = errors.New("email digest must be hourly or daily")
+	ErrMuted                = errors.New("notification kind is muted")
+)
@@ -0,0 +1,166 @@
+// path: backend/internal/domain/notification/notification.go
+
+// Package notification tells users about things that need their attention,
+// in the app and in email digests, as their preferences allow
+package notification
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Kind is what a notification is about
+type Kind string
+
+const (
+	KindPostFailed        Kind = "post_failed"
+	KindApprovalRequested Kind = "approval_requested"
+	KindTokenExpiring     Kind = "token_expiring"
+	KindInviteReceived    Kind = "invite_received"
+	KindQuotaNearLimit    Kind = "quota_near_limit"
+)
+
+// Kinds lists every kind, in the order preferences are shown
+var Kinds = []Kind{
+	KindPostFailed,
+	KindApprovalRequested,
+	KindTokenExpiring,
+	KindInviteReceived,
+	KindQuotaNearLimit,
+}
+
+func (k Kind) IsValid() bool {
+	for _, kind := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Channel is how a notification reaches its user
+type Channel string
+
+const (
+	ChannelInApp Channel = "in_app"
+	ChannelEmail Channel = "email"
+)
+
+const (
+	maxTitleLength = 200
+	maxBodyLength  = 1000
+)
+
+// Content is what a notification says. Link is a path in the web app.
+type Content struct {
+	Title string
+	Body  string
+	Link  string
+}
+
+// Notification is one thing a user is told about. It is listed in the app
+// and waits for the user's next email digest, on the channels their
+// preferences enabled when it was raised.
+type Notification struct {
+	id           uuid.UUID
+	userID       uuid.UUID
+	teamID       uuid.UUID // uuid.Nil if it concerns no team
+	kind         Kind
+	content      Content
+	dedupeKey    string
+	inApp        bool
+	emailPending bool
+	readAt       *time.Time
+	emailedAt    *time.Time
+	createdAt    time.Time
+}
+
+// New creates a notification for a user. A user has at most one
+// notification per dedupe key, so raising it again is harmless. It returns
+// ErrMuted if the user's preferences enable no channel for the kind.
+func New(userID, teamID uuid.UUID, kind Kind, content Content, dedupeKey string, prefs *Preferences) (*Notification, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidKind
+	}
+	channels := prefs.Channels(kind)
+	if !channels.InApp && !channels.Email {
+		return nil, ErrMuted
+	}
+
+	return &Notification{
+		id:     uuid.New(),
+		userID: userID,
+		teamID: teamID,
+		kind:   kind,
+		content: Content{
+			Title: truncate(strings.TrimSpace(content.Title), maxTitleLength),
+			Body:  truncate(strings.TrimSpace(content.Body), maxBodyLength),
+			Link:  content.Link,
+		},
+		dedupeKey:    dedupeKey,
+		inApp:        channels.InApp,
+		emailPending: channels.Email,
+		createdAt:    time.Now().UTC(),
+	}, nil
+}
+
+// Reconstruct recreates a notification from persistence
+func Reconstruct(
+	id, userID, teamID uuid.UUID,
+	kind Kind,
+	content Content,
+	dedupeKey string,
+	inApp, emailPending bool,
+	readAt, emailedAt *time.Time,
+	createdAt time.Time,
+) *Notification {
+	return &Notification{
+		id:           id,
+		userID:       userID,
+		teamID:       teamID,
+		kind:         kind,
+		content:      content,
+		dedupeKey:    dedupeKey,
+		inApp:        inApp,
+		emailPending: emailPending,
+		readAt:       readAt,
+		emailedAt:    emailedAt,
+		createdAt:    createdAt,
+	}
+}
+
+// Getters
+func (n *Notification) ID() uuid.UUID         { return n.id }
+func (n *Notification) UserID() uuid.UUID     { return n.userID }
+func (n *Notification) TeamID() uuid.UUID     { return n.teamID }
+func (n *Notification) Kind() Kind            { return n.kind }
+func (n *Notification) Content() Content      { return n.content }
+func (n *Notification) DedupeKey() string     { return n.dedupeKey }
+func (n *Notification) InApp() bool           { return n.inApp }
+func (n *Notification) EmailPending() bool    { return n.emailPending }
+func (n *Notification) ReadAt() *time.Time    { return n.readAt }
+func (n *Notification) EmailedAt() *time.Time { return n.emailedAt }
+func (n *Notification) CreatedAt() time.Time  { return n.createdAt }
+func (n *Notification) IsRead() bool          { return n.readAt != nil }
+
+// MarkRead records the user saw the notification and reports false if they
+// already had
+func (n *Notification) MarkRead() bool {
+	if n.readAt != nil {
+		return false
+	}
+	now := time.Now().UTC()
+	n.readAt = &now
+	return true
+}
+
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewFollowsPreferences(t *testing.T) {
+	user := uuid.New()
+	prefs := DefaultPreferences(user)
+	if err := prefs.Set(KindQuotaNearLimit, ChannelSettings{Email: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := prefs.Set(KindPostFailed, ChannelSettings{}); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := New(user, uuid.New(), KindQuotaNearLimit, Content{Title: " Almost full ", Body: strings.Repeat("x", 2000)}, "k", prefs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.InApp() || !n.EmailPending() {
+		t.Errorf("inApp = %v, emailPending = %v; want email only", n.InApp(), n.EmailPending())
+	}
+	if n.Content().Title != "Almost full" {
+		t.Errorf("title = %q", n.Content().Title)
+	}
+	if got := len([]rune(n.Content().Body)); got != maxBodyLength {
+		t.Errorf("body is %d runes, want %d", got, maxBodyLength)
+	}
+
+	if _, err := New(user, uuid.Nil, KindPostFailed, Content{Title: "Failed"}, "k", prefs); err != ErrMuted {
+		t.Errorf("muted kind: err = %v, want ErrMuted", err)
+	}
+	if _, err := New(user, uuid.Nil, Kind("other"), Content{Title: "Other"}, "k", prefs); err != ErrInvalidKind {
+		t.Errorf("unknown kind: err = %v, want ErrInvalidKind", err)
+	}
+}
+
+func TestPreferencesDigestDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	sent := now.Add(-2 * time.Hour)
+
+	prefs := ReconstructPreferences(uuid.New(), map[Kind]ChannelSettings{KindPostFailed: {InApp: true}}, DigestDaily, &sent, now)
+	if prefs.DigestDue(now) {
+		t.Error("daily digest sent 2 hours ago is due")
+	}
+	if !prefs.DigestDue(sent.Add(24 * time.Hour)) {
+		t.Error("daily digest is not due after a day")
+	}
+	if err := prefs.SetDigest(DigestHourly); err != nil {
+		t.Fatal(err)
+	}
+	if !prefs.DigestDue(now) {
+		t.Error("hourly digest sent 2 hours ago is not due")
+	}
+	if !DefaultPreferences(uuid.New()).DigestDue(now) {
+		t.Error("first digest is not due")
+	}
+
+	// Kinds never configured get the defaults
+	if got := prefs.Channels(KindInviteReceived); got != defaultChannels {
+		t.Errorf("unconfigured kind = %+v, want defaults", got)
+	}
+	if got := prefs.Channels(KindPostFailed); got.Email || !got.InApp {
+		t.Errorf("configured kind = %+v", got)
+	}
+}
@@ -0,0 +1,127 @@
+// path: backend/internal/domain/notification/preferences.go
+
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Digest is how often a user's pending notifications are emailed
+type Digest string
+
+const (
+	DigestHourly Digest = "hourly"
+	DigestDaily  Digest = "daily"
+)
+
+func (d Digest) IsValid() bool {
+	return d == DigestHourly || d == DigestDaily
+}
+
+// Interval is the least time between two digests
+func (d Digest) Interval() time.Duration {
+	if d == DigestDaily {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// ChannelSettings enables the channels of one kind of notification
+type ChannelSettings struct {
+	InApp bool `json:"inApp"`
+	Email bool `json:"email"`
+}
+
+// defaultChannels is what a user gets for kinds they never configured
+var defaultChannels = ChannelSettings{InApp: true, Email: true}
+
+// Preferences are how a user wants to be notified
+type Preferences struct {
+	userID       uuid.UUID
+	kinds        map[Kind]ChannelSettings
+	digest       Digest
+	digestSentAt *time.Time
+	updatedAt    time.Time
+}
+
+// DefaultPreferences notifies a user of everything, in the app and in
+// hourly digests
+func DefaultPreferences(userID uuid.UUID) *Preferences {
+	return ReconstructPreferences(userID, nil, DigestHourly, nil, time.Now().UTC())
+}
+
+// ReconstructPreferences recreates preferences from persistence. Kinds
+// missing from the stored settings, such as ones added since, get the
+// defaults.
+func ReconstructPreferences(userID uuid.UUID, kinds map[Kind]ChannelSettings, digest Digest, digestSentAt *time.Time, updatedAt time.Time) *Preferences {
+	p := &Preferences{
+		userID:       userID,
+		kinds:        make(map[Kind]ChannelSettings, len(Kinds)),
+		digest:       digest,
+		digestSentAt: digestSentAt,
+		updatedAt:    updatedAt,
+	}
+	for _, kind := range Kinds {
+		if settings, ok := kinds[kind]; ok {
+			p.kinds[kind] = settings
+		} else {
+			p.kinds[kind] = defaultChannels
+		}
+	}
+	if !p.digest.IsValid() {
+		p.digest = DigestHourly
+	}
+	return p
+}
+
+// Getters
+func (p *Preferences) UserID() uuid.UUID        { return p.userID }
+func (p *Preferences) Digest() Digest           { return p.digest }
+func (p *Preferences) DigestSentAt() *time.Time { return p.digestSentAt }
+func (p *Preferences) UpdatedAt() time.Time     { return p.updatedAt }
+
+// Kinds returns the settings of every kind
+func (p *Preferences) Kinds() map[Kind]ChannelSettings {
+	kinds := make(map[Kind]ChannelSettings, len(p.kinds))
+	for kind, settings := range p.kinds {
+		kinds[kind] = settings
+	}
+	return kinds
+}
+
+// Channels returns the channels enabled for a kind
+func (p *Preferences) Channels(kind Kind) ChannelSettings {
+	return p.kinds[kind]
+}
+
+// Set changes the channels of a kind
+func (p *Preferences) Set(kind Kind, settings ChannelSettings) error {
+	if !kind.IsValid() {
+		return ErrInvalidKind
+	}
+	p.kinds[kind] = settings
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetDigest changes how often pending notifications are emailed
+func (p *Preferences) SetDigest(d Digest) error {
+	if !d.IsValid() {
+		return ErrInvalidDigest
+	}
+	p.digest = d
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// DigestDue reports whether the user's next digest may be sent
+func (p *Preferences) DigestDue(now time.Time) bool {
+	return p.digestSentAt == nil || !now.Before(p.digestSentAt.Add(p.digest.Interval()))
+}
+
+// MarkDigestSent records a digest was emailed
+func (p *Preferences) MarkDigestSent(at time.Time) {
+	p.digestSentAt = &at
+}
@@ -0,0 +1,48 @@
+// path: backend/internal/domain/notification/repository.go
+
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Filter selects a user's in-app notifications, newest first
+type Filter struct {
+	UserID     uuid.UUID
+	UnreadOnly bool
+	Before     *time.Time // keyset cursor on the creation time
+	Limit      int
+}
+
+// Repository stores users' notifications
+type Repository interface {
+	// Create stores a notification and reports false if the user already
+	// has one with its dedupe key
+	Create(ctx context.Context, n *Notification) (bool, error)
+	Find(ctx context.Context, id uuid.UUID) (*Notification, error)
+	Update(ctx context.Context, n *Notification) error
+	// List returns in-app notifications only
+	List(ctx context.Context, filter Filter) ([]*Notification, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	// MarkAllRead marks the user's unread in-app notifications read and
+	// returns how many there were
+	MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+
+	// DigestRecipients returns users with notifications waiting for an
+	// email whose digest is due at now
+	DigestRecipients(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error)
+	// ClaimDigest returns the user's notifications waiting for an email,
+	// oldest first, and marks them emailed. Inside a transaction they stay
+	// locked until it ends.
+	ClaimDigest(ctx context.Context, userID uuid.UUID, at time.Time, limit int) ([]*Notification, error)
+}
+
+// PreferenceRepository stores how users want to be notified
+type PreferenceRepository interface {
+	// Find returns the defaults for users who never changed them
+	Find(ctx context.Context, userID uuid.UUID) (*Preferences, error)
+	Save(ctx context.Context, p *Preferences) error
+}
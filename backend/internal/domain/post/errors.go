@@ -3,7 +3,11 @@
 
 package post
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // Post-related errors
 var (
@@ -69,6 +73,11 @@ var (
 	ErrAccountSuspended    = errors.New("social account is suspended")
 	ErrAccountDisconnected = errors.New("social account is disconnected")
 
+	// Idempotency errors
+	ErrPublishAttemptNotFound = errors.New("publish attempt not found")
+	ErrPublishOutcomeUnknown  = errors.New("previous publish outcome unknown, verify on the platform before retrying")
+	ErrPublishDeferred        = errors.New("publishing deferred")
+
 	// Analytics errors
 	ErrAnalyticsNotAvailable = errors.New("analytics not available for this post")
 	ErrAnalyticsFetchFailed  = errors.New("failed to fetch analytics")
@@ -229,7 +238,8 @@ func IsPublishingError(err error) bool {
 		errors.Is(err, ErrAccountSuspended) ||
 		errors.Is(err, ErrAccountDisconnected) ||
 		errors.Is(err, ErrPlatformNotConnected) ||
-		errors.Is(err, ErrPlatformLimitReached)
+		errors.Is(err, ErrPlatformLimitReached) ||
+		errors.Is(err, ErrPublishOutcomeUnknown)
 }
 
 // IsLimitError checks if an error is a limit/quota error
@@ -250,3 +260,18 @@ func IsApprovalError(err error) bool {
 		errors.Is(err, ErrNotApproved) ||
 		errors.Is(err, ErrCannotApproveOwnPost)
 }
+
+// DeferredError asks the caller to reschedule the post for Until instead of
+// failing it (e.g. the account's platform quota is exhausted)
+type DeferredError struct {
+	Until  time.Time
+	Reason string
+}
+
+func (e *DeferredError) Error() string {
+	return fmt.Sprintf("publishing deferred until %s: %s", e.Until.Format(time.RFC3339), e.Reason)
+}
+
+func (e *DeferredError) Unwrap() error {
+	return ErrPublishDeferred
+}
@@ -0,0 +1,90 @@
+// path: backend/internal/domain/post/events.go
+
+package post
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+// Post event types
+const (
+	EventPostScheduled = "post.scheduled"
+	EventPostPublished = "post.published"
+	EventPostFailed    = "post.failed"
+
+	EventApprovalRequested = "post.approval_requested"
+)
+
+// PostScheduled is raised when a post is scheduled or rescheduled
+type PostScheduled struct {
+	event.Meta
+	PostID          uuid.UUID `json:"postId"`
+	SocialAccountID uuid.UUID `json:"socialAccountId"`
+	ScheduledAt     time.Time `json:"scheduledAt"`
+}
+
+func NewPostScheduled(p *Post) PostScheduled {
+	e := PostScheduled{Meta: event.NewMeta(p.TeamID()), PostID: p.ID(), SocialAccountID: p.SocialAccountID()}
+	if p.ScheduleTime() != nil {
+		e.ScheduledAt = *p.ScheduleTime()
+	}
+	return e
+}
+
+func (e PostScheduled) Type() string        { return EventPostScheduled }
+func (e PostScheduled) AggregateID() string { return e.PostID.String() }
+
+// PostPublished is raised when a post is live on its platform
+type PostPublished struct {
+	event.Meta
+	PostID          uuid.UUID `json:"postId"`
+	SocialAccountID uuid.UUID `json:"socialAccountId"`
+	PlatformPostID  string    `json:"platformPostId"`
+	URL             string    `json:"url,omitempty"`
+}
+
+func NewPostPublished(p *Post, platformPostID, url string) PostPublished {
+	return PostPublished{
+		Meta:            event.NewMeta(p.TeamID()),
+		PostID:          p.ID(),
+		SocialAccountID: p.SocialAccountID(),
+		PlatformPostID:  platformPostID,
+		URL:             url,
+	}
+}
+
+func (e PostPublished) Type() string        { return EventPostPublished }
+func (e PostPublished) AggregateID() string { return e.PostID.String() }
+
+// PostFailed is raised when publishing a post fails for good
+type PostFailed struct {
+	event.Meta
+	PostID          uuid.UUID `json:"postId"`
+	SocialAccountID uuid.UUID `json:"socialAccountId"`
+	Reason          string    `json:"reason"`
+}
+
+func NewPostFailed(p *Post, reason string) PostFailed {
+	return PostFailed{Meta: event.NewMeta(p.TeamID()), PostID: p.ID(), SocialAccountID: p.SocialAccountID(), Reason: reason}
+}
+
+func (e PostFailed) Type() string        { return EventPostFailed }
+func (e PostFailed) AggregateID() string { return e.PostID.String() }
+
+// ApprovalRequested is raised when a post that needs approval is scheduled
+type ApprovalRequested struct {
+	event.Meta
+	PostID          uuid.UUID `json:"postId"`
+	SocialAccountID uuid.UUID `json:"socialAccountId"`
+	RequestedBy     uuid.UUID `json:"requestedBy"`
+}
+
+func NewApprovalRequested(p *Post, requestedBy uuid.UUID) ApprovalRequested {
+	return ApprovalRequested{Meta: event.NewMeta(p.TeamID()), PostID: p.ID(), SocialAccountID: p.SocialAccountID(), RequestedBy: requestedBy}
+}
+
+func (e ApprovalRequested) Type() string        { return EventApprovalRequested }
+func (e ApprovalRequested) AggregateID() string { return e.PostID.String() }
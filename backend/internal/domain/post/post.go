@@ -13,20 +13,21 @@ import (
 
 // Post represents a social media post to be published
 type Post struct {
-	id           uuid.UUID
-	teamID       uuid.UUID
-	createdBy    uuid.UUID
-	content      Content
-	platforms    []Platform
-	scheduleTime *time.Time
-	publishedAt  *time.Time
-	status       Status
-	priority     Priority
-	metadata     Metadata
-	analytics    *Analytics
-	createdAt    time.Time
-	updatedAt    time.Time
-	deletedAt    *time.Time
+	id              uuid.UUID
+	teamID          uuid.UUID
+	createdBy       uuid.UUID
+	socialAccountID uuid.UUID // Target social account (uuid.Nil until assigned)
+	content         Content
+	platforms       []Platform
+	scheduleTime    *time.Time
+	publishedAt     *time.Time
+	status          Status
+	priority        Priority
+	metadata        Metadata
+	analytics       *Analytics
+	createdAt       time.Time
+	updatedAt       time.Time
+	deletedAt       *time.Time
 }
 
 // Content holds the post content
@@ -167,6 +168,7 @@ func Reconstruct(
 	id uuid.UUID,
 	teamID uuid.UUID,
 	createdBy uuid.UUID,
+	socialAccountID uuid.UUID,
 	content Content,
 	platforms []Platform,
 	scheduleTime *time.Time,
@@ -180,38 +182,40 @@ func Reconstruct(
 	deletedAt *time.Time,
 ) *Post {
 	return &Post{
-		id:           id,
-		teamID:       teamID,
-		createdBy:    createdBy,
-		content:      content,
-		platforms:    platforms,
-		scheduleTime: scheduleTime,
-		publishedAt:  publishedAt,
-		status:       status,
-		priority:     priority,
-		metadata:     metadata,
-		analytics:    analytics,
-		createdAt:    createdAt,
-		updatedAt:    updatedAt,
-		deletedAt:    deletedAt,
+		id:              id,
+		teamID:          teamID,
+		createdBy:       createdBy,
+		socialAccountID: socialAccountID,
+		content:         content,
+		platforms:       platforms,
+		scheduleTime:    scheduleTime,
+		publishedAt:     publishedAt,
+		status:          status,
+		priority:        priority,
+		metadata:        metadata,
+		analytics:       analytics,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+		deletedAt:       deletedAt,
 	}
 }
 
 // Getters
-func (p *Post) ID() uuid.UUID            { return p.id }
-func (p *Post) TeamID() uuid.UUID        { return p.teamID }
-func (p *Post) CreatedBy() uuid.UUID     { return p.createdBy }
-func (p *Post) Content() Content         { return p.content }
-func (p *Post) Platforms() []Platform    { return p.platforms }
-func (p *Post) ScheduleTime() *time.Time { return p.scheduleTime }
-func (p *Post) PublishedAt() *time.Time  { return p.publishedAt }
-func (p *Post) Status() Status           { return p.status }
-func (p *Post) Priority() Priority       { return p.priority }
-func (p *Post) Metadata() Metadata       { return p.metadata }
-func (p *Post) Analytics() *Analytics    { return p.analytics }
-func (p *Post) CreatedAt() time.Time     { return p.createdAt }
-func (p *Post) UpdatedAt() time.Time     { return p.updatedAt }
-func (p *Post) DeletedAt() *time.Time    { return p.deletedAt }
+func (p *Post) ID() uuid.UUID              { return p.id }
+func (p *Post) TeamID() uuid.UUID          { return p.teamID }
+func (p *Post) CreatedBy() uuid.UUID       { return p.createdBy }
+func (p *Post) SocialAccountID() uuid.UUID { return p.socialAccountID }
+func (p *Post) Content() Content           { return p.content }
+func (p *Post) Platforms() []Platform      { return p.platforms }
+func (p *Post) ScheduleTime() *time.Time   { return p.scheduleTime }
+func (p *Post) PublishedAt() *time.Time    { return p.publishedAt }
+func (p *Post) Status() Status             { return p.status }
+func (p *Post) Priority() Priority         { return p.priority }
+func (p *Post) Metadata() Metadata         { return p.metadata }
+func (p *Post) Analytics() *Analytics      { return p.analytics }
+func (p *Post) CreatedAt() time.Time       { return p.createdAt }
+func (p *Post) UpdatedAt() time.Time       { return p.updatedAt }
+func (p *Post) DeletedAt() *time.Time      { return p.deletedAt }
 
 // Business Logic Methods
 
@@ -287,6 +291,17 @@ func (p *Post) UpdatePlatforms(platforms []Platform) error {
 	return nil
 }
 
+// AssignSocialAccount sets the social account the post is published to
+func (p *Post) AssignSocialAccount(accountID uuid.UUID) error {
+	if p.status == StatusPublished || p.status == StatusPublishing {
+		return ErrCannotEditPublished
+	}
+
+	p.socialAccountID = accountID
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
 // Approve approves the post for publishing
 func (p *Post) Approve(approverID uuid.UUID) error {
 	if !p.metadata.RequiresApproval {
@@ -352,6 +367,22 @@ func (p *Post) MarkFailed(errorMessage string) error {
 	return nil
 }
 
+// Defer moves a pending post back to scheduled at a later time without
+// counting a failed attempt
+func (p *Post) Defer(until time.Time, reason string) error {
+	switch p.status {
+	case StatusScheduled, StatusQueued, StatusPublishing:
+	default:
+		return ErrInvalidStatus
+	}
+
+	p.status = StatusScheduled
+	p.scheduleTime = &until
+	p.metadata.LastError = reason
+	p.updatedAt = time.Now().UTC()
+	return nil
+}
+
 // Cancel cancels a scheduled post
 func (p *Post) Cancel() error {
 	if p.status == StatusPublished {
@@ -0,0 +1,157 @@
+// path: backend/internal/domain/post/publish_attempt.go
+
+package post
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttemptStatus represents the state of a publish attempt
+type AttemptStatus string
+
+const (
+	AttemptPending   AttemptStatus = "pending"   // Recorded before the platform call
+	AttemptSucceeded AttemptStatus = "succeeded" // Platform accepted the post
+	AttemptFailed    AttemptStatus = "failed"    // Platform rejected the post
+	AttemptUnknown   AttemptStatus = "unknown"   // Platform call failed without saying whether the post was created
+)
+
+// PublishAttempt records the publishing of a post to one target account.
+// It is persisted before the platform call so that a retry after a crash
+// knows a post may already exist on the platform.
+type PublishAttempt struct {
+	id              uuid.UUID
+	postID          uuid.UUID
+	socialAccountID uuid.UUID
+	idempotencyKey  string
+	contentHash     string
+	status          AttemptStatus
+	platformPostID  string
+	platformPostURL string
+	errorMessage    string
+	attempts        int
+	startedAt       time.Time
+	completedAt     *time.Time
+}
+
+// NewPublishAttempt creates a pending attempt for a post and target account
+func NewPublishAttempt(postID, socialAccountID uuid.UUID, text string) *PublishAttempt {
+	return &PublishAttempt{
+		id:              uuid.New(),
+		postID:          postID,
+		socialAccountID: socialAccountID,
+		idempotencyKey:  IdempotencyKey(postID, socialAccountID),
+		contentHash:     ContentHash(text),
+		status:          AttemptPending,
+		attempts:        1,
+		startedAt:       time.Now().UTC(),
+	}
+}
+
+// ReconstructPublishAttempt recreates an attempt from persistence
+func ReconstructPublishAttempt(
+	id, postID, socialAccountID uuid.UUID,
+	idempotencyKey, contentHash string,
+	status AttemptStatus,
+	platformPostID, platformPostURL, errorMessage string,
+	attempts int,
+	startedAt time.Time,
+	completedAt *time.Time,
+) *PublishAttempt {
+	return &PublishAttempt{
+		id:              id,
+		postID:          postID,
+		socialAccountID: socialAccountID,
+		idempotencyKey:  idempotencyKey,
+		contentHash:     contentHash,
+		status:          status,
+		platformPostID:  platformPostID,
+		platformPostURL: platformPostURL,
+		errorMessage:    errorMessage,
+		attempts:        attempts,
+		startedAt:       startedAt,
+		completedAt:     completedAt,
+	}
+}
+
+// Getters
+func (a *PublishAttempt) ID() uuid.UUID              { return a.id }
+func (a *PublishAttempt) PostID() uuid.UUID          { return a.postID }
+func (a *PublishAttempt) SocialAccountID() uuid.UUID { return a.socialAccountID }
+func (a *PublishAttempt) IdempotencyKey() string     { return a.idempotencyKey }
+func (a *PublishAttempt) ContentHash() string        { return a.contentHash }
+func (a *PublishAttempt) Status() AttemptStatus      { return a.status }
+func (a *PublishAttempt) PlatformPostID() string     { return a.platformPostID }
+func (a *PublishAttempt) PlatformPostURL() string    { return a.platformPostURL }
+func (a *PublishAttempt) ErrorMessage() string       { return a.errorMessage }
+func (a *PublishAttempt) Attempts() int              { return a.attempts }
+func (a *PublishAttempt) StartedAt() time.Time       { return a.startedAt }
+func (a *PublishAttempt) CompletedAt() *time.Time    { return a.completedAt }
+
+// Retry starts a new try of the same attempt with the current content
+func (a *PublishAttempt) Retry(text string) {
+	a.status = AttemptPending
+	a.contentHash = ContentHash(text)
+	a.errorMessage = ""
+	a.attempts++
+	a.startedAt = time.Now().UTC()
+	a.completedAt = nil
+}
+
+// MarkSucceeded records the platform post created by this attempt
+func (a *PublishAttempt) MarkSucceeded(platformPostID, platformPostURL string) {
+	now := time.Now().UTC()
+	a.status = AttemptSucceeded
+	a.platformPostID = platformPostID
+	a.platformPostURL = platformPostURL
+	a.errorMessage = ""
+	a.completedAt = &now
+}
+
+// MarkFailed records an attempt the platform definitely did not post,
+// such as one rejected with a 4xx
+func (a *PublishAttempt) MarkFailed(errorMessage string) {
+	now := time.Now().UTC()
+	a.status = AttemptFailed
+	a.errorMessage = errorMessage
+	a.completedAt = &now
+}
+
+// MarkUnknown records an attempt whose call failed after it may have
+// reached the platform (a timeout, a transport error or a 5xx). Like a
+// pending attempt, it must be reconciled before posting again.
+func (a *PublishAttempt) MarkUnknown(errorMessage string) {
+	a.status = AttemptUnknown
+	a.errorMessage = errorMessage
+	a.completedAt = nil
+}
+
+// IdempotencyKey returns the stable key for publishing a post to an account
+func IdempotencyKey(postID, socialAccountID uuid.UUID) string {
+	return "post:" + postID.String() + ":account:" + socialAccountID.String()
+}
+
+var (
+	urlPattern        = regexp.MustCompile(`https?://\S+`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeContent reduces text to a form that survives platform rewriting:
+// links are dropped (platforms shorten them), case and whitespace are folded
+func NormalizeContent(text string) string {
+	text = urlPattern.ReplaceAllString(text, "")
+	text = whitespacePattern.ReplaceAllString(text, " ")
+	return strings.ToLower(strings.TrimSpace(text))
+}
+
+// ContentHash returns the SHA-256 of the normalized text
+func ContentHash(text string) string {
+	sum := sha256.Sum256([]byte(NormalizeContent(text)))
+	return hex.EncodeToString(sum[:])
+}
@@ -27,6 +27,7 @@ type Repository interface {
 	FindByStatus(ctx context.Context, status Status, offset, limit int) ([]*Post, error)
 	FindScheduled(ctx context.Context, offset, limit int) ([]*Post, error)
 	FindDuePosts(ctx context.Context, before time.Time) ([]*Post, error)
+	FindDuePostsPerTeam(ctx context.Context, before time.Time, perTeam, limit int) ([]*Post, error)
 	FindQueued(ctx context.Context, limit int) ([]*Post, error)
 	FindPublished(ctx context.Context, teamID uuid.UUID, offset, limit int) ([]*Post, error)
 	FindFailed(ctx context.Context, limit int) ([]*Post, error)
@@ -77,6 +78,15 @@ type SchedulerRepository interface {
 	CanPublishToAccount(ctx context.Context, accountID uuid.UUID) (bool, error)
 }
 
+// PublishAttemptRepository persists publish attempts for idempotent publishing
+type PublishAttemptRepository interface {
+	// Create fails if an attempt with the same idempotency key exists
+	Create(ctx context.Context, attempt *PublishAttempt) error
+	Update(ctx context.Context, attempt *PublishAttempt) error
+	// FindByKey returns ErrPublishAttemptNotFound if there is no attempt
+	FindByKey(ctx context.Context, idempotencyKey string) (*PublishAttempt, error)
+}
+
 // AnalyticsRepository handles post analytics
 type AnalyticsRepository interface {
 	// Save analytics
@@ -0,0 +1,188 @@
+// path: backend/internal/domain/social/access.go
+
+package social
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// AccessLevel is what a restricted member may do with one social account.
+// Each level includes the ones below it.
+type AccessLevel string
+
+const (
+	AccessAnalytics AccessLevel = "analytics" // view the account and its analytics
+	AccessDraft     AccessLevel = "draft"     // also write drafts for it
+	AccessPublish   AccessLevel = "publish"   // also schedule and publish
+)
+
+func (l AccessLevel) rank() int {
+	switch l {
+	case AccessAnalytics:
+		return 1
+	case AccessDraft:
+		return 2
+	case AccessPublish:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// IsValid reports whether the level is known
+func (l AccessLevel) IsValid() bool {
+	return l.rank() > 0
+}
+
+// AccountGrant gives a member one level of access to one account
+type AccountGrant struct {
+	AccountID uuid.UUID   `json:"accountId"`
+	Level     AccessLevel `json:"access"`
+}
+
+// AccountAccess describes which of the team's social accounts a member may
+// use. Unrestricted members may use every account; restricted members only
+// the accounts they were granted.
+type AccountAccess struct {
+	teamID     uuid.UUID
+	userID     uuid.UUID
+	restricted bool
+	grants     []AccountGrant
+	updatedBy  uuid.UUID
+	updatedAt  time.Time
+}
+
+// NewAccountAccess creates a member's access settings
+func NewAccountAccess(teamID, userID, updatedBy uuid.UUID, restricted bool, grants []AccountGrant) (*AccountAccess, error) {
+	if teamID == uuid.Nil {
+		return nil, ErrInvalidTeamID
+	}
+	if userID == uuid.Nil {
+		return nil, ErrInvalidUserID
+	}
+
+	seen := make(map[uuid.UUID]bool, len(grants))
+	for _, g := range grants {
+		if !g.Level.IsValid() {
+			return nil, ErrInvalidAccessLevel
+		}
+		if seen[g.AccountID] {
+			return nil, ErrDuplicateAccountGrant
+		}
+		seen[g.AccountID] = true
+	}
+
+	return &AccountAccess{
+		teamID:     teamID,
+		userID:     userID,
+		restricted: restricted,
+		grants:     grants,
+		updatedBy:  updatedBy,
+		updatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// ReconstructAccountAccess recreates access settings from persistence
+func ReconstructAccountAccess(teamID, userID, updatedBy uuid.UUID, restricted bool, grants []AccountGrant, updatedAt time.Time) *AccountAccess {
+	return &AccountAccess{
+		teamID:     teamID,
+		userID:     userID,
+		restricted: restricted,
+		grants:     grants,
+		updatedBy:  updatedBy,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Getters
+func (a *AccountAccess) TeamID() uuid.UUID      { return a.teamID }
+func (a *AccountAccess) UserID() uuid.UUID      { return a.userID }
+func (a *AccountAccess) IsRestricted() bool     { return a.restricted }
+func (a *AccountAccess) Grants() []AccountGrant { return a.grants }
+func (a *AccountAccess) UpdatedBy() uuid.UUID   { return a.updatedBy }
+func (a *AccountAccess) UpdatedAt() time.Time   { return a.updatedAt }
+
+// Allows reports whether the member may use the account at the level
+func (a *AccountAccess) Allows(accountID uuid.UUID, level AccessLevel) bool {
+	if !a.restricted {
+		return true
+	}
+	for _, g := range a.grants {
+		if g.AccountID == accountID {
+			return g.Level.rank() >= level.rank()
+		}
+	}
+	return false
+}
+
+// Filter keeps the accounts the member may see
+func (a *AccountAccess) Filter(accounts []*Account) []*Account {
+	if !a.restricted {
+		return accounts
+	}
+	visible := make([]*Account, 0, len(accounts))
+	for _, account := range accounts {
+		if a.Allows(account.ID(), AccessAnalytics) {
+			visible = append(visible, account)
+		}
+	}
+	return visible
+}
+
+// AccessRepository persists member account access
+type AccessRepository interface {
+	// FindAccess returns unrestricted access when nothing was saved
+	FindAccess(ctx context.Context, teamID, userID uuid.UUID) (*AccountAccess, error)
+
+	// SaveAccess replaces the member's restriction and grants
+	SaveAccess(ctx context.Context, access *AccountAccess) error
+}
+
+// ============================================================================
+// ACCESS CHECKER
+// ============================================================================
+
+// AccessChecker resolves a member's account access. Members whose role can
+// manage the team's accounts are never restricted.
+type AccessChecker struct {
+	authorizer *teamDomain.Authorizer
+	accessRepo AccessRepository
+}
+
+// NewAccessChecker creates an access checker
+func NewAccessChecker(memberRepo teamDomain.MemberRepository, roleRepo teamDomain.RoleRepository, accessRepo AccessRepository) *AccessChecker {
+	return &AccessChecker{
+		authorizer: teamDomain.NewAuthorizer(memberRepo, roleRepo),
+		accessRepo: accessRepo,
+	}
+}
+
+// Resolve returns the member's effective access, or
+// teamDomain.ErrMemberNotFound for non-members
+func (c *AccessChecker) Resolve(ctx context.Context, teamID, userID uuid.UUID) (*AccountAccess, error) {
+	role, err := c.authorizer.Role(ctx, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role.Allows(teamDomain.PermAccountsManage) {
+		return ReconstructAccountAccess(teamID, userID, uuid.Nil, false, nil, time.Time{}), nil
+	}
+	return c.accessRepo.FindAccess(ctx, teamID, userID)
+}
+
+// Authorize returns ErrAccountAccessDenied unless the member may use the
+// account at the level
+func (c *AccessChecker) Authorize(ctx context.Context, teamID, userID, accountID uuid.UUID, level AccessLevel) error {
+	access, err := c.Resolve(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !access.Allows(accountID, level) {
+		return ErrAccountAccessDenied
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// path: backend/internal/domain/social/access_test.go
+package social
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+func TestAccountAccessAllows(t *testing.T) {
+	teamID, userID := uuid.New(), uuid.New()
+	drafts, publishes, other := uuid.New(), uuid.New(), uuid.New()
+	access, err := NewAccountAccess(teamID, userID, uuid.New(), true, []AccountGrant{
+		{AccountID: drafts, Level: AccessDraft},
+		{AccountID: publishes, Level: AccessPublish},
+	})
+	if err != nil {
+		t.Fatalf("NewAccountAccess: %v", err)
+	}
+
+	tests := []struct {
+		account uuid.UUID
+		level   AccessLevel
+		want    bool
+	}{
+		{drafts, AccessAnalytics, true},
+		{drafts, AccessDraft, true},
+		{drafts, AccessPublish, false},
+		{publishes, AccessPublish, true},
+		{other, AccessAnalytics, false},
+	}
+	for _, tt := range tests {
+		if got := access.Allows(tt.account, tt.level); got != tt.want {
+			t.Errorf("Allows(%s, %s) = %v, want %v", tt.account, tt.level, got, tt.want)
+		}
+	}
+
+	accounts := make([]*Account, 0, 3)
+	for _, id := range []uuid.UUID{drafts, publishes, other} {
+		now := time.Now()
+		accounts = append(accounts, Reconstruct(id, teamID, userID, PlatformLinkedIn, AccountType("profile"), "", "", "", "",
+			Credentials{}, AccountMetadata{}, StatusActive, RateLimits{}, nil, now, nil, now, now, nil))
+	}
+	if visible := access.Filter(accounts); len(visible) != 2 || visible[0].ID() != drafts || visible[1].ID() != publishes {
+		t.Errorf("Filter kept %d accounts, want the two granted", len(visible))
+	}
+
+	open := ReconstructAccountAccess(teamID, userID, uuid.Nil, false, nil, time.Time{})
+	if !open.Allows(other, AccessPublish) || len(open.Filter(accounts)) != 3 {
+		t.Error("unrestricted access is limited")
+	}
+
+	if _, err := NewAccountAccess(teamID, userID, uuid.New(), true, []AccountGrant{{AccountID: other, Level: "admin"}}); !errors.Is(err, ErrInvalidAccessLevel) {
+		t.Errorf("bad level: err = %v, want ErrInvalidAccessLevel", err)
+	}
+	_, err = NewAccountAccess(teamID, userID, uuid.New(), true, []AccountGrant{{AccountID: other, Level: AccessDraft}, {AccountID: other, Level: AccessPublish}})
+	if !errors.Is(err, ErrDuplicateAccountGrant) {
+		t.Errorf("duplicate grant: err = %v, want ErrDuplicateAccountGrant", err)
+	}
+}
+
+type activeMember struct {
+	teamDomain.MemberRepository
+	role teamDomain.MemberRole
+}
+
+func (r activeMember) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*teamDomain.Member, error) {
+	joined := time.Now()
+	return teamDomain.ReconstructMember(uuid.New(), teamID, userID, r.role, teamDomain.MemberStatusActive, userID, joined, &joined, nil), nil
+}
+
+type adminManagesAccounts struct {
+	teamDomain.RoleRepository
+}
+
+func (adminManagesAccounts) FindByName(ctx context.Context, teamID uuid.UUID, name teamDomain.MemberRole) (*teamDomain.Role, error) {
+	permissions := []teamDomain.Permission{"posts.*", teamDomain.PermAccountsView}
+	if name == teamDomain.MemberRoleAdmin {
+		permissions = append(permissions, teamDomain.PermAccountsManage)
+	}
+	return teamDomain.ReconstructRole(uuid.New(), teamID, name, "", permissions, true, time.Now(), time.Now()), nil
+}
+
+type savedAccess struct {
+	access *AccountAccess
+}
+
+func (r savedAccess) FindAccess(ctx context.Context, teamID, userID uuid.UUID) (*AccountAccess, error) {
+	return r.access, nil
+}
+
+func (r savedAccess) SaveAccess(ctx context.Context, access *AccountAccess) error {
+	return nil
+}
+
+func TestAccessCheckerHonorsScope(t *testing.T) {
+	teamID, userID, granted := uuid.New(), uuid.New(), uuid.New()
+	saved := savedAccess{access: ReconstructAccountAccess(teamID, userID, uuid.New(), true,
+		[]AccountGrant{{AccountID: granted, Level: AccessDraft}}, time.Now())}
+	ctx := context.Background()
+
+	editor := NewAccessChecker(activeMember{role: teamDomain.MemberRoleEditor}, adminManagesAccounts{}, saved)
+	if err := editor.Authorize(ctx, teamID, userID, granted, AccessDraft); err != nil {
+		t.Errorf("editor, granted account: %v", err)
+	}
+	if err := editor.Authorize(ctx, teamID, userID, uuid.New(), AccessAnalytics); !errors.Is(err, ErrAccountAccessDenied) {
+		t.Errorf("editor, other account: err = %v, want ErrAccountAccessDenied", err)
+	}
+
+	// Admins manage every account, so their saved grants don't apply...
+	admin := NewAccessChecker(activeMember{role: teamDomain.MemberRoleAdmin}, adminManagesAccounts{}, saved)
+	if err := admin.Authorize(ctx, teamID, userID, uuid.New(), AccessPublish); err != nil {
+		t.Errorf("admin: %v", err)
+	}
+
+	// ...unless they act through a key scoped without accounts.manage
+	scoped := teamDomain.WithScope(ctx, teamDomain.Scope{TeamID: teamID, Permissions: []teamDomain.Permission{"posts.*"}})
+	if err := admin.Authorize(scoped, teamID, userID, uuid.New(), AccessPublish); !errors.Is(err, ErrAccountAccessDenied) {
+		t.Errorf("scoped admin, other account: err = %v, want ErrAccountAccessDenied", err)
+	}
+	if err := admin.Authorize(scoped, teamID, userID, granted, AccessPublish); !errors.Is(err, ErrAccountAccessDenied) {
+		t.Errorf("scoped admin, draft grant: err = %v, want ErrAccountAccessDenied", err)
+	}
+	if err := admin.Authorize(scoped, teamID, userID, granted, AccessDraft); err != nil {
+		t.Errorf("scoped admin, granted account: %v", err)
+	}
+}
@@ -66,6 +66,8 @@ var (
 
 	// Webhook errors
 	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhookChallenge = errors.New("invalid webhook verification request")
+	ErrInvalidWebhookPayload   = errors.New("invalid webhook payload")
 	ErrWebhookEventNotFound    = errors.New("webhook event not found")
 	ErrWebhookProcessingFailed = errors.New("webhook processing failed")
 
@@ -78,6 +80,16 @@ var (
 	ErrInsufficientPermissions = errors.New("insufficient permissions on platform")
 )
 
+// Account access errors
+var (
+	ErrInvalidAccessLevel    = errors.New("access must be analytics, draft or publish")
+	ErrDuplicateAccountGrant = errors.New("account granted more than once")
+	ErrAccountAccessDenied   = errors.New("access denied: no access to this social account")
+	ErrAccountRequired       = errors.New("access denied: choose one of your granted social accounts")
+	ErrAccessNotRestrictable = errors.New("members who manage social accounts cannot be restricted")
+	ErrAccountNotInTeam      = errors.New("social account does not belong to this team")
+)
+
 // ErrorCode represents a unique error code for API responses
 type ErrorCode string
 
@@ -0,0 +1,96 @@
+// path: backend/internal/domain/social/events.go
+
+package social
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+// Social event types
+const (
+	EventAccountDisconnected = "social.account_disconnected"
+	EventAccountRevoked      = "social.account_revoked"
+	EventAccountExpired      = "social.account_expired"
+	EventInteractionReceived = "social.interaction_received"
+)
+
+// AccountDisconnected is raised when a social account is removed from a team
+type AccountDisconnected struct {
+	event.Meta
+	AccountID      uuid.UUID `json:"accountId"`
+	Platform       Platform  `json:"platform"`
+	DisconnectedBy uuid.UUID `json:"disconnectedBy"`
+}
+
+func NewAccountDisconnected(a *Account, by uuid.UUID) AccountDisconnected {
+	return AccountDisconnected{Meta: event.NewMeta(a.TeamID()), AccountID: a.ID(), Platform: a.Platform(), DisconnectedBy: by}
+}
+
+func (e AccountDisconnected) Type() string        { return EventAccountDisconnected }
+func (e AccountDisconnected) AggregateID() string { return e.AccountID.String() }
+
+// AccountRevoked is raised when a platform reports that the account's
+// authorization was withdrawn
+type AccountRevoked struct {
+	event.Meta
+	AccountID uuid.UUID `json:"accountId"`
+	Platform  Platform  `json:"platform"`
+}
+
+func NewAccountRevoked(a *Account) AccountRevoked {
+	return AccountRevoked{Meta: event.NewMeta(a.TeamID()), AccountID: a.ID(), Platform: a.Platform()}
+}
+
+func (e AccountRevoked) Type() string        { return EventAccountRevoked }
+func (e AccountRevoked) AggregateID() string { return e.AccountID.String() }
+
+// AccountExpired is raised when an account's token could not be refreshed
+// and it must be connected again
+type AccountExpired struct {
+	event.Meta
+	AccountID uuid.UUID `json:"accountId"`
+	Platform  Platform  `json:"platform"`
+}
+
+func NewAccountExpired(a *Account) AccountExpired {
+	return AccountExpired{Meta: event.NewMeta(a.TeamID()), AccountID: a.ID(), Platform: a.Platform()}
+}
+
+func (e AccountExpired) Type() string        { return EventAccountExpired }
+func (e AccountExpired) AggregateID() string { return e.AccountID.String() }
+
+// InteractionReceived is raised for a comment, mention or direct message a
+// platform reported for a connected account
+type InteractionReceived struct {
+	event.Meta
+	AccountID  uuid.UUID         `json:"accountId"`
+	Platform   Platform          `json:"platform"`
+	Kind       PlatformEventKind `json:"kind"`
+	ObjectID   string            `json:"objectId,omitempty"`
+	ItemID     string            `json:"itemId"`
+	AuthorID   string            `json:"authorId,omitempty"`
+	AuthorName string            `json:"authorName,omitempty"`
+	Text       string            `json:"text,omitempty"`
+	PostedAt   time.Time         `json:"postedAt"`
+}
+
+func NewInteractionReceived(a *Account, e PlatformEvent) InteractionReceived {
+	return InteractionReceived{
+		Meta:       event.NewMeta(a.TeamID()),
+		AccountID:  a.ID(),
+		Platform:   e.Platform,
+		Kind:       e.Kind,
+		ObjectID:   e.ObjectID,
+		ItemID:     e.ItemID,
+		AuthorID:   e.AuthorID,
+		AuthorName: e.AuthorName,
+		Text:       e.Text,
+		PostedAt:   e.OccurredAt,
+	}
+}
+
+func (e InteractionReceived) Type() string        { return EventInteractionReceived }
+func (e InteractionReceived) AggregateID() string { return e.AccountID.String() }
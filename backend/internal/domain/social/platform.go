@@ -6,8 +6,6 @@ package social
 import (
 	"context"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 // PlatformAdapter defines the interface for social media platform adapters
@@ -295,22 +293,3 @@ type PlatformError struct {
 func (e PlatformError) Error() string {
 	return string(e.Platform) + ": " + e.Message
 }
-
-// WebhookEvent represents an incoming webhook from a platform
-type WebhookEvent struct {
-	ID          uuid.UUID
-	Platform    Platform
-	AccountID   uuid.UUID
-	EventType   string
-	Payload     map[string]interface{}
-	ReceivedAt  time.Time
-	ProcessedAt *time.Time
-	Success     bool
-	Error       string
-}
-
-// WebhookHandler processes platform webhooks
-type WebhookHandler interface {
-	HandleWebhook(ctx context.Context, platform Platform, payload []byte) error
-	VerifySignature(platform Platform, payload []byte, signature string) bool
-}
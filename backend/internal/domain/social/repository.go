@@ -29,6 +29,9 @@ type AccountRepository interface {
 	// Platform queries
 	FindByPlatform(ctx context.Context, platform Platform, offset, limit int) ([]*Account, error)
 	FindByPlatformUserID(ctx context.Context, platform Platform, platformUserID string) (*Account, error)
+	// FindAllByPlatformUserID returns every team's connection of a
+	// platform account
+	FindAllByPlatformUserID(ctx context.Context, platform Platform, platformUserID string) ([]*Account, error)
 
 	// Status queries
 	FindByStatus(ctx context.Context, status Status, offset, limit int) ([]*Account, error)
@@ -60,19 +63,27 @@ type TokenRepository interface {
 	GetEncryptionKeyVersion(ctx context.Context, accountID uuid.UUID) (int, error)
 }
 
-// WebhookRepository handles webhook events
+// WebhookRepository stores inbound platform webhooks
 type WebhookRepository interface {
 	// Event storage
 	SaveEvent(ctx context.Context, event *WebhookEvent) error
 	FindEventByID(ctx context.Context, id uuid.UUID) (*WebhookEvent, error)
-	FindEventsByAccount(ctx context.Context, accountID uuid.UUID, limit int) ([]*WebhookEvent, error)
-	FindUnprocessedEvents(ctx context.Context, limit int) ([]*WebhookEvent, error)
 
-	// Event processing
-	MarkEventProcessed(ctx context.Context, id uuid.UUID, success bool, errorMsg string) error
+	// Event processing. ClaimDueEvents returns unprocessed entries that are
+	// due and pushes their next attempt back by lease.
+	ClaimDueEvents(ctx context.Context, limit int, lease time.Duration) ([]*WebhookEvent, error)
+	UpdateEvent(ctx context.Context, event *WebhookEvent) error
 
-	// Cleanup
-	DeleteOldEvents(ctx context.Context, olderThan time.Duration) (int, error)
+	// Replay. RequeueEvents makes matching entries due again and returns
+	// how many.
+	RequeueEvents(ctx context.Context, filter WebhookReplayFilter) (int, error)
+}
+
+// PostMetricsRepository stores metrics platforms report for published posts
+type PostMetricsRepository interface {
+	// MergePostMetrics merges metrics into the post the account published
+	// as platformPostID. It reports whether there is such a post.
+	MergePostMetrics(ctx context.Context, accountID uuid.UUID, platformPostID string, metrics map[string]int64, at time.Time) (bool, error)
 }
 
 // PostPublishingRepository tracks post publishing to platforms
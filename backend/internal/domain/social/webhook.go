@@ -0,0 +1,185 @@
+// path: backend/internal/domain/social/webhook.go
+
+package social
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Inbound platform webhooks are logged as received and processed later by
+// the worker. Each entry is parsed into PlatformEvents, which are
+// dispatched by kind.
+
+const (
+	// WebhookProcessLease keeps a claimed entry from being picked up by
+	// another worker while it is processed
+	WebhookProcessLease = 2 * time.Minute
+	// Stored error messages are truncated to this many bytes
+	maxWebhookError = 1024
+)
+
+// WebhookRetrySchedule is the wait after each failed attempt. An entry is
+// given up once the schedule is exhausted.
+var WebhookRetrySchedule = []time.Duration{
+	30 * time.Second,
+	2 * time.Minute,
+	10 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+}
+
+// WebhookEvent is a platform webhook payload as received
+type WebhookEvent struct {
+	ID            uuid.UUID
+	Platform      Platform
+	EventType     string
+	Payload       json.RawMessage
+	Attempts      int
+	NextAttemptAt time.Time
+	ReceivedAt    time.Time
+	ProcessedAt   *time.Time
+	Error         string
+}
+
+// NewWebhookEvent creates a log entry due for processing right away
+func NewWebhookEvent(platform Platform, eventType string, payload []byte) *WebhookEvent {
+	now := time.Now().UTC()
+	return &WebhookEvent{
+		ID:            uuid.New(),
+		Platform:      platform,
+		EventType:     eventType,
+		Payload:       json.RawMessage(payload),
+		NextAttemptAt: now,
+		ReceivedAt:    now,
+	}
+}
+
+// Processed reports whether the entry needs no further attempts
+func (e *WebhookEvent) Processed() bool { return e.ProcessedAt != nil }
+
+// MarkProcessed records a successful attempt
+func (e *WebhookEvent) MarkProcessed(now time.Time) {
+	e.Attempts++
+	e.ProcessedAt = &now
+	e.Error = ""
+}
+
+// RecordFailure records a failed attempt and schedules the next one. Once
+// the retry schedule is exhausted the entry is closed with its error. It
+// reports whether another attempt was scheduled.
+func (e *WebhookEvent) RecordFailure(err error, now time.Time) bool {
+	e.Attempts++
+	e.setError(err)
+	if e.Attempts > len(WebhookRetrySchedule) {
+		e.ProcessedAt = &now
+		return false
+	}
+	e.NextAttemptAt = now.Add(WebhookRetrySchedule[e.Attempts-1])
+	return true
+}
+
+// Abandon closes the entry without retrying, for failures another attempt
+// cannot fix
+func (e *WebhookEvent) Abandon(err error, now time.Time) {
+	e.Attempts++
+	e.setError(err)
+	e.ProcessedAt = &now
+}
+
+func (e *WebhookEvent) setError(err error) {
+	e.Error = err.Error()
+	if len(e.Error) > maxWebhookError {
+		e.Error = e.Error[:maxWebhookError]
+	}
+}
+
+// WebhookReplayFilter selects logged entries to process again
+type WebhookReplayFilter struct {
+	Platform   Platform // empty for all platforms
+	Since      time.Time
+	Until      time.Time // zero for now
+	FailedOnly bool      // only entries that were given up
+}
+
+// PlatformEventKind classifies what a webhook reported
+type PlatformEventKind string
+
+const (
+	PlatformEventTokenRevoked PlatformEventKind = "token_revoked"
+	PlatformEventComment      PlatformEventKind = "comment"
+	PlatformEventMention      PlatformEventKind = "mention"
+	PlatformEventMessage      PlatformEventKind = "message"
+	PlatformEventInsights     PlatformEventKind = "insights"
+)
+
+// PlatformEvent is one thing a webhook reported, normalized across
+// platforms. A payload may carry several.
+type PlatformEvent struct {
+	Kind     PlatformEventKind
+	Platform Platform
+	// PlatformUserID is the platform's ID of the connected account the
+	// event is for (page, business account or user)
+	PlatformUserID string
+	// ObjectID is the post or media the event concerns
+	ObjectID string
+	// ItemID is the comment or message itself
+	ItemID     string
+	AuthorID   string
+	AuthorName string
+	Text       string
+	Metrics    map[string]int64 // insights only
+	OccurredAt time.Time
+}
+
+// WebhookChallenge is the response to a platform's subscription handshake
+type WebhookChallenge struct {
+	ContentType string
+	Body        []byte
+}
+
+// WebhookParser verifies and decodes the webhooks of one platform. It is
+// implemented by the platform adapters.
+type WebhookParser interface {
+	Platform() Platform
+
+	// SignatureHeader names the request header carrying the signature
+	SignatureHeader() string
+	// VerifySignature checks the signature against the raw request body
+	VerifySignature(body []byte, signature string) bool
+
+	// Challenge answers the handshake the platform sends when the webhook
+	// is registered
+	Challenge(query url.Values) (*WebhookChallenge, error)
+
+	// EventType names a payload for the log
+	EventType(body []byte) string
+	// Parse extracts the events from a payload. Events the application has
+	// no use for are left out.
+	Parse(body []byte) ([]PlatformEvent, error)
+}
+
+// WebhookParsers looks up a parser by the platform in the webhook URL
+type WebhookParsers map[Platform]WebhookParser
+
+// NewWebhookParsers indexes parsers by their platform
+func NewWebhookParsers(parsers ...WebhookParser) WebhookParsers {
+	m := make(WebhookParsers, len(parsers))
+	for _, p := range parsers {
+		m[p.Platform()] = p
+	}
+	return m
+}
+
+// Get returns the parser for platform
+func (m WebhookParsers) Get(platform Platform) (WebhookParser, error) {
+	p, ok := m[platform]
+	if !ok {
+		return nil, ErrPlatformNotSupported
+	}
+	return p, nil
+}
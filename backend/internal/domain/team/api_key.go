@@ -0,0 +1,224 @@
+// path: backend/internal/domain/team/api_key.go
+
+package team
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// API key prefixes mark keys so they are recognizable in logs and secret
+// scanners, and tell them apart from session JWTs
+const (
+	PersonalTokenPrefix = "sq_pat_"
+	TeamKeyPrefix       = "sq_key_"
+)
+
+// IsAPIKey reports whether a bearer token looks like an API key
+func IsAPIKey(raw string) bool {
+	return strings.HasPrefix(raw, PersonalTokenPrefix) || strings.HasPrefix(raw, TeamKeyPrefix)
+}
+
+// APIKey is a long-lived credential. Personal access tokens act as their
+// owner in every team; team keys act as the member who created them and
+// only reach that team. Both are limited to their scopes. Only a hash of the
+// key is stored.
+type APIKey struct {
+	id         uuid.UUID
+	teamID     uuid.UUID // uuid.Nil for personal access tokens
+	userID     uuid.UUID
+	name       string
+	prefix     string
+	keyHash    string
+	scopes     []Permission
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+	createdAt  time.Time
+	reachable  []uuid.UUID // set on personal tokens when they authenticate
+}
+
+// NewAPIKey creates a key and returns it with its plaintext value, which is
+// shown once. A nil teamID creates a personal access token.
+func NewAPIKey(teamID, userID uuid.UUID, name string, scopes []Permission, expiresAt *time.Time) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", ErrInvalidAPIKeyName
+	}
+
+	seen := make(map[Permission]bool, len(scopes))
+	cleaned := make([]Permission, 0, len(scopes))
+	for _, p := range scopes {
+		p = Permission(strings.TrimSpace(string(p)))
+		if !p.IsKnown() {
+			return nil, "", ErrInvalidPermission
+		}
+		if !seen[p] {
+			seen[p] = true
+			cleaned = append(cleaned, p)
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, "", ErrAPIKeyScopeMissing
+	}
+
+	now := time.Now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrInvalidKeyExpiry
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	prefix := PersonalTokenPrefix
+	if teamID != uuid.Nil {
+		prefix = TeamKeyPrefix
+	}
+	raw := prefix + hex.EncodeToString(b)
+
+	return &APIKey{
+		id:        uuid.New(),
+		teamID:    teamID,
+		userID:    userID,
+		name:      name,
+		prefix:    raw[:len(prefix)+6],
+		keyHash:   HashAPIKey(raw),
+		scopes:    cleaned,
+		expiresAt: expiresAt,
+		createdAt: now,
+	}, raw, nil
+}
+
+// ReconstructAPIKey recreates a key from persistence
+func ReconstructAPIKey(id, teamID, userID uuid.UUID, name, prefix, keyHash string, scopes []Permission, expiresAt, lastUsedAt, revokedAt *time.Time, createdAt time.Time) *APIKey {
+	return &APIKey{
+		id:         id,
+		teamID:     teamID,
+		userID:     userID,
+		name:       name,
+		prefix:     prefix,
+		keyHash:    keyHash,
+		scopes:     scopes,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+		createdAt:  createdAt,
+	}
+}
+
+// HashAPIKey returns the stored form of a plaintext key
+func HashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Getters
+func (k *APIKey) ID() uuid.UUID          { return k.id }
+func (k *APIKey) TeamID() uuid.UUID      { return k.teamID }
+func (k *APIKey) UserID() uuid.UUID      { return k.userID }
+func (k *APIKey) Name() string           { return k.name }
+func (k *APIKey) Prefix() string         { return k.prefix }
+func (k *APIKey) KeyHash() string        { return k.keyHash }
+func (k *APIKey) Scopes() []Permission   { return k.scopes }
+func (k *APIKey) ExpiresAt() *time.Time  { return k.expiresAt }
+func (k *APIKey) LastUsedAt() *time.Time { return k.lastUsedAt }
+func (k *APIKey) RevokedAt() *time.Time  { return k.revokedAt }
+func (k *APIKey) CreatedAt() time.Time   { return k.createdAt }
+
+// IsPersonal reports whether the key is a personal access token
+func (k *APIKey) IsPersonal() bool { return k.teamID == uuid.Nil }
+
+// Validate returns ErrAPIKeyRevoked or ErrAPIKeyExpired for keys that can
+// no longer be used
+func (k *APIKey) Validate(now time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+	if k.expiresAt != nil && !now.Before(*k.expiresAt) {
+		return ErrAPIKeyExpired
+	}
+	return nil
+}
+
+// Revoke stops the key from working
+func (k *APIKey) Revoke() {
+	if k.revokedAt == nil {
+		now := time.Now().UTC()
+		k.revokedAt = &now
+	}
+}
+
+// LimitToTeams restricts a personal access token to the given teams for
+// the request it authenticates
+func (k *APIKey) LimitToTeams(teamIDs []uuid.UUID) {
+	k.reachable = teamIDs
+}
+
+// Scope returns what requests made with the key may do
+func (k *APIKey) Scope() Scope {
+	return Scope{TeamID: k.teamID, Teams: k.reachable, Permissions: k.scopes}
+}
+
+// APIKeyRepository persists API keys
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) error
+	// FindByHash returns ErrAPIKeyNotFound for unknown keys
+	FindByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	// FindByID returns ErrAPIKeyNotFound for unknown keys
+	FindByID(ctx context.Context, id uuid.UUID) (*APIKey, error)
+	// ListPersonal returns the user's personal access tokens
+	ListPersonal(ctx context.Context, userID uuid.UUID) ([]*APIKey, error)
+	// ListByTeam returns the team's keys
+	ListByTeam(ctx context.Context, teamID uuid.UUID) ([]*APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	Touch(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+// ============================================================================
+// REQUEST SCOPE
+// ============================================================================
+
+// Scope limits a request authenticated with an API key. The Authorizer
+// applies it to every permission check made for the request.
+type Scope struct {
+	TeamID      uuid.UUID   // uuid.Nil when any of the user's teams may be used
+	Teams       []uuid.UUID // when set, the only teams a personal token may reach
+	Permissions []Permission
+}
+
+// Reaches reports whether the scope allows acting in the team
+func (s Scope) Reaches(teamID uuid.UUID) bool {
+	if s.TeamID != uuid.Nil {
+		return s.TeamID == teamID
+	}
+	if s.Teams == nil {
+		return true
+	}
+	for _, id := range s.Teams {
+		if id == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+type scopeKey struct{}
+
+// WithScope attaches an API key scope to a request context
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the scope of a request made with an API key
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok
+}
@@ -103,6 +103,65 @@ var (
 	ErrCannotViewAnalytics = errors.New("cannot view analytics")
 )
 
+// Custom role errors
+var (
+	ErrRoleNotFound         = errors.New("role not found")
+	ErrRoleExists           = errors.New("a role with this name already exists")
+	ErrRoleInUse            = errors.New("role is still assigned to members")
+	ErrSystemRoleReadOnly   = errors.New("built-in roles cannot be changed")
+	ErrInvalidRoleName      = errors.New("role name must be 2-50 lowercase letters, numbers, dashes or underscores")
+	ErrInvalidPermission    = errors.New("unknown permission")
+	ErrPermissionEscalation = errors.New("cannot grant permissions you do not have")
+)
+
+// Single sign-on errors
+var (
+	ErrSSONotConfigured     = errors.New("single sign-on is not configured for this team")
+	ErrSSORequired          = errors.New("your organization requires signing in with single sign-on")
+	ErrSSODomainTaken       = errors.New("email domain is already used by another team's single sign-on")
+	ErrSSODomainNotClaimed  = errors.New("email domain is not claimed by this team's single sign-on")
+	ErrSSODomainNotVerified = errors.New("domain verification record not found")
+	ErrInvalidSSOConfig     = errors.New("invalid single sign-on configuration")
+	ErrSSOOwnerRoleGrant    = errors.New("single sign-on cannot grant the owner role")
+)
+
+// SCIM provisioning errors
+var (
+	ErrSCIMTokenNotFound    = errors.New("scim token not found")
+	ErrSCIMGroupNotFound    = errors.New("scim group not found")
+	ErrSCIMGroupExists      = errors.New("a scim group with this name already exists")
+	ErrSCIMUserExists       = errors.New("user is already provisioned in this team")
+	ErrSCIMDomainNotAllowed = errors.New("email domain is not managed by this team's single sign-on")
+)
+
+// API key errors
+var (
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrAPIKeyExpired      = errors.New("api key has expired")
+	ErrAPIKeyRevoked      = errors.New("api key has been revoked")
+	ErrInvalidAPIKeyName  = errors.New("api key name must be 1-100 characters")
+	ErrAPIKeyScopeMissing = errors.New("api key needs at least one scope")
+	ErrInvalidKeyExpiry   = errors.New("api key expiry must be in the future")
+)
+
+// OAuth provider errors
+var (
+	ErrOAuthClientNotFound = errors.New("oauth client not found")
+	ErrInvalidOAuthClient  = errors.New("oauth client name must be 1-100 characters")
+	ErrInvalidRedirectURI  = errors.New("redirect URIs must be https, loopback http or an app scheme, without a fragment")
+	ErrInvalidOAuthScope   = errors.New("requested scope is invalid or not allowed for this client")
+	ErrInvalidOAuthGrant   = errors.New("authorization grant is invalid, expired or revoked")
+)
+
+// Webhook errors
+var (
+	ErrWebhookNotFound           = errors.New("webhook not found")
+	ErrInvalidWebhookURL         = errors.New("webhook URL must be an absolute http or https URL without credentials or a fragment")
+	ErrInvalidWebhookDescription = errors.New("webhook description must be at most 255 characters")
+	ErrInvalidWebhookEvent       = errors.New("unknown webhook event type")
+	ErrWebhookEventsMissing      = errors.New("webhook needs at least one event type")
+)
+
 // ErrorCode represents a unique error code for API responses
 type ErrorCode string
 
@@ -0,0 +1,40 @@
+// path: backend/internal/domain/team/events.go
+
+package team
+
+import (
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+// Team event types
+const (
+	EventMemberInvited = "team.member_invited"
+	EventPlanChanged   = "team.plan_changed"
+)
+
+// MemberInvited is raised when a user is invited to a team
+type MemberInvited struct {
+	event.Meta
+	UserID    uuid.UUID  `json:"userId"`
+	Role      MemberRole `json:"role"`
+	InvitedBy uuid.UUID  `json:"invitedBy"`
+}
+
+func NewMemberInvited(m *Member) MemberInvited {
+	return MemberInvited{Meta: event.NewMeta(m.TeamID()), UserID: m.UserID(), Role: m.Role(), InvitedBy: m.InvitedBy()}
+}
+
+func (e MemberInvited) Type() string        { return EventMemberInvited }
+func (e MemberInvited) AggregateID() string { return e.Team.String() }
+
+// PlanChanged is raised when a team moves to another subscription plan
+type PlanChanged struct {
+	event.Meta
+	OldPlan   Plan      `json:"oldPlan"`
+	NewPlan   Plan      `json:"newPlan"`
+	ChangedBy uuid.UUID `json:"changedBy"`
+}
+
+func (e PlanChanged) Type() string        { return EventPlanChanged }
+func (e PlanChanged) AggregateID() string { return e.Team.String() }
@@ -212,64 +212,6 @@ func (m *Member) Reactivate() error {
 	return nil
 }
 
-// Permission Checks
-
-// CanManageTeam checks if the member can manage team settings
-func (m *Member) CanManageTeam() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner || m.role == MemberRoleAdmin)
-}
-
-// CanManageMembers checks if the member can manage other members
-func (m *Member) CanManageMembers() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner || m.role == MemberRoleAdmin)
-}
-
-// CanCreatePosts checks if the member can create posts
-func (m *Member) CanCreatePosts() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner ||
-			m.role == MemberRoleAdmin ||
-			m.role == MemberRoleEditor)
-}
-
-// CanEditPosts checks if the member can edit posts
-func (m *Member) CanEditPosts() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner ||
-			m.role == MemberRoleAdmin ||
-			m.role == MemberRoleEditor)
-}
-
-// CanDeletePosts checks if the member can delete posts
-func (m *Member) CanDeletePosts() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner || m.role == MemberRoleAdmin)
-}
-
-// CanViewAnalytics checks if the member can view analytics
-func (m *Member) CanViewAnalytics() bool {
-	return m.status == MemberStatusActive // All active members can view
-}
-
-// CanManageBilling checks if the member can manage billing
-func (m *Member) CanManageBilling() bool {
-	return m.status == MemberStatusActive && m.role == MemberRoleOwner
-}
-
-// CanManageSocialAccounts checks if the member can manage social accounts
-func (m *Member) CanManageSocialAccounts() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner || m.role == MemberRoleAdmin)
-}
-
-// CanInviteMembers checks if the member can invite new members
-func (m *Member) CanInviteMembers() bool {
-	return m.status == MemberStatusActive &&
-		(m.role == MemberRoleOwner || m.role == MemberRoleAdmin)
-}
-
 // IsActive checks if the member is active
 func (m *Member) IsActive() bool {
 	return m.status == MemberStatusActive
@@ -300,25 +242,8 @@ func (m *Member) GetMembershipDuration() time.Duration {
 
 // Helper Functions
 
+// isValidMemberRole accepts the built-in roles and well-formed custom role
+// names; whether a custom role exists is checked against the RoleRepository
 func isValidMemberRole(role MemberRole) bool {
-	switch role {
-	case MemberRoleOwner, MemberRoleAdmin, MemberRoleEditor, MemberRoleViewer:
-		return true
-	default:
-		return false
-	}
+	return role.IsSystem() || roleNamePattern.MatchString(string(role))
 }
-
-// Permission Matrix for reference
-//
-// | Permission              | Owner | Admin | Editor | Viewer |
-// |------------------------|-------|-------|--------|--------|
-// | Manage Team Settings   | ✅    | ✅    | ❌     | ❌     |
-// | Manage Members         | ✅    | ✅    | ❌     | ❌     |
-// | Create Posts           | ✅    | ✅    | ✅     | ❌     |
-// | Edit Posts             | ✅    | ✅    | ✅     | ❌     |
-// | Delete Posts           | ✅    | ✅    | ❌     | ❌     |
-// | View Analytics         | ✅    | ✅    | ✅     | ✅     |
-// | Manage Billing         | ✅    | ❌    | ❌     | ❌     |
-// | Manage Social Accounts | ✅    | ✅    | ❌     | ❌     |
-// | Invite Members         | ✅    | ✅    | ❌     | ❌     |
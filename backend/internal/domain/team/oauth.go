@@ -0,0 +1,407 @@
+// path: backend/internal/domain/team/oauth.go
+
+package team
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuth credential prefixes
+const (
+	OAuthClientIDPrefix     = "sqc_"
+	OAuthClientSecretPrefix = "sqcs_"
+	OAuthRefreshTokenPrefix = "sq_ort_"
+)
+
+// OAuth lifetimes
+const (
+	OAuthCodeTTL         = 10 * time.Minute
+	OAuthAccessTokenTTL  = time.Hour
+	OAuthRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// OAuthClient is a third-party application registered by a team. Scopes are
+// team permissions and cap what the client may ask for. Confidential clients
+// have a secret and may use the client credentials grant, acting as the
+// member who registered them; public clients must use PKCE.
+type OAuthClient struct {
+	id           uuid.UUID
+	teamID       uuid.UUID
+	clientID     string
+	secretHash   string // empty for public clients
+	name         string
+	redirectURIs []string
+	scopes       []Permission
+	createdBy    uuid.UUID
+	createdAt    time.Time
+}
+
+// NewOAuthClient registers a client and returns it with its plaintext
+// secret, which is shown once and empty for public clients
+func NewOAuthClient(teamID, createdBy uuid.UUID, name string, redirectURIs []string, scopes []Permission, confidential bool) (*OAuthClient, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", ErrInvalidOAuthClient
+	}
+
+	for _, uri := range redirectURIs {
+		if !validRedirectURI(uri) {
+			return nil, "", ErrInvalidRedirectURI
+		}
+	}
+	if len(redirectURIs) == 0 && !confidential {
+		return nil, "", ErrInvalidRedirectURI
+	}
+
+	cleaned, err := cleanScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	clientID, err := randomToken(OAuthClientIDPrefix, 16)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c := &OAuthClient{
+		id:           uuid.New(),
+		teamID:       teamID,
+		clientID:     clientID,
+		name:         name,
+		redirectURIs: redirectURIs,
+		scopes:       cleaned,
+		createdBy:    createdBy,
+		createdAt:    time.Now().UTC(),
+	}
+
+	var secret string
+	if confidential {
+		if secret, err = randomToken(OAuthClientSecretPrefix, 32); err != nil {
+			return nil, "", err
+		}
+		c.secretHash = HashOAuthSecret(secret)
+	}
+	return c, secret, nil
+}
+
+// ReconstructOAuthClient recreates a client from persistence
+func ReconstructOAuthClient(id, teamID uuid.UUID, clientID, secretHash, name string, redirectURIs []string, scopes []Permission, createdBy uuid.UUID, createdAt time.Time) *OAuthClient {
+	return &OAuthClient{
+		id:           id,
+		teamID:       teamID,
+		clientID:     clientID,
+		secretHash:   secretHash,
+		name:         name,
+		redirectURIs: redirectURIs,
+		scopes:       scopes,
+		createdBy:    createdBy,
+		createdAt:    createdAt,
+	}
+}
+
+// HashOAuthSecret returns the stored form of a client secret, refresh token
+// or authorization code
+func HashOAuthSecret(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Getters
+func (c *OAuthClient) ID() uuid.UUID          { return c.id }
+func (c *OAuthClient) TeamID() uuid.UUID      { return c.teamID }
+func (c *OAuthClient) ClientID() string       { return c.clientID }
+func (c *OAuthClient) SecretHash() string     { return c.secretHash }
+func (c *OAuthClient) Name() string           { return c.name }
+func (c *OAuthClient) RedirectURIs() []string { return c.redirectURIs }
+func (c *OAuthClient) Scopes() []Permission   { return c.scopes }
+func (c *OAuthClient) CreatedBy() uuid.UUID   { return c.createdBy }
+func (c *OAuthClient) CreatedAt() time.Time   { return c.createdAt }
+func (c *OAuthClient) IsConfidential() bool   { return c.secretHash != "" }
+
+// VerifySecret checks a client secret in constant time
+func (c *OAuthClient) VerifySecret(secret string) bool {
+	if !c.IsConfidential() || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashOAuthSecret(secret)), []byte(c.secretHash)) == 1
+}
+
+// AllowsRedirectURI reports whether the URI exactly matches a registered one
+func (c *OAuthClient) AllowsRedirectURI(uri string) bool {
+	for _, registered := range c.redirectURIs {
+		if registered == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// NarrowScopes checks requested scopes against the client's. No request
+// means all of the client's scopes.
+func (c *OAuthClient) NarrowScopes(requested []Permission) ([]Permission, error) {
+	return NarrowOAuthScopes(c.scopes, requested)
+}
+
+// NarrowOAuthScopes checks requested scopes against those already granted.
+// No request means everything granted.
+func NarrowOAuthScopes(granted, requested []Permission) ([]Permission, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+
+	cleaned, err := cleanScopes(requested)
+	if err != nil {
+		return nil, ErrInvalidOAuthScope
+	}
+	for _, scope := range cleaned {
+		if !anyGrants(granted, scope) {
+			return nil, ErrInvalidOAuthScope
+		}
+	}
+	return cleaned, nil
+}
+
+// ParseOAuthScope splits a space-separated OAuth scope parameter
+func ParseOAuthScope(scope string) []Permission {
+	fields := strings.Fields(scope)
+	scopes := make([]Permission, 0, len(fields))
+	for _, f := range fields {
+		scopes = append(scopes, Permission(f))
+	}
+	return scopes
+}
+
+// FormatOAuthScope joins scopes into an OAuth scope parameter
+func FormatOAuthScope(scopes []Permission) string {
+	parts := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		parts = append(parts, string(s))
+	}
+	return strings.Join(parts, " ")
+}
+
+// ============================================================================
+// AUTHORIZATION CODES
+// ============================================================================
+
+// OAuthAuthorizationCode is what a user's consent is exchanged for. Codes
+// are single use and bound to the client, redirect URI and PKCE challenge.
+type OAuthAuthorizationCode struct {
+	codeHash      string
+	clientID      uuid.UUID
+	userID        uuid.UUID
+	teamID        uuid.UUID
+	redirectURI   string
+	scopes        []Permission
+	codeChallenge string // S256 challenge, empty when PKCE was not used
+	expiresAt     time.Time
+}
+
+// NewOAuthAuthorizationCode creates a code and returns it with its
+// plaintext value
+func NewOAuthAuthorizationCode(client *OAuthClient, userID, teamID uuid.UUID, redirectURI string, scopes []Permission, codeChallenge string) (*OAuthAuthorizationCode, string, error) {
+	raw, err := randomToken("", 32)
+	if err != nil {
+		return nil, "", err
+	}
+	return &OAuthAuthorizationCode{
+		codeHash:      HashOAuthSecret(raw),
+		clientID:      client.ID(),
+		userID:        userID,
+		teamID:        teamID,
+		redirectURI:   redirectURI,
+		scopes:        scopes,
+		codeChallenge: codeChallenge,
+		expiresAt:     time.Now().UTC().Add(OAuthCodeTTL),
+	}, raw, nil
+}
+
+// ReconstructOAuthAuthorizationCode recreates a code from persistence
+func ReconstructOAuthAuthorizationCode(codeHash string, clientID, userID, teamID uuid.UUID, redirectURI string, scopes []Permission, codeChallenge string, expiresAt time.Time) *OAuthAuthorizationCode {
+	return &OAuthAuthorizationCode{
+		codeHash:      codeHash,
+		clientID:      clientID,
+		userID:        userID,
+		teamID:        teamID,
+		redirectURI:   redirectURI,
+		scopes:        scopes,
+		codeChallenge: codeChallenge,
+		expiresAt:     expiresAt,
+	}
+}
+
+// Getters
+func (c *OAuthAuthorizationCode) CodeHash() string      { return c.codeHash }
+func (c *OAuthAuthorizationCode) ClientID() uuid.UUID   { return c.clientID }
+func (c *OAuthAuthorizationCode) UserID() uuid.UUID     { return c.userID }
+func (c *OAuthAuthorizationCode) TeamID() uuid.UUID     { return c.teamID }
+func (c *OAuthAuthorizationCode) RedirectURI() string   { return c.redirectURI }
+func (c *OAuthAuthorizationCode) Scopes() []Permission  { return c.scopes }
+func (c *OAuthAuthorizationCode) CodeChallenge() string { return c.codeChallenge }
+func (c *OAuthAuthorizationCode) ExpiresAt() time.Time  { return c.expiresAt }
+
+// Redeem checks that the code may be exchanged by the client with this
+// redirect URI and PKCE verifier
+func (c *OAuthAuthorizationCode) Redeem(client *OAuthClient, redirectURI, codeVerifier string, now time.Time) error {
+	if c.clientID != client.ID() || c.redirectURI != redirectURI || !now.Before(c.expiresAt) {
+		return ErrInvalidOAuthGrant
+	}
+	if c.codeChallenge == "" {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(codeVerifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(challenge), []byte(c.codeChallenge)) != 1 {
+		return ErrInvalidOAuthGrant
+	}
+	return nil
+}
+
+// ============================================================================
+// REFRESH TOKENS
+// ============================================================================
+
+// OAuthRefreshToken lets a client get new access tokens without the user.
+// Refresh tokens rotate: each use revokes the token and issues a new one.
+type OAuthRefreshToken struct {
+	id        uuid.UUID
+	tokenHash string
+	clientID  uuid.UUID
+	userID    uuid.UUID
+	teamID    uuid.UUID
+	scopes    []Permission
+	expiresAt time.Time
+	revokedAt *time.Time
+	createdAt time.Time
+}
+
+// NewOAuthRefreshToken creates a refresh token and returns it with its
+// plaintext value
+func NewOAuthRefreshToken(clientID, userID, teamID uuid.UUID, scopes []Permission) (*OAuthRefreshToken, string, error) {
+	raw, err := randomToken(OAuthRefreshTokenPrefix, 32)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now().UTC()
+	return &OAuthRefreshToken{
+		id:        uuid.New(),
+		tokenHash: HashOAuthSecret(raw),
+		clientID:  clientID,
+		userID:    userID,
+		teamID:    teamID,
+		scopes:    scopes,
+		expiresAt: now.Add(OAuthRefreshTokenTTL),
+		createdAt: now,
+	}, raw, nil
+}
+
+// ReconstructOAuthRefreshToken recreates a refresh token from persistence
+func ReconstructOAuthRefreshToken(id uuid.UUID, tokenHash string, clientID, userID, teamID uuid.UUID, scopes []Permission, expiresAt time.Time, revokedAt *time.Time, createdAt time.Time) *OAuthRefreshToken {
+	return &OAuthRefreshToken{
+		id:        id,
+		tokenHash: tokenHash,
+		clientID:  clientID,
+		userID:    userID,
+		teamID:    teamID,
+		scopes:    scopes,
+		expiresAt: expiresAt,
+		revokedAt: revokedAt,
+		createdAt: createdAt,
+	}
+}
+
+// Getters
+func (t *OAuthRefreshToken) ID() uuid.UUID         { return t.id }
+func (t *OAuthRefreshToken) TokenHash() string     { return t.tokenHash }
+func (t *OAuthRefreshToken) ClientID() uuid.UUID   { return t.clientID }
+func (t *OAuthRefreshToken) UserID() uuid.UUID     { return t.userID }
+func (t *OAuthRefreshToken) TeamID() uuid.UUID     { return t.teamID }
+func (t *OAuthRefreshToken) Scopes() []Permission  { return t.scopes }
+func (t *OAuthRefreshToken) ExpiresAt() time.Time  { return t.expiresAt }
+func (t *OAuthRefreshToken) RevokedAt() *time.Time { return t.revokedAt }
+func (t *OAuthRefreshToken) CreatedAt() time.Time  { return t.createdAt }
+
+// IsActive reports whether the token can still be used
+func (t *OAuthRefreshToken) IsActive(now time.Time) bool {
+	return t.revokedAt == nil && now.Before(t.expiresAt)
+}
+
+// OAuthRepository persists OAuth clients and grants
+type OAuthRepository interface {
+	CreateClient(ctx context.Context, client *OAuthClient) error
+	// FindClient returns ErrOAuthClientNotFound for unknown client IDs
+	FindClient(ctx context.Context, clientID string) (*OAuthClient, error)
+	FindClientByID(ctx context.Context, id uuid.UUID) (*OAuthClient, error)
+	ListClients(ctx context.Context, teamID uuid.UUID) ([]*OAuthClient, error)
+	// DeleteClient removes the client with its codes and refresh tokens
+	DeleteClient(ctx context.Context, teamID, id uuid.UUID) error
+
+	CreateCode(ctx context.Context, code *OAuthAuthorizationCode) error
+	// ConsumeCode deletes and returns a code, or returns ErrInvalidOAuthGrant
+	ConsumeCode(ctx context.Context, codeHash string) (*OAuthAuthorizationCode, error)
+
+	CreateRefreshToken(ctx context.Context, token *OAuthRefreshToken) error
+	// FindRefreshToken returns ErrInvalidOAuthGrant for unknown tokens
+	FindRefreshToken(ctx context.Context, tokenHash string) (*OAuthRefreshToken, error)
+	// RevokeRefreshToken returns ErrInvalidOAuthGrant if it was already revoked
+	RevokeRefreshToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+}
+
+// cleanScopes validates and de-duplicates scopes; at least one is required
+func cleanScopes(scopes []Permission) ([]Permission, error) {
+	seen := make(map[Permission]bool, len(scopes))
+	cleaned := make([]Permission, 0, len(scopes))
+	for _, p := range scopes {
+		p = Permission(strings.TrimSpace(string(p)))
+		if p == PermissionAll || !p.IsKnown() {
+			return nil, ErrInvalidPermission
+		}
+		if !seen[p] {
+			seen[p] = true
+			cleaned = append(cleaned, p)
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, ErrInvalidOAuthScope
+	}
+	return cleaned, nil
+}
+
+// validRedirectURI accepts absolute https URIs, http on loopback for local
+// development, and custom schemes for native apps. Fragments are not allowed.
+func validRedirectURI(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return u.Host != ""
+	case "http":
+		host := u.Hostname()
+		return host == "localhost" || host == "127.0.0.1" || host == "::1"
+	case "javascript", "data", "vbscript", "file":
+		return false
+	default:
+		return true
+	}
+}
+
+func randomToken(prefix string, n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return prefix + hex.EncodeToString(b), nil
+}
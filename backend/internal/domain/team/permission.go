@@ -0,0 +1,170 @@
+// path: backend/internal/domain/team/permission.go
+
+package team
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Permission is something a team role allows, named "<area>.<action>".
+// Roles may also grant "<area>.*" or "*".
+type Permission string
+
+const (
+	PermissionAll Permission = "*"
+
+	PermTeamView   Permission = "team.view"
+	PermTeamManage Permission = "team.manage"
+	PermTeamDelete Permission = "team.delete"
+
+	PermMembersView   Permission = "members.view"
+	PermMembersInvite Permission = "members.invite"
+	PermMembersManage Permission = "members.manage"
+	PermRolesManage   Permission = "roles.manage"
+
+	PermPostsView     Permission = "posts.view"
+	PermPostsCreate   Permission = "posts.create"
+	PermPostsEdit     Permission = "posts.edit"
+	PermPostsDelete   Permission = "posts.delete"
+	PermPostsSchedule Permission = "posts.schedule"
+	PermPostsPublish  Permission = "posts.publish"
+
+	PermAccountsView    Permission = "accounts.view"
+	PermAccountsConnect Permission = "accounts.connect"
+	PermAccountsManage  Permission = "accounts.manage"
+
+	PermInboxView   Permission = "inbox.view"
+	PermInboxReply  Permission = "inbox.reply"
+	PermInboxManage Permission = "inbox.manage"
+
+	PermAnalyticsView Permission = "analytics.view"
+	PermBillingManage Permission = "billing.manage"
+	PermAuditView     Permission = "audit.view"
+)
+
+// PermissionInfo describes a registered permission
+type PermissionInfo struct {
+	Name        Permission `json:"name"`
+	Description string     `json:"description"`
+}
+
+var permissionRegistry = []PermissionInfo{
+	{PermTeamView, "View the team and its settings"},
+	{PermTeamManage, "Change team settings, single sign-on and provisioning"},
+	{PermTeamDelete, "Delete the team"},
+	{PermMembersView, "View team members and roles"},
+	{PermMembersInvite, "Invite new members"},
+	{PermMembersManage, "Remove members and change their roles"},
+	{PermRolesManage, "Create, change and delete custom roles"},
+	{PermPostsView, "View posts"},
+	{PermPostsCreate, "Create posts and manage your own"},
+	{PermPostsEdit, "Edit anyone's posts"},
+	{PermPostsDelete, "Delete anyone's posts"},
+	{PermPostsSchedule, "Schedule anyone's posts"},
+	{PermPostsPublish, "Publish anyone's posts"},
+	{PermAccountsView, "View connected social accounts"},
+	{PermAccountsConnect, "Connect social accounts"},
+	{PermAccountsManage, "Refresh and disconnect social accounts"},
+	{PermInboxView, "View inbox conversations and mark them read"},
+	{PermInboxReply, "Reply to inbox conversations"},
+	{PermInboxManage, "Assign and archive inbox conversations"},
+	{PermAnalyticsView, "View analytics"},
+	{PermBillingManage, "Change the plan and billing details"},
+	{PermAuditView, "View and export the audit log"},
+}
+
+// Permissions returns the permission registry
+func Permissions() []PermissionInfo {
+	return append([]PermissionInfo(nil), permissionRegistry...)
+}
+
+// IsKnown reports whether the permission, or the area of a wildcard, is
+// registered
+func (p Permission) IsKnown() bool {
+	if p == PermissionAll {
+		return true
+	}
+	area, isWildcard := strings.CutSuffix(string(p), ".*")
+	for _, info := range permissionRegistry {
+		if info.Name == p {
+			return true
+		}
+		if isWildcard && strings.HasPrefix(string(info.Name), area+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// grants reports whether a granted permission covers the requested one
+func (p Permission) grants(requested Permission) bool {
+	if p == PermissionAll || p == requested {
+		return true
+	}
+	area, ok := strings.CutSuffix(string(p), ".*")
+	return ok && strings.HasPrefix(string(requested), area+".")
+}
+
+// ============================================================================
+// AUTHORIZER
+// ============================================================================
+
+// Authorizer answers permission questions for team members by resolving
+// their role from the roles table
+type Authorizer struct {
+	memberRepo MemberRepository
+	roleRepo   RoleRepository
+}
+
+// NewAuthorizer creates an authorizer
+func NewAuthorizer(memberRepo MemberRepository, roleRepo RoleRepository) *Authorizer {
+	return &Authorizer{memberRepo: memberRepo, roleRepo: roleRepo}
+}
+
+// Role returns the role of an active member, or ErrMemberNotFound for
+// anyone else. For requests made with an API key the role is limited to the
+// key's scopes, and keys only resolve in the teams they may reach.
+func (a *Authorizer) Role(ctx context.Context, teamID, userID uuid.UUID) (*Role, error) {
+	scope, scoped := ScopeFromContext(ctx)
+	if scoped && !scope.Reaches(teamID) {
+		return nil, ErrMemberNotFound
+	}
+
+	member, err := a.memberRepo.FindMember(ctx, teamID, userID)
+	if err != nil || !member.IsActive() {
+		return nil, ErrMemberNotFound
+	}
+	role, err := a.roleRepo.FindByName(ctx, teamID, member.Role())
+	if err != nil || !scoped {
+		return role, err
+	}
+	return role.limitTo(scope.Permissions), nil
+}
+
+// Can reports whether the user is an active member holding the permission
+func (a *Authorizer) Can(ctx context.Context, teamID, userID uuid.UUID, permission Permission) (bool, error) {
+	role, err := a.Role(ctx, teamID, userID)
+	if err == ErrMemberNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return role.Allows(permission), nil
+}
+
+// Authorize returns ErrMemberNotFound for non-members and
+// ErrInsufficientPermissions when the member's role lacks the permission
+func (a *Authorizer) Authorize(ctx context.Context, teamID, userID uuid.UUID, permission Permission) error {
+	role, err := a.Role(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.Allows(permission) {
+		return ErrInsufficientPermissions
+	}
+	return nil
+}
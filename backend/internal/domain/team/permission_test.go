@@ -0,0 +1,145 @@
+// path: backend/internal/domain/team/permission_test.go
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPermissionGrants(t *testing.T) {
+	cases := []struct {
+		granted, requested Permission
+		want               bool
+	}{
+		{PermissionAll, PermBillingManage, true},
+		{PermPostsView, PermPostsView, true},
+		{PermPostsView, PermPostsCreate, false},
+		{"posts.*", PermPostsPublish, true},
+		{"posts.*", PermAccountsView, false},
+		{"post.*", PermPostsView, false},
+	}
+	for _, tc := range cases {
+		if got := tc.granted.grants(tc.requested); got != tc.want {
+			t.Errorf("%s grants %s = %v, want %v", tc.granted, tc.requested, got, tc.want)
+		}
+	}
+
+	for p, want := range map[Permission]bool{PermissionAll: true, PermAuditView: true, "inbox.*": true, "inbox.delete": false, "mail.*": false} {
+		if got := p.IsKnown(); got != want {
+			t.Errorf("%s IsKnown = %v, want %v", p, got, want)
+		}
+	}
+}
+
+func TestNewCustomRole(t *testing.T) {
+	teamID := uuid.New()
+
+	role, err := NewCustomRole(teamID, "freelancer", " Writes drafts ", []Permission{PermPostsCreate, " posts.view ", PermPostsCreate})
+	if err != nil {
+		t.Fatalf("NewCustomRole: %v", err)
+	}
+	if len(role.Permissions()) != 2 || role.Description() != "Writes drafts" {
+		t.Errorf("got %v %q, want trimmed and deduplicated", role.Permissions(), role.Description())
+	}
+	if !role.Allows(PermPostsView) || role.Allows(PermPostsPublish) {
+		t.Error("role allows the wrong permissions")
+	}
+
+	for name, tc := range map[string]struct {
+		name  MemberRole
+		perms []Permission
+		want  error
+	}{
+		"owner wildcard":     {"superuser", []Permission{PermissionAll}, ErrInvalidPermission},
+		"unknown permission": {"mailer", []Permission{"mail.send"}, ErrInvalidPermission},
+		"built-in name":      {MemberRoleAdmin, nil, ErrInvalidRoleName},
+		"bad name":           {"Social Team", nil, ErrInvalidRoleName},
+	} {
+		if _, err := NewCustomRole(teamID, tc.name, "", tc.perms); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", name, err, tc.want)
+		}
+	}
+
+	system := ReconstructRole(uuid.New(), uuid.Nil, MemberRoleEditor, "", []Permission{"posts.*"}, true, time.Now(), time.Now())
+	if err := system.Update("", nil); !errors.Is(err, ErrSystemRoleReadOnly) {
+		t.Errorf("built-in role: err = %v, want ErrSystemRoleReadOnly", err)
+	}
+}
+
+func TestRoleCovers(t *testing.T) {
+	editor := ReconstructRole(uuid.New(), uuid.Nil, MemberRoleEditor, "", []Permission{"posts.*", PermAccountsView}, true, time.Now(), time.Now())
+	writer, _ := NewCustomRole(uuid.New(), "writer", "", []Permission{PermPostsCreate, PermAccountsView})
+	billing, _ := NewCustomRole(uuid.New(), "billing", "", []Permission{PermBillingManage})
+
+	if !editor.Covers(writer) {
+		t.Error("editor does not cover a subset of its permissions")
+	}
+	if editor.Covers(billing) {
+		t.Error("editor covers a permission it lacks")
+	}
+}
+
+type oneMember struct {
+	MemberRepository
+	member *Member
+}
+
+func (r oneMember) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*Member, error) {
+	if r.member.TeamID() != teamID || r.member.UserID() != userID {
+		return nil, ErrMemberNotFound
+	}
+	return r.member, nil
+}
+
+type fixedRole struct {
+	RoleRepository
+	permissions []Permission
+}
+
+func (r fixedRole) FindByName(ctx context.Context, teamID uuid.UUID, name MemberRole) (*Role, error) {
+	return ReconstructRole(uuid.New(), uuid.Nil, name, "", r.permissions, true, time.Now(), time.Now()), nil
+}
+
+func TestAuthorizerLimitsScopedRequests(t *testing.T) {
+	teamID, userID := uuid.New(), uuid.New()
+	member, err := NewMember(teamID, userID, userID, MemberRoleAdmin)
+	if err != nil {
+		t.Fatalf("NewMember: %v", err)
+	}
+	authorizer := NewAuthorizer(oneMember{member: member}, fixedRole{permissions: []Permission{"posts.*", PermAccountsManage}})
+	ctx := context.Background()
+
+	// Invited but not yet joined
+	if _, err := authorizer.Role(ctx, teamID, userID); !errors.Is(err, ErrMemberNotFound) {
+		t.Fatalf("pending member: err = %v, want ErrMemberNotFound", err)
+	}
+	if err := member.AcceptInvitation(); err != nil {
+		t.Fatalf("AcceptInvitation: %v", err)
+	}
+
+	if err := authorizer.Authorize(ctx, teamID, userID, PermAccountsManage); err != nil {
+		t.Errorf("session: %v", err)
+	}
+
+	scoped := WithScope(ctx, Scope{TeamID: teamID, Permissions: []Permission{PermPostsView, PermBillingManage}})
+	role, err := authorizer.Role(scoped, teamID, userID)
+	if err != nil {
+		t.Fatalf("Role: %v", err)
+	}
+	if !role.Allows(PermPostsView) {
+		t.Error("scope removed a permission both the role and scope grant")
+	}
+	for _, p := range []Permission{PermPostsCreate, PermAccountsManage, PermBillingManage} {
+		if role.Allows(p) {
+			t.Errorf("scoped role allows %s", p)
+		}
+	}
+
+	if _, err := authorizer.Role(WithScope(ctx, Scope{TeamID: uuid.New(), Permissions: []Permission{PermissionAll}}), teamID, userID); !errors.Is(err, ErrMemberNotFound) {
+		t.Errorf("scope for another team: err = %v, want ErrMemberNotFound", err)
+	}
+}
@@ -0,0 +1,166 @@
+// path: backend/internal/domain/team/role.go
+
+package team
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,49}$`)
+
+// Role is a named set of permissions. The built-in roles (owner, admin,
+// editor, viewer) are shared by all teams; teams can add custom roles.
+type Role struct {
+	id          uuid.UUID
+	teamID      uuid.UUID // uuid.Nil for built-in roles
+	name        MemberRole
+	description string
+	permissions []Permission
+	isSystem    bool
+	createdAt   time.Time
+	updatedAt   time.Time
+
+	// scopes further limit the role for requests made with an API key
+	scopes []Permission
+}
+
+// NewCustomRole creates a team role. Custom roles cannot grant "*", which
+// is what makes an owner.
+func NewCustomRole(teamID uuid.UUID, name MemberRole, description string, permissions []Permission) (*Role, error) {
+	if teamID == uuid.Nil {
+		return nil, ErrInvalidTeamID
+	}
+	if !roleNamePattern.MatchString(string(name)) || name.IsSystem() {
+		return nil, ErrInvalidRoleName
+	}
+
+	now := time.Now().UTC()
+	r := &Role{
+		id:        uuid.New(),
+		teamID:    teamID,
+		name:      name,
+		createdAt: now,
+	}
+	if err := r.Update(description, permissions); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ReconstructRole recreates a role from persistence
+func ReconstructRole(id, teamID uuid.UUID, name MemberRole, description string, permissions []Permission, isSystem bool, createdAt, updatedAt time.Time) *Role {
+	return &Role{
+		id:          id,
+		teamID:      teamID,
+		name:        name,
+		description: description,
+		permissions: permissions,
+		isSystem:    isSystem,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (r *Role) ID() uuid.UUID             { return r.id }
+func (r *Role) TeamID() uuid.UUID         { return r.teamID }
+func (r *Role) Name() MemberRole          { return r.name }
+func (r *Role) Description() string       { return r.description }
+func (r *Role) Permissions() []Permission { return r.permissions }
+func (r *Role) IsSystem() bool            { return r.isSystem }
+func (r *Role) CreatedAt() time.Time      { return r.createdAt }
+func (r *Role) UpdatedAt() time.Time      { return r.updatedAt }
+
+// Update replaces the description and permissions of a custom role
+func (r *Role) Update(description string, permissions []Permission) error {
+	if r.isSystem {
+		return ErrSystemRoleReadOnly
+	}
+
+	seen := make(map[Permission]bool, len(permissions))
+	cleaned := make([]Permission, 0, len(permissions))
+	for _, p := range permissions {
+		p = Permission(strings.TrimSpace(string(p)))
+		if p == PermissionAll || !p.IsKnown() {
+			return ErrInvalidPermission
+		}
+		if !seen[p] {
+			seen[p] = true
+			cleaned = append(cleaned, p)
+		}
+	}
+
+	r.description = strings.TrimSpace(description)
+	r.permissions = cleaned
+	r.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// Allows reports whether the role grants the permission
+func (r *Role) Allows(permission Permission) bool {
+	if r.scopes != nil && !anyGrants(r.scopes, permission) {
+		return false
+	}
+	return anyGrants(r.permissions, permission)
+}
+
+// limitTo returns a copy of the role that only allows what the scopes also
+// allow
+func (r *Role) limitTo(scopes []Permission) *Role {
+	limited := *r
+	limited.scopes = append([]Permission{}, scopes...)
+	return &limited
+}
+
+func anyGrants(granted []Permission, permission Permission) bool {
+	for _, g := range granted {
+		if g.grants(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether this role grants everything the other role does.
+// Members may only hand out roles their own role covers.
+func (r *Role) Covers(other *Role) bool {
+	for _, p := range other.permissions {
+		if !r.Allows(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSystem reports whether the role is one of the built-in roles
+func (role MemberRole) IsSystem() bool {
+	switch role {
+	case MemberRoleOwner, MemberRoleAdmin, MemberRoleEditor, MemberRoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// RoleRepository persists roles
+type RoleRepository interface {
+	// FindByName returns a built-in role or one of the team's custom roles,
+	// or ErrRoleNotFound
+	FindByName(ctx context.Context, teamID uuid.UUID, name MemberRole) (*Role, error)
+
+	// List returns the built-in roles followed by the team's custom roles
+	List(ctx context.Context, teamID uuid.UUID) ([]*Role, error)
+
+	// Create returns ErrRoleExists when the team already has the name
+	Create(ctx context.Context, role *Role) error
+	Update(ctx context.Context, role *Role) error
+
+	// Delete removes a custom role, or returns ErrRoleNotFound or
+	// ErrRoleInUse while members hold it
+	Delete(ctx context.Context, teamID uuid.UUID, name MemberRole) error
+}
@@ -0,0 +1,245 @@
+// path: backend/internal/domain/team/scim.go
+
+package team
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIMTokenPrefix marks SCIM bearer tokens so they are recognizable in
+// logs and secret scanners
+const SCIMTokenPrefix = "scim_"
+
+// SCIMToken authenticates a team's identity provider to the SCIM API. Only
+// a hash of the token is stored.
+type SCIMToken struct {
+	id         uuid.UUID
+	teamID     uuid.UUID
+	name       string
+	tokenHash  string
+	createdBy  uuid.UUID
+	createdAt  time.Time
+	lastUsedAt *time.Time
+}
+
+// NewSCIMToken creates a token and returns it with its plaintext value,
+// which is shown once
+func NewSCIMToken(teamID, createdBy uuid.UUID, name string) (*SCIMToken, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate scim token: %w", err)
+	}
+	raw := SCIMTokenPrefix + hex.EncodeToString(b)
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "SCIM"
+	}
+
+	return &SCIMToken{
+		id:        uuid.New(),
+		teamID:    teamID,
+		name:      name,
+		tokenHash: HashSCIMToken(raw),
+		createdBy: createdBy,
+		createdAt: time.Now().UTC(),
+	}, raw, nil
+}
+
+// ReconstructSCIMToken recreates a token from persistence
+func ReconstructSCIMToken(id, teamID uuid.UUID, name, tokenHash string, createdBy uuid.UUID, createdAt time.Time, lastUsedAt *time.Time) *SCIMToken {
+	return &SCIMToken{
+		id:         id,
+		teamID:     teamID,
+		name:       name,
+		tokenHash:  tokenHash,
+		createdBy:  createdBy,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+	}
+}
+
+// HashSCIMToken returns the stored form of a plaintext token
+func HashSCIMToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Getters
+func (t *SCIMToken) ID() uuid.UUID          { return t.id }
+func (t *SCIMToken) TeamID() uuid.UUID      { return t.teamID }
+func (t *SCIMToken) Name() string           { return t.name }
+func (t *SCIMToken) TokenHash() string      { return t.tokenHash }
+func (t *SCIMToken) CreatedBy() uuid.UUID   { return t.createdBy }
+func (t *SCIMToken) CreatedAt() time.Time   { return t.createdAt }
+func (t *SCIMToken) LastUsedAt() *time.Time { return t.lastUsedAt }
+
+// SCIMGroup is a group pushed by the identity provider. Team admins map a
+// group to a member role; members get the highest role of their groups.
+type SCIMGroup struct {
+	id          uuid.UUID
+	teamID      uuid.UUID
+	displayName string
+	externalID  string
+	role        MemberRole // empty when the group grants no role
+	memberIDs   []uuid.UUID
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewSCIMGroup creates a group without a role
+func NewSCIMGroup(teamID uuid.UUID, displayName, externalID string) (*SCIMGroup, error) {
+	g := &SCIMGroup{
+		id:        uuid.New(),
+		teamID:    teamID,
+		createdAt: time.Now().UTC(),
+	}
+	if err := g.Rename(displayName); err != nil {
+		return nil, err
+	}
+	g.externalID = externalID
+	g.updatedAt = g.createdAt
+	return g, nil
+}
+
+// ReconstructSCIMGroup recreates a group from persistence
+func ReconstructSCIMGroup(id, teamID uuid.UUID, displayName, externalID string, role MemberRole, memberIDs []uuid.UUID, createdAt, updatedAt time.Time) *SCIMGroup {
+	return &SCIMGroup{
+		id:          id,
+		teamID:      teamID,
+		displayName: displayName,
+		externalID:  externalID,
+		role:        role,
+		memberIDs:   memberIDs,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (g *SCIMGroup) ID() uuid.UUID          { return g.id }
+func (g *SCIMGroup) TeamID() uuid.UUID      { return g.teamID }
+func (g *SCIMGroup) DisplayName() string    { return g.displayName }
+func (g *SCIMGroup) ExternalID() string     { return g.externalID }
+func (g *SCIMGroup) Role() MemberRole       { return g.role }
+func (g *SCIMGroup) MemberIDs() []uuid.UUID { return g.memberIDs }
+func (g *SCIMGroup) CreatedAt() time.Time   { return g.createdAt }
+func (g *SCIMGroup) UpdatedAt() time.Time   { return g.updatedAt }
+
+// Rename changes the display name
+func (g *SCIMGroup) Rename(displayName string) error {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return fmt.Errorf("group displayName is required")
+	}
+	g.displayName = displayName
+	g.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetExternalID records the identity provider's ID for the group
+func (g *SCIMGroup) SetExternalID(externalID string) {
+	g.externalID = externalID
+	g.updatedAt = time.Now().UTC()
+}
+
+// SetRole maps the group to a member role; an empty role unmaps it.
+// Provisioning only grants built-in roles and never ownership.
+func (g *SCIMGroup) SetRole(role MemberRole) error {
+	if role != "" && !role.IsSystem() {
+		return ErrInvalidMemberRole
+	}
+	if role == MemberRoleOwner {
+		return ErrSSOOwnerRoleGrant
+	}
+	g.role = role
+	g.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetMembers replaces the member list
+func (g *SCIMGroup) SetMembers(userIDs []uuid.UUID) {
+	g.memberIDs = nil
+	g.AddMembers(userIDs)
+}
+
+// AddMembers adds users that are not yet in the group
+func (g *SCIMGroup) AddMembers(userIDs []uuid.UUID) {
+	for _, id := range userIDs {
+		if !g.HasMember(id) {
+			g.memberIDs = append(g.memberIDs, id)
+		}
+	}
+	g.updatedAt = time.Now().UTC()
+}
+
+// RemoveMembers removes users from the group
+func (g *SCIMGroup) RemoveMembers(userIDs []uuid.UUID) {
+	kept := g.memberIDs[:0]
+	for _, id := range g.memberIDs {
+		removed := false
+		for _, r := range userIDs {
+			if id == r {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			kept = append(kept, id)
+		}
+	}
+	g.memberIDs = kept
+	g.updatedAt = time.Now().UTC()
+}
+
+// HasMember reports whether the user is in the group
+func (g *SCIMGroup) HasMember(userID uuid.UUID) bool {
+	for _, id := range g.memberIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// ProvisionedRole returns the highest role granted by the groups, or the
+// viewer role when none of them grants one
+func ProvisionedRole(groups []*SCIMGroup) MemberRole {
+	rank := map[MemberRole]int{MemberRoleViewer: 1, MemberRoleEditor: 2, MemberRoleAdmin: 3}
+	best := MemberRoleViewer
+	for _, g := range groups {
+		if rank[g.role] > rank[best] {
+			best = g.role
+		}
+	}
+	return best
+}
+
+// SCIMRepository persists SCIM tokens and groups
+type SCIMRepository interface {
+	CreateToken(ctx context.Context, token *SCIMToken) error
+	// FindTokenByHash returns ErrSCIMTokenNotFound for unknown tokens
+	FindTokenByHash(ctx context.Context, tokenHash string) (*SCIMToken, error)
+	ListTokens(ctx context.Context, teamID uuid.UUID) ([]*SCIMToken, error)
+	TouchToken(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+	DeleteToken(ctx context.Context, teamID, id uuid.UUID) error
+
+	// CreateGroup returns ErrSCIMGroupExists for a duplicate display name
+	CreateGroup(ctx context.Context, group *SCIMGroup) error
+	UpdateGroup(ctx context.Context, group *SCIMGroup) error
+	// FindGroup returns ErrSCIMGroupNotFound when the group is not the team's
+	FindGroup(ctx context.Context, teamID, id uuid.UUID) (*SCIMGroup, error)
+	ListGroups(ctx context.Context, teamID uuid.UUID) ([]*SCIMGroup, error)
+	ListGroupsByMember(ctx context.Context, teamID, userID uuid.UUID) ([]*SCIMGroup, error)
+	DeleteGroup(ctx context.Context, teamID, id uuid.UUID) error
+	// RemoveMemberFromGroups drops the user from all of the team's groups
+	RemoveMemberFromGroups(ctx context.Context, teamID, userID uuid.UUID) error
+}
@@ -11,22 +11,36 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
 )
 
 // Service provides domain-level business logic for teams
 type Service struct {
 	repo       Repository
 	memberRepo MemberRepository
+	authorizer *Authorizer
+	events     event.Publisher // nil disables domain events
 }
 
 // NewService creates a new team domain service
-func NewService(repo Repository, memberRepo MemberRepository) *Service {
+func NewService(repo Repository, memberRepo MemberRepository, roleRepo RoleRepository, events event.Publisher) *Service {
 	return &Service{
 		repo:       repo,
 		memberRepo: memberRepo,
+		authorizer: NewAuthorizer(memberRepo, roleRepo),
+		events:     events,
 	}
 }
 
+// authorize checks a permission, reporting non-members as ErrUnauthorized
+func (s *Service) authorize(ctx context.Context, teamID, userID uuid.UUID, permission Permission) error {
+	err := s.authorizer.Authorize(ctx, teamID, userID, permission)
+	if err == ErrMemberNotFound {
+		return ErrUnauthorized
+	}
+	return err
+}
+
 // CreateTeamWithOwner creates a new team and adds the owner as the first member
 func (s *Service) CreateTeamWithOwner(ctx context.Context, name, slug, description string, ownerID uuid.UUID) (*Team, *Member, error) {
 	// Check if slug already exists
@@ -75,13 +89,8 @@ func (s *Service) CreateTeamWithOwner(ctx context.Context, name, slug, descripti
 // InviteUserToTeam creates an invitation for a user to join the team
 func (s *Service) InviteUserToTeam(ctx context.Context, teamID, inviterID uuid.UUID, userID uuid.UUID, role MemberRole) (*Member, error) {
 	// Verify inviter has permission
-	inviter, err := s.memberRepo.FindMember(ctx, teamID, inviterID)
-	if err != nil {
-		return nil, ErrUnauthorized
-	}
-
-	if !inviter.CanInviteMembers() {
-		return nil, ErrInsufficientPermissions
+	if err := s.authorize(ctx, teamID, inviterID, PermMembersInvite); err != nil {
+		return nil, err
 	}
 
 	// Check if user is already a member
@@ -147,13 +156,8 @@ func (s *Service) AcceptInvitation(ctx context.Context, teamID, userID uuid.UUID
 // RemoveMemberFromTeam removes a member from the team
 func (s *Service) RemoveMemberFromTeam(ctx context.Context, teamID, removerID, userID uuid.UUID) error {
 	// Check remover's permission
-	remover, err := s.memberRepo.FindMember(ctx, teamID, removerID)
-	if err != nil {
-		return ErrUnauthorized
-	}
-
-	if !remover.CanManageMembers() {
-		return ErrInsufficientPermissions
+	if err := s.authorize(ctx, teamID, removerID, PermMembersManage); err != nil {
+		return err
 	}
 
 	// Get member to remove
@@ -190,13 +194,8 @@ func (s *Service) RemoveMemberFromTeam(ctx context.Context, teamID, removerID, u
 // ChangeMemberRole changes a team member's role
 func (s *Service) ChangeMemberRole(ctx context.Context, teamID, changerID, userID uuid.UUID, newRole MemberRole) error {
 	// Check changer's permission
-	changer, err := s.memberRepo.FindMember(ctx, teamID, changerID)
-	if err != nil {
-		return ErrUnauthorized
-	}
-
-	if !changer.CanManageMembers() {
-		return ErrInsufficientPermissions
+	if err := s.authorize(ctx, teamID, changerID, PermMembersManage); err != nil {
+		return err
 	}
 
 	// Get member to update
@@ -286,38 +285,42 @@ func (s *Service) TransferTeamOwnership(ctx context.Context, teamID, currentOwne
 	return nil
 }
 
-// UpgradeTeamPlan upgrades a team's subscription plan
-func (s *Service) UpgradeTeamPlan(ctx context.Context, teamID uuid.UUID, newPlan Plan, upgradedBy uuid.UUID) error {
-	// Verify upgrader has permission
-	member, err := s.memberRepo.FindMember(ctx, teamID, upgradedBy)
+// ChangeTeamPlan moves a team to another subscription plan, upgrading or
+// downgrading its limits. Only platform operators change plans, so the
+// caller authorizes changedBy. Callers that need the event committed with
+// the change run this inside a transaction carried by ctx.
+func (s *Service) ChangeTeamPlan(ctx context.Context, teamID uuid.UUID, newPlan Plan, changedBy uuid.UUID) (*Team, Plan, error) {
+	team, err := s.repo.FindByID(ctx, teamID)
 	if err != nil {
-		return ErrUnauthorized
+		return nil, "", ErrTeamNotFound
 	}
 
-	if !member.CanManageBilling() {
-		return ErrInsufficientPermissions
+	oldPlan := team.Plan()
+	if isValidPlan(newPlan) && canDowngrade(oldPlan, newPlan) {
+		err = team.DowngradePlan(newPlan)
+	} else {
+		err = team.UpgradePlan(newPlan)
 	}
-
-	// Get team
-	team, err := s.repo.FindByID(ctx, teamID)
 	if err != nil {
-		return ErrTeamNotFound
+		return nil, "", err
 	}
 
-	// Upgrade plan
-	if err := team.UpgradePlan(newPlan); err != nil {
-		return err
-	}
-
-	// Update team
 	if err := s.repo.Update(ctx, team); err != nil {
-		return fmt.Errorf("failed to upgrade plan: %w", err)
+		return nil, "", fmt.Errorf("failed to change plan: %w", err)
 	}
 
-	// TODO: Emit domain event for billing system
-	// events.Publish(TeamPlanUpgradedEvent{TeamID: teamID, OldPlan: oldPlan, NewPlan: newPlan})
+	if s.events != nil {
+		if err := s.events.Publish(ctx, PlanChanged{
+			Meta:      event.NewMeta(teamID),
+			OldPlan:   oldPlan,
+			NewPlan:   newPlan,
+			ChangedBy: changedBy,
+		}); err != nil {
+			return nil, "", fmt.Errorf("failed to publish plan change: %w", err)
+		}
+	}
 
-	return nil
+	return team, oldPlan, nil
 }
 
 // SuspendTeam suspends a team (typically for non-payment)
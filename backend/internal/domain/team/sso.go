@@ -0,0 +1,313 @@
+// path: backend/internal/domain/team/sso.go
+
+package team
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SSOConfig is a team's SAML 2.0 identity provider and how its assertions
+// map onto users and memberships
+type SSOConfig struct {
+	teamID uuid.UUID
+
+	// Identity provider
+	idpEntityID     string
+	idpSSOURL       string
+	idpCertificates []string // base64 DER signing certificates
+
+	// Attribute names in the assertion; email falls back to the NameID
+	emailAttribute     string
+	firstNameAttribute string
+	lastNameAttribute  string
+	roleAttribute      string
+	roleMapping        map[string]MemberRole // IdP value -> team role
+	defaultRole        MemberRole
+
+	domains    []SSODomain // email domains claimed for this IdP
+	enforceSSO bool
+	enabled    bool
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// SSO domain ownership is proven with a TXT record named
+// SSODomainRecordPrefix + domain holding SSODomainRecordValuePrefix + token
+const (
+	SSODomainRecordPrefix      = "_socialqueue-verification."
+	SSODomainRecordValuePrefix = "socialqueue-domain-verification="
+)
+
+// SSODomain is an email domain claimed by a team's SSO. Only a verified
+// domain routes logins, provisions accounts and enforces SSO.
+type SSODomain struct {
+	Domain            string
+	VerificationToken string
+	VerifiedAt        *time.Time
+}
+
+// IsVerified reports whether the team proved it owns the domain
+func (d SSODomain) IsVerified() bool { return d.VerifiedAt != nil }
+
+// RecordName is the DNS name of the verification TXT record
+func (d SSODomain) RecordName() string { return SSODomainRecordPrefix + d.Domain }
+
+// RecordValue is the expected value of the verification TXT record
+func (d SSODomain) RecordValue() string { return SSODomainRecordValuePrefix + d.VerificationToken }
+
+// SSOAttributeMapping configures how assertion attributes are read
+type SSOAttributeMapping struct {
+	Email       string                `json:"email"`
+	FirstName   string                `json:"firstName"`
+	LastName    string                `json:"lastName"`
+	Role        string                `json:"role"`
+	RoleMapping map[string]MemberRole `json:"roleMapping"`
+}
+
+// NewSSOConfig creates a disabled configuration for a team's identity
+// provider
+func NewSSOConfig(teamID uuid.UUID, idpEntityID, idpSSOURL string, certificates []string) (*SSOConfig, error) {
+	now := time.Now().UTC()
+	c := &SSOConfig{
+		teamID:      teamID,
+		defaultRole: MemberRoleViewer,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+	if err := c.SetIdentityProvider(idpEntityID, idpSSOURL, certificates); err != nil {
+		return nil, err
+	}
+	c.SetAttributeMapping(SSOAttributeMapping{})
+	return c, nil
+}
+
+// ReconstructSSOConfig recreates a configuration from persistence
+func ReconstructSSOConfig(
+	teamID uuid.UUID,
+	idpEntityID, idpSSOURL string,
+	idpCertificates []string,
+	mapping SSOAttributeMapping,
+	defaultRole MemberRole,
+	domains []SSODomain,
+	enforceSSO, enabled bool,
+	createdAt, updatedAt time.Time,
+) *SSOConfig {
+	return &SSOConfig{
+		teamID:             teamID,
+		idpEntityID:        idpEntityID,
+		idpSSOURL:          idpSSOURL,
+		idpCertificates:    idpCertificates,
+		emailAttribute:     mapping.Email,
+		firstNameAttribute: mapping.FirstName,
+		lastNameAttribute:  mapping.LastName,
+		roleAttribute:      mapping.Role,
+		roleMapping:        mapping.RoleMapping,
+		defaultRole:        defaultRole,
+		domains:            domains,
+		enforceSSO:         enforceSSO,
+		enabled:            enabled,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// Getters
+func (c *SSOConfig) TeamID() uuid.UUID          { return c.teamID }
+func (c *SSOConfig) IdPEntityID() string        { return c.idpEntityID }
+func (c *SSOConfig) IdPSSOURL() string          { return c.idpSSOURL }
+func (c *SSOConfig) IdPCertificates() []string  { return c.idpCertificates }
+func (c *SSOConfig) DefaultRole() MemberRole    { return c.defaultRole }
+func (c *SSOConfig) Domains() []SSODomain       { return c.domains }
+func (c *SSOConfig) EnforceSSO() bool           { return c.enforceSSO }
+func (c *SSOConfig) IsEnabled() bool            { return c.enabled }
+func (c *SSOConfig) CreatedAt() time.Time       { return c.createdAt }
+func (c *SSOConfig) UpdatedAt() time.Time       { return c.updatedAt }
+func (c *SSOConfig) EmailAttribute() string     { return c.emailAttribute }
+func (c *SSOConfig) FirstNameAttribute() string { return c.firstNameAttribute }
+func (c *SSOConfig) LastNameAttribute() string  { return c.lastNameAttribute }
+func (c *SSOConfig) RoleAttribute() string      { return c.roleAttribute }
+
+// AttributeMapping returns the attribute configuration
+func (c *SSOConfig) AttributeMapping() SSOAttributeMapping {
+	return SSOAttributeMapping{
+		Email:       c.emailAttribute,
+		FirstName:   c.firstNameAttribute,
+		LastName:    c.lastNameAttribute,
+		Role:        c.roleAttribute,
+		RoleMapping: c.roleMapping,
+	}
+}
+
+// SetIdentityProvider replaces the IdP endpoint and signing certificates
+func (c *SSOConfig) SetIdentityProvider(entityID, ssoURL string, certificates []string) error {
+	u, err := url.Parse(ssoURL)
+	if strings.TrimSpace(entityID) == "" || err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return ErrInvalidSSOConfig
+	}
+	if len(certificates) == 0 {
+		return ErrInvalidSSOConfig
+	}
+
+	c.idpEntityID = strings.TrimSpace(entityID)
+	c.idpSSOURL = ssoURL
+	c.idpCertificates = certificates
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetAttributeMapping changes how assertion attributes are read. Empty
+// names fall back to common defaults.
+func (c *SSOConfig) SetAttributeMapping(m SSOAttributeMapping) {
+	c.emailAttribute = defaultString(m.Email, "email")
+	c.firstNameAttribute = defaultString(m.FirstName, "firstName")
+	c.lastNameAttribute = defaultString(m.LastName, "lastName")
+	c.roleAttribute = strings.TrimSpace(m.Role)
+	c.roleMapping = m.RoleMapping
+	c.updatedAt = time.Now().UTC()
+}
+
+// SetDefaultRole sets the role given to provisioned members whose
+// assertion maps to no role. SSO only grants built-in roles and never
+// ownership.
+func (c *SSOConfig) SetDefaultRole(role MemberRole) error {
+	if !role.IsSystem() {
+		return ErrInvalidMemberRole
+	}
+	if role == MemberRoleOwner {
+		return ErrSSOOwnerRoleGrant
+	}
+	c.defaultRole = role
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetDomains sets the email domains claimed for this IdP. Domains already
+// claimed keep their verification; new ones must be verified.
+func (c *SSOConfig) SetDomains(domains []string) error {
+	claimed := make([]SSODomain, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d == "" || strings.ContainsAny(d, "@/ ") || !strings.Contains(d, ".") {
+			return ErrInvalidSSOConfig
+		}
+		if existing, ok := c.domain(d); ok {
+			claimed = append(claimed, existing)
+			continue
+		}
+
+		token := make([]byte, 16)
+		if _, err := rand.Read(token); err != nil {
+			return err
+		}
+		claimed = append(claimed, SSODomain{Domain: d, VerificationToken: hex.EncodeToString(token)})
+	}
+	c.domains = claimed
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// VerifyDomain records that the team proved it owns a claimed domain, after
+// finding one of txtRecords to be the domain's RecordValue
+func (c *SSOConfig) VerifyDomain(domain string, txtRecords []string, now time.Time) error {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	for i, d := range c.domains {
+		if d.Domain != domain {
+			continue
+		}
+		if d.IsVerified() {
+			return nil
+		}
+		for _, record := range txtRecords {
+			if strings.TrimSpace(record) == d.RecordValue() {
+				verifiedAt := now.UTC()
+				c.domains[i].VerifiedAt = &verifiedAt
+				c.updatedAt = now.UTC()
+				return nil
+			}
+		}
+		return ErrSSODomainNotVerified
+	}
+	return ErrSSODomainNotClaimed
+}
+
+func (c *SSOConfig) domain(name string) (SSODomain, bool) {
+	for _, d := range c.domains {
+		if d.Domain == name {
+			return d, true
+		}
+	}
+	return SSODomain{}, false
+}
+
+// SetEnforceSSO blocks password logins for members in the team's domains
+func (c *SSOConfig) SetEnforceSSO(enforce bool) {
+	c.enforceSSO = enforce
+	c.updatedAt = time.Now().UTC()
+}
+
+// SetEnabled turns SSO logins on or off
+func (c *SSOConfig) SetEnabled(enabled bool) error {
+	if enabled && len(c.domains) == 0 {
+		return ErrInvalidSSOConfig
+	}
+	c.enabled = enabled
+	c.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// HandlesEmail reports whether the address is in one of the team's
+// verified domains
+func (c *SSOConfig) HandlesEmail(email string) bool {
+	_, domain, ok := strings.Cut(strings.ToLower(email), "@")
+	if !ok {
+		return false
+	}
+	d, ok := c.domain(domain)
+	return ok && d.IsVerified()
+}
+
+// RequiresSSO reports whether password logins are blocked for the address
+func (c *SSOConfig) RequiresSSO(email string) bool {
+	return c.enabled && c.enforceSSO && c.HandlesEmail(email)
+}
+
+// RoleFor maps IdP role values to a team role. The first mapped value
+// wins; without one the default role applies. Owner is never granted.
+func (c *SSOConfig) RoleFor(values []string) MemberRole {
+	for _, v := range values {
+		if role, ok := c.roleMapping[v]; ok && role.IsSystem() && role != MemberRoleOwner {
+			return role
+		}
+	}
+	return c.defaultRole
+}
+
+func defaultString(s, fallback string) string {
+	if s = strings.TrimSpace(s); s != "" {
+		return s
+	}
+	return fallback
+}
+
+// SSORepository persists team SSO configurations
+type SSORepository interface {
+	// FindByTeamID returns ErrSSONotConfigured when the team has none
+	FindByTeamID(ctx context.Context, teamID uuid.UUID) (*SSOConfig, error)
+
+	// FindByDomain returns the configuration that verified an email
+	// domain, or ErrSSONotConfigured
+	FindByDomain(ctx context.Context, domain string) (*SSOConfig, error)
+
+	// Save returns ErrSSODomainTaken when another team verified one of the
+	// configuration's verified domains
+	Save(ctx context.Context, config *SSOConfig) error
+	Delete(ctx context.Context, teamID uuid.UUID) error
+}
@@ -0,0 +1,54 @@
+// path: backend/internal/domain/team/sso_test.go
+package team
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSSODomainVerification(t *testing.T) {
+	c, err := NewSSOConfig(uuid.New(), "https://idp.example.com", "https://idp.example.com/sso", []string{"cert"})
+	if err != nil {
+		t.Fatalf("NewSSOConfig: %v", err)
+	}
+	if err := c.SetDomains([]string{"Acme.com"}); err != nil {
+		t.Fatalf("SetDomains: %v", err)
+	}
+	if err := c.SetEnabled(true); err != nil {
+		t.Fatalf("SetEnabled: %v", err)
+	}
+	c.SetEnforceSSO(true)
+
+	// A claimed domain does nothing until its TXT record is published
+	if c.HandlesEmail("ana@acme.com") || c.RequiresSSO("ana@acme.com") {
+		t.Fatal("unverified domain handles logins")
+	}
+	d := c.Domains()[0]
+	if d.RecordName() != "_socialqueue-verification.acme.com" {
+		t.Errorf("RecordName = %s", d.RecordName())
+	}
+
+	if err := c.VerifyDomain("acme.com", []string{"v=spf1 -all"}, time.Now()); !errors.Is(err, ErrSSODomainNotVerified) {
+		t.Fatalf("wrong record: err = %v, want ErrSSODomainNotVerified", err)
+	}
+	if err := c.VerifyDomain("other.com", []string{d.RecordValue()}, time.Now()); !errors.Is(err, ErrSSODomainNotClaimed) {
+		t.Fatalf("unclaimed: err = %v, want ErrSSODomainNotClaimed", err)
+	}
+	if err := c.VerifyDomain("acme.com", []string{d.RecordValue()}, time.Now()); err != nil {
+		t.Fatalf("VerifyDomain: %v", err)
+	}
+	if !c.RequiresSSO("ana@acme.com") {
+		t.Error("verified domain does not enforce SSO")
+	}
+
+	// Re-saving the same domains keeps the verification
+	if err := c.SetDomains([]string{"acme.com", "acme.io"}); err != nil {
+		t.Fatalf("SetDomains: %v", err)
+	}
+	if !c.HandlesEmail("ana@acme.com") || c.HandlesEmail("ana@acme.io") {
+		t.Error("SetDomains changed verification")
+	}
+}
@@ -55,6 +55,7 @@ type TeamSettings struct {
 	EnableNotifications bool
 	EnableAnalytics     bool
 	RequireApproval     bool // Require approval before posting
+	RequireTwoFactor    bool // Members must sign in with two-factor authentication
 	AutoSchedule        bool
 	Language            string
 	DateFormat          string
@@ -270,6 +271,17 @@ func (t *Team) UpdateSettings(settings TeamSettings) error {
 	return nil
 }
 
+// SetRequireTwoFactor turns the team's two-factor requirement on or off
+func (t *Team) SetRequireTwoFactor(required bool) {
+	t.settings.RequireTwoFactor = required
+	t.updatedAt = time.Now().UTC()
+}
+
+// RequiresTwoFactor reports whether members must use two-factor sign-in
+func (t *Team) RequiresTwoFactor() bool {
+	return t.settings.RequireTwoFactor
+}
+
 // Suspend suspends the team (e.g., for non-payment)
 func (t *Team) Suspend(reason string) error {
 	if t.status == StatusSuspended {
@@ -390,6 +402,14 @@ func (t *Team) HasFeature(feature string) bool {
 		return t.plan == PlanEnterprise
 	case "priority_support":
 		return t.plan == PlanProfessional || t.plan == PlanEnterprise
+	case "custom_roles":
+		return t.plan == PlanProfessional || t.plan == PlanEnterprise
+	case "webhooks":
+		return t.plan == PlanProfessional || t.plan == PlanEnterprise
+	case "inbox":
+		return t.plan == PlanProfessional || t.plan == PlanEnterprise
+	case "sso", "scim", "audit_log":
+		return t.plan == PlanEnterprise
 	default:
 		return false
 	}
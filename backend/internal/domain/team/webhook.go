@@ -0,0 +1,478 @@
+// path: backend/internal/domain/team/webhook.go
+
+package team
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+// WebhookSecretPrefix marks signing secrets so they are recognizable
+const WebhookSecretPrefix = "whsec_"
+
+// Headers sent with every webhook request
+const (
+	WebhookSignatureHeader = "X-SocialQueue-Signature"
+	WebhookTimestampHeader = "X-SocialQueue-Timestamp"
+	WebhookEventHeader     = "X-SocialQueue-Event"
+	WebhookDeliveryHeader  = "X-SocialQueue-Delivery"
+)
+
+// WebhookTestEvent is the event type sent by "send test event"
+const WebhookTestEvent = "webhook.test"
+
+// WebhookEventTypes are the events endpoints can subscribe to
+var WebhookEventTypes = []string{
+	"post.scheduled",
+	"post.published",
+	"post.failed",
+	"social.account_disconnected",
+	"social.account_revoked",
+	"team.member_invited",
+}
+
+// An endpoint is disabled once it has failed this many attempts in a row
+// over at least this long
+const (
+	WebhookDisableAfterFailures = 20
+	WebhookDisableAfterPeriod   = 24 * time.Hour
+)
+
+// WebhookAttemptLease is how long a delivery being attempted is hidden
+// from other workers
+const WebhookAttemptLease = 2 * time.Minute
+
+// WebhookRetrySchedule is the delay before each retry of a failed delivery.
+// A delivery is given up once the schedule is exhausted.
+var WebhookRetrySchedule = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+}
+
+// Webhook is an HTTPS endpoint of a team that receives the events it
+// subscribes to, signed with its secret
+type Webhook struct {
+	id             uuid.UUID
+	teamID         uuid.UUID
+	url            string
+	description    string
+	secret         string
+	eventTypes     []string
+	active         bool
+	failureCount   int
+	failingSince   *time.Time
+	disabledAt     *time.Time
+	disabledReason string
+	createdBy      uuid.UUID
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewWebhook creates an active endpoint with a new signing secret
+func NewWebhook(teamID, createdBy uuid.UUID, rawURL, description string, eventTypes []string) (*Webhook, error) {
+	w := &Webhook{
+		id:        uuid.New(),
+		teamID:    teamID,
+		active:    true,
+		createdBy: createdBy,
+	}
+	if err := w.Update(rawURL, description, eventTypes); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	w.secret = secret
+	w.createdAt = w.updatedAt
+	return w, nil
+}
+
+// ReconstructWebhook recreates an endpoint from persistence
+func ReconstructWebhook(id, teamID uuid.UUID, url, description, secret string, eventTypes []string, active bool, failureCount int, failingSince, disabledAt *time.Time, disabledReason string, createdBy uuid.UUID, createdAt, updatedAt time.Time) *Webhook {
+	return &Webhook{
+		id:             id,
+		teamID:         teamID,
+		url:            url,
+		description:    description,
+		secret:         secret,
+		eventTypes:     eventTypes,
+		active:         active,
+		failureCount:   failureCount,
+		failingSince:   failingSince,
+		disabledAt:     disabledAt,
+		disabledReason: disabledReason,
+		createdBy:      createdBy,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return WebhookSecretPrefix + hex.EncodeToString(b), nil
+}
+
+// Getters
+func (w *Webhook) ID() uuid.UUID            { return w.id }
+func (w *Webhook) TeamID() uuid.UUID        { return w.teamID }
+func (w *Webhook) URL() string              { return w.url }
+func (w *Webhook) Description() string      { return w.description }
+func (w *Webhook) Secret() string           { return w.secret }
+func (w *Webhook) EventTypes() []string     { return w.eventTypes }
+func (w *Webhook) IsActive() bool           { return w.active }
+func (w *Webhook) FailureCount() int        { return w.failureCount }
+func (w *Webhook) FailingSince() *time.Time { return w.failingSince }
+func (w *Webhook) DisabledAt() *time.Time   { return w.disabledAt }
+func (w *Webhook) DisabledReason() string   { return w.disabledReason }
+func (w *Webhook) CreatedBy() uuid.UUID     { return w.createdBy }
+func (w *Webhook) CreatedAt() time.Time     { return w.createdAt }
+func (w *Webhook) UpdatedAt() time.Time     { return w.updatedAt }
+
+// Update changes where the endpoint is and what it receives
+func (w *Webhook) Update(rawURL, description string, eventTypes []string) error {
+	rawURL = strings.TrimSpace(rawURL)
+	if !validWebhookURL(rawURL) {
+		return ErrInvalidWebhookURL
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > 255 {
+		return ErrInvalidWebhookDescription
+	}
+
+	seen := make(map[string]bool, len(eventTypes))
+	cleaned := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		t = strings.TrimSpace(t)
+		if !IsWebhookEventType(t) {
+			return fmt.Errorf("%w: %s", ErrInvalidWebhookEvent, t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			cleaned = append(cleaned, t)
+		}
+	}
+	if len(cleaned) == 0 {
+		return ErrWebhookEventsMissing
+	}
+
+	w.url = rawURL
+	w.description = description
+	w.eventTypes = cleaned
+	w.updatedAt = time.Now().UTC()
+	return nil
+}
+
+// validWebhookURL accepts absolute https URLs, and http for local testing
+func validWebhookURL(raw string) bool {
+	if len(raw) > 2048 {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.User != nil || u.Fragment != "" {
+		return false
+	}
+	return u.Scheme == "https" || u.Scheme == "http"
+}
+
+// IsWebhookEventType reports whether endpoints can subscribe to an event
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribes reports whether the endpoint receives an event type
+func (w *Webhook) Subscribes(eventType string) bool {
+	for _, t := range w.eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Enable turns the endpoint back on and forgets earlier failures
+func (w *Webhook) Enable() {
+	w.active = true
+	w.failureCount = 0
+	w.failingSince = nil
+	w.disabledAt = nil
+	w.disabledReason = ""
+	w.updatedAt = time.Now().UTC()
+}
+
+// Disable stops deliveries to the endpoint
+func (w *Webhook) Disable(reason string) {
+	now := time.Now().UTC()
+	w.active = false
+	w.disabledAt = &now
+	w.disabledReason = reason
+	w.updatedAt = now
+}
+
+// RecordSuccess resets the failure streak after a delivered attempt
+func (w *Webhook) RecordSuccess() {
+	w.failureCount = 0
+	w.failingSince = nil
+}
+
+// RecordFailure counts a failed attempt and disables the endpoint once it
+// has been failing for WebhookDisableAfterFailures attempts over at least
+// WebhookDisableAfterPeriod. It reports whether the endpoint was disabled.
+func (w *Webhook) RecordFailure(now time.Time) bool {
+	w.failureCount++
+	if w.failingSince == nil {
+		w.failingSince = &now
+	}
+	if !w.active || w.failureCount < WebhookDisableAfterFailures || now.Sub(*w.failingSince) < WebhookDisableAfterPeriod {
+		return false
+	}
+	w.Disable(fmt.Sprintf("%d consecutive failed deliveries since %s", w.failureCount, w.failingSince.Format(time.RFC3339)))
+	return true
+}
+
+// ============================================================================
+// SIGNING
+// ============================================================================
+
+// SignWebhook returns the signature header value for a payload sent at
+// timestamp: "sha256=" and the hex HMAC-SHA256 of "<timestamp>.<body>".
+// Including the timestamp lets receivers reject replayed requests.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks a signature made by SignWebhook, rejecting
+// timestamps further than tolerance from now
+func VerifyWebhookSignature(secret, signature, timestamp string, body []byte, tolerance time.Duration, now time.Time) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if skew := now.Sub(time.Unix(ts, 0)); skew > tolerance || skew < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(SignWebhook(secret, ts, body)))
+}
+
+// WebhookPayload is the JSON body of a webhook request
+type WebhookPayload struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	TeamID     uuid.UUID       `json:"teamId"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewWebhookPayload encodes an event as a webhook body
+func NewWebhookPayload(e *event.Envelope) ([]byte, error) {
+	body, err := json.Marshal(WebhookPayload{
+		ID:         e.ID,
+		Type:       e.EventType,
+		TeamID:     e.Team,
+		OccurredAt: e.At,
+		Data:       e.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return body, nil
+}
+
+// ============================================================================
+// DELIVERIES
+// ============================================================================
+
+// Delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// maxResponseBody is how much of a response body a delivery keeps
+const maxResponseBody = 2048
+
+// WebhookDelivery is one event sent to one endpoint, with the outcome of
+// its latest attempt
+type WebhookDelivery struct {
+	id             uuid.UUID
+	webhookID      uuid.UUID
+	teamID         uuid.UUID
+	eventID        uuid.UUID
+	eventType      string
+	payload        []byte
+	status         string
+	attempts       int
+	nextAttemptAt  *time.Time
+	responseStatus int
+	responseBody   string
+	lastError      string
+	duration       time.Duration
+	createdAt      time.Time
+	completedAt    *time.Time
+}
+
+// NewWebhookDelivery creates a pending delivery of an encoded event. Its
+// creator makes the first attempt; should it never record one, the
+// delivery is retried once WebhookAttemptLease has passed.
+func NewWebhookDelivery(w *Webhook, e *event.Envelope, payload []byte) *WebhookDelivery {
+	now := time.Now().UTC()
+	leased := now.Add(WebhookAttemptLease)
+	return &WebhookDelivery{
+		id:            uuid.New(),
+		webhookID:     w.ID(),
+		teamID:        w.TeamID(),
+		eventID:       e.ID,
+		eventType:     e.EventType,
+		payload:       payload,
+		status:        DeliveryPending,
+		nextAttemptAt: &leased,
+		createdAt:     now,
+	}
+}
+
+// ReconstructWebhookDelivery recreates a delivery from persistence
+func ReconstructWebhookDelivery(id, webhookID, teamID, eventID uuid.UUID, eventType string, payload []byte, status string, attempts int, nextAttemptAt *time.Time, responseStatus int, responseBody, lastError string, duration time.Duration, createdAt time.Time, completedAt *time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		id:             id,
+		webhookID:      webhookID,
+		teamID:         teamID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		responseStatus: responseStatus,
+		responseBody:   responseBody,
+		lastError:      lastError,
+		duration:       duration,
+		createdAt:      createdAt,
+		completedAt:    completedAt,
+	}
+}
+
+// Getters
+func (d *WebhookDelivery) ID() uuid.UUID             { return d.id }
+func (d *WebhookDelivery) WebhookID() uuid.UUID      { return d.webhookID }
+func (d *WebhookDelivery) TeamID() uuid.UUID         { return d.teamID }
+func (d *WebhookDelivery) EventID() uuid.UUID        { return d.eventID }
+func (d *WebhookDelivery) EventType() string         { return d.eventType }
+func (d *WebhookDelivery) Payload() []byte           { return d.payload }
+func (d *WebhookDelivery) Status() string            { return d.status }
+func (d *WebhookDelivery) Attempts() int             { return d.attempts }
+func (d *WebhookDelivery) NextAttemptAt() *time.Time { return d.nextAttemptAt }
+func (d *WebhookDelivery) ResponseStatus() int       { return d.responseStatus }
+func (d *WebhookDelivery) ResponseBody() string      { return d.responseBody }
+func (d *WebhookDelivery) LastError() string         { return d.lastError }
+func (d *WebhookDelivery) Duration() time.Duration   { return d.duration }
+func (d *WebhookDelivery) CreatedAt() time.Time      { return d.createdAt }
+func (d *WebhookDelivery) CompletedAt() *time.Time   { return d.completedAt }
+
+// RecordAttempt stores the outcome of an attempt. A 2xx response succeeds;
+// anything else schedules the next retry, or fails the delivery when
+// retry is false or the retry schedule is exhausted. It reports whether
+// the attempt succeeded.
+func (d *WebhookDelivery) RecordAttempt(status int, body string, attemptErr error, duration time.Duration, now time.Time, retry bool) bool {
+	d.attempts++
+	d.responseStatus = status
+	if len(body) > maxResponseBody {
+		body = body[:maxResponseBody]
+	}
+	d.responseBody = body
+	d.duration = duration
+	d.lastError = ""
+
+	succeeded := attemptErr == nil && status >= 200 && status < 300
+	switch {
+	case succeeded:
+		d.status = DeliverySucceeded
+	case attemptErr != nil:
+		d.lastError = attemptErr.Error()
+	default:
+		d.lastError = fmt.Sprintf("endpoint responded with status %d", status)
+	}
+
+	if succeeded || !retry || d.attempts > len(WebhookRetrySchedule) {
+		if !succeeded {
+			d.status = DeliveryFailed
+		}
+		d.nextAttemptAt = nil
+		d.completedAt = &now
+		return succeeded
+	}
+
+	next := now.Add(WebhookRetrySchedule[d.attempts-1])
+	d.nextAttemptAt = &next
+	return false
+}
+
+// Abandon fails a pending delivery without another attempt
+func (d *WebhookDelivery) Abandon(reason string, now time.Time) {
+	d.status = DeliveryFailed
+	d.lastError = reason
+	d.nextAttemptAt = nil
+	d.completedAt = &now
+}
+
+// ============================================================================
+// REPOSITORIES
+// ============================================================================
+
+// WebhookRepository persists webhook endpoints
+type WebhookRepository interface {
+	Create(ctx context.Context, w *Webhook) error
+	// FindByID returns ErrWebhookNotFound for unknown endpoints
+	FindByID(ctx context.Context, id uuid.UUID) (*Webhook, error)
+	ListByTeam(ctx context.Context, teamID uuid.UUID) ([]*Webhook, error)
+	// ListSubscribed returns the team's active endpoints receiving eventType
+	ListSubscribed(ctx context.Context, teamID uuid.UUID, eventType string) ([]*Webhook, error)
+	Update(ctx context.Context, w *Webhook) error
+	// UpdateHealth saves only the failure streak and disabled state, so
+	// deliveries do not overwrite concurrent edits
+	UpdateHealth(ctx context.Context, w *Webhook) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WebhookDeliveryRepository persists deliveries
+type WebhookDeliveryRepository interface {
+	// Create stores a delivery and reports false when the event was already
+	// delivered to the endpoint
+	Create(ctx context.Context, d *WebhookDelivery) (bool, error)
+	Update(ctx context.Context, d *WebhookDelivery) error
+	// ListByWebhook returns the endpoint's latest deliveries
+	ListByWebhook(ctx context.Context, webhookID uuid.UUID, limit int) ([]*WebhookDelivery, error)
+	// ClaimDue returns pending deliveries whose retry is due and hides them
+	// from other workers for lease
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+}
@@ -69,9 +69,30 @@ var (
 	ErrTokenNotFound    = errors.New("token not found")
 
 	// Session-related errors
-	ErrSessionExpired  = errors.New("session has expired")
-	ErrSessionNotFound = errors.New("session not found")
-	ErrInvalidSession  = errors.New("invalid session")
+	ErrSessionExpired     = errors.New("session has expired")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrInvalidSession     = errors.New("invalid session")
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+
+	// Two-factor errors
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorRequired       = errors.New("two-factor authentication is required by your team")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrInvalidRecoveryCode     = errors.New("invalid recovery code")
+
+	// Passkey errors
+	ErrPasskeyNotFound    = errors.New("passkey not found")
+	ErrPasskeyExists      = errors.New("passkey is already registered")
+	ErrPasskeyCloned      = errors.New("passkey signature counter went backwards; it may have been cloned")
+	ErrInvalidPasskeyName = errors.New("passkey name must be 1 to 100 characters")
+
+	// External identity (OIDC) errors
+	ErrIdentityNotFound      = errors.New("linked account not found")
+	ErrIdentityAlreadyLinked = errors.New("this provider account is already linked to another user")
+	ErrAccountLinkRequired   = errors.New("an account with this email already exists; sign in and link the provider from your settings")
+	ErrLastSignInMethod      = errors.New("cannot remove the last way to sign in")
+	ErrPasswordAlreadySet    = errors.New("password is already set")
 
 	// Rate limiting errors
 	ErrTooManyAttempts = errors.New("too many attempts, please try again later")
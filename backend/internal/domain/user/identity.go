@@ -0,0 +1,87 @@
+// path: backend/internal/domain/user/identity.go
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Identity links a user to an account at an external identity provider
+// (Google, GitHub, Microsoft, ...). The provider's subject is stable; the
+// email is kept for display only.
+type Identity struct {
+	id          uuid.UUID
+	userID      uuid.UUID
+	provider    string
+	subject     string
+	email       string
+	createdAt   time.Time
+	lastLoginAt *time.Time
+}
+
+// NewIdentity links a provider account to a user
+func NewIdentity(userID uuid.UUID, provider, subject, email string) *Identity {
+	return &Identity{
+		id:        uuid.New(),
+		userID:    userID,
+		provider:  provider,
+		subject:   subject,
+		email:     email,
+		createdAt: time.Now().UTC(),
+	}
+}
+
+// ReconstructIdentity recreates an identity from persistence
+func ReconstructIdentity(
+	id, userID uuid.UUID,
+	provider, subject, email string,
+	createdAt time.Time,
+	lastLoginAt *time.Time,
+) *Identity {
+	return &Identity{
+		id:          id,
+		userID:      userID,
+		provider:    provider,
+		subject:     subject,
+		email:       email,
+		createdAt:   createdAt,
+		lastLoginAt: lastLoginAt,
+	}
+}
+
+// Getters
+func (i *Identity) ID() uuid.UUID           { return i.id }
+func (i *Identity) UserID() uuid.UUID       { return i.userID }
+func (i *Identity) Provider() string        { return i.provider }
+func (i *Identity) Subject() string         { return i.subject }
+func (i *Identity) Email() string           { return i.email }
+func (i *Identity) CreatedAt() time.Time    { return i.createdAt }
+func (i *Identity) LastLoginAt() *time.Time { return i.lastLoginAt }
+
+// RecordLogin notes a sign-in through this identity
+func (i *Identity) RecordLogin(email string, now time.Time) {
+	if email != "" {
+		i.email = email
+	}
+	loginAt := now.UTC()
+	i.lastLoginAt = &loginAt
+}
+
+// IdentityRepository persists external identities
+type IdentityRepository interface {
+	// Create fails with ErrIdentityAlreadyLinked if the provider account
+	// is linked already, or the user already has this provider linked
+	Create(ctx context.Context, identity *Identity) error
+	Update(ctx context.Context, identity *Identity) error
+
+	// FindBySubject returns ErrIdentityNotFound when unknown
+	FindBySubject(ctx context.Context, provider, subject string) (*Identity, error)
+
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Identity, error)
+
+	// Delete unlinks a provider from a user, or returns ErrIdentityNotFound
+	Delete(ctx context.Context, userID uuid.UUID, provider string) error
+}
@@ -0,0 +1,141 @@
+// path: backend/internal/domain/user/passkey.go
+
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Passkey is a WebAuthn credential registered by a user. A user may hold
+// several, e.g. one per device.
+type Passkey struct {
+	id           uuid.UUID
+	userID       uuid.UUID
+	credentialID []byte
+	publicKey    []byte // COSE_Key
+	signCount    uint32
+	aaguid       []byte
+	transports   []string
+	name         string
+	createdAt    time.Time
+	lastUsedAt   *time.Time
+}
+
+// NewPasskey creates a passkey from a verified registration
+func NewPasskey(
+	userID uuid.UUID,
+	name string,
+	credentialID, publicKey []byte,
+	signCount uint32,
+	aaguid []byte,
+	transports []string,
+) (*Passkey, error) {
+	name, err := validatePasskeyName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Passkey{
+		id:           uuid.New(),
+		userID:       userID,
+		credentialID: credentialID,
+		publicKey:    publicKey,
+		signCount:    signCount,
+		aaguid:       aaguid,
+		transports:   transports,
+		name:         name,
+		createdAt:    time.Now().UTC(),
+	}, nil
+}
+
+// ReconstructPasskey recreates a passkey from persistence
+func ReconstructPasskey(
+	id, userID uuid.UUID,
+	credentialID, publicKey []byte,
+	signCount uint32,
+	aaguid []byte,
+	transports []string,
+	name string,
+	createdAt time.Time,
+	lastUsedAt *time.Time,
+) *Passkey {
+	return &Passkey{
+		id:           id,
+		userID:       userID,
+		credentialID: credentialID,
+		publicKey:    publicKey,
+		signCount:    signCount,
+		aaguid:       aaguid,
+		transports:   transports,
+		name:         name,
+		createdAt:    createdAt,
+		lastUsedAt:   lastUsedAt,
+	}
+}
+
+// Getters
+func (p *Passkey) ID() uuid.UUID          { return p.id }
+func (p *Passkey) UserID() uuid.UUID      { return p.userID }
+func (p *Passkey) CredentialID() []byte   { return p.credentialID }
+func (p *Passkey) PublicKey() []byte      { return p.publicKey }
+func (p *Passkey) SignCount() uint32      { return p.signCount }
+func (p *Passkey) AAGUID() []byte         { return p.aaguid }
+func (p *Passkey) Transports() []string   { return p.transports }
+func (p *Passkey) Name() string           { return p.name }
+func (p *Passkey) CreatedAt() time.Time   { return p.createdAt }
+func (p *Passkey) LastUsedAt() *time.Time { return p.lastUsedAt }
+
+// Rename changes the label shown in the user's passkey list
+func (p *Passkey) Rename(name string) error {
+	name, err := validatePasskeyName(name)
+	if err != nil {
+		return err
+	}
+	p.name = name
+	return nil
+}
+
+// RecordUse stores the authenticator's signature counter after a successful
+// assertion. Authenticators that do not count always report zero; for the
+// others the counter must grow, otherwise two copies of the key exist.
+func (p *Passkey) RecordUse(signCount uint32, now time.Time) error {
+	if (signCount != 0 || p.signCount != 0) && signCount <= p.signCount {
+		return ErrPasskeyCloned
+	}
+
+	p.signCount = signCount
+	usedAt := now.UTC()
+	p.lastUsedAt = &usedAt
+	return nil
+}
+
+func validatePasskeyName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return "", ErrInvalidPasskeyName
+	}
+	return name, nil
+}
+
+// PasskeyRepository persists WebAuthn credentials
+type PasskeyRepository interface {
+	// Create fails with ErrPasskeyExists if the credential ID is taken
+	Create(ctx context.Context, p *Passkey) error
+	Update(ctx context.Context, p *Passkey) error
+
+	// FindByCredentialID returns ErrPasskeyNotFound when unknown
+	FindByCredentialID(ctx context.Context, credentialID []byte) (*Passkey, error)
+
+	// FindByID returns one of the user's passkeys, or ErrPasskeyNotFound
+	FindByID(ctx context.Context, userID, id uuid.UUID) (*Passkey, error)
+
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Passkey, error)
+
+	// Delete removes one of the user's passkeys, or returns
+	// ErrPasskeyNotFound
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPasskeyRecordUseDetectsClones(t *testing.T) {
+	p, err := NewPasskey(uuid.New(), "Laptop", []byte("cred"), []byte("key"), 5, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.RecordUse(6, time.Now()); err != nil {
+		t.Fatalf("increasing counter rejected: %v", err)
+	}
+	if err := p.RecordUse(6, time.Now()); !errors.Is(err, ErrPasskeyCloned) {
+		t.Errorf("repeated counter: err = %v", err)
+	}
+	if err := p.RecordUse(0, time.Now()); !errors.Is(err, ErrPasskeyCloned) {
+		t.Errorf("counter reset: err = %v", err)
+	}
+
+	// Authenticators without a counter always report zero
+	counterless, _ := NewPasskey(uuid.New(), "Phone", []byte("cred2"), []byte("key"), 0, nil, nil)
+	for i := 0; i < 2; i++ {
+		if err := counterless.RecordUse(0, time.Now()); err != nil {
+			t.Errorf("counterless use %d: %v", i, err)
+		}
+	}
+}
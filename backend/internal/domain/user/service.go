@@ -7,6 +7,8 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -270,6 +272,44 @@ func GenerateVerificationCode() (string, error) {
 	return fmt.Sprintf("%06d", code%1000000), nil
 }
 
+// AvailableUsername derives a username from the email's local part,
+// adding a random suffix when it is taken
+func (s *Service) AvailableUsername(ctx context.Context, email string) (string, error) {
+	local, _, _ := strings.Cut(strings.ToLower(email), "@")
+
+	var b strings.Builder
+	for _, ch := range local {
+		if (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9') || ch == '_' || ch == '-' {
+			b.WriteRune(ch)
+		}
+	}
+	base := b.String()
+	for len(base) < 3 {
+		base += "_"
+	}
+	if len(base) > 24 {
+		base = base[:24]
+	}
+
+	candidate := base
+	for attempt := 0; attempt < 5; attempt++ {
+		exists, err := s.repo.ExistsByUsername(ctx, candidate)
+		if err != nil {
+			return "", fmt.Errorf("failed to check username: %w", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(100000))
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s-%05d", base, n.Int64())
+	}
+	return "", fmt.Errorf("failed to pick a username")
+}
+
 // HashPassword hashes a plain text password
 func (s *Service) HashPassword(password string) (string, error) {
 	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
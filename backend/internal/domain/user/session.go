@@ -0,0 +1,160 @@
+// path: backend/internal/domain/user/session.go
+
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reasons recorded when a refresh token is revoked
+const (
+	RevokedRotated         = "rotated"
+	RevokedLogout          = "logout"
+	RevokedReuseDetected   = "reuse_detected"
+	RevokedSessionRevoked  = "session_revoked"
+	RevokedPasswordChanged = "password_changed"
+)
+
+// RefreshToken is one persisted refresh token. Tokens rotated from the same
+// login share a family, which is the session shown to the user. Only the
+// hash of the token is stored.
+type RefreshToken struct {
+	id            uuid.UUID
+	userID        uuid.UUID
+	familyID      uuid.UUID
+	parentID      *uuid.UUID
+	tokenHash     string
+	userAgent     string
+	ipAddress     string
+	expiresAt     time.Time
+	createdAt     time.Time
+	lastUsedAt    *time.Time
+	revokedAt     *time.Time
+	revokedReason string
+}
+
+// NewRefreshToken starts a new session (token family) for a user
+func NewRefreshToken(userID uuid.UUID, token, userAgent, ipAddress string, expiresAt time.Time) *RefreshToken {
+	id := uuid.New()
+	return &RefreshToken{
+		id:        id,
+		userID:    userID,
+		familyID:  id,
+		tokenHash: HashRefreshToken(token),
+		userAgent: userAgent,
+		ipAddress: ipAddress,
+		expiresAt: expiresAt,
+		createdAt: time.Now().UTC(),
+	}
+}
+
+// ReconstructRefreshToken recreates a refresh token from persistence
+func ReconstructRefreshToken(
+	id, userID, familyID uuid.UUID,
+	parentID *uuid.UUID,
+	tokenHash, userAgent, ipAddress string,
+	expiresAt, createdAt time.Time,
+	lastUsedAt, revokedAt *time.Time,
+	revokedReason string,
+) *RefreshToken {
+	return &RefreshToken{
+		id:            id,
+		userID:        userID,
+		familyID:      familyID,
+		parentID:      parentID,
+		tokenHash:     tokenHash,
+		userAgent:     userAgent,
+		ipAddress:     ipAddress,
+		expiresAt:     expiresAt,
+		createdAt:     createdAt,
+		lastUsedAt:    lastUsedAt,
+		revokedAt:     revokedAt,
+		revokedReason: revokedReason,
+	}
+}
+
+// Getters
+func (t *RefreshToken) ID() uuid.UUID          { return t.id }
+func (t *RefreshToken) UserID() uuid.UUID      { return t.userID }
+func (t *RefreshToken) FamilyID() uuid.UUID    { return t.familyID }
+func (t *RefreshToken) ParentID() *uuid.UUID   { return t.parentID }
+func (t *RefreshToken) TokenHash() string      { return t.tokenHash }
+func (t *RefreshToken) UserAgent() string      { return t.userAgent }
+func (t *RefreshToken) IPAddress() string      { return t.ipAddress }
+func (t *RefreshToken) ExpiresAt() time.Time   { return t.expiresAt }
+func (t *RefreshToken) CreatedAt() time.Time   { return t.createdAt }
+func (t *RefreshToken) LastUsedAt() *time.Time { return t.lastUsedAt }
+func (t *RefreshToken) RevokedAt() *time.Time  { return t.revokedAt }
+func (t *RefreshToken) RevokedReason() string  { return t.revokedReason }
+func (t *RefreshToken) IsRevoked() bool        { return t.revokedAt != nil }
+func (t *RefreshToken) IsExpired() bool        { return time.Now().After(t.expiresAt) }
+func (t *RefreshToken) WasRotated() bool       { return t.revokedReason == RevokedRotated }
+
+// Rotate revokes this token and returns its successor in the same family
+func (t *RefreshToken) Rotate(token, userAgent, ipAddress string, expiresAt time.Time) *RefreshToken {
+	now := time.Now().UTC()
+	t.revokedAt = &now
+	t.revokedReason = RevokedRotated
+	t.lastUsedAt = &now
+
+	parentID := t.id
+	return &RefreshToken{
+		id:         uuid.New(),
+		userID:     t.userID,
+		familyID:   t.familyID,
+		parentID:   &parentID,
+		tokenHash:  HashRefreshToken(token),
+		userAgent:  userAgent,
+		ipAddress:  ipAddress,
+		expiresAt:  expiresAt,
+		createdAt:  now,
+		lastUsedAt: &now,
+	}
+}
+
+// Session is the current state of a token family
+type Session struct {
+	ID         uuid.UUID // Family ID
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time // First login of the family
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
+
+// SessionRepository persists refresh tokens and their families
+type SessionRepository interface {
+	// Create persists the first token of a new family
+	Create(ctx context.Context, token *RefreshToken) error
+
+	// FindByHash returns a token by hash, including revoked ones
+	FindByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Rotate revokes old and stores next atomically. It fails with
+	// ErrRefreshTokenReused if old was revoked concurrently.
+	Rotate(ctx context.Context, old, next *RefreshToken) error
+
+	// RevokeFamily revokes every active token of a session
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, reason string) error
+
+	// RevokeAllForUser revokes every active token of a user
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID, reason string) error
+
+	// ListSessions returns the user's sessions with an active token
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*Session, error)
+
+	// RevokeSession revokes one of the user's sessions, or returns
+	// ErrSessionNotFound
+	RevokeSession(ctx context.Context, userID, familyID uuid.UUID, reason string) error
+}
+
+// HashRefreshToken returns the stored form of a refresh token
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,233 @@
+// path: backend/internal/domain/user/two_factor.go
+
+package user
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TOTP parameters (RFC 6238 defaults understood by every authenticator app)
+const (
+	TOTPDigits = 6
+	TOTPPeriod = 30 * time.Second
+
+	// Accept codes one step either side of now to tolerate clock drift
+	totpSkew = 1
+
+	RecoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactor is a user's TOTP enrollment. It exists from setup on, but only
+// guards sign-in once a first code has been confirmed (Enable).
+type TwoFactor struct {
+	userID       uuid.UUID
+	secret       string // base32, stored encrypted
+	enabledAt    *time.Time
+	lastUsedStep int64
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// NewTwoFactor starts an enrollment with a fresh secret
+func NewTwoFactor(userID uuid.UUID) (*TwoFactor, error) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &TwoFactor{
+		userID:    userID,
+		secret:    secret,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructTwoFactor recreates an enrollment from persistence
+func ReconstructTwoFactor(
+	userID uuid.UUID,
+	secret string,
+	enabledAt *time.Time,
+	lastUsedStep int64,
+	createdAt, updatedAt time.Time,
+) *TwoFactor {
+	return &TwoFactor{
+		userID:       userID,
+		secret:       secret,
+		enabledAt:    enabledAt,
+		lastUsedStep: lastUsedStep,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// Getters
+func (f *TwoFactor) UserID() uuid.UUID     { return f.userID }
+func (f *TwoFactor) Secret() string        { return f.secret }
+func (f *TwoFactor) EnabledAt() *time.Time { return f.enabledAt }
+func (f *TwoFactor) LastUsedStep() int64   { return f.lastUsedStep }
+func (f *TwoFactor) CreatedAt() time.Time  { return f.createdAt }
+func (f *TwoFactor) UpdatedAt() time.Time  { return f.updatedAt }
+func (f *TwoFactor) IsEnabled() bool       { return f.enabledAt != nil }
+
+// ProvisioningURI returns the otpauth:// URI to render as a QR code
+func (f *TwoFactor) ProvisioningURI(issuer, account string) string {
+	v := url.Values{}
+	v.Set("secret", f.secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(TOTPDigits))
+	v.Set("period", fmt.Sprint(int(TOTPPeriod.Seconds())))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Verify checks a code and consumes its time step, so the same code cannot
+// be replayed within its validity window. Callers persist the step with
+// TwoFactorRepository.ConsumeStep, which rejects concurrent replays.
+func (f *TwoFactor) Verify(code string, now time.Time) error {
+	step, ok := ValidateTOTP(f.secret, code, now)
+	if !ok || step <= f.lastUsedStep {
+		return ErrInvalidTwoFactorCode
+	}
+
+	f.lastUsedStep = step
+	f.updatedAt = now.UTC()
+	return nil
+}
+
+// Enable activates the enrollment after the user proved they hold the
+// secret
+func (f *TwoFactor) Enable(code string, now time.Time) error {
+	if f.IsEnabled() {
+		return ErrTwoFactorAlreadyEnabled
+	}
+	if err := f.Verify(code, now); err != nil {
+		return err
+	}
+
+	enabledAt := now.UTC()
+	f.enabledAt = &enabledAt
+	return nil
+}
+
+// TwoFactorRepository persists TOTP enrollments and recovery codes
+type TwoFactorRepository interface {
+	// FindByUserID returns ErrTwoFactorNotEnabled when the user never set up
+	// two-factor authentication
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*TwoFactor, error)
+
+	// Save creates or replaces the enrollment
+	Save(ctx context.Context, f *TwoFactor) error
+
+	// ConsumeStep records the time step of a verified code unless a later
+	// or the same step was already used, which it reports as
+	// ErrInvalidTwoFactorCode. It is atomic, so of concurrent logins with the
+	// same code only one succeeds.
+	ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) error
+
+	// Delete removes the enrollment and its recovery codes
+	Delete(ctx context.Context, userID uuid.UUID) error
+
+	// ReplaceRecoveryCodes discards unused codes and stores the new hashes
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+
+	// UseRecoveryCode marks a code as used, or returns ErrInvalidRecoveryCode
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+
+	// CountRecoveryCodes returns how many unused codes are left
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// GenerateTOTPSecret returns a random 160-bit base32 secret
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPCode returns the code for a time step (RFC 6238 with HMAC-SHA1)
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", fmt.Errorf("invalid secret: %w", err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1000000), nil
+}
+
+// ValidateTOTP checks a code against the steps around now and returns the
+// matching step
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(TOTPPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns single-use codes formatted as xxxxx-xxxxx,
+// along with the hashes to store
+func GenerateRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, RecoveryCodeCount)
+	hashes = make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		s := hex.EncodeToString(b)
+		codes[i] = s[:5] + "-" + s[5:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the stored form of a recovery code. Case and
+// separators are ignored so codes can be typed loosely.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,62 @@
+// path: backend/internal/domain/user/two_factor_test.go
+package user
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, SHA1 seed, truncated to six digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := TOTPCode(secret, tt.unix/30)
+		if err != nil {
+			t.Fatalf("TOTPCode(%d): %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestTwoFactorVerify(t *testing.T) {
+	f, err := NewTwoFactor(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	code, _ := TOTPCode(f.Secret(), now.Unix()/30)
+
+	if err := f.Enable(code, now); err != nil {
+		t.Fatalf("Enable: %v", err)
+	}
+	if err := f.Verify(code, now.Add(10*time.Second)); err != ErrInvalidTwoFactorCode {
+		t.Errorf("replayed code: err = %v, want ErrInvalidTwoFactorCode", err)
+	}
+
+	next, _ := TOTPCode(f.Secret(), now.Unix()/30+1)
+	if err := f.Verify(next, now.Add(30*time.Second)); err != nil {
+		t.Errorf("next code: %v", err)
+	}
+
+	if HashRecoveryCode("ABCDE-12345") != HashRecoveryCode("abcde12345") {
+		t.Error("recovery code hash should ignore case and separators")
+	}
+}
@@ -125,6 +125,39 @@ func NewUser(email, username, password, firstName, lastName string) (*User, erro
 	}, nil
 }
 
+// NewExternalUser creates a user signing up through an external identity
+// provider. The user has no password. emailVerified is true only when the
+// provider itself vouches for the address; team SSO and SCIM don't, since
+// they assert any address in a domain. A missing first name falls back to
+// the username.
+func NewExternalUser(email, username, firstName, lastName string, emailVerified bool) (*User, error) {
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	if err := validateUsername(username); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(firstName) == "" {
+		firstName = username
+	}
+
+	now := time.Now().UTC()
+
+	return &User{
+		id:            uuid.New(),
+		email:         strings.ToLower(strings.TrimSpace(email)),
+		username:      strings.ToLower(strings.TrimSpace(username)),
+		firstName:     strings.TrimSpace(firstName),
+		lastName:      strings.TrimSpace(lastName),
+		role:          RoleUser,
+		status:        StatusActive,
+		emailVerified: emailVerified,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
 // Reconstruct recreates a user entity from persistence layer
 func Reconstruct(
 	id uuid.UUID,
@@ -242,6 +275,32 @@ func (u *User) ResetPassword(newPassword string) error {
 	return nil
 }
 
+// HasPassword reports whether the user can sign in with a password
+func (u *User) HasPassword() bool {
+	return u.passwordHash != ""
+}
+
+// SetPassword gives a password to a user who signed up through an external
+// identity provider
+func (u *User) SetPassword(newPassword string) error {
+	if u.HasPassword() {
+		return ErrPasswordAlreadySet
+	}
+	return u.ResetPassword(newPassword)
+}
+
+// RemovePassword leaves the user with external sign-in only. The caller
+// must make sure another sign-in method remains.
+func (u *User) RemovePassword(password string) error {
+	if !u.VerifyPassword(password) {
+		return ErrInvalidCredentials
+	}
+
+	u.passwordHash = ""
+	u.updatedAt = time.Now().UTC()
+	return nil
+}
+
 // UpdateProfile updates user profile information
 func (u *User) UpdateProfile(firstName, lastName, avatarURL string) error {
 	if strings.TrimSpace(firstName) == "" {
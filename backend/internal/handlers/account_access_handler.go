@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/social"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// AccountAccessHandler handles per-member social account restrictions
+type AccountAccessHandler struct {
+	getUC *social.GetAccountAccessUseCase
+	setUC *social.SetAccountAccessUseCase
+}
+
+// NewAccountAccessHandler creates a new account access handler
+func NewAccountAccessHandler(
+	getUC *social.GetAccountAccessUseCase,
+	setUC *social.SetAccountAccessUseCase,
+) *AccountAccessHandler {
+	return &AccountAccessHandler{
+		getUC: getUC,
+		setUC: setUC,
+	}
+}
+
+// GetAccess handles GET /api/v2/teams/:id/members/:userId/social-access
+func (h *AccountAccessHandler) GetAccess(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+	memberID, err := uuid.Parse(chi.URLParam(r, "userId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	output, err := h.getUC.Execute(r.Context(), social.GetAccountAccessInput{
+		TeamID:   teamID,
+		UserID:   userID,
+		MemberID: memberID,
+	})
+	if err != nil {
+		respondAccountAccessError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// SetAccess handles PUT /api/v2/teams/:id/members/:userId/social-access
+func (h *AccountAccessHandler) SetAccess(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+	memberID, err := uuid.Parse(chi.URLParam(r, "userId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var input social.SetAccountAccessInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.MemberID = memberID
+
+	output, err := h.setUC.Execute(r.Context(), input)
+	if err != nil {
+		respondAccountAccessError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+func respondAccountAccessError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case err.Error() == "member not found":
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, socialDomain.ErrAccessNotRestrictable),
+		errors.Is(err, socialDomain.ErrAccountNotInTeam),
+		errors.Is(err, socialDomain.ErrInvalidAccessLevel),
+		errors.Is(err, socialDomain.ErrDuplicateAccountGrant):
+		respondError(w, http.StatusBadRequest, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
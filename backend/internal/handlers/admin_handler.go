@@ -0,0 +1,221 @@
+// ============================================================================
+// FILE: backend/internal/handlers/admin_handler.go
+// PURPOSE: Admin-only operations (background job status and manual runs,
+//
+//	sign-in lockouts, platform webhook replay, team plans)
+//
+// ============================================================================
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/application/social"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+type AdminHandler struct {
+	jobScheduler common.JobScheduler // nil when the worker queue is unavailable
+
+	getLockoutUC *auth.GetAccountLockoutUseCase
+	unlockUC     *auth.UnlockAccountUseCase
+
+	replayWebhooksUC *social.ReplayPlatformWebhooksUseCase
+
+	changePlanUC *team.ChangeTeamPlanUseCase
+}
+
+func NewAdminHandler(
+	jobScheduler common.JobScheduler,
+	getLockoutUC *auth.GetAccountLockoutUseCase,
+	unlockUC *auth.UnlockAccountUseCase,
+	replayWebhooksUC *social.ReplayPlatformWebhooksUseCase,
+	changePlanUC *team.ChangeTeamPlanUseCase,
+) *AdminHandler {
+	return &AdminHandler{
+		jobScheduler:     jobScheduler,
+		getLockoutUC:     getLockoutUC,
+		unlockUC:         unlockUC,
+		replayWebhooksUC: replayWebhooksUC,
+		changePlanUC:     changePlanUC,
+	}
+}
+
+// ============================================================================
+// GET /api/v2/admin/jobs - List scheduled worker jobs
+// ============================================================================
+
+func (h *AdminHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	if h.jobScheduler == nil {
+		respondError(w, http.StatusServiceUnavailable, "worker queue unavailable")
+		return
+	}
+
+	jobs, err := h.jobScheduler.ListJobs(r.Context())
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to list jobs")
+		return
+	}
+
+	respondSuccess(w, jobs)
+}
+
+// ============================================================================
+// POST /api/v2/admin/jobs/:name/run - Trigger a job immediately
+// ============================================================================
+
+func (h *AdminHandler) RunJob(w http.ResponseWriter, r *http.Request) {
+	if h.jobScheduler == nil {
+		respondError(w, http.StatusServiceUnavailable, "worker queue unavailable")
+		return
+	}
+
+	name := chi.URLParam(r, "name")
+	if name == "" {
+		respondError(w, http.StatusBadRequest, "job name is required")
+		return
+	}
+
+	if err := h.jobScheduler.TriggerJob(r.Context(), name); err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			respondError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "failed to trigger job")
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, SuccessResponse{
+		Message: "job run requested",
+		Data: map[string]string{
+			"job": name,
+		},
+	})
+}
+
+// ============================================================================
+// GET /api/v2/admin/users/:id/lockout - Sign-in lockout state of an account
+// ============================================================================
+
+func (h *AdminHandler) GetLockout(w http.ResponseWriter, r *http.Request) {
+	input, ok := lockoutRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getLockoutUC.Execute(r.Context(), input)
+	if err != nil {
+		respondLockoutError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// ============================================================================
+// DELETE /api/v2/admin/users/:id/lockout - Unlock an account
+// ============================================================================
+
+func (h *AdminHandler) Unlock(w http.ResponseWriter, r *http.Request) {
+	input, ok := lockoutRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.unlockUC.Execute(r.Context(), input); err != nil {
+		respondLockoutError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "account unlocked"})
+}
+
+// ============================================================================
+// POST /api/v2/admin/platform-webhooks/replay - Process logged webhooks again
+// ============================================================================
+
+func (h *AdminHandler) ReplayWebhooks(w http.ResponseWriter, r *http.Request) {
+	var input social.ReplayPlatformWebhooksInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	output, err := h.replayWebhooksUC.Execute(r.Context(), input)
+	if err != nil {
+		respondPlatformWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// ============================================================================
+// PUT /api/v2/admin/teams/:id/plan - Move a team to another plan
+// ============================================================================
+
+func (h *AdminHandler) ChangeTeamPlan(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid team ID")
+		return
+	}
+
+	var input team.ChangeTeamPlanInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.AdminID = adminID
+
+	output, err := h.changePlanUC.Execute(r.Context(), input)
+	switch {
+	case err == nil:
+		respondSuccess(w, output)
+	case errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, teamDomain.ErrInvalidPlan),
+		errors.Is(err, teamDomain.ErrSamePlan),
+		errors.Is(err, teamDomain.ErrCannotDowngrade):
+		respondError(w, http.StatusBadRequest, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, "failed to change plan")
+	}
+}
+
+func lockoutRequest(w http.ResponseWriter, r *http.Request) (auth.AccountLockoutInput, bool) {
+	adminID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return auth.AccountLockoutInput{}, false
+	}
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid user ID")
+		return auth.AccountLockoutInput{}, false
+	}
+	return auth.AccountLockoutInput{UserID: userID, AdminID: adminID}, true
+}
+
+func respondLockoutError(w http.ResponseWriter, err error) {
+	if errors.Is(err, userDomain.ErrUserNotFound) {
+		respondError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	respondError(w, http.StatusInternalServerError, err.Error())
+}
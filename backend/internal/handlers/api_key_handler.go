@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// APIKeyHandler handles personal access tokens (/auth/api-keys) and team
+// API keys (/teams/:id/api-keys). The same handlers serve both; a team in
+// the URL selects team keys.
+type APIKeyHandler struct {
+	createUC *team.CreateAPIKeyUseCase
+	listUC   *team.ListAPIKeysUseCase
+	revokeUC *team.RevokeAPIKeyUseCase
+}
+
+// NewAPIKeyHandler creates a new API key handler
+func NewAPIKeyHandler(
+	createUC *team.CreateAPIKeyUseCase,
+	listUC *team.ListAPIKeysUseCase,
+	revokeUC *team.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+	}
+}
+
+// CreateKey handles POST /api/v2/auth/api-keys and /api/v2/teams/:id/api-keys
+func (h *APIKeyHandler) CreateKey(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := apiKeyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.CreateAPIKeyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createUC.Execute(r.Context(), input)
+	if err != nil {
+		respondAPIKeyError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// ListKeys handles GET /api/v2/auth/api-keys and /api/v2/teams/:id/api-keys
+func (h *APIKeyHandler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := apiKeyRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), team.ListAPIKeysInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondAPIKeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// RevokeKey handles DELETE /api/v2/auth/api-keys/:keyId and
+// /api/v2/teams/:id/api-keys/:keyId
+func (h *APIKeyHandler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := apiKeyRequest(w, r)
+	if !ok {
+		return
+	}
+	keyID, err := uuid.Parse(chi.URLParam(r, "keyId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid key ID")
+		return
+	}
+
+	err = h.revokeUC.Execute(r.Context(), team.RevokeAPIKeyInput{TeamID: teamID, UserID: userID, KeyID: keyID})
+	if err != nil {
+		respondAPIKeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "API key revoked"})
+}
+
+// apiKeyRequest returns the user and, on team routes, the team
+func apiKeyRequest(w http.ResponseWriter, r *http.Request) (userID, teamID uuid.UUID, ok bool) {
+	if chi.URLParam(r, "id") != "" {
+		return teamRequest(w, r)
+	}
+
+	userID, ok = middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, uuid.Nil, true
+}
+
+func respondAPIKeyError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrAPIKeyNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
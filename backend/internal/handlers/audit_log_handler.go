@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// AuditLogHandler serves a team's audit log (/teams/:id/audit-log)
+type AuditLogHandler struct {
+	listUC *auditlog.ListAuditLogUseCase
+}
+
+// NewAuditLogHandler creates a new audit log handler
+func NewAuditLogHandler(listUC *auditlog.ListAuditLogUseCase) *AuditLogHandler {
+	return &AuditLogHandler{listUC: listUC}
+}
+
+// List handles GET /api/v2/teams/:id/audit-log. Filters: actor, action
+// (exact or "<area>.*"), targetType, targetId, since, until (RFC 3339), and
+// the before/limit cursor. format=csv returns the export.
+func (h *AuditLogHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("format") == "csv" {
+		h.Export(w, r)
+		return
+	}
+
+	input, ok := auditLogRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), input)
+	if err != nil {
+		respondAuditLogError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Export handles GET /api/v2/teams/:id/audit-log/export, writing the
+// filtered entries as CSV
+func (h *AuditLogHandler) Export(w http.ResponseWriter, r *http.Request) {
+	input, ok := auditLogRequest(w, r)
+	if !ok {
+		return
+	}
+	input.Export = true
+
+	output, err := h.listUC.Execute(r.Context(), input)
+	if err != nil {
+		respondAuditLogError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("audit-log-%s-%s.csv", input.TeamID, time.Now().UTC().Format("20060102"))
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"id", "created_at", "actor_id", "action", "target_type", "target_id", "ip_address", "user_agent", "changes", "metadata"})
+	for _, e := range output.Entries {
+		_ = cw.Write([]string{
+			e.ID.String(),
+			e.CreatedAt.Format(time.RFC3339),
+			optionalID(e.ActorID),
+			e.Action,
+			e.TargetType,
+			e.TargetID,
+			e.IPAddress,
+			e.UserAgent,
+			formatChanges(e),
+			formatMetadata(e.Metadata),
+		})
+	}
+	cw.Flush()
+}
+
+// auditLogRequest reads the team and filters from the request
+func auditLogRequest(w http.ResponseWriter, r *http.Request) (auditlog.ListAuditLogInput, bool) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return auditlog.ListAuditLogInput{}, false
+	}
+
+	q := r.URL.Query()
+	input := auditlog.ListAuditLogInput{
+		TeamID:     teamID,
+		UserID:     userID,
+		Action:     q.Get("action"),
+		TargetType: q.Get("targetType"),
+		TargetID:   q.Get("targetId"),
+	}
+
+	if v := q.Get("actor"); v != "" {
+		actorID, err := uuid.Parse(v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid actor")
+			return input, false
+		}
+		input.ActorID = &actorID
+	}
+	for name, dst := range map[string]**time.Time{"since": &input.Since, "until": &input.Until, "before": &input.Before} {
+		t, err := parseTimeParam(q, name)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return input, false
+		}
+		*dst = t
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return input, false
+		}
+		input.Limit = limit
+	}
+
+	return input, true
+}
+
+func parseTimeParam(q url.Values, name string) (*time.Time, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected an RFC 3339 time", name)
+	}
+	return &t, nil
+}
+
+func optionalID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// formatChanges renders the diff as "field: from -> to" pairs
+func formatChanges(e auditlog.EntryDTO) string {
+	parts := make([]string, 0, len(e.Changes))
+	for _, field := range e.Changes.Fields() {
+		c := e.Changes[field]
+		parts = append(parts, fmt.Sprintf("%s: %v -> %v", field, c.From, c.To))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+metadata[k])
+	}
+	return strings.Join(parts, "; ")
+}
+
+func respondAuditLogError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
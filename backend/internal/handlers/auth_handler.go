@@ -2,13 +2,19 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"math"
+	"net"
 	"net/http"
 	"os"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/auth"
 	"github.com/techappsUT/social-queue/internal/application/user"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
 	"github.com/techappsUT/social-queue/internal/middleware"
 )
 
@@ -30,6 +36,10 @@ type AuthHandler struct {
 	resetPasswordUC      *auth.ResetPasswordUseCase
 	changePasswordUC     *auth.ChangePasswordUseCase
 
+	// Sessions
+	listSessionsUC  *auth.ListSessionsUseCase
+	revokeSessionUC *auth.RevokeSessionUseCase
+
 	// Dev mode support
 	devMode bool
 	devCode string
@@ -49,6 +59,8 @@ func NewAuthHandler(
 	forgotPasswordUC *auth.ForgotPasswordUseCase,
 	resetPasswordUC *auth.ResetPasswordUseCase,
 	changePasswordUC *auth.ChangePasswordUseCase,
+	listSessionsUC *auth.ListSessionsUseCase,
+	revokeSessionUC *auth.RevokeSessionUseCase,
 ) *AuthHandler {
 	return &AuthHandler{
 		createUserUC:         createUserUC,
@@ -63,6 +75,8 @@ func NewAuthHandler(
 		forgotPasswordUC:     forgotPasswordUC,
 		resetPasswordUC:      resetPasswordUC,
 		changePasswordUC:     changePasswordUC,
+		listSessionsUC:       listSessionsUC,
+		revokeSessionUC:      revokeSessionUC,
 		devMode:              os.Getenv("DEVELOPMENT_MODE") == "true",
 		devCode:              os.Getenv("DEV_EMAIL_VERIFICATION_CODE"),
 	}
@@ -79,6 +93,7 @@ func (h *AuthHandler) Signup(w http.ResponseWriter, r *http.Request) {
 		respondError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	input.Client = clientInfo(r)
 
 	output, err := h.createUserUC.Execute(r.Context(), input)
 	if err != nil {
@@ -116,10 +131,22 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Execute without fingerprint (not in your current implementation)
+	input.Client = clientInfo(r)
+
 	output, err := h.loginUC.Execute(r.Context(), input)
 	if err != nil {
-		respondError(w, http.StatusUnauthorized, "Invalid credentials")
+		// The password was right; the client should offer SSO instead
+		if errors.Is(err, teamDomain.ErrSSORequired) {
+			respondError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		respondLoginError(w, err)
+		return
+	}
+
+	// Second factor pending: no session yet
+	if output.MFARequired {
+		respondSuccess(w, output)
 		return
 	}
 
@@ -210,7 +237,7 @@ func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	input := auth.RefreshTokenInput{RefreshToken: refreshToken}
+	input := auth.RefreshTokenInput{RefreshToken: refreshToken, Client: clientInfo(r)}
 	output, err := h.refreshTokenUC.Execute(r.Context(), input)
 	if err != nil {
 		respondError(w, http.StatusUnauthorized, err.Error())
@@ -350,6 +377,115 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// ListSessions handles GET /api/v2/auth/sessions (authenticated)
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.listSessionsUC.Execute(r.Context(), auth.ListSessionsInput{UserID: userID})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// RevokeSession handles DELETE /api/v2/auth/sessions/{id} (authenticated)
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid session ID")
+		return
+	}
+
+	output, err := h.revokeSessionUC.Execute(r.Context(), auth.RevokeSessionInput{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+	if err != nil {
+		if errors.Is(err, userDomain.ErrSessionNotFound) {
+			respondError(w, http.StatusNotFound, "Session not found")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// RevokeAllSessions handles DELETE /api/v2/auth/sessions (authenticated)
+func (h *AuthHandler) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.revokeSessionUC.Execute(r.Context(), auth.RevokeSessionInput{UserID: userID})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// clientInfo describes the device making the request. RemoteAddr already
+// holds the real client IP (middleware.RealIP).
+// respondLoginError reports failed and throttled sign-ins, telling the
+// client when to show a CAPTCHA and when it may try again
+func respondLoginError(w http.ResponseWriter, err error) {
+	var throttled *auth.LoginThrottledError
+	if errors.As(err, &throttled) {
+		retryAfter := int(math.Ceil(throttled.RetryAfter.Seconds()))
+		code := "login_throttled"
+		if throttled.LockedUntil != nil {
+			code = "account_locked"
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		respondJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+			"error":           http.StatusText(http.StatusTooManyRequests),
+			"message":         throttled.Error(),
+			"code":            code,
+			"retryAfter":      retryAfter,
+			"lockedUntil":     throttled.LockedUntil,
+			"captchaRequired": throttled.CaptchaRequired,
+		})
+		return
+	}
+
+	var failed *auth.LoginFailedError
+	if errors.As(err, &failed) {
+		respondJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"error":           http.StatusText(http.StatusUnauthorized),
+			"message":         "Invalid credentials",
+			"captchaRequired": failed.CaptchaRequired,
+		})
+		return
+	}
+
+	respondError(w, http.StatusUnauthorized, "Invalid credentials")
+}
+
+func clientInfo(r *http.Request) auth.ClientInfo {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return auth.ClientInfo{UserAgent: r.UserAgent(), IPAddress: ip}
+}
+
 // REMOVED duplicate response functions - they're in response.go
 // ============================================================================
 // USER MANAGEMENT ROUTES (authenticated) - Add these methods
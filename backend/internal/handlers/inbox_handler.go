@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/inbox"
+	inboxDomain "github.com/techappsUT/social-queue/internal/domain/inbox"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// InboxHandler serves a team's unified inbox (/teams/:id/inbox)
+type InboxHandler struct {
+	listUC   *inbox.ListConversationsUseCase
+	getUC    *inbox.GetConversationUseCase
+	stateUC  *inbox.UpdateConversationStateUseCase
+	assignUC *inbox.AssignConversationUseCase
+	replyUC  *inbox.ReplyUseCase
+}
+
+// NewInboxHandler creates a new inbox handler
+func NewInboxHandler(
+	listUC *inbox.ListConversationsUseCase,
+	getUC *inbox.GetConversationUseCase,
+	stateUC *inbox.UpdateConversationStateUseCase,
+	assignUC *inbox.AssignConversationUseCase,
+	replyUC *inbox.ReplyUseCase,
+) *InboxHandler {
+	return &InboxHandler{
+		listUC:   listUC,
+		getUC:    getUC,
+		stateUC:  stateUC,
+		assignUC: assignUC,
+		replyUC:  replyUC,
+	}
+}
+
+// List handles GET /api/v2/teams/:id/inbox/conversations. Filters:
+// accountId, state, kind, assignee ("me", "unassigned" or a user ID), tag,
+// before (RFC 3339, for the next page) and limit.
+func (h *InboxHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	input := inbox.ListConversationsInput{
+		TeamID: teamID,
+		UserID: userID,
+		State:  query.Get("state"),
+		Kind:   query.Get("kind"),
+		Tag:    query.Get("tag"),
+	}
+
+	if v := query.Get("accountId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid account ID")
+			return
+		}
+		input.AccountID = &id
+	}
+
+	switch v := query.Get("assignee"); v {
+	case "":
+	case "me":
+		input.AssignedTo = &userID
+	case "unassigned":
+		input.AssignedTo = &uuid.Nil
+	default:
+		id, err := uuid.Parse(v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid assignee")
+			return
+		}
+		input.AssignedTo = &id
+	}
+
+	if v := query.Get("before"); v != "" {
+		before, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid before, expected RFC 3339")
+			return
+		}
+		input.Before = &before
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		input.Limit = limit
+	}
+
+	output, err := h.listUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Get handles GET /api/v2/teams/:id/inbox/conversations/:conversationId.
+// Opening an unread conversation marks it read.
+func (h *InboxHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, conversationID, ok := conversationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getUC.Execute(r.Context(), inbox.GetConversationInput{TeamID: teamID, UserID: userID, ConversationID: conversationID})
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// UpdateState handles PATCH /api/v2/teams/:id/inbox/conversations/:conversationId
+func (h *InboxHandler) UpdateState(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, conversationID, ok := conversationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.UpdateConversationStateInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.ConversationID = conversationID
+
+	output, err := h.stateUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Assign handles PUT /api/v2/teams/:id/inbox/conversations/:conversationId/assignee.
+// A null assigneeId unassigns the conversation.
+func (h *InboxHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, conversationID, ok := conversationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.AssignConversationInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.ConversationID = conversationID
+
+	output, err := h.assignUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Reply handles POST /api/v2/teams/:id/inbox/conversations/:conversationId/replies
+func (h *InboxHandler) Reply(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, conversationID, ok := conversationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.ReplyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.ConversationID = conversationID
+
+	output, err := h.replyUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// conversationRequest returns the user, the team and the conversation in the URL
+func conversationRequest(w http.ResponseWriter, r *http.Request) (userID, teamID, conversationID uuid.UUID, ok bool) {
+	userID, teamID, ok = teamRequest(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	conversationID, err := uuid.Parse(chi.URLParam(r, "conversationId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid conversation ID")
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	return userID, teamID, conversationID, true
+}
+
+func respondInboxError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, inboxDomain.ErrConversationNotFound),
+		errors.Is(err, inboxDomain.ErrMessageNotFound),
+		errors.Is(err, inboxDomain.ErrSavedReplyNotFound),
+		errors.Is(err, inboxDomain.ErrRuleNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, inboxDomain.ErrSavedReplyInUse):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, inboxDomain.ErrReplyRateLimited):
+		respondError(w, http.StatusTooManyRequests, err.Error())
+	case errors.Is(err, inboxDomain.ErrReplyFailed):
+		respondError(w, http.StatusBadGateway, err.Error())
+	case errors.Is(err, inboxDomain.ErrReplyNotSupported),
+		errors.Is(err, socialDomain.ErrAccountNotActive):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
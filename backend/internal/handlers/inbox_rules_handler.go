@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/inbox"
+)
+
+// InboxRulesHandler serves a team's saved replies and auto-response rules
+// (/teams/:id/inbox/saved-replies and /teams/:id/inbox/rules)
+type InboxRulesHandler struct {
+	listRepliesUC *inbox.ListSavedRepliesUseCase
+	createReplyUC *inbox.CreateSavedReplyUseCase
+	updateReplyUC *inbox.UpdateSavedReplyUseCase
+	deleteReplyUC *inbox.DeleteSavedReplyUseCase
+	listRulesUC   *inbox.ListRulesUseCase
+	createRuleUC  *inbox.CreateRuleUseCase
+	updateRuleUC  *inbox.UpdateRuleUseCase
+	deleteRuleUC  *inbox.DeleteRuleUseCase
+	testRulesUC   *inbox.TestRulesUseCase
+}
+
+// NewInboxRulesHandler creates a new inbox rules handler
+func NewInboxRulesHandler(
+	listRepliesUC *inbox.ListSavedRepliesUseCase,
+	createReplyUC *inbox.CreateSavedReplyUseCase,
+	updateReplyUC *inbox.UpdateSavedReplyUseCase,
+	deleteReplyUC *inbox.DeleteSavedReplyUseCase,
+	listRulesUC *inbox.ListRulesUseCase,
+	createRuleUC *inbox.CreateRuleUseCase,
+	updateRuleUC *inbox.UpdateRuleUseCase,
+	deleteRuleUC *inbox.DeleteRuleUseCase,
+	testRulesUC *inbox.TestRulesUseCase,
+) *InboxRulesHandler {
+	return &InboxRulesHandler{
+		listRepliesUC: listRepliesUC,
+		createReplyUC: createReplyUC,
+		updateReplyUC: updateReplyUC,
+		deleteReplyUC: deleteReplyUC,
+		listRulesUC:   listRulesUC,
+		createRuleUC:  createRuleUC,
+		updateRuleUC:  updateRuleUC,
+		deleteRuleUC:  deleteRuleUC,
+		testRulesUC:   testRulesUC,
+	}
+}
+
+// ListSavedReplies handles GET /api/v2/teams/:id/inbox/saved-replies
+func (h *InboxRulesHandler) ListSavedReplies(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listRepliesUC.Execute(r.Context(), inbox.ListSavedRepliesInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// CreateSavedReply handles POST /api/v2/teams/:id/inbox/saved-replies
+func (h *InboxRulesHandler) CreateSavedReply(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.CreateSavedReplyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createReplyUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// UpdateSavedReply handles PATCH /api/v2/teams/:id/inbox/saved-replies/:savedReplyId
+func (h *InboxRulesHandler) UpdateSavedReply(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, savedReplyID, ok := inboxItemRequest(w, r, "savedReplyId", "invalid saved reply ID")
+	if !ok {
+		return
+	}
+
+	var input inbox.UpdateSavedReplyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.SavedReplyID = savedReplyID
+
+	output, err := h.updateReplyUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// DeleteSavedReply handles DELETE /api/v2/teams/:id/inbox/saved-replies/:savedReplyId
+func (h *InboxRulesHandler) DeleteSavedReply(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, savedReplyID, ok := inboxItemRequest(w, r, "savedReplyId", "invalid saved reply ID")
+	if !ok {
+		return
+	}
+
+	err := h.deleteReplyUC.Execute(r.Context(), inbox.DeleteSavedReplyInput{TeamID: teamID, UserID: userID, SavedReplyID: savedReplyID})
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Saved reply deleted"})
+}
+
+// ListRules handles GET /api/v2/teams/:id/inbox/rules
+func (h *InboxRulesHandler) ListRules(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listRulesUC.Execute(r.Context(), inbox.ListRulesInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// CreateRule handles POST /api/v2/teams/:id/inbox/rules
+func (h *InboxRulesHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.CreateRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createRuleUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// UpdateRule handles PATCH /api/v2/teams/:id/inbox/rules/:ruleId
+func (h *InboxRulesHandler) UpdateRule(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ruleID, ok := inboxItemRequest(w, r, "ruleId", "invalid rule ID")
+	if !ok {
+		return
+	}
+
+	var input inbox.UpdateRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.RuleID = ruleID
+
+	output, err := h.updateRuleUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// DeleteRule handles DELETE /api/v2/teams/:id/inbox/rules/:ruleId
+func (h *InboxRulesHandler) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ruleID, ok := inboxItemRequest(w, r, "ruleId", "invalid rule ID")
+	if !ok {
+		return
+	}
+
+	err := h.deleteRuleUC.Execute(r.Context(), inbox.DeleteRuleInput{TeamID: teamID, UserID: userID, RuleID: ruleID})
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Rule deleted"})
+}
+
+// TestRules handles POST /api/v2/teams/:id/inbox/rules/test. It shows what
+// the rules would do to sample messages without doing it.
+func (h *InboxRulesHandler) TestRules(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input inbox.TestRulesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.testRulesUC.Execute(r.Context(), input)
+	if err != nil {
+		respondInboxError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// inboxItemRequest returns the user, the team and the ID of the URL
+// parameter
+func inboxItemRequest(w http.ResponseWriter, r *http.Request, param, invalid string) (userID, teamID, id uuid.UUID, ok bool) {
+	userID, teamID, ok = teamRequest(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	id, err := uuid.Parse(chi.URLParam(r, param))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, invalid)
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	return userID, teamID, id, true
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/notification"
+	notificationDomain "github.com/techappsUT/social-queue/internal/domain/notification"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// NotificationHandler serves a user's notification center
+// (/users/:id/notifications and /users/:id/preferences). Users only reach
+// their own; ":id" may be "me".
+type NotificationHandler struct {
+	listUC        *notification.ListNotificationsUseCase
+	markReadUC    *notification.MarkNotificationReadUseCase
+	markAllReadUC *notification.MarkAllNotificationsReadUseCase
+	getPrefsUC    *notification.GetPreferencesUseCase
+	updatePrefsUC *notification.UpdatePreferencesUseCase
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(
+	listUC *notification.ListNotificationsUseCase,
+	markReadUC *notification.MarkNotificationReadUseCase,
+	markAllReadUC *notification.MarkAllNotificationsReadUseCase,
+	getPrefsUC *notification.GetPreferencesUseCase,
+	updatePrefsUC *notification.UpdatePreferencesUseCase,
+) *NotificationHandler {
+	return &NotificationHandler{
+		listUC:        listUC,
+		markReadUC:    markReadUC,
+		markAllReadUC: markAllReadUC,
+		getPrefsUC:    getPrefsUC,
+		updatePrefsUC: updatePrefsUC,
+	}
+}
+
+// List handles GET /api/v2/users/:id/notifications. Filters: unread
+// ("true" for unread only), before (RFC 3339, for the next page) and limit.
+func (h *NotificationHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := notificationUser(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	input := notification.ListNotificationsInput{
+		UserID:     userID,
+		UnreadOnly: query.Get("unread") == "true",
+	}
+
+	if v := query.Get("before"); v != "" {
+		before, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid before, expected RFC 3339")
+			return
+		}
+		input.Before = &before
+	}
+
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		input.Limit = limit
+	}
+
+	output, err := h.listUC.Execute(r.Context(), input)
+	if err != nil {
+		respondNotificationError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// MarkRead handles PUT /api/v2/users/:id/notifications/:notifId
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := notificationUser(w, r)
+	if !ok {
+		return
+	}
+	notificationID, err := uuid.Parse(chi.URLParam(r, "notifId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid notification ID")
+		return
+	}
+
+	output, err := h.markReadUC.Execute(r.Context(), notification.MarkNotificationReadInput{
+		UserID:         userID,
+		NotificationID: notificationID,
+	})
+	if err != nil {
+		respondNotificationError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// MarkAllRead handles POST /api/v2/users/:id/notifications/read-all
+func (h *NotificationHandler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := notificationUser(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.markAllReadUC.Execute(r.Context(), userID)
+	if err != nil {
+		respondNotificationError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// GetPreferences handles GET /api/v2/users/:id/preferences
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := notificationUser(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getPrefsUC.Execute(r.Context(), userID)
+	if err != nil {
+		respondNotificationError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// UpdatePreferences handles PUT /api/v2/users/:id/preferences. Only the
+// kinds in the body change; emailDigest is "hourly" or "daily".
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID, ok := notificationUser(w, r)
+	if !ok {
+		return
+	}
+
+	var input notification.UpdatePreferencesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.updatePrefsUC.Execute(r.Context(), input)
+	if err != nil {
+		respondNotificationError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// notificationUser returns the caller, provided the URL names them
+func notificationUser(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, false
+	}
+
+	if id := chi.URLParam(r, "id"); id != "me" {
+		target, err := uuid.Parse(id)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid user ID")
+			return uuid.Nil, false
+		}
+		if target != userID {
+			respondError(w, http.StatusForbidden, "access denied: notifications of another user")
+			return uuid.Nil, false
+		}
+	}
+	return userID, true
+}
+
+func respondNotificationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, notificationDomain.ErrNotificationNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, notificationDomain.ErrInvalidKind),
+		errors.Is(err, notificationDomain.ErrInvalidDigest):
+		respondError(w, http.StatusBadRequest, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// OAuthHandler serves the OAuth 2.0 authorization server: client
+// registration by teams, the consent step for signed-in users, and the
+// token, introspection and revocation endpoints third-party apps call
+type OAuthHandler struct {
+	registerUC *team.RegisterOAuthClientUseCase
+	listUC     *team.ListOAuthClientsUseCase
+	deleteUC   *team.DeleteOAuthClientUseCase
+
+	consentUC    *auth.GetOAuthConsentUseCase
+	decideUC     *auth.DecideOAuthConsentUseCase
+	tokenUC      *auth.OAuthTokenUseCase
+	introspectUC *auth.IntrospectOAuthTokenUseCase
+	revokeUC     *auth.RevokeOAuthTokenUseCase
+}
+
+// NewOAuthHandler creates a new OAuth handler
+func NewOAuthHandler(
+	registerUC *team.RegisterOAuthClientUseCase,
+	listUC *team.ListOAuthClientsUseCase,
+	deleteUC *team.DeleteOAuthClientUseCase,
+	consentUC *auth.GetOAuthConsentUseCase,
+	decideUC *auth.DecideOAuthConsentUseCase,
+	tokenUC *auth.OAuthTokenUseCase,
+	introspectUC *auth.IntrospectOAuthTokenUseCase,
+	revokeUC *auth.RevokeOAuthTokenUseCase,
+) *OAuthHandler {
+	return &OAuthHandler{
+		registerUC:   registerUC,
+		listUC:       listUC,
+		deleteUC:     deleteUC,
+		consentUC:    consentUC,
+		decideUC:     decideUC,
+		tokenUC:      tokenUC,
+		introspectUC: introspectUC,
+		revokeUC:     revokeUC,
+	}
+}
+
+// ============================================================================
+// CLIENT REGISTRATION
+// ============================================================================
+
+// RegisterClient handles POST /api/v2/teams/:id/oauth/clients
+func (h *OAuthHandler) RegisterClient(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.RegisterOAuthClientInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.registerUC.Execute(r.Context(), input)
+	if err != nil {
+		respondOAuthClientError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// ListClients handles GET /api/v2/teams/:id/oauth/clients
+func (h *OAuthHandler) ListClients(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), team.ListOAuthClientsInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondOAuthClientError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// DeleteClient handles DELETE /api/v2/teams/:id/oauth/clients/:clientId
+func (h *OAuthHandler) DeleteClient(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+	clientID, err := uuid.Parse(chi.URLParam(r, "clientId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid client ID")
+		return
+	}
+
+	err = h.deleteUC.Execute(r.Context(), team.DeleteOAuthClientInput{TeamID: teamID, UserID: userID, ClientID: clientID})
+	if err != nil {
+		respondOAuthClientError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "OAuth client deleted"})
+}
+
+// ============================================================================
+// AUTHORIZATION
+// ============================================================================
+
+// Authorize handles GET /api/v2/oauth/authorize. The frontend forwards the
+// client's query string and renders the returned consent data.
+func (h *OAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	q := r.URL.Query()
+	output, err := h.consentUC.Execute(r.Context(), auth.OAuthAuthorizeInput{
+		UserID:              userID,
+		ClientID:            q.Get("client_id"),
+		RedirectURI:         q.Get("redirect_uri"),
+		ResponseType:        q.Get("response_type"),
+		Scope:               q.Get("scope"),
+		State:               q.Get("state"),
+		CodeChallenge:       q.Get("code_challenge"),
+		CodeChallengeMethod: q.Get("code_challenge_method"),
+	})
+	if err != nil {
+		respondAuthorizeError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Decide handles POST /api/v2/oauth/authorize with the user's answer and
+// returns the URL to send the browser to
+func (h *OAuthHandler) Decide(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var input auth.OAuthDecisionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.decideUC.Execute(r.Context(), input)
+	if err != nil {
+		respondAuthorizeError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// ============================================================================
+// CLIENT ENDPOINTS (RFC 6749, 7662, 7009)
+// ============================================================================
+
+// Token handles POST /api/v2/oauth/token
+func (h *OAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		respondOAuth(w, http.StatusBadRequest, &auth.OAuthError{Code: "invalid_request", Description: "malformed form body"})
+		return
+	}
+
+	output, err := h.tokenUC.Execute(r.Context(), auth.OAuthTokenInput{
+		OAuthClientCredentials: oauthClientCredentials(r),
+		GrantType:              r.PostForm.Get("grant_type"),
+		Code:                   r.PostForm.Get("code"),
+		RedirectURI:            r.PostForm.Get("redirect_uri"),
+		CodeVerifier:           r.PostForm.Get("code_verifier"),
+		RefreshToken:           r.PostForm.Get("refresh_token"),
+		Scope:                  r.PostForm.Get("scope"),
+	})
+	if err != nil {
+		respondOAuthError(w, err)
+		return
+	}
+
+	respondOAuth(w, http.StatusOK, output)
+}
+
+// Introspect handles POST /api/v2/oauth/introspect
+func (h *OAuthHandler) Introspect(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		respondOAuth(w, http.StatusBadRequest, &auth.OAuthError{Code: "invalid_request", Description: "malformed form body"})
+		return
+	}
+
+	output, err := h.introspectUC.Execute(r.Context(), auth.OAuthTokenRequest{
+		OAuthClientCredentials: oauthClientCredentials(r),
+		Token:                  r.PostForm.Get("token"),
+	})
+	if err != nil {
+		respondOAuthError(w, err)
+		return
+	}
+
+	respondOAuth(w, http.StatusOK, output)
+}
+
+// Revoke handles POST /api/v2/oauth/revoke
+func (h *OAuthHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		respondOAuth(w, http.StatusBadRequest, &auth.OAuthError{Code: "invalid_request", Description: "malformed form body"})
+		return
+	}
+
+	err := h.revokeUC.Execute(r.Context(), auth.OAuthTokenRequest{
+		OAuthClientCredentials: oauthClientCredentials(r),
+		Token:                  r.PostForm.Get("token"),
+	})
+	if err != nil {
+		respondOAuthError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// oauthClientCredentials reads HTTP Basic credentials, falling back to
+// client_id and client_secret form parameters
+func oauthClientCredentials(r *http.Request) auth.OAuthClientCredentials {
+	if id, secret, ok := r.BasicAuth(); ok {
+		return auth.OAuthClientCredentials{ClientID: id, ClientSecret: secret}
+	}
+	return auth.OAuthClientCredentials{
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+	}
+}
+
+// respondOAuth writes a bare JSON body as RFC 6749 §5.1 requires, without
+// the API's response envelope
+func respondOAuth(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func respondOAuthError(w http.ResponseWriter, err error) {
+	var oauthErr *auth.OAuthError
+	if !errors.As(err, &oauthErr) {
+		respondOAuth(w, http.StatusInternalServerError, &auth.OAuthError{Code: "server_error"})
+		return
+	}
+
+	status := http.StatusBadRequest
+	if oauthErr.Code == "invalid_client" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		status = http.StatusUnauthorized
+	}
+	respondOAuth(w, status, oauthErr)
+}
+
+// respondAuthorizeError reports authorization request problems to the
+// signed-in user; they are never sent to an unverified redirect URI
+func respondAuthorizeError(w http.ResponseWriter, err error) {
+	var oauthErr *auth.OAuthError
+	switch {
+	case errors.As(err, &oauthErr):
+		respondJSON(w, http.StatusBadRequest, ErrorResponse{
+			Error:   http.StatusText(http.StatusBadRequest),
+			Message: oauthErr.Description,
+			Code:    oauthErr.Code,
+		})
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
+
+func respondOAuthClientError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrOAuthClientNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// OIDCHandler handles sign-in with external identity providers, linked
+// accounts, and passwords for accounts created through a provider
+type OIDCHandler struct {
+	providers        auth.IdentityProviders
+	beginUC          *auth.BeginOIDCLoginUseCase
+	finishUC         *auth.FinishOIDCLoginUseCase
+	listIdentitiesUC *auth.ListIdentitiesUseCase
+	unlinkUC         *auth.UnlinkIdentityUseCase
+	setPasswordUC    *auth.SetPasswordUseCase
+	removePasswordUC *auth.RemovePasswordUseCase
+}
+
+// NewOIDCHandler creates a new OIDC handler
+func NewOIDCHandler(
+	providers auth.IdentityProviders,
+	beginUC *auth.BeginOIDCLoginUseCase,
+	finishUC *auth.FinishOIDCLoginUseCase,
+	listIdentitiesUC *auth.ListIdentitiesUseCase,
+	unlinkUC *auth.UnlinkIdentityUseCase,
+	setPasswordUC *auth.SetPasswordUseCase,
+	removePasswordUC *auth.RemovePasswordUseCase,
+) *OIDCHandler {
+	return &OIDCHandler{
+		providers:        providers,
+		beginUC:          beginUC,
+		finishUC:         finishUC,
+		listIdentitiesUC: listIdentitiesUC,
+		unlinkUC:         unlinkUC,
+		setPasswordUC:    setPasswordUC,
+		removePasswordUC: removePasswordUC,
+	}
+}
+
+// Providers handles GET /api/v2/auth/oidc
+func (h *OIDCHandler) Providers(w http.ResponseWriter, r *http.Request) {
+	respondSuccess(w, map[string]interface{}{"providers": h.providers.Names()})
+}
+
+// Redirect handles GET /api/v2/auth/oidc/{provider} by sending the browser
+// to the provider's sign-in page
+func (h *OIDCHandler) Redirect(w http.ResponseWriter, r *http.Request) {
+	output, err := h.beginUC.Execute(r.Context(), auth.BeginOIDCLoginInput{
+		Provider: chi.URLParam(r, "provider"),
+	})
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, output.AuthorizationURL, http.StatusFound)
+}
+
+// Authorize handles POST /api/v2/auth/oidc/{provider}. It returns the
+// provider URL instead of redirecting; when signed in, the flow links the
+// provider to the current user.
+func (h *OIDCHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.GetUserID(r.Context())
+
+	output, err := h.beginUC.Execute(r.Context(), auth.BeginOIDCLoginInput{
+		Provider: chi.URLParam(r, "provider"),
+		UserID:   userID,
+	})
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Callback handles POST /api/v2/auth/oidc/{provider}/callback with the
+// code and state the provider redirected the browser back with
+func (h *OIDCHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	var input auth.FinishOIDCLoginInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.Provider = chi.URLParam(r, "provider")
+	input.Client = clientInfo(r)
+
+	output, err := h.finishUC.Execute(r.Context(), input)
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	if output.LoginOutput != nil && output.RefreshToken != "" {
+		setRefreshTokenCookie(w, output.RefreshToken)
+		output.RefreshToken = ""
+	}
+
+	respondSuccess(w, output)
+}
+
+// Unlink handles DELETE /api/v2/auth/oidc/{provider}
+func (h *OIDCHandler) Unlink(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	err := h.unlinkUC.Execute(r.Context(), auth.UnlinkIdentityInput{
+		UserID:   userID,
+		Provider: chi.URLParam(r, "provider"),
+	})
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Account unlinked"})
+}
+
+// ListIdentities handles GET /api/v2/auth/identities
+func (h *OIDCHandler) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.listIdentitiesUC.Execute(r.Context(), auth.ListIdentitiesInput{UserID: userID})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// SetPassword handles POST /api/v2/auth/password
+func (h *OIDCHandler) SetPassword(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var input auth.SetPasswordInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.setPasswordUC.Execute(r.Context(), input)
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// RemovePassword handles DELETE /api/v2/auth/password
+func (h *OIDCHandler) RemovePassword(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var input auth.RemovePasswordInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.removePasswordUC.Execute(r.Context(), input)
+	if err != nil {
+		respondOIDCError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+func respondOIDCError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, common.ErrInvalidCredentials):
+		respondError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, userDomain.ErrIdentityNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, userDomain.ErrAccountLinkRequired),
+		errors.Is(err, userDomain.ErrIdentityAlreadyLinked),
+		errors.Is(err, userDomain.ErrPasswordAlreadySet),
+		errors.Is(err, userDomain.ErrLastSignInMethod):
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
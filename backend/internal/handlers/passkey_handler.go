@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// PasskeyHandler handles WebAuthn passkey registration, management and login
+type PasskeyHandler struct {
+	beginRegistrationUC  *auth.BeginPasskeyRegistrationUseCase
+	finishRegistrationUC *auth.FinishPasskeyRegistrationUseCase
+	listUC               *auth.ListPasskeysUseCase
+	renameUC             *auth.RenamePasskeyUseCase
+	deleteUC             *auth.DeletePasskeyUseCase
+	beginLoginUC         *auth.BeginPasskeyLoginUseCase
+	finishLoginUC        *auth.FinishPasskeyLoginUseCase
+}
+
+// NewPasskeyHandler creates a new passkey handler
+func NewPasskeyHandler(
+	beginRegistrationUC *auth.BeginPasskeyRegistrationUseCase,
+	finishRegistrationUC *auth.FinishPasskeyRegistrationUseCase,
+	listUC *auth.ListPasskeysUseCase,
+	renameUC *auth.RenamePasskeyUseCase,
+	deleteUC *auth.DeletePasskeyUseCase,
+	beginLoginUC *auth.BeginPasskeyLoginUseCase,
+	finishLoginUC *auth.FinishPasskeyLoginUseCase,
+) *PasskeyHandler {
+	return &PasskeyHandler{
+		beginRegistrationUC:  beginRegistrationUC,
+		finishRegistrationUC: finishRegistrationUC,
+		listUC:               listUC,
+		renameUC:             renameUC,
+		deleteUC:             deleteUC,
+		beginLoginUC:         beginLoginUC,
+		finishLoginUC:        finishLoginUC,
+	}
+}
+
+// RegistrationOptions handles POST /api/v2/passkeys/register/options
+func (h *PasskeyHandler) RegistrationOptions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.beginRegistrationUC.Execute(r.Context(), auth.BeginPasskeyRegistrationInput{UserID: userID})
+	if err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Register handles POST /api/v2/passkeys/register
+func (h *PasskeyHandler) Register(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var input auth.FinishPasskeyRegistrationInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.finishRegistrationUC.Execute(r.Context(), input)
+	if err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// List handles GET /api/v2/passkeys
+func (h *PasskeyHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), auth.ListPasskeysInput{UserID: userID})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Rename handles PATCH /api/v2/passkeys/{id}
+func (h *PasskeyHandler) Rename(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	passkeyID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid passkey ID")
+		return
+	}
+
+	var input auth.RenamePasskeyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+	input.PasskeyID = passkeyID
+
+	output, err := h.renameUC.Execute(r.Context(), input)
+	if err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Delete handles DELETE /api/v2/passkeys/{id}
+func (h *PasskeyHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	passkeyID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid passkey ID")
+		return
+	}
+
+	if err := h.deleteUC.Execute(r.Context(), auth.DeletePasskeyInput{UserID: userID, PasskeyID: passkeyID}); err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Passkey removed"})
+}
+
+// LoginOptions handles POST /api/v2/passkeys/login/options. The body may
+// carry the challenge token of a login waiting for its second factor.
+func (h *PasskeyHandler) LoginOptions(w http.ResponseWriter, r *http.Request) {
+	var input auth.BeginPasskeyLoginInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	output, err := h.beginLoginUC.Execute(r.Context(), input)
+	if err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Login handles POST /api/v2/passkeys/login
+func (h *PasskeyHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var input auth.FinishPasskeyLoginInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.Client = clientInfo(r)
+
+	output, err := h.finishLoginUC.Execute(r.Context(), input)
+	if err != nil {
+		respondPasskeyError(w, err)
+		return
+	}
+
+	setRefreshTokenCookie(w, output.RefreshToken)
+	output.RefreshToken = ""
+
+	respondSuccess(w, output)
+}
+
+func respondPasskeyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, common.ErrInvalidCredentials),
+		errors.Is(err, userDomain.ErrPasskeyCloned):
+		respondError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, userDomain.ErrPasskeyNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, userDomain.ErrPasskeyExists):
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
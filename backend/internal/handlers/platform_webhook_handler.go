@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/application/social"
+	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+// Platforms send small payloads; anything larger is not theirs
+const maxPlatformWebhookBody = 1 << 20
+
+// PlatformWebhookHandler receives webhooks from social platforms
+// (/webhooks/:platform). Payloads are logged and processed by the worker.
+type PlatformWebhookHandler struct {
+	verifyUC  *social.VerifyWebhookSubscriptionUseCase
+	receiveUC *social.ReceivePlatformWebhookUseCase
+}
+
+// NewPlatformWebhookHandler creates a new platform webhook handler
+func NewPlatformWebhookHandler(
+	verifyUC *social.VerifyWebhookSubscriptionUseCase,
+	receiveUC *social.ReceivePlatformWebhookUseCase,
+) *PlatformWebhookHandler {
+	return &PlatformWebhookHandler{
+		verifyUC:  verifyUC,
+		receiveUC: receiveUC,
+	}
+}
+
+// Verify handles GET /webhooks/:platform, the platform's subscription
+// handshake
+func (h *PlatformWebhookHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	challenge, err := h.verifyUC.Execute(r.Context(), social.VerifyWebhookSubscriptionInput{
+		Platform: platformParam(r),
+		Query:    r.URL.Query(),
+	})
+	if err != nil {
+		respondPlatformWebhookError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", challenge.ContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(challenge.Body)
+}
+
+// Receive handles POST /webhooks/:platform
+func (h *PlatformWebhookHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	platform := platformParam(r)
+	header, err := h.receiveUC.SignatureHeader(platform)
+	if err != nil {
+		respondPlatformWebhookError(w, err)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPlatformWebhookBody))
+	if err != nil {
+		respondError(w, http.StatusRequestEntityTooLarge, "payload too large")
+		return
+	}
+
+	err = h.receiveUC.Execute(r.Context(), social.ReceivePlatformWebhookInput{
+		Platform:  platform,
+		Signature: r.Header.Get(header),
+		Body:      body,
+	})
+	if err != nil {
+		respondPlatformWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "received"})
+}
+
+func platformParam(r *http.Request) socialDomain.Platform {
+	return socialDomain.Platform(strings.ToLower(chi.URLParam(r, "platform")))
+}
+
+func respondPlatformWebhookError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, socialDomain.ErrPlatformNotSupported):
+		respondError(w, http.StatusNotFound, "unknown platform")
+	case errors.Is(err, socialDomain.ErrInvalidWebhookSignature):
+		respondError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, socialDomain.ErrInvalidWebhookChallenge):
+		respondError(w, http.StatusForbidden, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
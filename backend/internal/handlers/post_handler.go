@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -74,6 +75,10 @@ func (h *PostHandler) CreateDraft(w http.ResponseWriter, r *http.Request) {
 		case postDomain.ErrInvalidPlatform:
 			respondError(w, http.StatusBadRequest, "invalid platform selected")
 		default:
+			if strings.HasPrefix(err.Error(), "access denied") {
+				respondError(w, http.StatusForbidden, err.Error())
+				return
+			}
 			respondError(w, http.StatusBadRequest, err.Error())
 		}
 		return
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/application/realtime"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+const (
+	// streamHeartbeat keeps idle connections from being closed by proxies
+	streamHeartbeat = 25 * time.Second
+	// streamLifetime is how long a stream stays open before the client is
+	// asked to reconnect, which refreshes its permissions
+	streamLifetime = 30 * time.Minute
+	// streamWriteTimeout bounds each write to a slow client
+	streamWriteTimeout = 10 * time.Second
+	// streamRetry is the reconnect delay suggested to EventSource clients
+	streamRetry = 3 * time.Second
+)
+
+// RealtimeHandler streams a team's updates (/teams/:id/events) as
+// server-sent events, or over a WebSocket when the client asks to upgrade
+type RealtimeHandler struct {
+	streamUC *realtime.StreamUseCase
+	ticketUC *realtime.TicketUseCase
+	logger   common.Logger
+}
+
+// NewRealtimeHandler creates a new realtime handler
+func NewRealtimeHandler(streamUC *realtime.StreamUseCase, ticketUC *realtime.TicketUseCase, logger common.Logger) *RealtimeHandler {
+	return &RealtimeHandler{streamUC: streamUC, ticketUC: ticketUC, logger: logger}
+}
+
+// Authenticate accepts a stream ticket in the ticket query parameter in
+// place of the Authorization header, which browsers cannot send with
+// EventSource or WebSocket. Requests without a ticket go through
+// requireAuth.
+func (h *RealtimeHandler) Authenticate(requireAuth func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		authenticated := requireAuth(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ticket := r.URL.Query().Get("ticket")
+			if ticket == "" {
+				authenticated.ServeHTTP(w, r)
+				return
+			}
+
+			teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+			if err != nil {
+				respondError(w, http.StatusBadRequest, "invalid team ID")
+				return
+			}
+			userID, err := h.ticketUC.Redeem(r.Context(), teamID, ticket)
+			if err != nil {
+				respondError(w, http.StatusUnauthorized, realtime.ErrInvalidTicket.Error())
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), middleware.UserIDKey, userID.String())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// IssueTicket handles POST /api/v2/teams/:id/events/tickets
+func (h *RealtimeHandler) IssueTicket(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.ticketUC.Issue(r.Context(), realtime.IssueTicketInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondRealtimeError(w, err)
+		return
+	}
+	respondCreated(w, output)
+}
+
+// Stream handles GET /api/v2/teams/:id/events. Clients resume after the
+// Last-Event-ID header or the lastEventId query parameter; a stream.reset
+// event tells them updates were missed and they should refetch.
+func (h *RealtimeHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), streamLifetime)
+	defer cancel()
+
+	events, err := h.streamUC.Execute(ctx, realtime.StreamInput{
+		TeamID:      teamID,
+		UserID:      userID,
+		LastEventID: lastEventID,
+	})
+	if err != nil {
+		respondRealtimeError(w, err)
+		return
+	}
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		h.serveWebSocket(ctx, cancel, w, r, events)
+		return
+	}
+	h.serveEventStream(ctx, w, events)
+}
+
+func (h *RealtimeHandler) serveEventStream(ctx context.Context, w http.ResponseWriter, events <-chan *common.RealtimeEvent) {
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	write := func(frame string) bool {
+		// The server's write timeout would otherwise end the stream
+		if err := rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return false
+		}
+		if _, err := fmt.Fprint(w, frame); err != nil {
+			return false
+		}
+		return rc.Flush() == nil
+	}
+
+	if !write(fmt.Sprintf("retry: %d\n\n", streamRetry.Milliseconds())) {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			frame, err := eventStreamFrame(e)
+			if err != nil {
+				h.logger.Warn("Skipping unencodable realtime event", "type", e.Type, "error", err)
+				continue
+			}
+			if !write(frame) {
+				return
+			}
+		case <-heartbeat.C:
+			if !write(": ping\n\n") {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func eventStreamFrame(e *common.RealtimeEvent) (string, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if e.ID != "" {
+		b.WriteString("id: " + e.ID + "\n")
+	}
+	b.WriteString("event: " + e.Type + "\n")
+	b.WriteString("data: ")
+	b.Write(data)
+	b.WriteString("\n\n")
+	return b.String(), nil
+}
+
+func (h *RealtimeHandler) serveWebSocket(ctx context.Context, cancel context.CancelFunc, w http.ResponseWriter, r *http.Request, events <-chan *common.RealtimeEvent) {
+	conn, err := upgradeWebSocket(w, r)
+	if errors.Is(err, errWebSocketHandshake) {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Warn("WebSocket upgrade failed", "error", err)
+		return
+	}
+
+	go func() {
+		conn.readLoop()
+		cancel()
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				// Too far behind; the client reconnects and resumes
+				conn.close(wsCloseGoingAway)
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				h.logger.Warn("Skipping unencodable realtime event", "type", e.Type, "error", err)
+				continue
+			}
+			if err := conn.writeText(data, streamWriteTimeout); err != nil {
+				conn.close(wsCloseGoingAway)
+				return
+			}
+		case <-heartbeat.C:
+			if err := conn.writeFrame(wsOpPing, nil, streamWriteTimeout); err != nil {
+				conn.close(wsCloseGoingAway)
+				return
+			}
+		case <-ctx.Done():
+			conn.close(wsCloseGoingAway)
+			return
+		}
+	}
+}
+
+func respondRealtimeError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// RoleHandler handles team roles and the permission registry
+type RoleHandler struct {
+	listUC   *team.ListRolesUseCase
+	createUC *team.CreateRoleUseCase
+	updateUC *team.UpdateRoleUseCase
+	deleteUC *team.DeleteRoleUseCase
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(
+	listUC *team.ListRolesUseCase,
+	createUC *team.CreateRoleUseCase,
+	updateUC *team.UpdateRoleUseCase,
+	deleteUC *team.DeleteRoleUseCase,
+) *RoleHandler {
+	return &RoleHandler{
+		listUC:   listUC,
+		createUC: createUC,
+		updateUC: updateUC,
+		deleteUC: deleteUC,
+	}
+}
+
+// ListRoles handles GET /api/v2/teams/:id/roles
+func (h *RoleHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), team.ListRolesInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondRoleError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// CreateRole handles POST /api/v2/teams/:id/roles
+func (h *RoleHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.CreateRoleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createUC.Execute(r.Context(), input)
+	if err != nil {
+		respondRoleError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// UpdateRole handles PUT /api/v2/teams/:id/roles/:name
+func (h *RoleHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.UpdateRoleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.Name = chi.URLParam(r, "name")
+
+	output, err := h.updateUC.Execute(r.Context(), input)
+	if err != nil {
+		respondRoleError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// DeleteRole handles DELETE /api/v2/teams/:id/roles/:name
+func (h *RoleHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.deleteUC.Execute(r.Context(), team.DeleteRoleInput{
+		TeamID: teamID,
+		UserID: userID,
+		Name:   chi.URLParam(r, "name"),
+	})
+	if err != nil {
+		respondRoleError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Role deleted"})
+}
+
+func respondRoleError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"),
+		errors.Is(err, teamDomain.ErrSystemRoleReadOnly):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrRoleNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, teamDomain.ErrRoleExists),
+		errors.Is(err, teamDomain.ErrRoleInUse):
+		respondError(w, http.StatusConflict, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
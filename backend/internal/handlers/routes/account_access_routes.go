@@ -0,0 +1,23 @@
+// backend/internal/handlers/routes/account_access_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterAccountAccessRoutes sets up per-member social account access
+func RegisterAccountAccessRoutes(r chi.Router, h *handlers.AccountAccessHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/members/{userId}/social-access", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequireTeamMembership)
+
+		r.Get("/", h.GetAccess)
+		r.Put("/", h.SetAccess)
+	})
+}
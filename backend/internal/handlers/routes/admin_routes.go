@@ -0,0 +1,34 @@
+// path: backend/internal/handlers/routes/admin_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterAdminRoutes registers admin-only operational routes
+func RegisterAdminRoutes(r chi.Router, h *handlers.AdminHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(middleware.RequireAdmin)
+
+		// Background jobs
+		r.Get("/jobs", h.ListJobs)
+		r.Post("/jobs/{name}/run", h.RunJob)
+
+		// Sign-in lockouts
+		r.Get("/users/{id}/lockout", h.GetLockout)
+		r.Delete("/users/{id}/lockout", h.Unlock)
+
+		// Inbound platform webhooks
+		r.Post("/platform-webhooks/replay", h.ReplayWebhooks)
+
+		// Team plans
+		r.Put("/teams/{id}/plan", h.ChangeTeamPlan)
+	})
+}
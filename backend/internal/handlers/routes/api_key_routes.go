@@ -0,0 +1,32 @@
+// backend/internal/handlers/routes/api_key_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterAPIKeyRoutes sets up personal access tokens and team API keys.
+// Keys cannot be managed with a key, only from a signed-in session.
+func RegisterAPIKeyRoutes(r chi.Router, h *handlers.APIKeyHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/auth/api-keys", func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/", h.ListKeys)
+		r.Post("/", h.CreateKey)
+		r.Delete("/{keyId}", h.RevokeKey)
+	})
+
+	r.Route("/teams/{id}/api-keys", func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/", h.ListKeys)
+		r.Post("/", h.CreateKey)
+		r.Delete("/{keyId}", h.RevokeKey)
+	})
+}
@@ -0,0 +1,24 @@
+// backend/internal/handlers/routes/audit_log_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterAuditLogRoutes sets up the team audit log and its CSV export
+func RegisterAuditLogRoutes(r chi.Router, h *handlers.AuditLogHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/audit-log", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermAuditView))
+
+		r.Get("/", h.List)
+		r.Get("/export", h.Export)
+	})
+}
@@ -0,0 +1,127 @@
+// path: backend/internal/handlers/routes/audit_log_routes_test.go
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auditlog"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+type oneTeam struct {
+	team.Repository
+	team *team.Team
+}
+
+func (r oneTeam) FindByID(ctx context.Context, id uuid.UUID) (*team.Team, error) {
+	if r.team.ID() != id {
+		return nil, team.ErrTeamNotFound
+	}
+	return r.team, nil
+}
+
+type rolesByUser struct {
+	team.MemberRepository
+	roles map[uuid.UUID]team.MemberRole
+}
+
+func (r rolesByUser) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	role, ok := r.roles[userID]
+	if !ok {
+		return nil, team.ErrMemberNotFound
+	}
+	joined := time.Now()
+	return team.ReconstructMember(uuid.New(), teamID, userID, role, team.MemberStatusActive, userID, joined, &joined, nil), nil
+}
+
+// adminCanAudit gives only admins the audit.view permission
+type adminCanAudit struct {
+	team.RoleRepository
+}
+
+func (adminCanAudit) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	permissions := []team.Permission{team.PermPostsView}
+	if name == team.MemberRoleAdmin {
+		permissions = append(permissions, team.PermAuditView)
+	}
+	return team.ReconstructRole(uuid.New(), teamID, name, "", permissions, true, time.Now(), time.Now()), nil
+}
+
+type fixedLog struct {
+	audit.Repository
+	entries []*audit.Entry
+}
+
+func (r fixedLog) List(ctx context.Context, f audit.Filter) ([]*audit.Entry, error) {
+	return r.entries, nil
+}
+
+func TestAuditLogRoutes(t *testing.T) {
+	now := time.Now()
+	acme := team.Reconstruct(uuid.New(), "Acme", "acme", "", "", uuid.New(), team.PlanEnterprise, team.StatusActive,
+		team.TeamSettings{}, team.TeamLimits{}, now, now, nil)
+	admin, viewer := uuid.New(), uuid.New()
+	members := rolesByUser{roles: map[uuid.UUID]team.MemberRole{admin: team.MemberRoleAdmin, viewer: team.MemberRoleViewer}}
+	entry := audit.ReconstructEntry(uuid.New(), acme.ID(), admin, audit.ActionPlanChanged, audit.TargetTeam, acme.ID().String(),
+		"", "", audit.Changes{"plan": {From: "free", To: "enterprise"}}, nil, now)
+
+	tokens := services.NewJWTTokenService("access-secret", "refresh-secret")
+	list := auditlog.NewListAuditLogUseCase(fixedLog{entries: []*audit.Entry{entry}}, oneTeam{team: acme}, members, adminCanAudit{})
+	r := chi.NewRouter()
+	RegisterAuditLogRoutes(r, handlers.NewAuditLogHandler(list), middleware.NewAuthMiddleware(tokens, nil),
+		middleware.NewPolicyMiddleware(team.NewAuthorizer(members, adminCanAudit{})))
+
+	get := func(userID uuid.UUID, path string) *httptest.ResponseRecorder {
+		t.Helper()
+		token, err := tokens.GenerateAccessToken(userID.String(), "someone@acme.com", "user")
+		if err != nil {
+			t.Fatalf("GenerateAccessToken: %v", err)
+		}
+		req := httptest.NewRequest(http.MethodGet, "/teams/"+acme.ID().String()+"/audit-log"+path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get(admin, "/?action=billing.*&limit=10")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("admin: status = %d, body %s", rec.Code, rec.Body)
+	}
+	var body struct {
+		Data auditlog.ListAuditLogOutput `json:"data"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(body.Data.Entries) != 1 || body.Data.Entries[0].Changes["plan"].To != "enterprise" {
+		t.Errorf("admin: entries = %+v, want the plan change", body.Data.Entries)
+	}
+
+	rec = get(admin, "/export")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "plan: free -> enterprise") {
+		t.Errorf("export: status = %d, body %s", rec.Code, rec.Body)
+	}
+
+	if rec := get(admin, "/?since=yesterday"); rec.Code != http.StatusBadRequest {
+		t.Errorf("bad since: status = %d, want 400", rec.Code)
+	}
+	if rec := get(viewer, "/"); rec.Code != http.StatusForbidden {
+		t.Errorf("viewer: status = %d, want 403", rec.Code)
+	}
+	if rec := get(uuid.New(), "/"); rec.Code != http.StatusForbidden {
+		t.Errorf("outsider: status = %d, want 403", rec.Code)
+	}
+}
@@ -7,15 +7,20 @@ import (
 	"github.com/techappsUT/social-queue/internal/middleware"
 )
 
-// RegisterAuthRoutes sets up all authentication-related routes
-func RegisterAuthRoutes(r chi.Router, h *handlers.AuthHandler, authMW *middleware.AuthMiddleware) {
+// RegisterAuthRoutes sets up all authentication-related routes. The rate
+// limiter is nil when Redis is unavailable.
+func RegisterAuthRoutes(r chi.Router, h *handlers.AuthHandler, authMW *middleware.AuthMiddleware, limiter *middleware.RateLimiter) {
 	// ========================================================================
 	// PUBLIC AUTH ROUTES (no authentication required)
 	// ========================================================================
 	r.Route("/auth", func(r chi.Router) {
 		// User registration & authentication
 		r.Post("/signup", h.Signup)
-		r.Post("/login", h.Login)
+		if limiter != nil {
+			r.With(limiter.RateLimitByIP(middleware.DefaultRateLimitConfigs["auth"])).Post("/login", h.Login)
+		} else {
+			r.Post("/login", h.Login)
+		}
 
 		// Token management
 		r.Post("/refresh", h.RefreshToken)
@@ -28,6 +33,15 @@ func RegisterAuthRoutes(r chi.Router, h *handlers.AuthHandler, authMW *middlewar
 		// Password reset
 		r.Post("/forgot-password", h.ForgotPassword)
 		r.Post("/reset-password", h.ResetPassword)
+
+		// Active sessions (one per login, across token rotations)
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireSession)
+
+			r.Get("/sessions", h.ListSessions)
+			r.Delete("/sessions", h.RevokeAllSessions)
+			r.Delete("/sessions/{id}", h.RevokeSession)
+		})
 	})
 
 	// ========================================================================
@@ -35,7 +49,7 @@ func RegisterAuthRoutes(r chi.Router, h *handlers.AuthHandler, authMW *middlewar
 	// ========================================================================
 	// PROTECTED ROUTES (require authentication) - in a sub-group
 	r.Group(func(r chi.Router) {
-		r.Use(authMW.RequireAuth)
+		r.Use(authMW.RequireSession)
 
 		r.Get("/me", h.GetUser)                      // Get current user
 		r.Post("/change-password", h.ChangePassword) // Change password
@@ -0,0 +1,137 @@
+// path: backend/internal/handlers/routes/auth_routes_test.go
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// sessionsByUser keeps each user's active sessions
+type sessionsByUser struct {
+	user.SessionRepository
+	sessions map[uuid.UUID][]*user.Session
+}
+
+func (r *sessionsByUser) ListSessions(ctx context.Context, userID uuid.UUID) ([]*user.Session, error) {
+	return r.sessions[userID], nil
+}
+
+func (r *sessionsByUser) RevokeSession(ctx context.Context, userID, familyID uuid.UUID, reason string) error {
+	for i, s := range r.sessions[userID] {
+		if s.ID == familyID {
+			r.sessions[userID] = append(r.sessions[userID][:i], r.sessions[userID][i+1:]...)
+			return nil
+		}
+	}
+	return user.ErrSessionNotFound
+}
+
+func (r *sessionsByUser) RevokeAllForUser(ctx context.Context, userID uuid.UUID, reason string) error {
+	delete(r.sessions, userID)
+	return nil
+}
+
+func TestSessionRoutes(t *testing.T) {
+	ana, bob := uuid.New(), uuid.New()
+	session := func(agent string) *user.Session {
+		now := time.Now()
+		return &user.Session{ID: uuid.New(), UserAgent: agent, IPAddress: "203.0.113.7", CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)}
+	}
+	laptop, phone, bobs := session("laptop"), session("phone"), session("tablet")
+	store := &sessionsByUser{sessions: map[uuid.UUID][]*user.Session{ana: {laptop, phone}, bob: {bobs}}}
+
+	key, apiKey, err := team.NewAPIKey(uuid.New(), ana, "ci", []team.Permission{team.PermPostsView}, nil)
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	tokens := services.NewJWTTokenService("access-secret", "refresh-secret")
+	logger := services.NewLogger()
+	h := handlers.NewAuthHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		auth.NewListSessionsUseCase(store, logger), auth.NewRevokeSessionUseCase(store, logger))
+	r := chi.NewRouter()
+	RegisterAuthRoutes(r, h, middleware.NewAuthMiddleware(tokens, staticKeys{key: key}), nil)
+
+	send := func(method, path, bearer string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, "/auth/sessions"+path, nil)
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+	token, err := tokens.GenerateAccessToken(ana.String(), "ana@acme.com", "user")
+	if err != nil {
+		t.Fatalf("GenerateAccessToken: %v", err)
+	}
+	listed := func() []auth.SessionDTO {
+		t.Helper()
+		rec := send(http.MethodGet, "", token)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET: status = %d, body %s", rec.Code, rec.Body)
+		}
+		var body struct {
+			Data auth.ListSessionsOutput `json:"data"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return body.Data.Sessions
+	}
+
+	if got := listed(); len(got) != 2 || got[0].ID != laptop.ID.String() || got[1].UserAgent != "phone" {
+		t.Errorf("GET: sessions = %+v, want laptop and phone", got)
+	}
+
+	// Another user's session is not found rather than revoked
+	if rec := send(http.MethodDelete, "/"+bobs.ID.String(), token); rec.Code != http.StatusNotFound {
+		t.Errorf("DELETE another user's session: status = %d, want 404", rec.Code)
+	}
+	if rec := send(http.MethodDelete, "/phone", token); rec.Code != http.StatusBadRequest {
+		t.Errorf("DELETE bad ID: status = %d, want 400", rec.Code)
+	}
+	if rec := send(http.MethodDelete, "/"+phone.ID.String(), token); rec.Code != http.StatusOK {
+		t.Errorf("DELETE own session: status = %d, body %s", rec.Code, rec.Body)
+	}
+	if got := listed(); len(got) != 1 || got[0].ID != laptop.ID.String() {
+		t.Errorf("after DELETE: sessions = %+v, want laptop", got)
+	}
+
+	if rec := send(http.MethodDelete, "", token); rec.Code != http.StatusOK {
+		t.Errorf("DELETE all: status = %d, body %s", rec.Code, rec.Body)
+	}
+	if got := listed(); len(got) != 0 {
+		t.Errorf("after DELETE all: sessions = %+v, want none", got)
+	}
+	if len(store.sessions[bob]) != 1 {
+		t.Error("DELETE all revoked another user's sessions")
+	}
+
+	for name, tt := range map[string]struct {
+		bearer string
+		want   int
+	}{
+		"anonymous": {"", http.StatusUnauthorized},
+		"api key":   {apiKey, http.StatusForbidden},
+	} {
+		for _, method := range []string{http.MethodGet, http.MethodDelete} {
+			if rec := send(method, "", tt.bearer); rec.Code != tt.want {
+				t.Errorf("%s %s: status = %d, want %d", name, method, rec.Code, tt.want)
+			}
+		}
+	}
+}
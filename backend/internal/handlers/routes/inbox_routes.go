@@ -0,0 +1,57 @@
+// backend/internal/handlers/routes/inbox_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterInboxRoutes sets up the team's unified inbox of comments,
+// mentions and direct messages
+func RegisterInboxRoutes(r chi.Router, h *handlers.InboxHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/inbox/conversations", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermInboxView))
+
+		r.Get("/", h.List)
+		r.Get("/{conversationId}", h.Get)
+		r.Patch("/{conversationId}", h.UpdateState)
+		r.Put("/{conversationId}/assignee", h.Assign)
+		r.Post("/{conversationId}/replies", h.Reply)
+	})
+}
+
+// RegisterInboxRuleRoutes sets up the team's saved replies and the rules
+// that act on received messages
+func RegisterInboxRuleRoutes(r chi.Router, h *handlers.InboxRulesHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/inbox/saved-replies", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermInboxView))
+
+		r.Get("/", h.ListSavedReplies)
+		r.Post("/", h.CreateSavedReply)
+		r.Patch("/{savedReplyId}", h.UpdateSavedReply)
+		r.Delete("/{savedReplyId}", h.DeleteSavedReply)
+	})
+
+	r.Route("/teams/{id}/inbox/rules", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermInboxView))
+
+		r.Get("/", h.ListRules)
+		r.Post("/", h.CreateRule)
+		r.Post("/test", h.TestRules)
+		r.Patch("/{ruleId}", h.UpdateRule)
+		r.Delete("/{ruleId}", h.DeleteRule)
+	})
+}
@@ -0,0 +1,40 @@
+// backend/internal/handlers/routes/oauth_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterOAuthRoutes sets up the OAuth 2.0 authorization server. Client
+// registration and consent need a signed-in session; the token,
+// introspection and revocation endpoints authenticate the client itself.
+func RegisterOAuthRoutes(r chi.Router, h *handlers.OAuthHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/oauth/clients", func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/", h.ListClients)
+		r.Post("/", h.RegisterClient)
+		r.Delete("/{clientId}", h.DeleteClient)
+	})
+
+	r.Route("/oauth", func(r chi.Router) {
+		// PUBLIC: client authenticated
+		r.Post("/token", h.Token)
+		r.Post("/introspect", h.Introspect)
+		r.Post("/revoke", h.Revoke)
+
+		// PROTECTED: the resource owner
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireSession)
+
+			r.Get("/authorize", h.Authorize)
+			r.Post("/authorize", h.Decide)
+		})
+	})
+}
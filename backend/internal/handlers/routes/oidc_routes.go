@@ -0,0 +1,34 @@
+// backend/internal/handlers/routes/oidc_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterOIDCRoutes sets up sign-in with external identity providers
+func RegisterOIDCRoutes(r chi.Router, h *handlers.OIDCHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/auth/oidc", func(r chi.Router) {
+		r.Get("/", h.Providers)
+		r.Get("/{provider}", h.Redirect)
+		r.Post("/{provider}/callback", h.Callback)
+
+		// Signed in, the flow links the provider instead of logging in
+		r.With(authMW.OptionalAuth).Post("/{provider}", h.Authorize)
+		r.With(authMW.RequireSession).Delete("/{provider}", h.Unlink)
+	})
+
+	// PROTECTED
+	r.Group(func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/auth/identities", h.ListIdentities)
+		r.Post("/auth/password", h.SetPassword)
+		r.Delete("/auth/password", h.RemovePassword)
+	})
+}
@@ -0,0 +1,32 @@
+// backend/internal/handlers/routes/passkey_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterPasskeyRoutes sets up WebAuthn passkey routes
+func RegisterPasskeyRoutes(r chi.Router, h *handlers.PasskeyHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/passkeys", func(r chi.Router) {
+		// Passwordless login, or the second step of a password login
+		r.Post("/login/options", h.LoginOptions)
+		r.Post("/login", h.Login)
+
+		// PROTECTED
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireSession)
+
+			r.Get("/", h.List)
+			r.Post("/register/options", h.RegistrationOptions)
+			r.Post("/register", h.Register)
+			r.Patch("/{id}", h.Rename)
+			r.Delete("/{id}", h.Delete)
+		})
+	})
+}
@@ -0,0 +1,21 @@
+// backend/internal/handlers/routes/platform_webhook_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+)
+
+// RegisterPlatformWebhookRoutes sets up the callback URLs registered with
+// the social platforms. They live at /webhooks, outside the versioned API,
+// and are authenticated by the platforms' signatures.
+func RegisterPlatformWebhookRoutes(r chi.Router, h *handlers.PlatformWebhookHandler) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/webhooks/{platform}", func(r chi.Router) {
+		r.Get("/", h.Verify)
+		r.Post("/", h.Receive)
+	})
+}
@@ -0,0 +1,26 @@
+// backend/internal/handlers/routes/realtime_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RealtimeStreamPattern is the long-lived event stream, which the request
+// timeout leaves alone
+const RealtimeStreamPattern = "/teams/{id}/events"
+
+// RegisterRealtimeRoutes sets up the team's stream of live updates. The
+// stream authorizes members itself, so it only needs an identity: a bearer
+// token, or a ticket issued to a signed-in session.
+func RegisterRealtimeRoutes(r chi.Router, h *handlers.RealtimeHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route(RealtimeStreamPattern, func(r chi.Router) {
+		r.With(h.Authenticate(authMW.RequireAuth)).Get("/", h.Stream)
+		r.With(authMW.RequireSession).Post("/tickets", h.IssueTicket)
+	})
+}
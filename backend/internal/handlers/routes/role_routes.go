@@ -0,0 +1,25 @@
+// backend/internal/handlers/routes/role_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterRoleRoutes sets up team roles and the permission registry
+func RegisterRoleRoutes(r chi.Router, h *handlers.RoleHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/roles", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequireTeamMembership)
+
+		r.Get("/", h.ListRoles)
+		r.Post("/", h.CreateRole)
+		r.Put("/{name}", h.UpdateRole)
+		r.Delete("/{name}", h.DeleteRole)
+	})
+}
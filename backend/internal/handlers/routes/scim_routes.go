@@ -0,0 +1,56 @@
+// backend/internal/handlers/routes/scim_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterSCIMRoutes sets up the team admin endpoints for SCIM provisioning
+func RegisterSCIMRoutes(r chi.Router, h *handlers.SCIMHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	// PROTECTED: team owners and admins
+	r.Route("/teams/{id}/scim", func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/tokens", h.ListTokens)
+		r.Post("/tokens", h.CreateToken)
+		r.Delete("/tokens/{tokenId}", h.RevokeToken)
+
+		r.Get("/groups", h.ListGroupRoles)
+		r.Put("/groups/{groupId}/role", h.SetGroupRole)
+	})
+}
+
+// RegisterSCIMAPIRoutes sets up the SCIM 2.0 API identity providers call.
+// It lives at /scim/v2, outside the versioned API, and authenticates with
+// team SCIM tokens instead of user sessions.
+func RegisterSCIMAPIRoutes(r chi.Router, h *handlers.SCIMHandler) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/scim/v2", func(r chi.Router) {
+		r.Use(h.Authenticate)
+
+		r.Get("/ServiceProviderConfig", h.ServiceProviderConfig)
+
+		r.Get("/Users", h.ListUsers)
+		r.Post("/Users", h.CreateUser)
+		r.Get("/Users/{id}", h.GetUser)
+		r.Put("/Users/{id}", h.ReplaceUser)
+		r.Patch("/Users/{id}", h.PatchUser)
+		r.Delete("/Users/{id}", h.DeleteUser)
+
+		r.Get("/Groups", h.ListGroups)
+		r.Post("/Groups", h.CreateGroup)
+		r.Get("/Groups/{id}", h.GetGroup)
+		r.Put("/Groups/{id}", h.ReplaceGroup)
+		r.Patch("/Groups/{id}", h.PatchGroup)
+		r.Delete("/Groups/{id}", h.DeleteGroup)
+	})
+}
@@ -3,12 +3,13 @@ package routes
 
 import (
 	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 	"github.com/techappsUT/social-queue/internal/handlers"
 	"github.com/techappsUT/social-queue/internal/middleware"
 )
 
 // RegisterSocialRoutes registers social OAuth and account routes
-func RegisterSocialRoutes(r chi.Router, h *handlers.SocialHandler, authMW *middleware.AuthMiddleware) {
+func RegisterSocialRoutes(r chi.Router, h *handlers.SocialHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
 	if h == nil {
 		return
 	}
@@ -37,6 +38,7 @@ func RegisterSocialRoutes(r chi.Router, h *handlers.SocialHandler, authMW *middl
 					r.Delete("/", h.DisconnectAccount)
 					r.Post("/refresh", h.RefreshTokens)
 					r.Post("/publish", h.PublishPost)
+					r.Get("/quota", h.GetQuota)
 					r.Get("/posts/{postId}/analytics", h.GetAnalytics)
 				})
 			})
@@ -46,6 +48,7 @@ func RegisterSocialRoutes(r chi.Router, h *handlers.SocialHandler, authMW *middl
 	// Team-specific social account routes
 	r.Route("/teams/{teamId}/social", func(r chi.Router) {
 		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermAccountsView))
 		r.Get("/accounts", h.ListAccounts)
 	})
 }
@@ -0,0 +1,32 @@
+// backend/internal/handlers/routes/sso_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterSSORoutes sets up team SAML configuration and sign-in
+func RegisterSSORoutes(r chi.Router, h *handlers.SSOHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	// PUBLIC: the browser and the identity provider talk to these
+	r.Route("/sso/saml", func(r chi.Router) {
+		r.Get("/login", h.Login)
+		r.Get("/{teamId}/metadata", h.Metadata)
+		r.Post("/{teamId}/acs", h.ACS)
+	})
+
+	// PROTECTED: team owners and admins
+	r.Route("/teams/{id}/sso", func(r chi.Router) {
+		r.Use(authMW.RequireSession)
+
+		r.Get("/", h.GetConfig)
+		r.Put("/", h.Configure)
+		r.Delete("/", h.DeleteConfig)
+		r.Post("/domains/{domain}/verify", h.VerifyDomain)
+	})
+}
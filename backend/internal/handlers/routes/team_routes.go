@@ -3,33 +3,41 @@ package routes
 
 import (
 	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 	"github.com/techappsUT/social-queue/internal/handlers"
 	"github.com/techappsUT/social-queue/internal/middleware"
 )
 
-func RegisterTeamRoutes(r chi.Router, h *handlers.TeamHandler, authMW *middleware.AuthMiddleware) {
+// RegisterTeamRoutes sets up teams, members and invitations. Creating,
+// listing and joining teams act for the user rather than in one team, so
+// API keys and OAuth tokens cannot reach them.
+func RegisterTeamRoutes(r chi.Router, h *handlers.TeamHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
 	r.Route("/teams", func(r chi.Router) {
-		r.Use(authMW.RequireAuth)
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireSession)
 
-		// Team CRUD
-		r.Get("/", h.ListTeams)
-		r.Post("/", h.CreateTeam)
-		r.Get("/{id}", h.GetTeam)
-		r.Put("/{id}", h.UpdateTeam)
-		r.Delete("/{id}", h.DeleteTeam)
+			r.Get("/", h.ListTeams)
+			r.Post("/", h.CreateTeam)
+			r.Post("/{id}/accept", h.AcceptInvitation)
+		})
 
-		// Team member management
-		r.Post("/{id}/members", h.InviteMember)
-		r.Delete("/{id}/members/{userId}", h.RemoveMember)
-		r.Patch("/{id}/members/{userId}/role", h.UpdateMemberRole)
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireAuth)
 
-		// NEW: Accept invitation
-		r.Post("/{id}/accept", h.AcceptInvitation)
+			// Team CRUD
+			r.With(policy.RequireTeamMembership).Get("/{id}", h.GetTeam)
+			r.With(policy.RequirePermission(team.PermTeamManage)).Put("/{id}", h.UpdateTeam)
+			r.With(policy.RequirePermission(team.PermTeamDelete)).Delete("/{id}", h.DeleteTeam)
+
+			// Team member management
+			r.With(policy.RequirePermission(team.PermMembersInvite)).Post("/{id}/members", h.InviteMember)
+			r.With(policy.RequirePermission(team.PermMembersManage)).Delete("/{id}/members/{userId}", h.RemoveMember)
+			r.With(policy.RequirePermission(team.PermMembersManage)).Patch("/{id}/members/{userId}/role", h.UpdateMemberRole)
+		})
 	})
 
-	// NEW: Invitations route (separate from /teams)
 	r.Route("/invitations", func(r chi.Router) {
-		r.Use(authMW.RequireAuth)
+		r.Use(authMW.RequireSession)
 		r.Get("/pending", h.GetPendingInvitations)
 	})
 }
@@ -0,0 +1,64 @@
+// path: backend/internal/handlers/routes/team_routes_test.go
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+type staticKeys struct {
+	key *team.APIKey
+}
+
+func (s staticKeys) Execute(ctx context.Context, rawKey string) (*team.APIKey, error) {
+	return s.key, nil
+}
+
+// TestTeamRoutesRejectScopedCredentials checks API keys and OAuth tokens
+// cannot create, list or join teams
+func TestTeamRoutesRejectScopedCredentials(t *testing.T) {
+	teamID, userID := uuid.New(), uuid.New()
+	key, raw, err := team.NewAPIKey(teamID, userID, "ci", []team.Permission{team.PermPostsView}, nil)
+	if err != nil {
+		t.Fatalf("NewAPIKey: %v", err)
+	}
+	tokens := services.NewJWTTokenService("access-secret", "refresh-secret")
+	oauthToken, err := tokens.GenerateOAuthAccessToken(userID.String(), teamID.String(), "client", []string{string(team.PermPostsView)}, time.Hour)
+	if err != nil {
+		t.Fatalf("GenerateOAuthAccessToken: %v", err)
+	}
+
+	r := chi.NewRouter()
+	RegisterTeamRoutes(r, &handlers.TeamHandler{}, middleware.NewAuthMiddleware(tokens, staticKeys{key: key}), nil)
+
+	routes := []struct{ method, path string }{
+		{http.MethodGet, "/teams"},
+		{http.MethodPost, "/teams"},
+		{http.MethodPost, "/teams/" + teamID.String() + "/accept"},
+		{http.MethodGet, "/invitations/pending"},
+	}
+	credentials := map[string]string{"api key": raw, "oauth token": oauthToken}
+
+	for _, route := range routes {
+		for name, credential := range credentials {
+			req := httptest.NewRequest(route.method, route.path, nil)
+			req.Header.Set("Authorization", "Bearer "+credential)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Errorf("%s %s with %s: status = %d, want 403", route.method, route.path, name, rec.Code)
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// backend/internal/handlers/routes/two_factor_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterTwoFactorRoutes sets up TOTP enrollment and verification routes
+func RegisterTwoFactorRoutes(r chi.Router, h *handlers.TwoFactorHandler, authMW *middleware.AuthMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/2fa", func(r chi.Router) {
+		// Second login step, authenticated by the challenge token
+		r.Post("/verify", h.Verify)
+
+		// Enrollment: signed in, or with an enrollment challenge from login
+		r.With(authMW.OptionalAuth).Post("/setup", h.Setup)
+		r.With(authMW.OptionalAuth).Post("/enable", h.Enable)
+
+		// PROTECTED
+		r.Group(func(r chi.Router) {
+			r.Use(authMW.RequireSession)
+
+			r.Get("/", h.Status)
+			r.Post("/disable", h.Disable)
+			r.Post("/recovery-codes", h.RegenerateRecoveryCodes)
+		})
+	})
+}
@@ -9,23 +9,29 @@ import (
 
 // RegisterUserRoutes sets up all user management routes
 // Only includes IMPLEMENTED endpoints (no TODOs)
-func RegisterUserRoutes(r chi.Router, h *handlers.AuthHandler, authMW *middleware.AuthMiddleware) {
+// and, when notifications are wired, the user's notification center
+func RegisterUserRoutes(r chi.Router, h *handlers.AuthHandler, notifications *handlers.NotificationHandler, authMW *middleware.AuthMiddleware) {
 	// ========================================================================
 	// USER ROUTES (authentication required)
 	// ========================================================================
 	r.Route("/users", func(r chi.Router) {
-		r.Use(authMW.RequireAuth)
+		r.Use(authMW.RequireSession)
 
 		// ✅ Implemented user profile operations
 		r.Get("/{id}", h.GetUser)       // Get user by ID
 		r.Put("/{id}", h.UpdateUser)    // Update user profile
 		r.Delete("/{id}", h.DeleteUser) // Delete user account
 
+		// ✅ Notification center ({id} is the caller or "me")
+		if notifications != nil {
+			r.Get("/{id}/notifications", notifications.List)
+			r.Put("/{id}/notifications/{notifId}", notifications.MarkRead)
+			r.Post("/{id}/notifications/read-all", notifications.MarkAllRead)
+			r.Get("/{id}/preferences", notifications.GetPreferences)
+			r.Put("/{id}/preferences", notifications.UpdatePreferences)
+		}
+
 		// TODO: Implement these later
-		// r.Get("/{id}/preferences", h.GetUserPreferences)
-		// r.Put("/{id}/preferences", h.UpdateUserPreferences)
-		// r.Get("/{id}/notifications", h.GetUserNotifications)
-		// r.Put("/{id}/notifications/{notifId}", h.MarkNotificationRead)
 		// r.Get("/{id}/activity", h.GetUserActivity)
 	})
 
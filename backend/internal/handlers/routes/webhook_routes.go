@@ -0,0 +1,30 @@
+// backend/internal/handlers/routes/webhook_routes.go
+package routes
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/handlers"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// RegisterWebhookRoutes sets up a team's outgoing webhooks, their delivery
+// logs and test deliveries
+func RegisterWebhookRoutes(r chi.Router, h *handlers.WebhookHandler, authMW *middleware.AuthMiddleware, policy *middleware.PolicyMiddleware) {
+	if h == nil {
+		return
+	}
+
+	r.Route("/teams/{id}/webhooks", func(r chi.Router) {
+		r.Use(authMW.RequireAuth)
+		r.Use(policy.RequirePermission(team.PermTeamManage))
+
+		r.Get("/", h.List)
+		r.Post("/", h.Create)
+		r.Get("/event-types", h.EventTypes)
+		r.Patch("/{webhookId}", h.Update)
+		r.Delete("/{webhookId}", h.Delete)
+		r.Get("/{webhookId}/deliveries", h.Deliveries)
+		r.Post("/{webhookId}/test", h.SendTest)
+	})
+}
@@ -0,0 +1,520 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+type scimTeamKey struct{}
+
+// SCIMHandler serves the SCIM 2.0 API identity providers provision teams
+// through, and the team admin endpoints that manage it
+type SCIMHandler struct {
+	authenticateUC *team.AuthenticateSCIMUseCase
+
+	listUsersUC  *team.ListSCIMUsersUseCase
+	getUserUC    *team.GetSCIMUserUseCase
+	createUserUC *team.CreateSCIMUserUseCase
+	updateUserUC *team.UpdateSCIMUserUseCase
+	deleteUserUC *team.DeleteSCIMUserUseCase
+
+	listGroupsUC  *team.ListSCIMGroupsUseCase
+	getGroupUC    *team.GetSCIMGroupUseCase
+	createGroupUC *team.CreateSCIMGroupUseCase
+	updateGroupUC *team.UpdateSCIMGroupUseCase
+	deleteGroupUC *team.DeleteSCIMGroupUseCase
+
+	createTokenUC    *team.CreateSCIMTokenUseCase
+	listTokensUC     *team.ListSCIMTokensUseCase
+	revokeTokenUC    *team.RevokeSCIMTokenUseCase
+	listGroupRolesUC *team.ListSCIMGroupRolesUseCase
+	setGroupRoleUC   *team.SetSCIMGroupRoleUseCase
+}
+
+// NewSCIMHandler creates a new SCIM handler
+func NewSCIMHandler(
+	authenticateUC *team.AuthenticateSCIMUseCase,
+	listUsersUC *team.ListSCIMUsersUseCase,
+	getUserUC *team.GetSCIMUserUseCase,
+	createUserUC *team.CreateSCIMUserUseCase,
+	updateUserUC *team.UpdateSCIMUserUseCase,
+	deleteUserUC *team.DeleteSCIMUserUseCase,
+	listGroupsUC *team.ListSCIMGroupsUseCase,
+	getGroupUC *team.GetSCIMGroupUseCase,
+	createGroupUC *team.CreateSCIMGroupUseCase,
+	updateGroupUC *team.UpdateSCIMGroupUseCase,
+	deleteGroupUC *team.DeleteSCIMGroupUseCase,
+	createTokenUC *team.CreateSCIMTokenUseCase,
+	listTokensUC *team.ListSCIMTokensUseCase,
+	revokeTokenUC *team.RevokeSCIMTokenUseCase,
+	listGroupRolesUC *team.ListSCIMGroupRolesUseCase,
+	setGroupRoleUC *team.SetSCIMGroupRoleUseCase,
+) *SCIMHandler {
+	return &SCIMHandler{
+		authenticateUC:   authenticateUC,
+		listUsersUC:      listUsersUC,
+		getUserUC:        getUserUC,
+		createUserUC:     createUserUC,
+		updateUserUC:     updateUserUC,
+		deleteUserUC:     deleteUserUC,
+		listGroupsUC:     listGroupsUC,
+		getGroupUC:       getGroupUC,
+		createGroupUC:    createGroupUC,
+		updateGroupUC:    updateGroupUC,
+		deleteGroupUC:    deleteGroupUC,
+		createTokenUC:    createTokenUC,
+		listTokensUC:     listTokensUC,
+		revokeTokenUC:    revokeTokenUC,
+		listGroupRolesUC: listGroupRolesUC,
+		setGroupRoleUC:   setGroupRoleUC,
+	}
+}
+
+// ============================================================================
+// SCIM API (bearer token)
+// ============================================================================
+
+// Authenticate resolves the SCIM bearer token to its team
+func (h *SCIMHandler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			respondSCIMError(w, http.StatusUnauthorized, "", "missing bearer token")
+			return
+		}
+
+		teamID, err := h.authenticateUC.Execute(r.Context(), strings.TrimSpace(token))
+		if err != nil {
+			if errors.Is(err, teamDomain.ErrFeatureNotAvailable) {
+				respondSCIMError(w, http.StatusForbidden, "", err.Error())
+				return
+			}
+			respondSCIMError(w, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), scimTeamKey{}, teamID)))
+	})
+}
+
+func scimTeamID(r *http.Request) uuid.UUID {
+	teamID, _ := r.Context().Value(scimTeamKey{}).(uuid.UUID)
+	return teamID
+}
+
+// ServiceProviderConfig handles GET /scim/v2/ServiceProviderConfig
+func (h *SCIMHandler) ServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	respondSCIM(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{"urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": 200},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]string{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "Team SCIM token",
+		}},
+	})
+}
+
+// ListUsers handles GET /scim/v2/Users
+func (h *SCIMHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listUsersUC.Execute(r.Context(), scimListInput(r))
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// GetUser handles GET /scim/v2/Users/:id
+func (h *SCIMHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	userID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getUserUC.Execute(r.Context(), team.GetSCIMUserInput{TeamID: scimTeamID(r), UserID: userID})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// CreateUser handles POST /scim/v2/Users
+func (h *SCIMHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var resource team.SCIMUserResource
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.createUserUC.Execute(r.Context(), team.CreateSCIMUserInput{TeamID: scimTeamID(r), Resource: resource})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusCreated, output)
+}
+
+// ReplaceUser handles PUT /scim/v2/Users/:id
+func (h *SCIMHandler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	userID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+	var resource team.SCIMUserResource
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.updateUserUC.Execute(r.Context(), team.UpdateSCIMUserInput{
+		TeamID:   scimTeamID(r),
+		UserID:   userID,
+		Resource: &resource,
+	})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// PatchUser handles PATCH /scim/v2/Users/:id
+func (h *SCIMHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	userID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+	var patch team.SCIMPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.updateUserUC.Execute(r.Context(), team.UpdateSCIMUserInput{
+		TeamID: scimTeamID(r),
+		UserID: userID,
+		Patch:  &patch,
+	})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// DeleteUser handles DELETE /scim/v2/Users/:id
+func (h *SCIMHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	userID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteUserUC.Execute(r.Context(), team.DeleteSCIMUserInput{TeamID: scimTeamID(r), UserID: userID}); err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups handles GET /scim/v2/Groups
+func (h *SCIMHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listGroupsUC.Execute(r.Context(), scimListInput(r))
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// GetGroup handles GET /scim/v2/Groups/:id
+func (h *SCIMHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getGroupUC.Execute(r.Context(), team.GetSCIMGroupInput{TeamID: scimTeamID(r), GroupID: groupID})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// CreateGroup handles POST /scim/v2/Groups
+func (h *SCIMHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var resource team.SCIMGroupResource
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.createGroupUC.Execute(r.Context(), team.CreateSCIMGroupInput{TeamID: scimTeamID(r), Resource: resource})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusCreated, output)
+}
+
+// ReplaceGroup handles PUT /scim/v2/Groups/:id
+func (h *SCIMHandler) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+	var resource team.SCIMGroupResource
+	if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.updateGroupUC.Execute(r.Context(), team.UpdateSCIMGroupInput{
+		TeamID:   scimTeamID(r),
+		GroupID:  groupID,
+		Resource: &resource,
+	})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// PatchGroup handles PATCH /scim/v2/Groups/:id
+func (h *SCIMHandler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+	var patch team.SCIMPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		respondSCIMError(w, http.StatusBadRequest, "invalidSyntax", "invalid request body")
+		return
+	}
+
+	output, err := h.updateGroupUC.Execute(r.Context(), team.UpdateSCIMGroupInput{
+		TeamID:  scimTeamID(r),
+		GroupID: groupID,
+		Patch:   &patch,
+	})
+	if err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	respondSCIM(w, http.StatusOK, output)
+}
+
+// DeleteGroup handles DELETE /scim/v2/Groups/:id
+func (h *SCIMHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, ok := scimResourceID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteGroupUC.Execute(r.Context(), team.DeleteSCIMGroupInput{TeamID: scimTeamID(r), GroupID: groupID}); err != nil {
+		respondSCIMUseCaseError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func scimListInput(r *http.Request) team.SCIMListInput {
+	query := r.URL.Query()
+	startIndex, _ := strconv.Atoi(query.Get("startIndex"))
+	count, _ := strconv.Atoi(query.Get("count"))
+	return team.SCIMListInput{
+		TeamID:     scimTeamID(r),
+		Filter:     query.Get("filter"),
+		StartIndex: startIndex,
+		Count:      count,
+	}
+}
+
+// scimResourceID parses the id path parameter; unknown ids are not found
+func scimResourceID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondSCIMError(w, http.StatusNotFound, "", "resource not found")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func respondSCIM(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func respondSCIMError(w http.ResponseWriter, status int, scimType, detail string) {
+	body := map[string]interface{}{
+		"schemas": []string{scimErrorSchema},
+		"status":  strconv.Itoa(status),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	respondSCIM(w, status, body)
+}
+
+func respondSCIMUseCaseError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, teamDomain.ErrMemberNotFound),
+		errors.Is(err, teamDomain.ErrSCIMGroupNotFound):
+		respondSCIMError(w, http.StatusNotFound, "", err.Error())
+	case errors.Is(err, teamDomain.ErrSCIMUserExists),
+		errors.Is(err, teamDomain.ErrSCIMGroupExists),
+		errors.Is(err, userDomain.ErrAccountLinkRequired):
+		respondSCIMError(w, http.StatusConflict, "uniqueness", err.Error())
+	case errors.Is(err, team.ErrSCIMInvalidFilter):
+		respondSCIMError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+	case errors.Is(err, teamDomain.ErrCannotRemoveOwner),
+		errors.Is(err, teamDomain.ErrSCIMDomainNotAllowed):
+		respondSCIMError(w, http.StatusForbidden, "", err.Error())
+	case strings.HasPrefix(err.Error(), "failed to"):
+		respondSCIMError(w, http.StatusInternalServerError, "", err.Error())
+	default:
+		respondSCIMError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	}
+}
+
+// ============================================================================
+// TEAM ADMIN
+// ============================================================================
+
+// CreateToken handles POST /api/v2/teams/:id/scim/tokens
+func (h *SCIMHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.CreateSCIMTokenInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createTokenUC.Execute(r.Context(), input)
+	if err != nil {
+		respondSCIMAdminError(w, err)
+		return
+	}
+	respondCreated(w, output)
+}
+
+// ListTokens handles GET /api/v2/teams/:id/scim/tokens
+func (h *SCIMHandler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listTokensUC.Execute(r.Context(), team.ListSCIMTokensInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondSCIMAdminError(w, err)
+		return
+	}
+	respondSuccess(w, output)
+}
+
+// RevokeToken handles DELETE /api/v2/teams/:id/scim/tokens/:tokenId
+func (h *SCIMHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+	tokenID, err := uuid.Parse(chi.URLParam(r, "tokenId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid token ID")
+		return
+	}
+
+	if err := h.revokeTokenUC.Execute(r.Context(), team.RevokeSCIMTokenInput{TeamID: teamID, UserID: userID, TokenID: tokenID}); err != nil {
+		respondSCIMAdminError(w, err)
+		return
+	}
+	respondSuccess(w, map[string]string{"message": "SCIM token revoked"})
+}
+
+// ListGroupRoles handles GET /api/v2/teams/:id/scim/groups
+func (h *SCIMHandler) ListGroupRoles(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listGroupRolesUC.Execute(r.Context(), team.ListSCIMGroupRolesInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondSCIMAdminError(w, err)
+		return
+	}
+	respondSuccess(w, output)
+}
+
+// SetGroupRole handles PUT /api/v2/teams/:id/scim/groups/:groupId/role
+func (h *SCIMHandler) SetGroupRole(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+	groupID, err := uuid.Parse(chi.URLParam(r, "groupId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid group ID")
+		return
+	}
+
+	var input team.SetSCIMGroupRoleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.GroupID = groupID
+
+	output, err := h.setGroupRoleUC.Execute(r.Context(), input)
+	if err != nil {
+		respondSCIMAdminError(w, err)
+		return
+	}
+	respondSuccess(w, output)
+}
+
+func respondSCIMAdminError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrSCIMTokenNotFound),
+		errors.Is(err, teamDomain.ErrSCIMGroupNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/adapters/social"
+	"github.com/techappsUT/social-queue/internal/application/common"
 	appSocial "github.com/techappsUT/social-queue/internal/application/social"
 	socialDomain "github.com/techappsUT/social-queue/internal/domain/social"
 	"github.com/techappsUT/social-queue/internal/middleware"
@@ -26,6 +28,7 @@ type SocialHandler struct {
 	listAccountsUC   *appSocial.ListAccountsUseCase
 	publishPostUC    *appSocial.PublishPostUseCase
 	getAnalyticsUC   *appSocial.GetAnalyticsUseCase
+	getQuotaUC       *appSocial.GetAccountQuotaUseCase
 	adapters         map[socialDomain.Platform]social.Adapter
 }
 
@@ -36,6 +39,7 @@ func NewSocialHandler(
 	listAccountsUC *appSocial.ListAccountsUseCase,
 	publishPostUC *appSocial.PublishPostUseCase,
 	getAnalyticsUC *appSocial.GetAnalyticsUseCase,
+	getQuotaUC *appSocial.GetAccountQuotaUseCase,
 	adapters map[socialDomain.Platform]social.Adapter,
 ) *SocialHandler {
 	return &SocialHandler{
@@ -45,6 +49,7 @@ func NewSocialHandler(
 		listAccountsUC:   listAccountsUC,
 		publishPostUC:    publishPostUC,
 		getAnalyticsUC:   getAnalyticsUC,
+		getQuotaUC:       getQuotaUC,
 		adapters:         adapters,
 	}
 }
@@ -273,8 +278,12 @@ func (h *SocialHandler) PublishPost(w http.ResponseWriter, r *http.Request) {
 	}
 
 	output, err := h.publishPostUC.Execute(r.Context(), input)
+	if errors.Is(err, common.ErrRateLimitExceeded) {
+		respondError(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+		respondSocialError(w, err)
 		return
 	}
 
@@ -310,7 +319,33 @@ func (h *SocialHandler) GetAnalytics(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.getAnalyticsUC.Execute(r.Context(), input)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
+		respondSocialError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// GetQuota handles GET /api/v2/social/accounts/:id/quota
+func (h *SocialHandler) GetQuota(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	accountID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid account ID")
+		return
+	}
+
+	output, err := h.getQuotaUC.Execute(r.Context(), appSocial.GetAccountQuotaInput{
+		AccountID: accountID,
+		UserID:    userID,
+	})
+	if err != nil {
+		respondSocialError(w, err)
 		return
 	}
 
@@ -372,3 +407,12 @@ func (h *SocialHandler) CompleteOAuthConnection(w http.ResponseWriter, r *http.R
 
 	respondCreated(w, output)
 }
+
+// respondSocialError maps account use case errors; denied access is 403
+func respondSocialError(w http.ResponseWriter, err error) {
+	if strings.HasPrefix(err.Error(), "access denied") {
+		respondError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondError(w, http.StatusBadRequest, err.Error())
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// SSOHandler handles team SAML configuration and the SAML sign-in flow
+type SSOHandler struct {
+	samlSP      common.SAMLServiceProvider
+	getUC       *team.GetSSOConfigUseCase
+	configureUC *team.ConfigureSSOUseCase
+	deleteUC    *team.DeleteSSOConfigUseCase
+	verifyUC    *team.VerifySSODomainUseCase
+	beginUC     *auth.BeginSAMLLoginUseCase
+	finishUC    *auth.FinishSAMLLoginUseCase
+
+	// loginRedirectURL is the frontend page the ACS sends the browser to;
+	// it picks up the session from the refresh token cookie
+	loginRedirectURL string
+}
+
+// NewSSOHandler creates a new SSO handler
+func NewSSOHandler(
+	samlSP common.SAMLServiceProvider,
+	getUC *team.GetSSOConfigUseCase,
+	configureUC *team.ConfigureSSOUseCase,
+	deleteUC *team.DeleteSSOConfigUseCase,
+	verifyUC *team.VerifySSODomainUseCase,
+	beginUC *auth.BeginSAMLLoginUseCase,
+	finishUC *auth.FinishSAMLLoginUseCase,
+	loginRedirectURL string,
+) *SSOHandler {
+	return &SSOHandler{
+		samlSP:           samlSP,
+		getUC:            getUC,
+		configureUC:      configureUC,
+		deleteUC:         deleteUC,
+		verifyUC:         verifyUC,
+		beginUC:          beginUC,
+		finishUC:         finishUC,
+		loginRedirectURL: loginRedirectURL,
+	}
+}
+
+// GetConfig handles GET /api/v2/teams/:id/sso
+func (h *SSOHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.getUC.Execute(r.Context(), team.GetSSOConfigInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondSSOError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Configure handles PUT /api/v2/teams/:id/sso
+func (h *SSOHandler) Configure(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.ConfigureSSOInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.configureUC.Execute(r.Context(), input)
+	if err != nil {
+		respondSSOError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// DeleteConfig handles DELETE /api/v2/teams/:id/sso
+func (h *SSOHandler) DeleteConfig(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteUC.Execute(r.Context(), team.DeleteSSOConfigInput{TeamID: teamID, UserID: userID}); err != nil {
+		respondSSOError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Single sign-on removed"})
+}
+
+// VerifyDomain handles POST /api/v2/teams/:id/sso/domains/:domain/verify
+func (h *SSOHandler) VerifyDomain(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.verifyUC.Execute(r.Context(), team.VerifySSODomainInput{
+		TeamID: teamID,
+		UserID: userID,
+		Domain: chi.URLParam(r, "domain"),
+	})
+	if err != nil {
+		respondSSOError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Metadata handles GET /api/v2/sso/saml/:teamId/metadata
+func (h *SSOHandler) Metadata(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(chi.URLParam(r, "teamId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid team ID")
+		return
+	}
+
+	metadata, err := h.samlSP.Metadata(teamID.String())
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "failed to build metadata")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.Write(metadata)
+}
+
+// Login handles GET /api/v2/sso/saml/login?email= or ?team= by sending the
+// browser to the team's identity provider
+func (h *SSOHandler) Login(w http.ResponseWriter, r *http.Request) {
+	output, err := h.beginUC.Execute(r.Context(), auth.BeginSAMLLoginInput{
+		Email:    r.URL.Query().Get("email"),
+		TeamSlug: r.URL.Query().Get("team"),
+	})
+	if err != nil {
+		respondSSOError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, output.RedirectURL, http.StatusFound)
+}
+
+// ACS handles POST /api/v2/sso/saml/:teamId/acs, where the identity
+// provider posts its response. The browser ends up on the frontend either
+// signed in or with an error message.
+func (h *SSOHandler) ACS(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(chi.URLParam(r, "teamId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid team ID")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	output, err := h.finishUC.Execute(r.Context(), auth.FinishSAMLLoginInput{
+		TeamID:       teamID,
+		SAMLResponse: r.PostForm.Get("SAMLResponse"),
+		Client:       clientInfo(r),
+	})
+	if err != nil {
+		h.redirectToFrontend(w, r, url.Values{"error": {err.Error()}})
+		return
+	}
+
+	setRefreshTokenCookie(w, output.RefreshToken)
+	h.redirectToFrontend(w, r, nil)
+}
+
+func (h *SSOHandler) redirectToFrontend(w http.ResponseWriter, r *http.Request, params url.Values) {
+	target := h.loginRedirectURL
+	if len(params) > 0 {
+		sep := "?"
+		if strings.Contains(target, "?") {
+			sep = "&"
+		}
+		target += sep + params.Encode()
+	}
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
+
+// teamRequest reads the signed-in user and the team ID path parameter
+func teamRequest(w http.ResponseWriter, r *http.Request) (userID, teamID uuid.UUID, ok bool) {
+	userID, ok = middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	teamID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid team ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, teamID, true
+}
+
+func respondSSOError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrSSONotConfigured),
+		errors.Is(err, teamDomain.ErrSSODomainNotClaimed),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, teamDomain.ErrSSODomainTaken),
+		errors.Is(err, userDomain.ErrAccountLinkRequired):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, teamDomain.ErrSSODomainNotVerified):
+		respondError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
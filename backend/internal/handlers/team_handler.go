@@ -272,7 +272,7 @@ func (h *TeamHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
 
 	var requestBody struct {
 		Email string `json:"email" validate:"required,email"`
-		Role  string `json:"role" validate:"required,oneof=owner admin editor viewer"`
+		Role  string `json:"role" validate:"required,max=50"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
@@ -300,6 +300,8 @@ func (h *TeamHandler) InviteMember(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case strings.Contains(err.Error(), "access denied"):
 			middleware.RespondForbidden(w, err.Error()) // ✅ 403
+		case strings.HasPrefix(err.Error(), "invalid role"):
+			middleware.RespondError(w, http.StatusBadRequest, "invalid_role", err.Error())
 		case strings.Contains(err.Error(), "limit reached"):
 			middleware.RespondError(w, http.StatusPaymentRequired, "limit_exceeded", err.Error()) // ✅ 402
 		case strings.Contains(err.Error(), "not found"):
@@ -347,7 +349,7 @@ func (h *TeamHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
 	if err := h.removeMemberUC.Execute(r.Context(), input); err != nil {
 		// Handle different error types appropriately
 		switch {
-		case err.Error() == "access denied: only admins and owners can remove members":
+		case strings.HasPrefix(err.Error(), "access denied"):
 			respondError(w, http.StatusForbidden, err.Error())
 		case err.Error() == "cannot remove team owner, transfer ownership first":
 			respondError(w, http.StatusForbidden, err.Error())
@@ -389,7 +391,7 @@ func (h *TeamHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
 
 	// ✅ Validate request body structure
 	var requestBody struct {
-		Role string `json:"role" validate:"required,oneof=owner admin editor viewer"`
+		Role string `json:"role" validate:"required,max=50"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
 		middleware.RespondError(w, http.StatusBadRequest, "invalid_json", "Invalid request body")
@@ -413,7 +415,16 @@ func (h *TeamHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.updateMemberRoleUC.Execute(r.Context(), input)
 	if err != nil {
-		// Handle errors...
+		switch {
+		case strings.HasPrefix(err.Error(), "access denied"):
+			middleware.RespondForbidden(w, err.Error())
+		case err.Error() == "member not found":
+			middleware.RespondNotFound(w, "member")
+		case strings.HasPrefix(err.Error(), "failed"):
+			middleware.RespondInternalError(w, "Failed to update member role")
+		default:
+			middleware.RespondError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		}
 		return
 	}
 
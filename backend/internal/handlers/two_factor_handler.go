@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/techappsUT/social-queue/internal/application/auth"
+	userDomain "github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/middleware"
+)
+
+// TwoFactorHandler handles TOTP enrollment and the second login step
+type TwoFactorHandler struct {
+	statusUC     *auth.GetTwoFactorStatusUseCase
+	setupUC      *auth.SetupTwoFactorUseCase
+	enableUC     *auth.EnableTwoFactorUseCase
+	disableUC    *auth.DisableTwoFactorUseCase
+	regenerateUC *auth.RegenerateRecoveryCodesUseCase
+	verifyUC     *auth.VerifyTwoFactorUseCase
+}
+
+// NewTwoFactorHandler creates a new two-factor handler
+func NewTwoFactorHandler(
+	statusUC *auth.GetTwoFactorStatusUseCase,
+	setupUC *auth.SetupTwoFactorUseCase,
+	enableUC *auth.EnableTwoFactorUseCase,
+	disableUC *auth.DisableTwoFactorUseCase,
+	regenerateUC *auth.RegenerateRecoveryCodesUseCase,
+	verifyUC *auth.VerifyTwoFactorUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		statusUC:     statusUC,
+		setupUC:      setupUC,
+		enableUC:     enableUC,
+		disableUC:    disableUC,
+		regenerateUC: regenerateUC,
+		verifyUC:     verifyUC,
+	}
+}
+
+// Status handles GET /api/v2/2fa
+func (h *TwoFactorHandler) Status(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	output, err := h.statusUC.Execute(r.Context(), auth.GetTwoFactorStatusInput{UserID: userID})
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, "Failed to load two-factor status")
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Setup handles POST /api/v2/2fa/setup (signed in, or with an enrollment
+// challenge from login)
+func (h *TwoFactorHandler) Setup(w http.ResponseWriter, r *http.Request) {
+	var input auth.SetupTwoFactorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID, _ = middleware.GetUserID(r.Context())
+
+	output, err := h.setupUC.Execute(r.Context(), input)
+	if err != nil {
+		respondTwoFactorError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Enable handles POST /api/v2/2fa/enable (signed in, or with an enrollment
+// challenge from login, in which case the session is started too)
+func (h *TwoFactorHandler) Enable(w http.ResponseWriter, r *http.Request) {
+	var input auth.EnableTwoFactorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID, _ = middleware.GetUserID(r.Context())
+	input.Client = clientInfo(r)
+
+	output, err := h.enableUC.Execute(r.Context(), input)
+	if err != nil {
+		respondTwoFactorError(w, err)
+		return
+	}
+
+	if output.Login != nil {
+		setRefreshTokenCookie(w, output.Login.RefreshToken)
+		output.Login.RefreshToken = ""
+	}
+
+	respondSuccess(w, output)
+}
+
+// Disable handles POST /api/v2/2fa/disable
+func (h *TwoFactorHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var input auth.DisableTwoFactorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.disableUC.Execute(r.Context(), input)
+	if err != nil {
+		respondTwoFactorError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// RegenerateRecoveryCodes handles POST /api/v2/2fa/recovery-codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var input auth.RegenerateRecoveryCodesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.UserID = userID
+
+	output, err := h.regenerateUC.Execute(r.Context(), input)
+	if err != nil {
+		respondTwoFactorError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Verify handles POST /api/v2/2fa/verify, the second step of login
+func (h *TwoFactorHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	var input auth.VerifyTwoFactorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	input.Client = clientInfo(r)
+
+	output, err := h.verifyUC.Execute(r.Context(), input)
+	if err != nil {
+		respondTwoFactorError(w, err)
+		return
+	}
+
+	setRefreshTokenCookie(w, output.RefreshToken)
+	output.RefreshToken = ""
+
+	respondSuccess(w, output)
+}
+
+func respondTwoFactorError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, userDomain.ErrInvalidTwoFactorCode),
+		errors.Is(err, userDomain.ErrInvalidRecoveryCode),
+		errors.Is(err, userDomain.ErrInvalidCredentials),
+		errors.Is(err, userDomain.ErrUnauthorized):
+		respondError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, userDomain.ErrTwoFactorAlreadyEnabled):
+		respondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, userDomain.ErrTwoFactorRequired):
+		respondError(w, http.StatusForbidden, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
+
+// setRefreshTokenCookie hands the refresh token to the browser as an
+// HTTP-only cookie, like login does
+func setRefreshTokenCookie(w http.ResponseWriter, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    token,
+		HttpOnly: true,
+		Secure:   false, // Set to true in production
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+		MaxAge:   30 * 24 * 60 * 60,
+	})
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/team"
+	teamDomain "github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+// WebhookHandler manages a team's outgoing webhooks (/teams/:id/webhooks)
+type WebhookHandler struct {
+	createUC     *team.CreateWebhookUseCase
+	listUC       *team.ListWebhooksUseCase
+	updateUC     *team.UpdateWebhookUseCase
+	deleteUC     *team.DeleteWebhookUseCase
+	deliveriesUC *team.ListWebhookDeliveriesUseCase
+	testUC       *team.SendTestWebhookUseCase
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(
+	createUC *team.CreateWebhookUseCase,
+	listUC *team.ListWebhooksUseCase,
+	updateUC *team.UpdateWebhookUseCase,
+	deleteUC *team.DeleteWebhookUseCase,
+	deliveriesUC *team.ListWebhookDeliveriesUseCase,
+	testUC *team.SendTestWebhookUseCase,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createUC:     createUC,
+		listUC:       listUC,
+		updateUC:     updateUC,
+		deleteUC:     deleteUC,
+		deliveriesUC: deliveriesUC,
+		testUC:       testUC,
+	}
+}
+
+// EventTypes handles GET /api/v2/teams/:id/webhooks/event-types
+func (h *WebhookHandler) EventTypes(w http.ResponseWriter, r *http.Request) {
+	respondSuccess(w, teamDomain.WebhookEventTypes)
+}
+
+// Create handles POST /api/v2/teams/:id/webhooks. The response carries the
+// signing secret, which is not shown again.
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.CreateWebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+
+	output, err := h.createUC.Execute(r.Context(), input)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondCreated(w, output)
+}
+
+// List handles GET /api/v2/teams/:id/webhooks
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, ok := teamRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.listUC.Execute(r.Context(), team.ListWebhooksInput{TeamID: teamID, UserID: userID})
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Update handles PATCH /api/v2/teams/:id/webhooks/:webhookId
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, webhookID, ok := webhookRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var input team.UpdateWebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	input.TeamID = teamID
+	input.UserID = userID
+	input.WebhookID = webhookID
+
+	output, err := h.updateUC.Execute(r.Context(), input)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// Delete handles DELETE /api/v2/teams/:id/webhooks/:webhookId
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, webhookID, ok := webhookRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.deleteUC.Execute(r.Context(), team.DeleteWebhookInput{TeamID: teamID, UserID: userID, WebhookID: webhookID})
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, map[string]string{"message": "Webhook deleted"})
+}
+
+// Deliveries handles GET /api/v2/teams/:id/webhooks/:webhookId/deliveries
+func (h *WebhookHandler) Deliveries(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, webhookID, ok := webhookRequest(w, r)
+	if !ok {
+		return
+	}
+
+	input := team.ListWebhookDeliveriesInput{TeamID: teamID, UserID: userID, WebhookID: webhookID}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			respondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		input.Limit = limit
+	}
+
+	output, err := h.deliveriesUC.Execute(r.Context(), input)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// SendTest handles POST /api/v2/teams/:id/webhooks/:webhookId/test. The
+// delivery is returned whether or not the endpoint accepted it.
+func (h *WebhookHandler) SendTest(w http.ResponseWriter, r *http.Request) {
+	userID, teamID, webhookID, ok := webhookRequest(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.testUC.Execute(r.Context(), team.SendTestWebhookInput{TeamID: teamID, UserID: userID, WebhookID: webhookID})
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondSuccess(w, output)
+}
+
+// webhookRequest returns the user, the team and the webhook in the URL
+func webhookRequest(w http.ResponseWriter, r *http.Request) (userID, teamID, webhookID uuid.UUID, ok bool) {
+	userID, teamID, ok = teamRequest(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	webhookID, err := uuid.Parse(chi.URLParam(r, "webhookId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid webhook ID")
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	return userID, teamID, webhookID, true
+}
+
+func respondWebhookError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasPrefix(err.Error(), "access denied"):
+		respondError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamDomain.ErrFeatureNotAvailable):
+		respondError(w, http.StatusPaymentRequired, err.Error())
+	case errors.Is(err, teamDomain.ErrWebhookNotFound),
+		errors.Is(err, teamDomain.ErrTeamNotFound):
+		respondError(w, http.StatusNotFound, err.Error())
+	case strings.HasPrefix(err.Error(), "failed"):
+		respondError(w, http.StatusInternalServerError, err.Error())
+	default:
+		respondError(w, http.StatusBadRequest, err.Error())
+	}
+}
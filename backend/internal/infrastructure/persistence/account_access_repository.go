@@ -0,0 +1,106 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/account_access_repository.go
+// PURPOSE: Per-member social account restrictions and grants
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+type AccountAccessRepository struct {
+	db *sql.DB
+}
+
+func NewAccountAccessRepository(database *sql.DB) social.AccessRepository {
+	return &AccountAccessRepository{db: database}
+}
+
+func (r *AccountAccessRepository) FindAccess(ctx context.Context, teamID, userID uuid.UUID) (*social.AccountAccess, error) {
+	var (
+		restrictedBy uuid.NullUUID
+		updatedAt    sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT restricted_by, updated_at FROM team_member_account_restrictions
+		WHERE team_id = $1 AND user_id = $2
+	`, teamID, userID).Scan(&restrictedBy, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return social.ReconstructAccountAccess(teamID, userID, uuid.Nil, false, nil, updatedAt.Time), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account access: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT social_account_id, access FROM team_member_account_grants
+		WHERE team_id = $1 AND user_id = $2
+		ORDER BY created_at
+	`, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account grants: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []social.AccountGrant
+	for rows.Next() {
+		var (
+			accountID uuid.UUID
+			level     string
+		)
+		if err := rows.Scan(&accountID, &level); err != nil {
+			return nil, fmt.Errorf("failed to scan account grant: %w", err)
+		}
+		grants = append(grants, social.AccountGrant{AccountID: accountID, Level: social.AccessLevel(level)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find account grants: %w", err)
+	}
+
+	return social.ReconstructAccountAccess(teamID, userID, restrictedBy.UUID, true, grants, updatedAt.Time), nil
+}
+
+func (r *AccountAccessRepository) SaveAccess(ctx context.Context, access *social.AccountAccess) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Grants cascade with the restriction row
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM team_member_account_restrictions WHERE team_id = $1 AND user_id = $2
+	`, access.TeamID(), access.UserID())
+	if err != nil {
+		return fmt.Errorf("failed to save account access: %w", err)
+	}
+
+	if access.IsRestricted() {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO team_member_account_restrictions (team_id, user_id, restricted_by, updated_at)
+			VALUES ($1, $2, $3, $4)
+		`, access.TeamID(), access.UserID(), uuid.NullUUID{UUID: access.UpdatedBy(), Valid: access.UpdatedBy() != uuid.Nil}, access.UpdatedAt())
+		if err != nil {
+			return fmt.Errorf("failed to save account access: %w", err)
+		}
+
+		for _, g := range access.Grants() {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO team_member_account_grants (team_id, user_id, social_account_id, access)
+				VALUES ($1, $2, $3, $4)
+			`, access.TeamID(), access.UserID(), g.AccountID, string(g.Level))
+			if err != nil {
+				return fmt.Errorf("failed to save account grant: %w", err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
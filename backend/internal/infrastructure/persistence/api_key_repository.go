@@ -0,0 +1,147 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/api_key_repository.go
+// PURPOSE: Personal access tokens and team API keys
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const apiKeyColumns = `id, team_id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+type APIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(database *sql.DB) team.APIKeyRepository {
+	return &APIKeyRepository{db: database}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, k *team.APIKey) error {
+	scopes, err := json.Marshal(k.Scopes())
+	if err != nil {
+		return fmt.Errorf("failed to encode scopes: %w", err)
+	}
+
+	teamID := uuid.NullUUID{UUID: k.TeamID(), Valid: !k.IsPersonal()}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO api_keys (`+apiKeyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, k.ID(), teamID, k.UserID(), k.Name(), k.Prefix(), k.KeyHash(), scopes,
+		k.ExpiresAt(), k.LastUsedAt(), k.RevokedAt(), k.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*team.APIKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, keyHash)
+	return scanAPIKey(row)
+}
+
+func (r *APIKeyRepository) FindByID(ctx context.Context, id uuid.UUID) (*team.APIKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, id)
+	return scanAPIKey(row)
+}
+
+func (r *APIKeyRepository) ListPersonal(ctx context.Context, userID uuid.UUID) ([]*team.APIKey, error) {
+	return r.list(ctx, `
+		SELECT `+apiKeyColumns+` FROM api_keys
+		WHERE user_id = $1 AND team_id IS NULL
+		ORDER BY created_at DESC
+	`, userID)
+}
+
+func (r *APIKeyRepository) ListByTeam(ctx context.Context, teamID uuid.UUID) ([]*team.APIKey, error) {
+	return r.list(ctx, `
+		SELECT `+apiKeyColumns+` FROM api_keys
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`, teamID)
+}
+
+func (r *APIKeyRepository) list(ctx context.Context, query string, arg interface{}) ([]*team.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, query, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*team.APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE api_keys SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL
+	`, id, revokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) Touch(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE api_keys SET last_used_at = $2 WHERE id = $1`, id, usedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update api key: %w", err)
+	}
+	return nil
+}
+
+func scanAPIKey(row rowScanner) (*team.APIKey, error) {
+	var (
+		id, userID                       uuid.UUID
+		teamID                           uuid.NullUUID
+		name, prefix, keyHash            string
+		rawScopes                        []byte
+		expiresAt, lastUsedAt, revokedAt sql.NullTime
+		createdAt                        sql.NullTime
+	)
+
+	err := row.Scan(&id, &teamID, &userID, &name, &prefix, &keyHash, &rawScopes, &expiresAt, &lastUsedAt, &revokedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan api key: %w", err)
+	}
+
+	var scopes []team.Permission
+	if len(rawScopes) > 0 {
+		if err := json.Unmarshal(rawScopes, &scopes); err != nil {
+			return nil, fmt.Errorf("failed to decode api key scopes: %w", err)
+		}
+	}
+
+	return team.ReconstructAPIKey(
+		id, teamID.UUID, userID, name, prefix, keyHash, scopes,
+		nullTimePtr(expiresAt), nullTimePtr(lastUsedAt), nullTimePtr(revokedAt), createdAt.Time,
+	), nil
+}
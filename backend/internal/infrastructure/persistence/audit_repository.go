@@ -0,0 +1,149 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/audit_repository.go
+// PURPOSE: Append-only audit log
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+)
+
+const auditColumns = `id, team_id, actor_id, action, target_type, target_id, ip_address, user_agent, changes, metadata, created_at`
+
+type AuditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditRepository(database *sql.DB) audit.Repository {
+	return &AuditRepository{db: database}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, e *audit.Entry) error {
+	var changes, metadata []byte
+	var err error
+	if len(e.Changes()) > 0 {
+		if changes, err = json.Marshal(e.Changes()); err != nil {
+			return fmt.Errorf("failed to encode audit changes: %w", err)
+		}
+	}
+	if len(e.Metadata()) > 0 {
+		if metadata, err = json.Marshal(e.Metadata()); err != nil {
+			return fmt.Errorf("failed to encode audit metadata: %w", err)
+		}
+	}
+
+	// Inside a transaction the entry commits or rolls back with the change
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO audit_log (`+auditColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, e.ID(), nullUUID(e.TeamID()), nullUUID(e.ActorID()), string(e.Action()), e.TargetType(), e.TargetID(),
+		e.IPAddress(), e.UserAgent(), changes, metadata, e.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+	return nil
+}
+
+func (r *AuditRepository) List(ctx context.Context, f audit.Filter) ([]*audit.Entry, error) {
+	// The team's own entries, plus account-level entries of its members
+	where := []string{`(team_id = $1 OR (team_id IS NULL AND actor_id IN (
+		SELECT user_id FROM team_memberships
+		WHERE team_id = $1 AND is_active = TRUE AND deleted_at IS NULL
+	)))`}
+	args := []interface{}{f.TeamID}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.ActorID != nil {
+		add("actor_id = $%d", *f.ActorID)
+	}
+	if area, ok := strings.CutSuffix(string(f.Action), ".*"); ok {
+		add("action LIKE $%d", area+".%")
+	} else if f.Action != "" {
+		add("action = $%d", string(f.Action))
+	}
+	if f.TargetType != "" {
+		add("target_type = $%d", f.TargetType)
+	}
+	if f.TargetID != "" {
+		add("target_id = $%d", f.TargetID)
+	}
+	if f.Since != nil {
+		add("created_at >= $%d", *f.Since)
+	}
+	if f.Until != nil {
+		add("created_at < $%d", *f.Until)
+	}
+	if f.Before != nil {
+		add("created_at < $%d", *f.Before)
+	}
+	args = append(args, f.Limit)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+auditColumns+` FROM audit_log
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_at DESC
+		LIMIT $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*audit.Entry
+	for rows.Next() {
+		e, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func scanAuditEntry(row rowScanner) (*audit.Entry, error) {
+	var (
+		id                                          uuid.UUID
+		teamID, actorID                             uuid.NullUUID
+		action, targetType, targetID, ip, userAgent string
+		rawChanges, rawMetadata                     []byte
+		createdAt                                   sql.NullTime
+	)
+
+	err := row.Scan(&id, &teamID, &actorID, &action, &targetType, &targetID, &ip, &userAgent, &rawChanges, &rawMetadata, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+	}
+
+	var changes audit.Changes
+	if len(rawChanges) > 0 {
+		if err := json.Unmarshal(rawChanges, &changes); err != nil {
+			return nil, fmt.Errorf("failed to decode audit changes: %w", err)
+		}
+	}
+	var metadata map[string]string
+	if len(rawMetadata) > 0 {
+		if err := json.Unmarshal(rawMetadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to decode audit metadata: %w", err)
+		}
+	}
+
+	return audit.ReconstructEntry(
+		id, teamID.UUID, actorID.UUID, audit.Action(action), targetType, targetID, ip, userAgent,
+		changes, metadata, createdAt.Time,
+	), nil
+}
+
+// nullUUID stores uuid.Nil as NULL
+func nullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
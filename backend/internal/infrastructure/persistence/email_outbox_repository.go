@@ -0,0 +1,125 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/email_outbox_repository.go
+// PURPOSE: Outbox of rendered emails and the recipients' locales
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/mail"
+)
+
+type EmailOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewEmailOutboxRepository(database *sql.DB) mail.Outbox {
+	return &EmailOutboxRepository{db: database}
+}
+
+func (r *EmailOutboxRepository) Enqueue(ctx context.Context, msg *mail.Message) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO email_outbox (id, from_address, to_address, subject, html_body, text_body, template, locale, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, msg.ID, msg.From, msg.To, msg.Subject, msg.HTML, msg.Text, msg.Template, msg.Locale, msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s email: %w", msg.Template, err)
+	}
+	return nil
+}
+
+// Claim pushes the next attempt of each claimed email out by the lease, so
+// concurrent senders skip them and a crashed sender's emails come back
+func (r *EmailOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*mail.Pending, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE email_outbox o
+		SET attempts = o.attempts + 1, next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE o.id IN (
+			SELECT id FROM email_outbox
+			WHERE sent_at IS NULL AND failed_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING o.id, o.from_address, o.to_address, o.subject, o.html_body, o.text_body, o.template, o.locale,
+			o.created_at, o.attempts
+	`, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox emails: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []*mail.Pending
+	for rows.Next() {
+		var (
+			msg      mail.Message
+			attempts int
+		)
+		if err := rows.Scan(&msg.ID, &msg.From, &msg.To, &msg.Subject, &msg.HTML, &msg.Text, &msg.Template, &msg.Locale,
+			&msg.CreatedAt, &attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox email: %w", err)
+		}
+		pending = append(pending, &mail.Pending{Message: &msg, Attempts: attempts})
+	}
+	return pending, rows.Err()
+}
+
+func (r *EmailOutboxRepository) MarkSent(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE email_outbox SET sent_at = NOW(), last_error = NULL WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox email sent: %w", err)
+	}
+	return nil
+}
+
+func (r *EmailOutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error {
+	var err error
+	if retryAt != nil {
+		_, err = r.db.ExecContext(ctx, `
+			UPDATE email_outbox SET last_error = $2, next_attempt_at = $3 WHERE id = $1
+		`, id, reason, *retryAt)
+	} else {
+		_, err = r.db.ExecContext(ctx, `
+			UPDATE email_outbox SET last_error = $2, failed_at = NOW() WHERE id = $1
+		`, id, reason)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox email failed: %w", err)
+	}
+	return nil
+}
+
+// UserLocaleRepository looks up the locale emails to an address are
+// written in
+type UserLocaleRepository struct {
+	db *sql.DB
+}
+
+func NewUserLocaleRepository(database *sql.DB) *UserLocaleRepository {
+	return &UserLocaleRepository{db: database}
+}
+
+// Locale returns the users.locale of the address, or "" for addresses of
+// no user, such as invitations to new members
+func (r *UserLocaleRepository) Locale(ctx context.Context, email string) (string, error) {
+	var locale sql.NullString
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT locale FROM users WHERE email = $1 AND deleted_at IS NULL
+	`, email).Scan(&locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find user locale: %w", err)
+	}
+	return locale.String, nil
+}
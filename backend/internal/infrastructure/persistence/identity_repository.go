@@ -0,0 +1,126 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/identity_repository.go
+// PURPOSE: Links between users and external identity provider accounts
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const identityColumns = `id, user_id, provider, subject, email, created_at, last_login_at`
+
+type IdentityRepository struct {
+	db *sql.DB
+}
+
+func NewIdentityRepository(database *sql.DB) user.IdentityRepository {
+	return &IdentityRepository{db: database}
+}
+
+func (r *IdentityRepository) Create(ctx context.Context, i *user.Identity) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_identities (`+identityColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, i.ID(), i.UserID(), i.Provider(), i.Subject(), i.Email(), i.CreatedAt(), i.LastLoginAt())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+			return user.ErrIdentityAlreadyLinked
+		}
+		return fmt.Errorf("failed to create identity: %w", err)
+	}
+	return nil
+}
+
+func (r *IdentityRepository) Update(ctx context.Context, i *user.Identity) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE user_identities SET email = $2, last_login_at = $3 WHERE id = $1
+	`, i.ID(), i.Email(), i.LastLoginAt())
+	if err != nil {
+		return fmt.Errorf("failed to update identity: %w", err)
+	}
+	return nil
+}
+
+func (r *IdentityRepository) FindBySubject(ctx context.Context, provider, subject string) (*user.Identity, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+identityColumns+` FROM user_identities WHERE provider = $1 AND subject = $2
+	`, provider, subject)
+	return scanIdentity(row)
+}
+
+func (r *IdentityRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*user.Identity, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+identityColumns+` FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+	defer rows.Close()
+
+	var identities []*user.Identity
+	for rows.Next() {
+		i, err := scanIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+	return identities, nil
+}
+
+func (r *IdentityRepository) Delete(ctx context.Context, userID uuid.UUID, provider string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM user_identities WHERE user_id = $1 AND provider = $2
+	`, userID, provider)
+	if err != nil {
+		return fmt.Errorf("failed to delete identity: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete identity: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func scanIdentity(row rowScanner) (*user.Identity, error) {
+	var (
+		id, userID        uuid.UUID
+		provider, subject string
+		email             sql.NullString
+		createdAt         sql.NullTime
+		lastLoginAt       sql.NullTime
+	)
+
+	err := row.Scan(&id, &userID, &provider, &subject, &email, &createdAt, &lastLoginAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, user.ErrIdentityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan identity: %w", err)
+	}
+
+	return user.ReconstructIdentity(
+		id, userID,
+		provider, subject, email.String,
+		createdAt.Time,
+		nullTimePtr(lastLoginAt),
+	), nil
+}
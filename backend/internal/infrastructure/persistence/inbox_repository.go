@@ -0,0 +1,335 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/inbox_repository.go
+// PURPOSE: Social inbox conversations, messages and sync cursors
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+	"github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+const conversationColumns = `id, team_id, social_account_id, platform, kind, thread_key, object_id,
+	participant_id, participant_name, state, assigned_to, tags, last_message_at, last_message_preview,
+	message_count, created_at, updated_at`
+
+const inboxMessageColumns = `id, conversation_id, platform_message_id, direction, author_id, author_name,
+	text, sent_by, sent_at, created_at`
+
+type InboxRepository struct {
+	db *sql.DB
+}
+
+func NewInboxRepository(database *sql.DB) *InboxRepository {
+	return &InboxRepository{db: database}
+}
+
+var (
+	_ inbox.Repository           = (*InboxRepository)(nil)
+	_ inbox.SyncCursorRepository = (*InboxRepository)(nil)
+	_ inbox.RuleQueue            = (*InboxRepository)(nil)
+)
+
+func (r *InboxRepository) EnsureConversation(ctx context.Context, c *inbox.Conversation) (*inbox.Conversation, error) {
+	tags, err := json.Marshal(c.Tags())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tags: %w", err)
+	}
+
+	// The no-op update returns the existing row and locks it
+	row := conn(ctx, r.db).QueryRowContext(ctx, `
+		INSERT INTO inbox_conversations (`+conversationColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (social_account_id, kind, thread_key)
+		DO UPDATE SET updated_at = inbox_conversations.updated_at
+		RETURNING `+conversationColumns,
+		c.ID(), c.TeamID(), c.AccountID(), string(c.Platform()), string(c.Kind()), c.ThreadKey(), c.ObjectID(),
+		c.ParticipantID(), c.ParticipantName(), string(c.State()), nullUUID(c.AssignedTo()), tags, c.LastMessageAt(), c.Preview(),
+		c.MessageCount(), c.CreatedAt(), c.UpdatedAt())
+	conversation, err := scanConversation(row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save conversation: %w", err)
+	}
+	return conversation, nil
+}
+
+func (r *InboxRepository) FindConversation(ctx context.Context, id uuid.UUID) (*inbox.Conversation, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+conversationColumns+` FROM inbox_conversations WHERE id = $1`, id)
+	c, err := scanConversation(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, inbox.ErrConversationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load conversation: %w", err)
+	}
+	return c, nil
+}
+
+func (r *InboxRepository) UpdateConversation(ctx context.Context, c *inbox.Conversation) error {
+	tags, err := json.Marshal(c.Tags())
+	if err != nil {
+		return fmt.Errorf("failed to encode tags: %w", err)
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE inbox_conversations
+		SET participant_name = $2, state = $3, assigned_to = $4, tags = $5, last_message_at = $6,
+			last_message_preview = $7, message_count = $8, updated_at = $9
+		WHERE id = $1
+	`, c.ID(), c.ParticipantName(), string(c.State()), nullUUID(c.AssignedTo()), tags, c.LastMessageAt(),
+		c.Preview(), c.MessageCount(), c.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+	return expectOneRow(result, inbox.ErrConversationNotFound)
+}
+
+func (r *InboxRepository) ListConversations(ctx context.Context, filter inbox.Filter) ([]*inbox.Conversation, error) {
+	conditions := []string{"team_id = $1"}
+	args := []interface{}{filter.TeamID}
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.AccountIDs != nil {
+		add("social_account_id = ANY($%d)", pq.Array(filter.AccountIDs))
+	}
+	if filter.State != "" {
+		add("state = $%d", string(filter.State))
+	} else {
+		conditions = append(conditions, "state <> 'archived'")
+	}
+	if filter.Kind != "" {
+		add("kind = $%d", string(filter.Kind))
+	}
+	if filter.AssignedTo != nil {
+		if *filter.AssignedTo == uuid.Nil {
+			conditions = append(conditions, "assigned_to IS NULL")
+		} else {
+			add("assigned_to = $%d", *filter.AssignedTo)
+		}
+	}
+	if filter.Tag != "" {
+		add("tags ? $%d", filter.Tag)
+	}
+	if filter.Before != nil {
+		add("last_message_at < $%d", *filter.Before)
+	}
+	args = append(args, filter.Limit)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, fmt.Sprintf(`
+		SELECT `+conversationColumns+` FROM inbox_conversations
+		WHERE %s
+		ORDER BY last_message_at DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+	defer rows.Close()
+
+	var conversations []*inbox.Conversation
+	for rows.Next() {
+		c, err := scanConversation(rows)
+		if err != nil {
+			return nil, err
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, rows.Err()
+}
+
+// AddMessage queues received messages for the rule processor
+func (r *InboxRepository) AddMessage(ctx context.Context, m *inbox.Message) (bool, error) {
+	var rulesDueAt *time.Time
+	if m.Direction() == inbox.DirectionInbound {
+		createdAt := m.CreatedAt()
+		rulesDueAt = &createdAt
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO inbox_messages (`+inboxMessageColumns+`, rules_due_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (conversation_id, platform_message_id) DO NOTHING
+	`, m.ID(), m.ConversationID(), m.PlatformMessageID(), string(m.Direction()), m.AuthorID(), m.AuthorName(),
+		m.Text(), nullUUID(m.SentBy()), m.SentAt(), m.CreatedAt(), rulesDueAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to save message: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *InboxRepository) ListMessages(ctx context.Context, conversationID uuid.UUID, limit int) ([]*inbox.Message, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT * FROM (
+			SELECT `+inboxMessageColumns+` FROM inbox_messages
+			WHERE conversation_id = $1
+			ORDER BY sent_at DESC, created_at DESC
+			LIMIT $2
+		) latest
+		ORDER BY sent_at, created_at
+	`, conversationID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*inbox.Message
+	for rows.Next() {
+		m, err := scanInboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return messages, rows.Err()
+}
+
+func (r *InboxRepository) FindMessage(ctx context.Context, conversationID, id uuid.UUID) (*inbox.Message, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT `+inboxMessageColumns+` FROM inbox_messages
+		WHERE conversation_id = $1 AND id = $2
+	`, conversationID, id)
+	m, err := scanInboxMessage(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, inbox.ErrMessageNotFound
+	}
+	return m, err
+}
+
+func (r *InboxRepository) ClaimRuleEvaluations(ctx context.Context, limit int, lease time.Duration, maxAttempts int) ([]*inbox.Message, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		UPDATE inbox_messages m
+		SET rules_attempts = rules_attempts + 1,
+			rules_due_at = CASE WHEN rules_attempts + 1 >= $3 THEN NULL
+				ELSE NOW() + $2 * INTERVAL '1 millisecond' END
+		WHERE m.id IN (
+			SELECT id FROM inbox_messages
+			WHERE rules_due_at <= NOW()
+			ORDER BY rules_due_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+inboxMessageColumns, limit, lease.Milliseconds(), maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim messages for rules: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []*inbox.Message
+	for rows.Next() {
+		m, err := scanInboxMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return messages, rows.Err()
+}
+
+func (r *InboxRepository) CompleteRuleEvaluation(ctx context.Context, messageID uuid.UUID) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE inbox_messages SET rules_due_at = NULL WHERE id = $1
+	`, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to complete rule evaluation: %w", err)
+	}
+	return nil
+}
+
+func (r *InboxRepository) SyncedUntil(ctx context.Context, accountID uuid.UUID) (time.Time, error) {
+	var at time.Time
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT synced_until FROM inbox_sync_cursors WHERE social_account_id = $1
+	`, accountID).Scan(&at)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load sync cursor: %w", err)
+	}
+	return at, nil
+}
+
+func (r *InboxRepository) SaveSyncedUntil(ctx context.Context, accountID uuid.UUID, at time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO inbox_sync_cursors (social_account_id, synced_until, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (social_account_id) DO UPDATE SET synced_until = EXCLUDED.synced_until, updated_at = NOW()
+	`, accountID, at)
+	if err != nil {
+		return fmt.Errorf("failed to save sync cursor: %w", err)
+	}
+	return nil
+}
+
+func scanConversation(row rowScanner) (*inbox.Conversation, error) {
+	var (
+		id, teamID, accountID                                 uuid.UUID
+		platform, kind, threadKey, objectID                   string
+		participantID, participantName, state, messagePreview string
+		assignedTo                                            uuid.NullUUID
+		rawTags                                               []byte
+		lastMessageAt, createdAt, updatedAt                   time.Time
+		messageCount                                          int
+	)
+	err := row.Scan(&id, &teamID, &accountID, &platform, &kind, &threadKey, &objectID,
+		&participantID, &participantName, &state, &assignedTo, &rawTags, &lastMessageAt, &messagePreview,
+		&messageCount, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	if err := json.Unmarshal(rawTags, &tags); err != nil {
+		return nil, fmt.Errorf("failed to decode tags: %w", err)
+	}
+
+	return inbox.ReconstructConversation(
+		id, teamID, accountID,
+		social.Platform(platform),
+		inbox.Kind(kind),
+		threadKey, objectID, participantID, participantName,
+		inbox.State(state),
+		assignedTo.UUID,
+		tags,
+		lastMessageAt,
+		messagePreview,
+		messageCount,
+		createdAt, updatedAt,
+	), nil
+}
+
+func scanInboxMessage(row rowScanner) (*inbox.Message, error) {
+	var (
+		id, conversationID           uuid.UUID
+		platformMessageID, direction string
+		authorID, authorName, text   string
+		sentBy                       uuid.NullUUID
+		sentAt, createdAt            time.Time
+	)
+	err := row.Scan(&id, &conversationID, &platformMessageID, &direction, &authorID, &authorName,
+		&text, &sentBy, &sentAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return inbox.ReconstructMessage(id, conversationID, platformMessageID, inbox.Direction(direction),
+		authorID, authorName, text, sentBy.UUID, sentAt, createdAt), nil
+}
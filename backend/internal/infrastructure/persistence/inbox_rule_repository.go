@@ -0,0 +1,274 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/inbox_rule_repository.go
+// PURPOSE: Inbox auto-response rules, their runs and saved replies
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/inbox"
+)
+
+const inboxRuleColumns = `id, team_id, name, enabled, keywords, kinds, account_ids, actions, rate_limit,
+	created_by, created_at, updated_at`
+
+const savedReplyColumns = `id, team_id, name, body, created_by, created_at, updated_at`
+
+type InboxRuleRepository struct {
+	db *sql.DB
+}
+
+func NewInboxRuleRepository(database *sql.DB) inbox.RuleRepository {
+	return &InboxRuleRepository{db: database}
+}
+
+// ruleJSON holds the encoded list fields of a rule
+type ruleJSON struct {
+	keywords, kinds, accountIDs, actions []byte
+}
+
+func encodeRule(r *inbox.Rule) (*ruleJSON, error) {
+	var (
+		encoded ruleJSON
+		err     error
+	)
+	if encoded.keywords, err = json.Marshal(r.Keywords()); err != nil {
+		return nil, fmt.Errorf("failed to encode rule keywords: %w", err)
+	}
+	if encoded.kinds, err = json.Marshal(r.Kinds()); err != nil {
+		return nil, fmt.Errorf("failed to encode rule kinds: %w", err)
+	}
+	if encoded.accountIDs, err = json.Marshal(r.AccountIDs()); err != nil {
+		return nil, fmt.Errorf("failed to encode rule accounts: %w", err)
+	}
+	if encoded.actions, err = json.Marshal(r.Actions()); err != nil {
+		return nil, fmt.Errorf("failed to encode rule actions: %w", err)
+	}
+	return &encoded, nil
+}
+
+func (r *InboxRuleRepository) CreateRule(ctx context.Context, rule *inbox.Rule) error {
+	encoded, err := encodeRule(rule)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO inbox_rules (`+inboxRuleColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, rule.ID(), rule.TeamID(), rule.Name(), rule.IsEnabled(), encoded.keywords, encoded.kinds, encoded.accountIDs,
+		encoded.actions, rule.RateLimit(), nullUUID(rule.CreatedBy()), rule.CreatedAt(), rule.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create inbox rule: %w", err)
+	}
+	return nil
+}
+
+func (r *InboxRuleRepository) FindRule(ctx context.Context, id uuid.UUID) (*inbox.Rule, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+inboxRuleColumns+` FROM inbox_rules WHERE id = $1`, id)
+	return scanInboxRule(row)
+}
+
+func (r *InboxRuleRepository) UpdateRule(ctx context.Context, rule *inbox.Rule) error {
+	encoded, err := encodeRule(rule)
+	if err != nil {
+		return err
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE inbox_rules
+		SET name = $2, enabled = $3, keywords = $4, kinds = $5, account_ids = $6, actions = $7,
+			rate_limit = $8, updated_at = $9
+		WHERE id = $1
+	`, rule.ID(), rule.Name(), rule.IsEnabled(), encoded.keywords, encoded.kinds, encoded.accountIDs,
+		encoded.actions, rule.RateLimit(), rule.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update inbox rule: %w", err)
+	}
+	return expectOneRow(result, inbox.ErrRuleNotFound)
+}
+
+func (r *InboxRuleRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM inbox_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete inbox rule: %w", err)
+	}
+	return expectOneRow(result, inbox.ErrRuleNotFound)
+}
+
+func (r *InboxRuleRepository) ListRules(ctx context.Context, teamID uuid.UUID) ([]*inbox.Rule, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT `+inboxRuleColumns+` FROM inbox_rules
+		WHERE team_id = $1
+		ORDER BY created_at
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbox rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*inbox.Rule
+	for rows.Next() {
+		rule, err := scanInboxRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *InboxRuleRepository) CountRuleRuns(ctx context.Context, ruleID uuid.UUID, since time.Time) (int, error) {
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		WITH locked AS (SELECT id FROM inbox_rules WHERE id = $1 FOR UPDATE)
+		SELECT COUNT(*) FROM inbox_rule_runs
+		WHERE rule_id = (SELECT id FROM locked) AND ran_at > $2
+	`, ruleID, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count rule runs: %w", err)
+	}
+	return count, nil
+}
+
+func (r *InboxRuleRepository) AddRuleRun(ctx context.Context, ruleID, messageID uuid.UUID) (bool, error) {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO inbox_rule_runs (rule_id, message_id, ran_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (rule_id, message_id) DO NOTHING
+	`, ruleID, messageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to record rule run: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return n > 0, nil
+}
+
+func scanInboxRule(row rowScanner) (*inbox.Rule, error) {
+	var (
+		id, teamID                                       uuid.UUID
+		name                                             string
+		enabled                                          bool
+		rawKeywords, rawKinds, rawAccountIDs, rawActions []byte
+		rateLimit                                        int
+		createdBy                                        uuid.NullUUID
+		createdAt, updatedAt                             time.Time
+	)
+
+	err := row.Scan(&id, &teamID, &name, &enabled, &rawKeywords, &rawKinds, &rawAccountIDs, &rawActions, &rateLimit,
+		&createdBy, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, inbox.ErrRuleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan inbox rule: %w", err)
+	}
+
+	var (
+		keywords   []string
+		kinds      []inbox.Kind
+		accountIDs []uuid.UUID
+		actions    []inbox.Action
+	)
+	for _, field := range []struct {
+		raw []byte
+		v   interface{}
+	}{{rawKeywords, &keywords}, {rawKinds, &kinds}, {rawAccountIDs, &accountIDs}, {rawActions, &actions}} {
+		if err := json.Unmarshal(field.raw, field.v); err != nil {
+			return nil, fmt.Errorf("failed to decode inbox rule: %w", err)
+		}
+	}
+
+	return inbox.ReconstructRule(id, teamID, name, enabled, keywords, kinds, accountIDs, actions, rateLimit,
+		createdBy.UUID, createdAt, updatedAt), nil
+}
+
+// ============================================================================
+// SAVED REPLIES
+// ============================================================================
+
+func (r *InboxRuleRepository) CreateSavedReply(ctx context.Context, s *inbox.SavedReply) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO inbox_saved_replies (`+savedReplyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, s.ID(), s.TeamID(), s.Name(), s.Body(), nullUUID(s.CreatedBy()), s.CreatedAt(), s.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create saved reply: %w", err)
+	}
+	return nil
+}
+
+func (r *InboxRuleRepository) FindSavedReply(ctx context.Context, id uuid.UUID) (*inbox.SavedReply, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+savedReplyColumns+` FROM inbox_saved_replies WHERE id = $1`, id)
+	return scanSavedReply(row)
+}
+
+func (r *InboxRuleRepository) UpdateSavedReply(ctx context.Context, s *inbox.SavedReply) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE inbox_saved_replies SET name = $2, body = $3, updated_at = $4 WHERE id = $1
+	`, s.ID(), s.Name(), s.Body(), s.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update saved reply: %w", err)
+	}
+	return expectOneRow(result, inbox.ErrSavedReplyNotFound)
+}
+
+func (r *InboxRuleRepository) DeleteSavedReply(ctx context.Context, id uuid.UUID) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM inbox_saved_replies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved reply: %w", err)
+	}
+	return expectOneRow(result, inbox.ErrSavedReplyNotFound)
+}
+
+func (r *InboxRuleRepository) ListSavedReplies(ctx context.Context, teamID uuid.UUID) ([]*inbox.SavedReply, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT `+savedReplyColumns+` FROM inbox_saved_replies
+		WHERE team_id = $1
+		ORDER BY name
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved replies: %w", err)
+	}
+	defer rows.Close()
+
+	var replies []*inbox.SavedReply
+	for rows.Next() {
+		s, err := scanSavedReply(rows)
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, s)
+	}
+	return replies, rows.Err()
+}
+
+func scanSavedReply(row rowScanner) (*inbox.SavedReply, error) {
+	var (
+		id, teamID           uuid.UUID
+		name, body           string
+		createdBy            uuid.NullUUID
+		createdAt, updatedAt time.Time
+	)
+
+	err := row.Scan(&id, &teamID, &name, &body, &createdBy, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, inbox.ErrSavedReplyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan saved reply: %w", err)
+	}
+
+	return inbox.ReconstructSavedReply(id, teamID, name, body, createdBy.UUID, createdAt, updatedAt), nil
+}
@@ -0,0 +1,259 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/notification_repository.go
+// PURPOSE: Users' notifications and notification preferences
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/notification"
+)
+
+const notificationColumns = `id, user_id, team_id, kind, title, body, link, dedupe_key, in_app, email_pending,
+	read_at, emailed_at, created_at`
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(database *sql.DB) notification.Repository {
+	return &NotificationRepository{db: database}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, n *notification.Notification) (bool, error) {
+	content := n.Content()
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO notifications (`+notificationColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (user_id, dedupe_key) DO NOTHING
+	`, n.ID(), n.UserID(), nullUUID(n.TeamID()), n.Kind(), content.Title, content.Body, content.Link, n.DedupeKey(),
+		n.InApp(), n.EmailPending(), n.ReadAt(), n.EmailedAt(), n.CreatedAt())
+	if err != nil {
+		return false, fmt.Errorf("failed to create notification: %w", err)
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *NotificationRepository) Find(ctx context.Context, id uuid.UUID) (*notification.Notification, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+notificationColumns+` FROM notifications WHERE id = $1`, id)
+	return scanNotification(row)
+}
+
+func (r *NotificationRepository) Update(ctx context.Context, n *notification.Notification) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE notifications SET read_at = $2, email_pending = $3, emailed_at = $4 WHERE id = $1
+	`, n.ID(), n.ReadAt(), n.EmailPending(), n.EmailedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	return expectOneRow(result, notification.ErrNotificationNotFound)
+}
+
+func (r *NotificationRepository) List(ctx context.Context, filter notification.Filter) ([]*notification.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE user_id = $1 AND in_app`
+	args := []interface{}{filter.UserID}
+
+	if filter.UnreadOnly {
+		query += ` AND read_at IS NULL`
+	}
+	if filter.Before != nil {
+		args = append(args, *filter.Before)
+		query += fmt.Sprintf(` AND created_at < $%d`, len(args))
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(` ORDER BY created_at DESC LIMIT $%d`, len(args))
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return scanNotifications(rows)
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND in_app AND read_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND in_app AND read_at IS NULL
+	`, userID, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return int(count), nil
+}
+
+// DigestRecipients applies notification.Digest's intervals; users without
+// preferences get hourly digests
+func (r *NotificationRepository) DigestRecipients(ctx context.Context, now time.Time, limit int) ([]uuid.UUID, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT n.user_id FROM notifications n
+		LEFT JOIN notification_preferences p ON p.user_id = n.user_id
+		WHERE n.email_pending
+			AND (p.digest_sent_at IS NULL OR p.digest_sent_at <= $1 -
+				CASE p.email_digest WHEN 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '1 hour' END)
+		GROUP BY n.user_id
+		ORDER BY MIN(n.created_at)
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find digest recipients: %w", err)
+	}
+	defer rows.Close()
+
+	var users []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan digest recipient: %w", err)
+		}
+		users = append(users, id)
+	}
+	return users, rows.Err()
+}
+
+func (r *NotificationRepository) ClaimDigest(ctx context.Context, userID uuid.UUID, at time.Time, limit int) ([]*notification.Notification, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		WITH claimed AS (
+			SELECT id FROM notifications
+			WHERE user_id = $1 AND email_pending
+			ORDER BY created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE notifications n SET email_pending = FALSE, emailed_at = $2
+		FROM claimed WHERE n.id = claimed.id
+		RETURNING n.id, n.user_id, n.team_id, n.kind, n.title, n.body, n.link, n.dedupe_key, n.in_app,
+			n.email_pending, n.read_at, n.emailed_at, n.created_at
+	`, userID, at, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notification digest: %w", err)
+	}
+
+	claimed, err := scanNotifications(rows)
+	if err != nil {
+		return nil, err
+	}
+	// RETURNING has no order
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i].CreatedAt().Before(claimed[j].CreatedAt()) })
+	return claimed, nil
+}
+
+func scanNotifications(rows *sql.Rows) ([]*notification.Notification, error) {
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, rows.Err()
+}
+
+func scanNotification(row rowScanner) (*notification.Notification, error) {
+	var (
+		id, userID        uuid.UUID
+		teamID            uuid.NullUUID
+		kind              string
+		content           notification.Content
+		dedupeKey         string
+		inApp, emailing   bool
+		readAt, emailedAt sql.NullTime
+		createdAt         time.Time
+	)
+
+	err := row.Scan(&id, &userID, &teamID, &kind, &content.Title, &content.Body, &content.Link, &dedupeKey, &inApp, &emailing,
+		&readAt, &emailedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, notification.ErrNotificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan notification: %w", err)
+	}
+
+	return notification.Reconstruct(id, userID, teamID.UUID, notification.Kind(kind), content, dedupeKey, inApp, emailing,
+		nullTimePtr(readAt), nullTimePtr(emailedAt), createdAt), nil
+}
+
+// ============================================================================
+// PREFERENCES
+// ============================================================================
+
+type NotificationPreferenceRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationPreferenceRepository(database *sql.DB) notification.PreferenceRepository {
+	return &NotificationPreferenceRepository{db: database}
+}
+
+func (r *NotificationPreferenceRepository) Find(ctx context.Context, userID uuid.UUID) (*notification.Preferences, error) {
+	var (
+		rawKinds     []byte
+		digest       string
+		digestSentAt sql.NullTime
+		updatedAt    time.Time
+	)
+	err := conn(ctx, r.db).QueryRowContext(ctx, `
+		SELECT kinds, email_digest, digest_sent_at, updated_at FROM notification_preferences WHERE user_id = $1
+	`, userID).Scan(&rawKinds, &digest, &digestSentAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return notification.DefaultPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notification preferences: %w", err)
+	}
+
+	var kinds map[notification.Kind]notification.ChannelSettings
+	if err := json.Unmarshal(rawKinds, &kinds); err != nil {
+		return nil, fmt.Errorf("failed to decode notification preferences: %w", err)
+	}
+	return notification.ReconstructPreferences(userID, kinds, notification.Digest(digest), nullTimePtr(digestSentAt), updatedAt), nil
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, p *notification.Preferences) error {
+	kinds, err := json.Marshal(p.Kinds())
+	if err != nil {
+		return fmt.Errorf("failed to encode notification preferences: %w", err)
+	}
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO notification_preferences (user_id, kinds, email_digest, digest_sent_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET kinds = EXCLUDED.kinds, email_digest = EXCLUDED.email_digest,
+			digest_sent_at = EXCLUDED.digest_sent_at, updated_at = EXCLUDED.updated_at
+	`, p.UserID(), kinds, p.Digest(), p.DigestSentAt(), p.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return nil
+}
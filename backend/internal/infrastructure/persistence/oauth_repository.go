@@ -0,0 +1,262 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/oauth_repository.go
+// PURPOSE: OAuth clients, authorization codes and refresh tokens
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	oauthClientColumns  = `id, team_id, client_id, secret_hash, name, redirect_uris, scopes, created_by, created_at`
+	oauthCodeColumns    = `code_hash, client_id, user_id, team_id, redirect_uri, scopes, code_challenge, expires_at`
+	oauthRefreshColumns = `id, token_hash, client_id, user_id, team_id, scopes, expires_at, revoked_at, created_at`
+)
+
+type OAuthRepository struct {
+	db *sql.DB
+}
+
+func NewOAuthRepository(database *sql.DB) team.OAuthRepository {
+	return &OAuthRepository{db: database}
+}
+
+// ============================================================================
+// CLIENTS
+// ============================================================================
+
+func (r *OAuthRepository) CreateClient(ctx context.Context, c *team.OAuthClient) error {
+	redirectURIs, err := json.Marshal(c.RedirectURIs())
+	if err != nil {
+		return fmt.Errorf("failed to encode redirect uris: %w", err)
+	}
+	scopes, err := json.Marshal(c.Scopes())
+	if err != nil {
+		return fmt.Errorf("failed to encode scopes: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO oauth_clients (`+oauthClientColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, c.ID(), c.TeamID(), c.ClientID(), nullString(c.SecretHash()), c.Name(), redirectURIs, scopes, c.CreatedBy(), c.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create oauth client: %w", err)
+	}
+	return nil
+}
+
+func (r *OAuthRepository) FindClient(ctx context.Context, clientID string) (*team.OAuthClient, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+oauthClientColumns+` FROM oauth_clients WHERE client_id = $1`, clientID)
+	return scanOAuthClient(row)
+}
+
+func (r *OAuthRepository) FindClientByID(ctx context.Context, id uuid.UUID) (*team.OAuthClient, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+oauthClientColumns+` FROM oauth_clients WHERE id = $1`, id)
+	return scanOAuthClient(row)
+}
+
+func (r *OAuthRepository) ListClients(ctx context.Context, teamID uuid.UUID) ([]*team.OAuthClient, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+oauthClientColumns+` FROM oauth_clients
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list oauth clients: %w", err)
+	}
+	defer rows.Close()
+
+	var clients []*team.OAuthClient
+	for rows.Next() {
+		c, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return clients, rows.Err()
+}
+
+func (r *OAuthRepository) DeleteClient(ctx context.Context, teamID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM oauth_clients WHERE team_id = $1 AND id = $2`, teamID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth client: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth client: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+func scanOAuthClient(row rowScanner) (*team.OAuthClient, error) {
+	var (
+		id, teamID              uuid.UUID
+		clientID, name          string
+		secretHash              sql.NullString
+		rawRedirects, rawScopes []byte
+		createdBy               uuid.NullUUID
+		createdAt               sql.NullTime
+	)
+
+	err := row.Scan(&id, &teamID, &clientID, &secretHash, &name, &rawRedirects, &rawScopes, &createdBy, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrOAuthClientNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan oauth client: %w", err)
+	}
+
+	var redirectURIs []string
+	if err := json.Unmarshal(rawRedirects, &redirectURIs); err != nil {
+		return nil, fmt.Errorf("failed to decode redirect uris: %w", err)
+	}
+	scopes, err := decodeScopes(rawScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return team.ReconstructOAuthClient(
+		id, teamID, clientID, secretHash.String, name, redirectURIs, scopes,
+		createdBy.UUID, createdAt.Time,
+	), nil
+}
+
+// ============================================================================
+// AUTHORIZATION CODES
+// ============================================================================
+
+func (r *OAuthRepository) CreateCode(ctx context.Context, c *team.OAuthAuthorizationCode) error {
+	scopes, err := json.Marshal(c.Scopes())
+	if err != nil {
+		return fmt.Errorf("failed to encode scopes: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO oauth_authorization_codes (`+oauthCodeColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, c.CodeHash(), c.ClientID(), c.UserID(), c.TeamID(), c.RedirectURI(), scopes, nullString(c.CodeChallenge()), c.ExpiresAt())
+	if err != nil {
+		return fmt.Errorf("failed to create authorization code: %w", err)
+	}
+	return nil
+}
+
+func (r *OAuthRepository) ConsumeCode(ctx context.Context, codeHash string) (*team.OAuthAuthorizationCode, error) {
+	var (
+		hash, redirectURI      string
+		clientID, userID, tmID uuid.UUID
+		rawScopes              []byte
+		codeChallenge          sql.NullString
+		expiresAt              time.Time
+	)
+
+	// Deleting on read makes codes single use even under concurrent
+	// exchanges
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM oauth_authorization_codes WHERE code_hash = $1
+		RETURNING `+oauthCodeColumns+`
+	`, codeHash).Scan(&hash, &clientID, &userID, &tmID, &redirectURI, &rawScopes, &codeChallenge, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrInvalidOAuthGrant
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume authorization code: %w", err)
+	}
+
+	scopes, err := decodeScopes(rawScopes)
+	if err != nil {
+		return nil, err
+	}
+	return team.ReconstructOAuthAuthorizationCode(hash, clientID, userID, tmID, redirectURI, scopes, codeChallenge.String, expiresAt), nil
+}
+
+// ============================================================================
+// REFRESH TOKENS
+// ============================================================================
+
+func (r *OAuthRepository) CreateRefreshToken(ctx context.Context, t *team.OAuthRefreshToken) error {
+	scopes, err := json.Marshal(t.Scopes())
+	if err != nil {
+		return fmt.Errorf("failed to encode scopes: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO oauth_refresh_tokens (`+oauthRefreshColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, t.ID(), t.TokenHash(), t.ClientID(), t.UserID(), t.TeamID(), scopes, t.ExpiresAt(), t.RevokedAt(), t.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+func (r *OAuthRepository) FindRefreshToken(ctx context.Context, tokenHash string) (*team.OAuthRefreshToken, error) {
+	var (
+		id, clientID, userID, teamID uuid.UUID
+		hash                         string
+		rawScopes                    []byte
+		expiresAt                    time.Time
+		revokedAt, createdAt         sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT `+oauthRefreshColumns+` FROM oauth_refresh_tokens WHERE token_hash = $1
+	`, tokenHash).Scan(&id, &hash, &clientID, &userID, &teamID, &rawScopes, &expiresAt, &revokedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrInvalidOAuthGrant
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	scopes, err := decodeScopes(rawScopes)
+	if err != nil {
+		return nil, err
+	}
+	return team.ReconstructOAuthRefreshToken(
+		id, hash, clientID, userID, teamID, scopes, expiresAt, nullTimePtr(revokedAt), createdAt.Time,
+	), nil
+}
+
+func (r *OAuthRepository) RevokeRefreshToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE oauth_refresh_tokens SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL
+	`, id, revokedAt)
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrInvalidOAuthGrant
+	}
+	return nil
+}
+
+func decodeScopes(raw []byte) ([]team.Permission, error) {
+	var scopes []team.Permission
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &scopes); err != nil {
+			return nil, fmt.Errorf("failed to decode scopes: %w", err)
+		}
+	}
+	return scopes, nil
+}
@@ -0,0 +1,100 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/outbox_repository.go
+// PURPOSE: Transactional outbox for domain events
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(database *sql.DB) event.Outbox {
+	return &OutboxRepository{db: database}
+}
+
+func (r *OutboxRepository) Append(ctx context.Context, env *event.Envelope) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO event_outbox (id, event_type, aggregate_id, team_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, env.ID, env.EventType, env.Aggregate, nullUUID(env.Team), []byte(env.Payload), env.At)
+	if err != nil {
+		return fmt.Errorf("failed to append %s event to outbox: %w", env.EventType, err)
+	}
+	return nil
+}
+
+// Claim pushes the next attempt of each claimed entry out by the lease, so
+// concurrent relays skip them and a crashed relay's entries come back
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*event.Pending, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE event_outbox o
+		SET attempts = o.attempts + 1, next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE o.id IN (
+			SELECT id FROM event_outbox
+			WHERE dispatched_at IS NULL AND failed_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY occurred_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING o.id, o.event_type, o.aggregate_id, o.team_id, o.payload, o.occurred_at, o.attempts
+	`, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []*event.Pending
+	for rows.Next() {
+		var (
+			env      event.Envelope
+			teamID   uuid.NullUUID
+			payload  []byte
+			attempts int
+		)
+		if err := rows.Scan(&env.ID, &env.EventType, &env.Aggregate, &teamID, &payload, &env.At, &attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		env.Team = teamID.UUID
+		env.Payload = payload
+		pending = append(pending, &event.Pending{Envelope: &env, Attempts: attempts})
+	}
+	return pending, rows.Err()
+}
+
+func (r *OutboxRepository) MarkDispatched(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE event_outbox SET dispatched_at = NOW(), last_error = NULL WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event dispatched: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error {
+	var err error
+	if retryAt != nil {
+		_, err = r.db.ExecContext(ctx, `
+			UPDATE event_outbox SET last_error = $2, next_attempt_at = $3 WHERE id = $1
+		`, id, reason, *retryAt)
+	} else {
+		_, err = r.db.ExecContext(ctx, `
+			UPDATE event_outbox SET last_error = $2, failed_at = NOW() WHERE id = $1
+		`, id, reason)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
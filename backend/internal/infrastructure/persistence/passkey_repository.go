@@ -0,0 +1,148 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/passkey_repository.go
+// PURPOSE: WebAuthn credentials (passkeys) registered by users
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+const passkeyColumns = `id, user_id, credential_id, public_key, sign_count, aaguid, transports, name, created_at, last_used_at`
+
+type PasskeyRepository struct {
+	db *sql.DB
+}
+
+func NewPasskeyRepository(database *sql.DB) user.PasskeyRepository {
+	return &PasskeyRepository{db: database}
+}
+
+func (r *PasskeyRepository) Create(ctx context.Context, p *user.Passkey) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_passkeys (`+passkeyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, p.ID(), p.UserID(), p.CredentialID(), p.PublicKey(), int64(p.SignCount()),
+		p.AAGUID(), pq.Array(p.Transports()), p.Name(), p.CreatedAt(), p.LastUsedAt())
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // unique_violation
+			return user.ErrPasskeyExists
+		}
+		return fmt.Errorf("failed to create passkey: %w", err)
+	}
+	return nil
+}
+
+func (r *PasskeyRepository) Update(ctx context.Context, p *user.Passkey) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE user_passkeys
+		SET sign_count = $2, name = $3, last_used_at = $4
+		WHERE id = $1
+	`, p.ID(), int64(p.SignCount()), p.Name(), p.LastUsedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update passkey: %w", err)
+	}
+	return nil
+}
+
+func (r *PasskeyRepository) FindByCredentialID(ctx context.Context, credentialID []byte) (*user.Passkey, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+passkeyColumns+` FROM user_passkeys WHERE credential_id = $1
+	`, credentialID)
+	return scanPasskey(row)
+}
+
+func (r *PasskeyRepository) FindByID(ctx context.Context, userID, id uuid.UUID) (*user.Passkey, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+passkeyColumns+` FROM user_passkeys WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	return scanPasskey(row)
+}
+
+func (r *PasskeyRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*user.Passkey, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+passkeyColumns+` FROM user_passkeys
+		WHERE user_id = $1
+		ORDER BY created_at
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list passkeys: %w", err)
+	}
+	defer rows.Close()
+
+	var passkeys []*user.Passkey
+	for rows.Next() {
+		p, err := scanPasskey(rows)
+		if err != nil {
+			return nil, err
+		}
+		passkeys = append(passkeys, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list passkeys: %w", err)
+	}
+	return passkeys, nil
+}
+
+func (r *PasskeyRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM user_passkeys WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete passkey: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete passkey: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrPasskeyNotFound
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPasskey(row rowScanner) (*user.Passkey, error) {
+	var (
+		id, userID              uuid.UUID
+		credentialID, publicKey []byte
+		signCount               int64
+		aaguid                  []byte
+		transports              []string
+		name                    string
+		createdAt               sql.NullTime
+		lastUsedAt              sql.NullTime
+	)
+
+	err := row.Scan(&id, &userID, &credentialID, &publicKey, &signCount, &aaguid,
+		pq.Array(&transports), &name, &createdAt, &lastUsedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, user.ErrPasskeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan passkey: %w", err)
+	}
+
+	return user.ReconstructPasskey(
+		id, userID,
+		credentialID, publicKey,
+		uint32(signCount),
+		aaguid,
+		transports,
+		name,
+		createdAt.Time,
+		nullTimePtr(lastUsedAt),
+	), nil
+}
@@ -0,0 +1,142 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/platform_webhook_repository.go
+// PURPOSE: Inbound platform webhooks logged in webhooks_log
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+const platformWebhookColumns = `id, platform, event_type, payload, attempts, next_attempt_at,
+	received_at, processed_at, error`
+
+type PlatformWebhookRepository struct {
+	db *sql.DB
+}
+
+func NewPlatformWebhookRepository(database *sql.DB) social.WebhookRepository {
+	return &PlatformWebhookRepository{db: database}
+}
+
+func (r *PlatformWebhookRepository) SaveEvent(ctx context.Context, e *social.WebhookEvent) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO webhooks_log (source, processed, `+platformWebhookColumns+`)
+		VALUES ('social_platform', $1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, e.Processed(), e.ID, e.Platform, e.EventType, []byte(e.Payload), e.Attempts, e.NextAttemptAt,
+		e.ReceivedAt, e.ProcessedAt, nullString(e.Error))
+	if err != nil {
+		return fmt.Errorf("failed to save webhook event: %w", err)
+	}
+	return nil
+}
+
+func (r *PlatformWebhookRepository) FindEventByID(ctx context.Context, id uuid.UUID) (*social.WebhookEvent, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+platformWebhookColumns+` FROM webhooks_log
+		WHERE id = $1 AND source = 'social_platform'
+	`, id)
+	e, err := scanPlatformWebhook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, social.ErrWebhookEventNotFound
+	}
+	return e, err
+}
+
+// ClaimDueEvents pushes the next attempt of each claimed entry out by the
+// lease, like webhook deliveries, so concurrent workers skip them
+func (r *PlatformWebhookRepository) ClaimDueEvents(ctx context.Context, limit int, lease time.Duration) ([]*social.WebhookEvent, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE webhooks_log l
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE l.id IN (
+			SELECT id FROM webhooks_log
+			WHERE source = 'social_platform' AND NOT processed AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+platformWebhookColumns, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*social.WebhookEvent
+	for rows.Next() {
+		e, err := scanPlatformWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (r *PlatformWebhookRepository) UpdateEvent(ctx context.Context, e *social.WebhookEvent) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE webhooks_log
+		SET processed = $2, processed_at = $3, attempts = $4, next_attempt_at = $5, error = $6
+		WHERE id = $1
+	`, e.ID, e.Processed(), e.ProcessedAt, e.Attempts, e.NextAttemptAt, nullString(e.Error))
+	if err != nil {
+		return fmt.Errorf("failed to update webhook event: %w", err)
+	}
+	return expectOneRow(result, social.ErrWebhookEventNotFound)
+}
+
+// RequeueEvents resets matching entries to a fresh, due state
+func (r *PlatformWebhookRepository) RequeueEvents(ctx context.Context, f social.WebhookReplayFilter) (int, error) {
+	until := f.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE webhooks_log
+		SET processed = FALSE, processed_at = NULL, attempts = 0, error = NULL, next_attempt_at = NOW()
+		WHERE source = 'social_platform'
+			AND received_at >= $1 AND received_at <= $2
+			AND ($3 = '' OR platform = $3)
+			AND (NOT $4 OR (processed AND error IS NOT NULL))
+	`, f.Since, until, string(f.Platform), f.FailedOnly)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue webhook events: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return int(n), nil
+}
+
+func scanPlatformWebhook(row rowScanner) (*social.WebhookEvent, error) {
+	var (
+		e           social.WebhookEvent
+		platform    sql.NullString
+		payload     []byte
+		processedAt sql.NullTime
+		lastError   sql.NullString
+	)
+	if err := row.Scan(&e.ID, &platform, &e.EventType, &payload, &e.Attempts, &e.NextAttemptAt,
+		&e.ReceivedAt, &processedAt, &lastError); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan webhook event: %w", err)
+	}
+	e.Platform = social.Platform(platform.String)
+	e.Payload = payload
+	e.ProcessedAt = nullTimePtr(processedAt)
+	e.Error = lastError.String
+	return &e, nil
+}
@@ -0,0 +1,49 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/post_metrics_repository.go
+// PURPOSE: Metrics reported by platforms for published posts
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/social"
+)
+
+type PostMetricsRepository struct {
+	db *sql.DB
+}
+
+func NewPostMetricsRepository(database *sql.DB) social.PostMetricsRepository {
+	return &PostMetricsRepository{db: database}
+}
+
+// MergePostMetrics overwrites the reported keys of posts.metrics and keeps
+// the others
+func (r *PostMetricsRepository) MergePostMetrics(ctx context.Context, accountID uuid.UUID, platformPostID string, metrics map[string]int64, at time.Time) (bool, error) {
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode metrics: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE posts
+		SET metrics = COALESCE(metrics, '{}'::jsonb) || $3::jsonb, last_metrics_fetch_at = $4
+		WHERE social_account_id = $1 AND platform_post_id = $2
+	`, accountID, platformPostID, data, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to merge post metrics: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	return n > 0, nil
+}
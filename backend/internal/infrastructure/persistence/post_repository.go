@@ -16,6 +16,9 @@ import (
 	"github.com/techappsUT/social-queue/internal/domain/post"
 )
 
+// Posts stuck in publishing longer than this are considered abandoned
+const stalePublishingAfter = 10 * time.Minute
+
 type PostRepository struct {
 	db      *sql.DB
 	queries *db.Queries
@@ -44,10 +47,12 @@ func (r *PostRepository) Create(ctx context.Context, p *post.Post) error {
 
 	// Note: For now, we'll use the first platform's social account
 	// In production, you'd need to handle multiple platforms differently
-	var socialAccountID uuid.UUID
-	// This is a placeholder - in real implementation, you'd look up the social account
-	// based on team + platform combination
-	socialAccountID = uuid.New() // TODO: Look up actual social account
+	socialAccountID := p.SocialAccountID()
+	if socialAccountID == uuid.Nil {
+		// This is a placeholder - in real implementation, you'd look up the social account
+		// based on team + platform combination
+		socialAccountID = uuid.New() // TODO: Look up actual social account
+	}
 
 	// Prepare JSONB fields - FIXED: Use pqtype.NullRawMessage correctly
 	shortenedLinks := pqtype.NullRawMessage{
@@ -223,6 +228,45 @@ func (r *PostRepository) FindDuePosts(ctx context.Context, before time.Time) ([]
 	return posts, nil
 }
 
+// FindDuePostsPerTeam returns due posts with at most perTeam posts per team,
+// so a single team's bulk schedule cannot crowd out everyone else
+func (r *PostRepository) FindDuePostsPerTeam(ctx context.Context, before time.Time, perTeam, limit int) ([]*post.Post, error) {
+	query := `
+		SELECT id, team_id, created_by, social_account_id, content, content_html,
+		       shortened_links, status, scheduled_at, published_at,
+		       platform_specific_options, error_message, retry_count, max_retries,
+		       created_at, updated_at, deleted_at
+		FROM (
+			SELECT sp.*,
+			       ROW_NUMBER() OVER (PARTITION BY sp.team_id ORDER BY sp.scheduled_at ASC) AS team_rank
+			FROM scheduled_posts sp
+			INNER JOIN social_accounts sa ON sp.social_account_id = sa.id
+			WHERE (
+				sp.status IN ('scheduled', 'queued')
+				-- Posts left mid-publish by a crashed worker; publish attempts
+				-- reconcile these against the platform before re-posting
+				OR (sp.status = 'processing' AND sp.updated_at <= $1::timestamptz - $4::interval)
+			  )
+			  AND sp.scheduled_at <= $1
+			  AND sp.deleted_at IS NULL
+			  AND sa.status = 'active'
+			  AND sa.deleted_at IS NULL
+		) ranked
+		WHERE team_rank <= $2
+		ORDER BY team_rank ASC, scheduled_at ASC
+		LIMIT $3
+	`
+
+	stale := fmt.Sprintf("%d seconds", int(stalePublishingAfter.Seconds()))
+	rows, err := r.db.QueryContext(ctx, query, before, perTeam, limit, stale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due posts per team: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanPostRows(ctx, rows)
+}
+
 func (r *PostRepository) FindScheduled(ctx context.Context, offset, limit int) ([]*post.Post, error) {
 	query := `
 		SELECT * FROM scheduled_posts
@@ -267,7 +311,8 @@ func (r *PostRepository) Update(ctx context.Context, p *post.Post) error {
 		scheduleTime = sql.NullTime{Time: *p.ScheduleTime(), Valid: true}
 	}
 
-	_, err := r.queries.UpdateScheduledPost(ctx, db.UpdateScheduledPostParams{
+	q := queriesFor(ctx, r.queries)
+	_, err := q.UpdateScheduledPost(ctx, db.UpdateScheduledPostParams{
 		ID:          p.ID(),
 		Content:     sql.NullString{String: p.Content().Text, Valid: true},
 		ScheduledAt: scheduleTime,
@@ -297,7 +342,7 @@ func (r *PostRepository) Update(ctx context.Context, p *post.Post) error {
 		dbStatus = db.PostStatusDraft
 	}
 
-	err = r.queries.UpdateScheduledPostStatus(ctx, db.UpdateScheduledPostStatusParams{
+	err = q.UpdateScheduledPostStatus(ctx, db.UpdateScheduledPostStatusParams{
 		ID:     p.ID(),
 		Status: db.NullPostStatus{PostStatus: dbStatus, Valid: true},
 	})
@@ -461,6 +506,41 @@ func (r *PostRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// FindDuplicates returns posts of a team published (or being published)
+// within timeWindow whose normalized content matches the given text
+func (r *PostRepository) FindDuplicates(ctx context.Context, teamID uuid.UUID, content string, timeWindow time.Duration) ([]*post.Post, error) {
+	query := `
+		SELECT * FROM scheduled_posts
+		WHERE team_id = $1
+		  AND status IN ('processing', 'published')
+		  AND COALESCE(published_at, updated_at) >= $2
+		  AND deleted_at IS NULL
+		ORDER BY COALESCE(published_at, updated_at) DESC
+		LIMIT 500
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, teamID, time.Now().Add(-timeWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate posts: %w", err)
+	}
+	defer rows.Close()
+
+	candidates, err := r.scanPostRows(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	target := post.NormalizeContent(content)
+	duplicates := make([]*post.Post, 0)
+	for _, candidate := range candidates {
+		if post.NormalizeContent(candidate.Content().Text) == target {
+			duplicates = append(duplicates, candidate)
+		}
+	}
+
+	return duplicates, nil
+}
+
 // ============================================================================
 // HELPER FUNCTIONS
 // ============================================================================
@@ -540,6 +620,7 @@ func (r *PostRepository) mapToPost(sp db.ScheduledPost, attachments []db.PostAtt
 		sp.ID,
 		sp.TeamID,
 		sp.CreatedBy,
+		sp.SocialAccountID,
 		content,
 		platforms,
 		scheduleTime,
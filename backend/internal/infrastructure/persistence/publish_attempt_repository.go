@@ -0,0 +1,147 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/publish_attempt_repository.go
+// PURPOSE: Publish attempts keyed by idempotency key (post + target account)
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/post"
+)
+
+type PublishAttemptRepository struct {
+	db *sql.DB
+}
+
+func NewPublishAttemptRepository(database *sql.DB) post.PublishAttemptRepository {
+	return &PublishAttemptRepository{db: database}
+}
+
+func (r *PublishAttemptRepository) Create(ctx context.Context, a *post.PublishAttempt) error {
+	query := `
+		INSERT INTO publish_attempts (
+			id, scheduled_post_id, social_account_id, idempotency_key, content_hash,
+			status, platform_post_id, platform_post_url, error_message, attempts,
+			started_at, completed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		a.ID(),
+		a.PostID(),
+		a.SocialAccountID(),
+		a.IdempotencyKey(),
+		a.ContentHash(),
+		string(a.Status()),
+		nullString(a.PlatformPostID()),
+		nullString(a.PlatformPostURL()),
+		nullString(a.ErrorMessage()),
+		a.Attempts(),
+		a.StartedAt(),
+		a.CompletedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create publish attempt: %w", err)
+	}
+	return nil
+}
+
+func (r *PublishAttemptRepository) Update(ctx context.Context, a *post.PublishAttempt) error {
+	query := `
+		UPDATE publish_attempts
+		SET content_hash = $2,
+		    status = $3,
+		    platform_post_id = $4,
+		    platform_post_url = $5,
+		    error_message = $6,
+		    attempts = $7,
+		    started_at = $8,
+		    completed_at = $9
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		a.ID(),
+		a.ContentHash(),
+		string(a.Status()),
+		nullString(a.PlatformPostID()),
+		nullString(a.PlatformPostURL()),
+		nullString(a.ErrorMessage()),
+		a.Attempts(),
+		a.StartedAt(),
+		a.CompletedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update publish attempt: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update publish attempt: %w", err)
+	}
+	if rows == 0 {
+		return post.ErrPublishAttemptNotFound
+	}
+	return nil
+}
+
+func (r *PublishAttemptRepository) FindByKey(ctx context.Context, idempotencyKey string) (*post.PublishAttempt, error) {
+	query := `
+		SELECT id, scheduled_post_id, social_account_id, idempotency_key, content_hash,
+		       status, platform_post_id, platform_post_url, error_message, attempts,
+		       started_at, completed_at
+		FROM publish_attempts
+		WHERE idempotency_key = $1
+	`
+
+	var (
+		id, postID, accountID                         uuid.UUID
+		key, contentHash, status                      string
+		platformPostID, platformPostURL, errorMessage sql.NullString
+		attempts                                      int
+		startedAt                                     sql.NullTime
+		completedAt                                   sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx, query, idempotencyKey).Scan(
+		&id, &postID, &accountID, &key, &contentHash,
+		&status, &platformPostID, &platformPostURL, &errorMessage, &attempts,
+		&startedAt, &completedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, post.ErrPublishAttemptNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find publish attempt: %w", err)
+	}
+
+	return post.ReconstructPublishAttempt(
+		id, postID, accountID,
+		key, contentHash,
+		post.AttemptStatus(status),
+		platformPostID.String, platformPostURL.String, errorMessage.String,
+		attempts,
+		startedAt.Time,
+		nullTimePtr(completedAt),
+	), nil
+}
+
+// nullString stores empty strings as NULL
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullTimePtr converts a nullable column into a time pointer
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
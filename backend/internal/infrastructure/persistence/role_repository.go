@@ -0,0 +1,156 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/role_repository.go
+// PURPOSE: Built-in and team custom roles with their permissions
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const roleColumns = `id, team_id, name, description, permissions, is_system, created_at, updated_at`
+
+type RoleRepository struct {
+	db *sql.DB
+}
+
+func NewRoleRepository(database *sql.DB) team.RoleRepository {
+	return &RoleRepository{db: database}
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+roleColumns+` FROM roles
+		WHERE name = $2 AND (team_id IS NULL OR team_id = $1)
+		ORDER BY team_id NULLS FIRST
+		LIMIT 1
+	`, teamID, string(name))
+	return scanRole(row)
+}
+
+func (r *RoleRepository) List(ctx context.Context, teamID uuid.UUID) ([]*team.Role, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+roleColumns+` FROM roles
+		WHERE team_id IS NULL OR team_id = $1
+		ORDER BY team_id NULLS FIRST, created_at, name
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []*team.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (r *RoleRepository) Create(ctx context.Context, role *team.Role) error {
+	permissions, err := json.Marshal(role.Permissions())
+	if err != nil {
+		return fmt.Errorf("failed to encode permissions: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO roles (`+roleColumns+`)
+		VALUES ($1, $2, $3, $4, $5, FALSE, $6, $7)
+	`, role.ID(), role.TeamID(), string(role.Name()), nullString(role.Description()), permissions, role.CreatedAt(), role.UpdatedAt())
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return team.ErrRoleExists
+		}
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+	return nil
+}
+
+func (r *RoleRepository) Update(ctx context.Context, role *team.Role) error {
+	permissions, err := json.Marshal(role.Permissions())
+	if err != nil {
+		return fmt.Errorf("failed to encode permissions: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE roles SET description = $3, permissions = $4, updated_at = $5
+		WHERE team_id = $1 AND id = $2
+	`, role.TeamID(), role.ID(), nullString(role.Description()), permissions, role.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, teamID uuid.UUID, name team.MemberRole) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM roles WHERE team_id = $1 AND name = $2`, teamID, string(name))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return team.ErrRoleInUse
+		}
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrRoleNotFound
+	}
+	return nil
+}
+
+func scanRole(row rowScanner) (*team.Role, error) {
+	var (
+		id                   uuid.UUID
+		teamID               uuid.NullUUID
+		name                 string
+		description          sql.NullString
+		rawPermissions       []byte
+		isSystem             sql.NullBool
+		createdAt, updatedAt sql.NullTime
+	)
+
+	err := row.Scan(&id, &teamID, &name, &description, &rawPermissions, &isSystem, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan role: %w", err)
+	}
+
+	var permissions []team.Permission
+	if len(rawPermissions) > 0 {
+		if err := json.Unmarshal(rawPermissions, &permissions); err != nil {
+			return nil, fmt.Errorf("failed to decode role permissions: %w", err)
+		}
+	}
+
+	return team.ReconstructRole(
+		id, teamID.UUID, team.MemberRole(name), description.String, permissions,
+		isSystem.Bool, createdAt.Time, updatedAt.Time,
+	), nil
+}
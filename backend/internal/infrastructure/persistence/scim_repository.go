@@ -0,0 +1,318 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/scim_repository.go
+// PURPOSE: SCIM provisioning tokens and identity provider groups
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const (
+	scimTokenColumns = `id, team_id, name, token_hash, created_by, created_at, last_used_at`
+	scimGroupColumns = `id, team_id, display_name, external_id, role, created_at, updated_at`
+)
+
+type SCIMRepository struct {
+	db *sql.DB
+}
+
+func NewSCIMRepository(database *sql.DB) team.SCIMRepository {
+	return &SCIMRepository{db: database}
+}
+
+// ============================================================================
+// TOKENS
+// ============================================================================
+
+func (r *SCIMRepository) CreateToken(ctx context.Context, t *team.SCIMToken) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO team_scim_tokens (`+scimTokenColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, t.ID(), t.TeamID(), t.Name(), t.TokenHash(), t.CreatedBy(), t.CreatedAt(), t.LastUsedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create scim token: %w", err)
+	}
+	return nil
+}
+
+func (r *SCIMRepository) FindTokenByHash(ctx context.Context, tokenHash string) (*team.SCIMToken, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+scimTokenColumns+` FROM team_scim_tokens WHERE token_hash = $1
+	`, tokenHash)
+	return scanSCIMToken(row)
+}
+
+func (r *SCIMRepository) ListTokens(ctx context.Context, teamID uuid.UUID) ([]*team.SCIMToken, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+scimTokenColumns+` FROM team_scim_tokens
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scim tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*team.SCIMToken
+	for rows.Next() {
+		t, err := scanSCIMToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *SCIMRepository) TouchToken(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE team_scim_tokens SET last_used_at = $2 WHERE id = $1`, id, usedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update scim token: %w", err)
+	}
+	return nil
+}
+
+func (r *SCIMRepository) DeleteToken(ctx context.Context, teamID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM team_scim_tokens WHERE team_id = $1 AND id = $2`, teamID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scim token: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete scim token: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrSCIMTokenNotFound
+	}
+	return nil
+}
+
+func scanSCIMToken(row rowScanner) (*team.SCIMToken, error) {
+	var (
+		id, teamID      uuid.UUID
+		name, tokenHash string
+		createdBy       uuid.NullUUID
+		createdAt       sql.NullTime
+		lastUsedAt      sql.NullTime
+	)
+
+	err := row.Scan(&id, &teamID, &name, &tokenHash, &createdBy, &createdAt, &lastUsedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrSCIMTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan scim token: %w", err)
+	}
+
+	return team.ReconstructSCIMToken(id, teamID, name, tokenHash, createdBy.UUID, createdAt.Time, nullTimePtr(lastUsedAt)), nil
+}
+
+// ============================================================================
+// GROUPS
+// ============================================================================
+
+func (r *SCIMRepository) CreateGroup(ctx context.Context, g *team.SCIMGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO team_scim_groups (`+scimGroupColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, g.ID(), g.TeamID(), g.DisplayName(), nullString(g.ExternalID()), nullString(string(g.Role())), g.CreatedAt(), g.UpdatedAt())
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return team.ErrSCIMGroupExists
+		}
+		return fmt.Errorf("failed to create scim group: %w", err)
+	}
+
+	if err := replaceGroupMembers(ctx, tx, g); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *SCIMRepository) UpdateGroup(ctx context.Context, g *team.SCIMGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE team_scim_groups
+		SET display_name = $3, external_id = $4, role = $5, updated_at = $6
+		WHERE team_id = $1 AND id = $2
+	`, g.TeamID(), g.ID(), g.DisplayName(), nullString(g.ExternalID()), nullString(string(g.Role())), g.UpdatedAt())
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return team.ErrSCIMGroupExists
+		}
+		return fmt.Errorf("failed to update scim group: %w", err)
+	}
+
+	if err := replaceGroupMembers(ctx, tx, g); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func replaceGroupMembers(ctx context.Context, tx *sql.Tx, g *team.SCIMGroup) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_scim_group_members WHERE group_id = $1`, g.ID()); err != nil {
+		return fmt.Errorf("failed to update scim group members: %w", err)
+	}
+	for _, userID := range g.MemberIDs() {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO team_scim_group_members (group_id, user_id) VALUES ($1, $2)
+		`, g.ID(), userID)
+		if err != nil {
+			return fmt.Errorf("failed to update scim group members: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *SCIMRepository) FindGroup(ctx context.Context, teamID, id uuid.UUID) (*team.SCIMGroup, error) {
+	groups, err := r.queryGroups(ctx, `
+		SELECT `+scimGroupColumns+` FROM team_scim_groups WHERE team_id = $1 AND id = $2
+	`, teamID, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, team.ErrSCIMGroupNotFound
+	}
+	return groups[0], nil
+}
+
+func (r *SCIMRepository) ListGroups(ctx context.Context, teamID uuid.UUID) ([]*team.SCIMGroup, error) {
+	return r.queryGroups(ctx, `
+		SELECT `+scimGroupColumns+` FROM team_scim_groups
+		WHERE team_id = $1
+		ORDER BY display_name
+	`, teamID)
+}
+
+func (r *SCIMRepository) ListGroupsByMember(ctx context.Context, teamID, userID uuid.UUID) ([]*team.SCIMGroup, error) {
+	return r.queryGroups(ctx, `
+		SELECT `+scimGroupColumns+` FROM team_scim_groups
+		WHERE team_id = $1 AND id IN (SELECT group_id FROM team_scim_group_members WHERE user_id = $2)
+		ORDER BY display_name
+	`, teamID, userID)
+}
+
+func (r *SCIMRepository) DeleteGroup(ctx context.Context, teamID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM team_scim_groups WHERE team_id = $1 AND id = $2`, teamID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scim group: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete scim group: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrSCIMGroupNotFound
+	}
+	return nil
+}
+
+func (r *SCIMRepository) RemoveMemberFromGroups(ctx context.Context, teamID, userID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM team_scim_group_members
+		WHERE user_id = $2 AND group_id IN (SELECT id FROM team_scim_groups WHERE team_id = $1)
+	`, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove member from scim groups: %w", err)
+	}
+	return nil
+}
+
+// queryGroups loads groups and then their members with one more query
+func (r *SCIMRepository) queryGroups(ctx context.Context, query string, args ...interface{}) ([]*team.SCIMGroup, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scim groups: %w", err)
+	}
+	defer rows.Close()
+
+	type groupRow struct {
+		id, teamID           uuid.UUID
+		displayName          string
+		externalID, role     sql.NullString
+		createdAt, updatedAt sql.NullTime
+	}
+	var found []groupRow
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var g groupRow
+		if err := rows.Scan(&g.id, &g.teamID, &g.displayName, &g.externalID, &g.role, &g.createdAt, &g.updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan scim group: %w", err)
+		}
+		found = append(found, g)
+		ids = append(ids, g.id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find scim groups: %w", err)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	members, err := r.groupMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*team.SCIMGroup, 0, len(found))
+	for _, g := range found {
+		groups = append(groups, team.ReconstructSCIMGroup(
+			g.id, g.teamID, g.displayName, g.externalID.String,
+			team.MemberRole(g.role.String), members[g.id],
+			g.createdAt.Time, g.updatedAt.Time,
+		))
+	}
+	return groups, nil
+}
+
+func (r *SCIMRepository) groupMembers(ctx context.Context, groupIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	keys := make([]string, len(groupIDs))
+	for i, id := range groupIDs {
+		keys[i] = id.String()
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT group_id, user_id FROM team_scim_group_members
+		WHERE group_id = ANY($1::uuid[])
+	`, pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scim group members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make(map[uuid.UUID][]uuid.UUID)
+	for rows.Next() {
+		var groupID, userID uuid.UUID
+		if err := rows.Scan(&groupID, &userID); err != nil {
+			return nil, fmt.Errorf("failed to scan scim group member: %w", err)
+		}
+		members[groupID] = append(members[groupID], userID)
+	}
+	return members, rows.Err()
+}
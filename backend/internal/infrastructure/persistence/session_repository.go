@@ -0,0 +1,217 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/session_repository.go
+// PURPOSE: Hashed refresh tokens grouped into sessions (token families)
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+)
+
+type SessionRepository struct {
+	db *sql.DB
+}
+
+func NewSessionRepository(database *sql.DB) user.SessionRepository {
+	return &SessionRepository{db: database}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, t *user.RefreshToken) error {
+	if err := insertRefreshToken(ctx, r.db, t); err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepository) FindByHash(ctx context.Context, tokenHash string) (*user.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, parent_id, token_hash, user_agent, ip_address,
+		       expires_at, created_at, last_used_at,
+		       COALESCE(revoked_at, CASE WHEN revoked THEN created_at END), revoked_reason
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var (
+		id, userID, familyID         uuid.UUID
+		parentID                     uuid.NullUUID
+		hash                         string
+		userAgent, ipAddress, reason sql.NullString
+		expiresAt, createdAt         sql.NullTime
+		lastUsedAt, revokedAt        sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&id, &userID, &familyID, &parentID, &hash, &userAgent, &ipAddress,
+		&expiresAt, &createdAt, &lastUsedAt, &revokedAt, &reason,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, user.ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+
+	var parent *uuid.UUID
+	if parentID.Valid {
+		parent = &parentID.UUID
+	}
+
+	return user.ReconstructRefreshToken(
+		id, userID, familyID, parent,
+		hash, userAgent.String, ipAddress.String,
+		expiresAt.Time, createdAt.Time,
+		nullTimePtr(lastUsedAt), nullTimePtr(revokedAt),
+		reason.String,
+	), nil
+}
+
+func (r *SessionRepository) Rotate(ctx context.Context, old, next *user.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Only an unrevoked token may be rotated; losing this race means the
+	// same token was presented twice
+	result, err := tx.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked = TRUE, revoked_at = $2, revoked_reason = $3, last_used_at = $4
+		WHERE id = $1 AND revoked = FALSE
+	`, old.ID(), old.RevokedAt(), old.RevokedReason(), old.LastUsedAt())
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrRefreshTokenReused
+	}
+
+	if err := insertRefreshToken(ctx, tx, next); err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit rotation: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked = TRUE, revoked_at = NOW(), revoked_reason = $2
+		WHERE family_id = $1 AND revoked = FALSE
+	`, familyID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked = TRUE, revoked_at = NOW(), revoked_reason = $2
+		WHERE user_id = $1 AND revoked = FALSE
+	`, userID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepository) ListSessions(ctx context.Context, userID uuid.UUID) ([]*user.Session, error) {
+	// The active token of each family carries the latest device and IP;
+	// the family's first token carries the login time
+	query := `
+		SELECT t.family_id, t.user_agent, t.ip_address,
+		       (SELECT MIN(f.created_at) FROM refresh_tokens f WHERE f.family_id = t.family_id),
+		       COALESCE(t.last_used_at, t.created_at), t.expires_at
+		FROM refresh_tokens t
+		WHERE t.user_id = $1 AND t.revoked = FALSE AND t.expires_at > NOW()
+		ORDER BY COALESCE(t.last_used_at, t.created_at) DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*user.Session
+	for rows.Next() {
+		var (
+			s                    user.Session
+			userAgent, ipAddress sql.NullString
+		)
+		if err := rows.Scan(&s.ID, &userAgent, &ipAddress, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		s.UserAgent = userAgent.String
+		s.IPAddress = ipAddress.String
+		sessions = append(sessions, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *SessionRepository) RevokeSession(ctx context.Context, userID, familyID uuid.UUID, reason string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked = TRUE, revoked_at = NOW(), revoked_reason = $3
+		WHERE user_id = $1 AND family_id = $2 AND revoked = FALSE
+	`, userID, familyID, reason)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrSessionNotFound
+	}
+	return nil
+}
+
+// execer is satisfied by *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func insertRefreshToken(ctx context.Context, db execer, t *user.RefreshToken) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (
+			id, user_id, family_id, parent_id, token_hash, user_agent, ip_address,
+			expires_at, created_at, last_used_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`,
+		t.ID(),
+		t.UserID(),
+		t.FamilyID(),
+		t.ParentID(),
+		t.TokenHash(),
+		nullString(t.UserAgent()),
+		nullString(t.IPAddress()),
+		t.ExpiresAt(),
+		t.CreatedAt(),
+		t.LastUsedAt(),
+	)
+	return err
+}
@@ -18,12 +18,14 @@ import (
 )
 
 type SocialRepository struct {
+	db         *sql.DB
 	queries    *db.Queries
 	encryption *services.EncryptionService
 }
 
-func NewSocialRepository(queries *db.Queries, encryption *services.EncryptionService) social.AccountRepository {
+func NewSocialRepository(database *sql.DB, queries *db.Queries, encryption *services.EncryptionService) social.AccountRepository {
 	return &SocialRepository{
+		db:         database,
 		queries:    queries,
 		encryption: encryption,
 	}
@@ -227,7 +229,7 @@ func (r *SocialRepository) Update(ctx context.Context, account *social.Account)
 // Delete soft-deletes a social account
 func (r *SocialRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	// FIX: Use SoftDeleteSocialAccount instead of DeleteSocialAccount
-	return r.queries.SoftDeleteSocialAccount(ctx, id)
+	return queriesFor(ctx, r.queries).SoftDeleteSocialAccount(ctx, id)
 }
 
 // CountByTeamID counts social accounts for a team
@@ -237,10 +239,9 @@ func (r *SocialRepository) CountByTeamID(ctx context.Context, teamID uuid.UUID)
 
 // BulkUpdateStatus updates status for multiple accounts
 func (r *SocialRepository) BulkUpdateStatus(ctx context.Context, ids []uuid.UUID, status social.Status) error {
-	// Update each account's status in a transaction would be ideal,
-	// but for simplicity we'll update one by one
+	// One by one, in the caller's transaction if there is one
 	for _, id := range ids {
-		err := r.queries.UpdateSocialAccountStatus(ctx, db.UpdateSocialAccountStatusParams{
+		err := queriesFor(ctx, r.queries).UpdateSocialAccountStatus(ctx, db.UpdateSocialAccountStatusParams{
 			ID:     id,
 			Status: db.NullSocialAccountStatus{SocialAccountStatus: db.SocialAccountStatus(status), Valid: true},
 		})
@@ -270,6 +271,51 @@ func (r *SocialRepository) FindByPlatformUserID(ctx context.Context, platform so
 	return nil, fmt.Errorf("not implemented")
 }
 
+// FindAllByPlatformUserID retrieves every team's connection of a platform
+// account
+func (r *SocialRepository) FindAllByPlatformUserID(ctx context.Context, platform social.Platform, platformUserID string) ([]*social.Account, error) {
+	return r.findByIDQuery(ctx, `
+		SELECT id FROM social_accounts
+		WHERE platform = $1 AND platform_user_id = $2 AND deleted_at IS NULL`,
+		platform, platformUserID)
+}
+
+// findByIDQuery loads the accounts whose IDs a query selects
+func (r *SocialRepository) findByIDQuery(ctx context.Context, query string, args ...interface{}) ([]*social.Account, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan account: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*social.Account, 0, len(ids))
+	for _, id := range ids {
+		row, err := queriesFor(ctx, r.queries).GetSocialAccountWithToken(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load account %s: %w", id, err)
+		}
+		account, err := r.mapToAccount(row)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 // FindByStatus retrieves accounts by status with pagination
 func (r *SocialRepository) FindByStatus(ctx context.Context, status social.Status, offset, limit int) ([]*social.Account, error) {
 	// Note: You may need to add a SQLC query for this
@@ -282,10 +328,16 @@ func (r *SocialRepository) FindExpiredAccounts(ctx context.Context) ([]*social.A
 	return []*social.Account{}, nil
 }
 
-// FindExpiringAccounts retrieves accounts expiring within specified days
+// FindExpiringAccounts retrieves active accounts whose token expires
+// within the specified days
 func (r *SocialRepository) FindExpiringAccounts(ctx context.Context, withinDays int) ([]*social.Account, error) {
-	// Note: You may need to add a SQLC query for this
-	return []*social.Account{}, nil
+	return r.findByIDQuery(ctx, `
+		SELECT sa.id FROM social_accounts sa
+		JOIN social_tokens st ON st.social_account_id = sa.id
+		WHERE sa.status = $1 AND sa.deleted_at IS NULL
+			AND st.expires_at > NOW() AND st.expires_at <= NOW() + make_interval(days => $2)
+		ORDER BY st.expires_at`,
+		social.StatusActive, withinDays)
 }
 
 // FindRateLimitedAccounts retrieves all rate-limited accounts
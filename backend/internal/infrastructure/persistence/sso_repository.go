@@ -0,0 +1,240 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/sso_repository.go
+// PURPOSE: Per-team SAML single sign-on configuration
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+const ssoColumns = `team_id, idp_entity_id, idp_sso_url, idp_certificates,
+	email_attribute, first_name_attribute, last_name_attribute, role_attribute, role_mapping,
+	default_role, enforce_sso, enabled, created_at, updated_at`
+
+type SSORepository struct {
+	db *sql.DB
+}
+
+func NewSSORepository(database *sql.DB) team.SSORepository {
+	return &SSORepository{db: database}
+}
+
+func (r *SSORepository) FindByTeamID(ctx context.Context, teamID uuid.UUID) (*team.SSOConfig, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+ssoColumns+` FROM team_sso_configs WHERE team_id = $1
+	`, teamID)
+	return r.load(ctx, row)
+}
+
+func (r *SSORepository) FindByDomain(ctx context.Context, domain string) (*team.SSOConfig, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+ssoColumns+` FROM team_sso_configs
+		WHERE team_id = (
+			SELECT team_id FROM team_sso_domains WHERE domain = $1 AND verified_at IS NOT NULL
+		)
+	`, strings.ToLower(domain))
+	return r.load(ctx, row)
+}
+
+// load scans a configuration and reads its domains
+func (r *SSORepository) load(ctx context.Context, row rowScanner) (*team.SSOConfig, error) {
+	config, err := scanSSOConfig(row)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT domain, verification_token, verified_at FROM team_sso_domains
+		WHERE team_id = $1
+		ORDER BY created_at, domain
+	`, config.teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sso domains: %w", err)
+	}
+	defer rows.Close()
+
+	var domains []team.SSODomain
+	for rows.Next() {
+		var (
+			d          team.SSODomain
+			verifiedAt sql.NullTime
+		)
+		if err := rows.Scan(&d.Domain, &d.VerificationToken, &verifiedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan sso domain: %w", err)
+		}
+		d.VerifiedAt = nullTimePtr(verifiedAt)
+		domains = append(domains, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load sso domains: %w", err)
+	}
+
+	return config.reconstruct(domains), nil
+}
+
+func (r *SSORepository) Save(ctx context.Context, c *team.SSOConfig) error {
+	mapping := c.AttributeMapping()
+	roleMapping, err := json.Marshal(mapping.RoleMapping)
+	if err != nil {
+		return fmt.Errorf("failed to encode role mapping: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO team_sso_configs (`+ssoColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (team_id) DO UPDATE SET
+			idp_entity_id = EXCLUDED.idp_entity_id,
+			idp_sso_url = EXCLUDED.idp_sso_url,
+			idp_certificates = EXCLUDED.idp_certificates,
+			email_attribute = EXCLUDED.email_attribute,
+			first_name_attribute = EXCLUDED.first_name_attribute,
+			last_name_attribute = EXCLUDED.last_name_attribute,
+			role_attribute = EXCLUDED.role_attribute,
+			role_mapping = EXCLUDED.role_mapping,
+			default_role = EXCLUDED.default_role,
+			enforce_sso = EXCLUDED.enforce_sso,
+			enabled = EXCLUDED.enabled,
+			updated_at = EXCLUDED.updated_at
+	`,
+		c.TeamID(), c.IdPEntityID(), c.IdPSSOURL(), pq.Array(c.IdPCertificates()),
+		mapping.Email, mapping.FirstName, mapping.LastName, mapping.Role, roleMapping,
+		string(c.DefaultRole()), c.EnforceSSO(), c.IsEnabled(),
+		c.CreatedAt(), c.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save sso config: %w", err)
+	}
+
+	names := make([]string, 0, len(c.Domains()))
+	for _, d := range c.Domains() {
+		names = append(names, d.Domain)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM team_sso_domains WHERE team_id = $1 AND NOT (domain = ANY($2))
+	`, c.TeamID(), pq.Array(names)); err != nil {
+		return fmt.Errorf("failed to save sso domains: %w", err)
+	}
+
+	for _, d := range c.Domains() {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO team_sso_domains (team_id, domain, verification_token, verified_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (team_id, domain) DO UPDATE SET
+				verification_token = EXCLUDED.verification_token,
+				verified_at = EXCLUDED.verified_at
+		`, c.TeamID(), d.Domain, d.VerificationToken, d.VerifiedAt)
+		if err != nil {
+			// The partial unique index allows one verified claim per domain
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+				return team.ErrSSODomainTaken
+			}
+			return fmt.Errorf("failed to save sso domain: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit sso config: %w", err)
+	}
+	return nil
+}
+
+func (r *SSORepository) Delete(ctx context.Context, teamID uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM team_sso_configs WHERE team_id = $1`, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to delete sso config: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete sso config: %w", err)
+	}
+	if rows == 0 {
+		return team.ErrSSONotConfigured
+	}
+	return nil
+}
+
+// scannedSSOConfig is a configuration row waiting for its domains
+type scannedSSOConfig struct {
+	teamID               uuid.UUID
+	entityID, ssoURL     string
+	certificates         []string
+	mapping              team.SSOAttributeMapping
+	defaultRole          string
+	enforceSSO, enabled  bool
+	createdAt, updatedAt time.Time
+}
+
+func (c *scannedSSOConfig) reconstruct(domains []team.SSODomain) *team.SSOConfig {
+	return team.ReconstructSSOConfig(
+		c.teamID,
+		c.entityID, c.ssoURL, c.certificates,
+		c.mapping,
+		team.MemberRole(c.defaultRole),
+		domains,
+		c.enforceSSO, c.enabled,
+		c.createdAt, c.updatedAt,
+	)
+}
+
+func scanSSOConfig(row rowScanner) (*scannedSSOConfig, error) {
+	var (
+		teamID               uuid.UUID
+		entityID, ssoURL     string
+		certificates         []string
+		mapping              team.SSOAttributeMapping
+		roleMapping          []byte
+		defaultRole          string
+		enforceSSO, enabled  bool
+		createdAt, updatedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&teamID, &entityID, &ssoURL, pq.Array(&certificates),
+		&mapping.Email, &mapping.FirstName, &mapping.LastName, &mapping.Role, &roleMapping,
+		&defaultRole, &enforceSSO, &enabled, &createdAt, &updatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrSSONotConfigured
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan sso config: %w", err)
+	}
+	if len(roleMapping) > 0 {
+		if err := json.Unmarshal(roleMapping, &mapping.RoleMapping); err != nil {
+			return nil, fmt.Errorf("failed to decode role mapping: %w", err)
+		}
+	}
+
+	return &scannedSSOConfig{
+		teamID:       teamID,
+		entityID:     entityID,
+		ssoURL:       ssoURL,
+		certificates: certificates,
+		mapping:      mapping,
+		defaultRole:  defaultRole,
+		enforceSSO:   enforceSSO,
+		enabled:      enabled,
+		createdAt:    createdAt.Time,
+		updatedAt:    updatedAt.Time,
+	}, nil
+}
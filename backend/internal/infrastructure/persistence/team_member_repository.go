@@ -29,19 +29,18 @@ func NewTeamMemberRepository(database *sql.DB) team.MemberRepository {
 }
 
 func (r *TeamMemberRepository) AddMember(ctx context.Context, member *team.Member) error {
-	// Get role ID by name
-	dbRole, err := r.queries.GetRoleByName(ctx, string(member.Role()))
+	roleID, err := r.roleID(ctx, member.TeamID(), member.Role())
 	if err != nil {
-		return fmt.Errorf("failed to get role: %w", err)
+		return err
 	}
 
 	params := db.AddTeamMemberParams{
 		TeamID: member.TeamID(),
 		UserID: member.UserID(),
-		RoleID: dbRole.ID,
+		RoleID: roleID,
 	}
 
-	_, err = r.queries.AddTeamMember(ctx, params)
+	_, err = queriesFor(ctx, r.queries).AddTeamMember(ctx, params)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -56,15 +55,14 @@ func (r *TeamMemberRepository) AddMember(ctx context.Context, member *team.Membe
 }
 
 func (r *TeamMemberRepository) UpdateMember(ctx context.Context, member *team.Member) error {
-	// Get role ID by name
-	dbRole, err := r.queries.GetRoleByName(ctx, string(member.Role()))
+	roleID, err := r.roleID(ctx, member.TeamID(), member.Role())
 	if err != nil {
-		return fmt.Errorf("failed to get role: %w", err)
+		return err
 	}
 
 	params := db.UpdateTeamMemberRoleParams{
 		TeamID: member.TeamID(),
-		RoleID: dbRole.ID,
+		RoleID: roleID,
 		UserID: member.UserID(),
 	}
 
@@ -73,9 +71,37 @@ func (r *TeamMemberRepository) UpdateMember(ctx context.Context, member *team.Me
 		return fmt.Errorf("failed to update member: %w", err)
 	}
 
+	// Deactivated members (SCIM deprovisioning) keep their membership row
+	_, err = r.database.ExecContext(ctx, `
+		UPDATE team_memberships SET is_active = $3
+		WHERE team_id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`, member.TeamID(), member.UserID(), member.Status() != team.MemberStatusInactive)
+	if err != nil {
+		return fmt.Errorf("failed to update member status: %w", err)
+	}
+
 	return nil
 }
 
+// roleID resolves a role name to a built-in role or one of the team's
+// custom roles
+func (r *TeamMemberRepository) roleID(ctx context.Context, teamID uuid.UUID, role team.MemberRole) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.database.QueryRowContext(ctx, `
+		SELECT id FROM roles
+		WHERE name = $2 AND (team_id IS NULL OR team_id = $1)
+		ORDER BY team_id NULLS FIRST
+		LIMIT 1
+	`, teamID, string(role)).Scan(&id)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, team.ErrRoleNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	return id, nil
+}
+
 func (r *TeamMemberRepository) RemoveMember(ctx context.Context, teamID, userID uuid.UUID) error {
 	params := db.RemoveTeamMemberParams{
 		TeamID: teamID,
@@ -118,6 +144,19 @@ func (r *TeamMemberRepository) FindMember(ctx context.Context, teamID, userID uu
 		joinedAt = &t
 	}
 
+	var isActive sql.NullBool
+	err = r.database.QueryRowContext(ctx, `
+		SELECT is_active FROM team_memberships
+		WHERE team_id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`, teamID, userID).Scan(&isActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member status: %w", err)
+	}
+	status := team.MemberStatusActive
+	if isActive.Valid && !isActive.Bool {
+		status = team.MemberStatusInactive
+	}
+
 	// Reconstruct domain member
 	role := team.MemberRole(dbMember.RoleName)
 	member := team.ReconstructMember(
@@ -125,11 +164,11 @@ func (r *TeamMemberRepository) FindMember(ctx context.Context, teamID, userID uu
 		dbMember.TeamID,
 		dbMember.UserID,
 		role,
-		team.MemberStatusActive, // Assuming active if found
-		uuid.Nil,                // invitedBy - not in current schema
-		createdAt,               // FIXED: Now using time.Time
-		joinedAt,                // FIXED: Now using *time.Time
-		nil,                     // leftAt
+		status,
+		uuid.Nil,  // invitedBy - not in current schema
+		createdAt, // FIXED: Now using time.Time
+		joinedAt,  // FIXED: Now using *time.Time
+		nil,       // leftAt
 	)
 
 	return member, nil
@@ -146,6 +185,11 @@ func (r *TeamMemberRepository) FindTeamMembers(ctx context.Context, teamID uuid.
 		return nil, fmt.Errorf("failed to find team members: %w", err)
 	}
 
+	inactive, err := r.inactiveMembers(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
 	members := make([]*team.Member, 0, len(dbMembers))
 	for _, dbMember := range dbMembers {
 		// FIXED: Convert sql.NullTime to time.Time and *time.Time
@@ -162,13 +206,18 @@ func (r *TeamMemberRepository) FindTeamMembers(ctx context.Context, teamID uuid.
 			joinedAt = &t
 		}
 
+		status := team.MemberStatusActive
+		if inactive[dbMember.UserID] {
+			status = team.MemberStatusInactive
+		}
+
 		role := team.MemberRole(dbMember.RoleName)
 		member := team.ReconstructMember(
 			dbMember.ID,
 			dbMember.TeamID,
 			dbMember.UserID,
 			role,
-			team.MemberStatusActive,
+			status,
 			uuid.Nil,
 			createdAt, // FIXED: Now using time.Time
 			joinedAt,  // FIXED: Now using *time.Time
@@ -180,6 +229,29 @@ func (r *TeamMemberRepository) FindTeamMembers(ctx context.Context, teamID uuid.
 	return members, nil
 }
 
+// inactiveMembers returns the team's deactivated members, which the
+// generated queries do not expose
+func (r *TeamMemberRepository) inactiveMembers(ctx context.Context, teamID uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := r.database.QueryContext(ctx, `
+		SELECT user_id FROM team_memberships
+		WHERE team_id = $1 AND is_active = FALSE AND deleted_at IS NULL
+	`, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inactive members: %w", err)
+	}
+	defer rows.Close()
+
+	inactive := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan inactive member: %w", err)
+		}
+		inactive[userID] = true
+	}
+	return inactive, rows.Err()
+}
+
 func (r *TeamMemberRepository) FindUserMemberships(ctx context.Context, userID uuid.UUID) ([]*team.Member, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -204,17 +276,30 @@ func (r *TeamMemberRepository) CountActiveMembers(ctx context.Context, teamID uu
 	return r.CountMembers(ctx, teamID)
 }
 
+// CountByRole counts active members holding the role
 func (r *TeamMemberRepository) CountByRole(ctx context.Context, teamID uuid.UUID, role team.MemberRole) (int, error) {
-	return 0, fmt.Errorf("not implemented")
+	var count int
+	err := r.database.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM team_memberships m
+		JOIN roles ro ON ro.id = m.role_id
+		WHERE m.team_id = $1 AND ro.name = $2 AND m.deleted_at IS NULL AND m.is_active IS NOT FALSE
+	`, teamID, string(role)).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count members by role: %w", err)
+	}
+	return count, nil
 }
 
+// IsMember reports whether the user is an active member; deactivated
+// members keep their row but lose access
 func (r *TeamMemberRepository) IsMember(ctx context.Context, teamID, userID uuid.UUID) (bool, error) {
-	params := db.ExistsTeamMemberParams{
-		TeamID: teamID,
-		UserID: userID,
-	}
-
-	exists, err := r.queries.ExistsTeamMember(ctx, params)
+	var exists bool
+	err := r.database.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM team_memberships
+			WHERE team_id = $1 AND user_id = $2 AND deleted_at IS NULL AND is_active IS NOT FALSE
+		)
+	`, teamID, userID).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -99,7 +99,7 @@ func (r *TeamRepository) Update(ctx context.Context, t *team.Team) error {
 		params.AvatarUrl = sql.NullString{String: t.AvatarURL(), Valid: true}
 	}
 
-	_, err = r.queries.UpdateTeam(ctx, params)
+	_, err = queriesFor(ctx, r.queries).UpdateTeam(ctx, params)
 	return err
 }
 
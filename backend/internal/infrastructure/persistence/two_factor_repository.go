@@ -0,0 +1,188 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/two_factor_repository.go
+// PURPOSE: TOTP enrollments (encrypted secrets) and hashed recovery codes
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/user"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+type TwoFactorRepository struct {
+	db         *sql.DB
+	encryption *services.EncryptionService
+}
+
+func NewTwoFactorRepository(database *sql.DB, encryption *services.EncryptionService) user.TwoFactorRepository {
+	return &TwoFactorRepository{
+		db:         database,
+		encryption: encryption,
+	}
+}
+
+func (r *TwoFactorRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*user.TwoFactor, error) {
+	query := `
+		SELECT secret_encrypted, enabled_at, last_used_step, created_at, updated_at
+		FROM user_two_factor
+		WHERE user_id = $1
+	`
+
+	var (
+		encrypted            string
+		enabledAt            sql.NullTime
+		lastUsedStep         int64
+		createdAt, updatedAt sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&encrypted, &enabledAt, &lastUsedStep, &createdAt, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, user.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor enrollment: %w", err)
+	}
+
+	secret, err := r.encryption.Decrypt(encrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt two-factor secret: %w", err)
+	}
+
+	return user.ReconstructTwoFactor(
+		userID,
+		secret,
+		nullTimePtr(enabledAt),
+		lastUsedStep,
+		createdAt.Time,
+		updatedAt.Time,
+	), nil
+}
+
+func (r *TwoFactorRepository) Save(ctx context.Context, f *user.TwoFactor) error {
+	encrypted, err := r.encryption.Encrypt(f.Secret())
+	if err != nil {
+		return fmt.Errorf("failed to encrypt two-factor secret: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO user_two_factor (user_id, secret_encrypted, enabled_at, last_used_step, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret_encrypted = EXCLUDED.secret_encrypted,
+			enabled_at = EXCLUDED.enabled_at,
+			last_used_step = EXCLUDED.last_used_step
+	`, f.UserID(), encrypted, f.EnabledAt(), f.LastUsedStep(), f.CreatedAt(), f.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to save two-factor enrollment: %w", err)
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) ConsumeStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	// Conditional update so a code can only be used once, even concurrently
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE user_two_factor
+		SET last_used_step = $2, updated_at = NOW()
+		WHERE user_id = $1 AND last_used_step < $2
+	`, userID, step)
+	if err != nil {
+		return fmt.Errorf("failed to record two-factor code use: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to record two-factor code use: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_two_factor WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete two-factor enrollment: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit two-factor removal: %w", err)
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO user_recovery_codes (user_id, code_hash) VALUES ($1, $2)
+		`, userID, hash); err != nil {
+			return fmt.Errorf("failed to store recovery code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit recovery codes: %w", err)
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	// Conditional update so a code can only be spent once, even concurrently
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE user_recovery_codes
+		SET used_at = NOW()
+		WHERE id = (
+			SELECT id FROM user_recovery_codes
+			WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+	`, userID, codeHash)
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if rows == 0 {
+		return user.ErrInvalidRecoveryCode
+	}
+	return nil
+}
+
+func (r *TwoFactorRepository) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return count, nil
+}
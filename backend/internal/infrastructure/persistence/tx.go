@@ -0,0 +1,64 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/tx.go
+// PURPOSE: Transactions carried by the context
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/techappsUT/social-queue/internal/db"
+)
+
+type txKey struct{}
+
+// Transactor runs work in a transaction that repositories pick up from the
+// context
+type Transactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(database *sql.DB) *Transactor {
+	return &Transactor{db: database}
+}
+
+// WithinTx commits when fn succeeds and rolls back otherwise. Called inside
+// another transaction, it joins it.
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// conn returns the transaction carried by ctx, or the database
+func conn(ctx context.Context, database *sql.DB) db.DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return database
+}
+
+// queriesFor binds sqlc queries to the transaction carried by ctx, if any
+func queriesFor(ctx context.Context, q *db.Queries) *db.Queries {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return q.WithTx(tx)
+	}
+	return q
+}
@@ -38,7 +38,7 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 	params := db.CreateUserParams{
 		Email:         u.Email(),
 		EmailVerified: sql.NullBool{Bool: u.IsEmailVerified(), Valid: true},
-		PasswordHash:  sql.NullString{String: u.PasswordHash(), Valid: true},
+		PasswordHash:  sql.NullString{String: u.PasswordHash(), Valid: u.HasPassword()},
 		Username:      u.Username(),
 		FirstName:     u.FirstName(),
 		LastName:      u.LastName(),
@@ -228,10 +228,12 @@ func (r *UserRepository) UpdateLastLogin(ctx context.Context, id uuid.UUID, last
 	return nil
 }
 
+// UpdatePassword stores a new password hash; an empty hash removes the
+// password from an externally authenticated user
 func (r *UserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
 	err := r.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
 		ID:           id,
-		PasswordHash: sql.NullString{String: passwordHash, Valid: true},
+		PasswordHash: sql.NullString{String: passwordHash, Valid: passwordHash != ""},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update password: %w", err)
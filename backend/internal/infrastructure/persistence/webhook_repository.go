@@ -0,0 +1,349 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/persistence/webhook_repository.go
+// PURPOSE: Outgoing webhook endpoints and their deliveries
+// ============================================================================
+
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+	"github.com/techappsUT/social-queue/internal/infrastructure/services"
+)
+
+const webhookColumns = `id, team_id, url, description, secret_encrypted, event_types, active, failure_count,
+	failing_since, disabled_at, disabled_reason, created_by, created_at, updated_at`
+
+// webhookSelectColumns adds the plaintext secret of endpoints created
+// before secrets were encrypted
+const webhookSelectColumns = webhookColumns + `, secret`
+
+// WebhookRepository stores endpoints with their signing secret encrypted
+type WebhookRepository struct {
+	db         *sql.DB
+	encryption *services.EncryptionService
+}
+
+func NewWebhookRepository(database *sql.DB, encryption *services.EncryptionService) team.WebhookRepository {
+	return &WebhookRepository{db: database, encryption: encryption}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, w *team.Webhook) error {
+	eventTypes, err := json.Marshal(w.EventTypes())
+	if err != nil {
+		return fmt.Errorf("failed to encode event types: %w", err)
+	}
+	secret, err := r.encryption.Encrypt(w.Secret())
+	if err != nil {
+		return fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO webhook_endpoints (`+webhookColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`, w.ID(), w.TeamID(), w.URL(), w.Description(), secret, eventTypes, w.IsActive(), w.FailureCount(),
+		w.FailingSince(), w.DisabledAt(), nullString(w.DisabledReason()), nullUUID(w.CreatedBy()), w.CreatedAt(), w.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepository) FindByID(ctx context.Context, id uuid.UUID) (*team.Webhook, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+webhookSelectColumns+` FROM webhook_endpoints WHERE id = $1`, id)
+	return r.scan(row)
+}
+
+func (r *WebhookRepository) ListByTeam(ctx context.Context, teamID uuid.UUID) ([]*team.Webhook, error) {
+	return r.list(ctx, `
+		SELECT `+webhookSelectColumns+` FROM webhook_endpoints
+		WHERE team_id = $1
+		ORDER BY created_at
+	`, teamID)
+}
+
+func (r *WebhookRepository) ListSubscribed(ctx context.Context, teamID uuid.UUID, eventType string) ([]*team.Webhook, error) {
+	return r.list(ctx, `
+		SELECT `+webhookSelectColumns+` FROM webhook_endpoints
+		WHERE team_id = $1 AND active AND event_types ? $2
+		ORDER BY created_at
+	`, teamID, eventType)
+}
+
+func (r *WebhookRepository) list(ctx context.Context, query string, args ...interface{}) ([]*team.Webhook, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []*team.Webhook
+	for rows.Next() {
+		w, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+	return webhooks, rows.Err()
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, w *team.Webhook) error {
+	eventTypes, err := json.Marshal(w.EventTypes())
+	if err != nil {
+		return fmt.Errorf("failed to encode event types: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE webhook_endpoints
+		SET url = $2, description = $3, event_types = $4, active = $5, failure_count = $6,
+			failing_since = $7, disabled_at = $8, disabled_reason = $9, updated_at = $10
+		WHERE id = $1
+	`, w.ID(), w.URL(), w.Description(), eventTypes, w.IsActive(), w.FailureCount(),
+		w.FailingSince(), w.DisabledAt(), nullString(w.DisabledReason()), w.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return expectOneRow(result, team.ErrWebhookNotFound)
+}
+
+func (r *WebhookRepository) UpdateHealth(ctx context.Context, w *team.Webhook) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE webhook_endpoints
+		SET active = $2, failure_count = $3, failing_since = $4, disabled_at = $5, disabled_reason = $6
+		WHERE id = $1
+	`, w.ID(), w.IsActive(), w.FailureCount(), w.FailingSince(), w.DisabledAt(), nullString(w.DisabledReason()))
+	if err != nil {
+		return fmt.Errorf("failed to update webhook health: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_endpoints WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return expectOneRow(result, team.ErrWebhookNotFound)
+}
+
+// EncryptLegacySecrets encrypts the plaintext secrets of endpoints created
+// before secrets were encrypted and clears them
+func (r *WebhookRepository) EncryptLegacySecrets(ctx context.Context) (int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, secret FROM webhook_endpoints
+		WHERE secret_encrypted IS NULL AND secret IS NOT NULL
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list plaintext webhook secrets: %w", err)
+	}
+	legacy := map[uuid.UUID]string{}
+	for rows.Next() {
+		var (
+			id     uuid.UUID
+			secret string
+		)
+		if err := rows.Scan(&id, &secret); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan webhook secret: %w", err)
+		}
+		legacy[id] = secret
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to list plaintext webhook secrets: %w", err)
+	}
+
+	for id, secret := range legacy {
+		encrypted, err := r.encryption.Encrypt(secret)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+		}
+		if _, err := r.db.ExecContext(ctx, `
+			UPDATE webhook_endpoints SET secret_encrypted = $2, secret = NULL
+			WHERE id = $1 AND secret_encrypted IS NULL
+		`, id, encrypted); err != nil {
+			return 0, fmt.Errorf("failed to store encrypted webhook secret: %w", err)
+		}
+	}
+	return len(legacy), nil
+}
+
+func expectOneRow(result sql.Result, notFound error) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if rows == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func (r *WebhookRepository) scan(row rowScanner) (*team.Webhook, error) {
+	var (
+		id, teamID               uuid.UUID
+		url, description         string
+		encrypted, legacy        sql.NullString
+		rawEventTypes            []byte
+		active                   bool
+		failureCount             int
+		failingSince, disabledAt sql.NullTime
+		disabledReason           sql.NullString
+		createdBy                uuid.NullUUID
+		createdAt, updatedAt     time.Time
+	)
+
+	err := row.Scan(&id, &teamID, &url, &description, &encrypted, &rawEventTypes, &active, &failureCount,
+		&failingSince, &disabledAt, &disabledReason, &createdBy, &createdAt, &updatedAt, &legacy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, team.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan webhook: %w", err)
+	}
+
+	var eventTypes []string
+	if err := json.Unmarshal(rawEventTypes, &eventTypes); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event types: %w", err)
+	}
+
+	secret := legacy.String
+	if encrypted.Valid {
+		if secret, err = r.encryption.Decrypt(encrypted.String); err != nil {
+			return nil, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+		}
+	}
+
+	return team.ReconstructWebhook(
+		id, teamID, url, description, secret, eventTypes, active, failureCount,
+		nullTimePtr(failingSince), nullTimePtr(disabledAt), disabledReason.String,
+		createdBy.UUID, createdAt, updatedAt,
+	), nil
+}
+
+// ============================================================================
+// DELIVERIES
+// ============================================================================
+
+const webhookDeliveryColumns = `id, webhook_id, team_id, event_id, event_type, payload, status, attempts,
+	next_attempt_at, response_status, response_body, error, duration_ms, created_at, completed_at`
+
+type WebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryRepository(database *sql.DB) team.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: database}
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, d *team.WebhookDelivery) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (`+webhookDeliveryColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
+	`, deliveryArgs(d)...)
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return rows == 1, nil
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, d *team.WebhookDelivery) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, response_status = $5,
+			response_body = $6, error = $7, duration_ms = $8, completed_at = $9
+		WHERE id = $1
+	`, d.ID(), d.Status(), d.Attempts(), d.NextAttemptAt(), nullInt(d.ResponseStatus()),
+		nullString(d.ResponseBody()), nullString(d.LastError()), nullInt(int(d.Duration().Milliseconds())), d.CompletedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookDeliveryRepository) ListByWebhook(ctx context.Context, webhookID uuid.UUID, limit int) ([]*team.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return scanDeliveries(rows)
+}
+
+// ClaimDue pushes the next attempt of each claimed delivery out by the
+// lease, like the event outbox, so concurrent workers skip them
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*team.WebhookDelivery, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+webhookDeliveryColumns, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return scanDeliveries(rows)
+}
+
+func deliveryArgs(d *team.WebhookDelivery) []interface{} {
+	return []interface{}{
+		d.ID(), d.WebhookID(), d.TeamID(), d.EventID(), d.EventType(), d.Payload(), d.Status(), d.Attempts(),
+		d.NextAttemptAt(), nullInt(d.ResponseStatus()), nullString(d.ResponseBody()), nullString(d.LastError()),
+		nullInt(int(d.Duration().Milliseconds())), d.CreatedAt(), d.CompletedAt(),
+	}
+}
+
+func scanDeliveries(rows *sql.Rows) ([]*team.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*team.WebhookDelivery
+	for rows.Next() {
+		var (
+			id, webhookID, teamID, eventID uuid.UUID
+			eventType, status              string
+			payload                        []byte
+			attempts                       int
+			nextAttemptAt, completedAt     sql.NullTime
+			responseStatus, durationMs     sql.NullInt64
+			responseBody, lastError        sql.NullString
+			createdAt                      time.Time
+		)
+		if err := rows.Scan(&id, &webhookID, &teamID, &eventID, &eventType, &payload, &status, &attempts,
+			&nextAttemptAt, &responseStatus, &responseBody, &lastError, &durationMs, &createdAt, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, team.ReconstructWebhookDelivery(
+			id, webhookID, teamID, eventID, eventType, payload, status, attempts,
+			nullTimePtr(nextAttemptAt), int(responseStatus.Int64), responseBody.String, lastError.String,
+			time.Duration(durationMs.Int64)*time.Millisecond, createdAt, nullTimePtr(completedAt),
+		))
+	}
+	return deliveries, rows.Err()
+}
+
+// nullInt stores zero as NULL
+func nullInt(v int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(v), Valid: v != 0}
+}
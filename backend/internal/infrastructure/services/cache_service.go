@@ -4,6 +4,7 @@ package services
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -91,6 +92,25 @@ func (c *InMemoryCacheService) Exists(ctx context.Context, key string) (bool, er
 	return true, nil
 }
 
+// Increment atomically adds one to a counter and resets its TTL
+func (c *InMemoryCacheService) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var count int64
+	if item, exists := c.data[key]; exists && time.Now().Before(item.expiresAt) {
+		count, _ = strconv.ParseInt(item.value, 10, 64)
+	}
+	count++
+
+	c.data[key] = cacheItem{
+		value:     strconv.FormatInt(count, 10),
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	return count, nil
+}
+
 // cleanupExpired periodically removes expired items
 func (c *InMemoryCacheService) cleanupExpired() {
 	ticker := time.NewTicker(1 * time.Minute)
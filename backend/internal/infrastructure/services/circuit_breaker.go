@@ -0,0 +1,215 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/circuit_breaker.go
+// PURPOSE: Redis circuit breakers for social platform outages, shared by the
+//          API and worker replicas
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	CircuitKeyPrefix      = "circuit:state:"
+	CircuitProbeKeyPrefix = "circuit:probe:"
+
+	DefaultCircuitFailureThreshold = 5
+	DefaultCircuitCooldown         = 30 * time.Second
+	DefaultCircuitMaxCooldown      = 10 * time.Minute
+
+	// How long a half-open probe may run before another one is let through
+	circuitProbeTimeout = time.Minute
+)
+
+// Let the call through while closed. Once the cooldown of an open circuit
+// has passed, let exactly one probe through at a time (half-open).
+// Returns {allowed, retry_after_ms}.
+var circuitAllowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local probe_ttl = tonumber(ARGV[2])
+
+local c = redis.call("HMGET", KEYS[1], "state", "open_until")
+local state = c[1]
+if not state or state == "closed" then
+	return {1, 0}
+end
+
+local open_until = tonumber(c[2]) or 0
+if now < open_until then
+	return {0, open_until - now}
+end
+
+if redis.call("SET", KEYS[2], now, "NX", "PX", probe_ttl) then
+	redis.call("HSET", KEYS[1], "state", "half_open")
+	return {1, 0}
+end
+return {0, math.max(redis.call("PTTL", KEYS[2]), 0)}
+`)
+
+// A success closes the circuit. Consecutive failures past the threshold, or
+// a failed probe, open it with an exponentially growing cooldown.
+// Returns the resulting state.
+var circuitRecordScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local failed = ARGV[2] == "1"
+local threshold = tonumber(ARGV[3])
+local cooldown = tonumber(ARGV[4])
+local max_cooldown = tonumber(ARGV[5])
+
+if not failed then
+	redis.call("DEL", KEYS[1], KEYS[2])
+	return "closed"
+end
+
+local state = redis.call("HGET", KEYS[1], "state") or "closed"
+local failures = redis.call("HINCRBY", KEYS[1], "failures", 1)
+redis.call("HSET", KEYS[1], "platform", ARGV[6], "endpoint", ARGV[7])
+
+if state == "half_open" or (state == "closed" and failures >= threshold) then
+	local trips = redis.call("HINCRBY", KEYS[1], "trips", 1)
+	local wait = math.floor(math.min(cooldown * 2 ^ (trips - 1), max_cooldown))
+	redis.call("HSET", KEYS[1], "state", "open", "open_until", now + wait)
+	redis.call("DEL", KEYS[2])
+	state = "open"
+elseif state == "closed" then
+	redis.call("HSET", KEYS[1], "state", "closed")
+end
+
+redis.call("PEXPIRE", KEYS[1], max_cooldown * 6)
+return state
+`)
+
+// RedisCircuitBreaker implements common.CircuitBreaker with one circuit per
+// (platform, endpoint class)
+type RedisCircuitBreaker struct {
+	client      *redis.Client
+	threshold   int
+	cooldown    time.Duration
+	maxCooldown time.Duration
+	logger      common.Logger
+}
+
+// NewRedisCircuitBreaker creates a breaker that opens after threshold
+// consecutive failures and first probes again after cooldown
+func NewRedisCircuitBreaker(client *redis.Client, threshold int, cooldown time.Duration, logger common.Logger) *RedisCircuitBreaker {
+	return &RedisCircuitBreaker{
+		client:      client,
+		threshold:   threshold,
+		cooldown:    cooldown,
+		maxCooldown: max(cooldown, DefaultCircuitMaxCooldown),
+		logger:      logger,
+	}
+}
+
+// Allow reports whether a call to the platform endpoint may be made
+func (b *RedisCircuitBreaker) Allow(ctx context.Context, platform, endpoint string) (bool, time.Duration, error) {
+	res, err := circuitAllowScript.Run(ctx, b.client,
+		[]string{circuitKey(platform, endpoint), circuitProbeKey(platform, endpoint)},
+		time.Now().UnixMilli(), circuitProbeTimeout.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return true, 0, fmt.Errorf("failed to check circuit: %w", err)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// Record reports the outcome of a call
+func (b *RedisCircuitBreaker) Record(ctx context.Context, platform, endpoint string, failed bool) error {
+	flag := "0"
+	if failed {
+		flag = "1"
+	}
+
+	state, err := circuitRecordScript.Run(ctx, b.client,
+		[]string{circuitKey(platform, endpoint), circuitProbeKey(platform, endpoint)},
+		time.Now().UnixMilli(), flag, b.threshold,
+		b.cooldown.Milliseconds(), b.maxCooldown.Milliseconds(),
+		platform, endpoint,
+	).Text()
+	if err != nil {
+		return fmt.Errorf("failed to record circuit outcome: %w", err)
+	}
+
+	if failed && state == common.CircuitOpen {
+		b.logger.Warn(fmt.Sprintf("Circuit opened for %s %s", platform, endpoint))
+	}
+	return nil
+}
+
+// States returns every circuit that is open or half-open
+func (b *RedisCircuitBreaker) States(ctx context.Context) ([]common.CircuitState, error) {
+	var states []common.CircuitState
+	now := time.Now()
+
+	iter := b.client.Scan(ctx, 0, CircuitKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		fields, err := b.client.HGetAll(ctx, iter.Val()).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read circuit: %w", err)
+		}
+		if state, ok := circuitState(fields, now); ok {
+			states = append(states, state)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list circuits: %w", err)
+	}
+
+	return states, nil
+}
+
+// circuitState converts a stored circuit; closed circuits are skipped
+func circuitState(fields map[string]string, now time.Time) (common.CircuitState, bool) {
+	state := fields["state"]
+	if state == "" || state == common.CircuitClosed {
+		return common.CircuitState{}, false
+	}
+
+	failures, _ := strconv.Atoi(fields["failures"])
+	cs := common.CircuitState{
+		Platform: fields["platform"],
+		Endpoint: fields["endpoint"],
+		State:    state,
+		Failures: failures,
+	}
+
+	if openUntil, err := strconv.ParseInt(fields["open_until"], 10, 64); err == nil && state == common.CircuitOpen {
+		retryAt := time.UnixMilli(openUntil).UTC()
+		if retryAt.Before(now) {
+			// Cooldown over; the next call will probe
+			cs.State = common.CircuitHalfOpen
+		} else {
+			cs.RetryAt = &retryAt
+		}
+	}
+
+	return cs, true
+}
+
+// OpenCircuits indexes circuits that currently reject calls by
+// "platform:endpoint"
+func OpenCircuits(states []common.CircuitState) map[string]time.Time {
+	open := make(map[string]time.Time)
+	for _, s := range states {
+		if s.State == common.CircuitOpen && s.RetryAt != nil {
+			open[s.Platform+":"+s.Endpoint] = *s.RetryAt
+		}
+	}
+	return open
+}
+
+func circuitKey(platform, endpoint string) string {
+	return CircuitKeyPrefix + platform + ":" + endpoint
+}
+
+func circuitProbeKey(platform, endpoint string) string {
+	return CircuitProbeKeyPrefix + platform + ":" + endpoint
+}
@@ -0,0 +1,53 @@
+// path: backend/internal/infrastructure/services/circuit_breaker_test.go
+package services
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+func TestCircuitState(t *testing.T) {
+	now := time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC)
+	ms := func(t time.Time) string { return strconv.FormatInt(t.UnixMilli(), 10) }
+
+	tests := []struct {
+		name      string
+		fields    map[string]string
+		wantOK    bool
+		wantState string
+		wantRetry bool
+	}{
+		{"missing", map[string]string{}, false, "", false},
+		{"closed with failures", map[string]string{"state": "closed", "failures": "3"}, false, "", false},
+		{"open", map[string]string{"state": "open", "failures": "5", "open_until": ms(now.Add(time.Minute))}, true, common.CircuitOpen, true},
+		{"open past cooldown", map[string]string{"state": "open", "failures": "5", "open_until": ms(now.Add(-time.Second))}, true, common.CircuitHalfOpen, false},
+		{"probing", map[string]string{"state": "half_open", "failures": "5", "open_until": ms(now.Add(-time.Second))}, true, common.CircuitHalfOpen, false},
+	}
+
+	for _, tt := range tests {
+		tt.fields["platform"], tt.fields["endpoint"] = "twitter", "publish"
+
+		got, ok := circuitState(tt.fields, now)
+		if ok != tt.wantOK {
+			t.Errorf("%s: ok = %v, want %v", tt.name, ok, tt.wantOK)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got.State != tt.wantState || (got.RetryAt != nil) != tt.wantRetry {
+			t.Errorf("%s: got %+v", tt.name, got)
+		}
+	}
+
+	open := OpenCircuits([]common.CircuitState{
+		{Platform: "twitter", Endpoint: "publish", State: common.CircuitOpen, RetryAt: &now},
+		{Platform: "facebook", Endpoint: "publish", State: common.CircuitHalfOpen},
+	})
+	if len(open) != 1 || !open["twitter:publish"].Equal(now) {
+		t.Errorf("OpenCircuits = %v", open)
+	}
+}
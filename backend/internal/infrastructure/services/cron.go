@@ -0,0 +1,243 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/cron.go
+// PURPOSE: Cron expression parser used by the worker scheduler
+// ============================================================================
+
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule computes the next activation time of a job
+type CronSchedule interface {
+	Next(after time.Time) time.Time
+	String() string
+}
+
+// cronBounds holds the allowed range of a single cron field
+type cronBounds struct {
+	min, max int
+}
+
+var (
+	secondBounds = cronBounds{0, 59}
+	minuteBounds = cronBounds{0, 59}
+	hourBounds   = cronBounds{0, 23}
+	domBounds    = cronBounds{1, 31}
+	monthBounds  = cronBounds{1, 12}
+	dowBounds    = cronBounds{0, 7}
+)
+
+// cronDescriptors maps shorthand descriptors to standard expressions
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// SpecSchedule is a parsed cron expression (seconds precision)
+type SpecSchedule struct {
+	expr                                  string
+	second, minute, hour, dom, month, dow uint64
+	domStar, dowStar                      bool
+	location                              *time.Location
+}
+
+// EverySchedule runs at a fixed interval
+type EverySchedule struct {
+	Interval time.Duration
+}
+
+// ParseCronSchedule parses a cron expression.
+//
+// Supported forms:
+//   - 5 fields: "minute hour day-of-month month day-of-week"
+//   - 6 fields: "second minute hour day-of-month month day-of-week"
+//   - descriptors: @yearly, @monthly, @weekly, @daily, @hourly, @every <duration>
+//
+// Fields accept "*", "?", single values, ranges (a-b), steps (*/n, a-b/n) and
+// comma-separated lists. Times are evaluated in UTC.
+func ParseCronSchedule(expr string) (CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("empty cron expression")
+	}
+
+	if strings.HasPrefix(expr, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expr, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every duration: %w", err)
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("@every interval must be at least 1s")
+		}
+		return &EverySchedule{Interval: interval}, nil
+	}
+
+	spec := expr
+	if descriptor, ok := cronDescriptors[expr]; ok {
+		spec = descriptor
+	} else if strings.HasPrefix(expr, "@") {
+		return nil, fmt.Errorf("unknown cron descriptor: %s", expr)
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron expression must have 5 or 6 fields, got %d", len(fields))
+	}
+
+	s := &SpecSchedule{expr: expr, location: time.UTC}
+	var err error
+	if s.second, err = parseCronField(fields[0], secondBounds); err != nil {
+		return nil, fmt.Errorf("invalid seconds field: %w", err)
+	}
+	if s.minute, err = parseCronField(fields[1], minuteBounds); err != nil {
+		return nil, fmt.Errorf("invalid minutes field: %w", err)
+	}
+	if s.hour, err = parseCronField(fields[2], hourBounds); err != nil {
+		return nil, fmt.Errorf("invalid hours field: %w", err)
+	}
+	if s.dom, err = parseCronField(fields[3], domBounds); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %w", err)
+	}
+	if s.month, err = parseCronField(fields[4], monthBounds); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if s.dow, err = parseCronField(fields[5], dowBounds); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %w", err)
+	}
+	// Accept 7 as Sunday for compatibility with common cron dialects
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = fields[3] == "*" || fields[3] == "?"
+	s.dowStar = fields[5] == "*" || fields[5] == "?"
+
+	return s, nil
+}
+
+// parseCronField parses a single field into a bitset of allowed values
+func parseCronField(field string, bounds cronBounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return 0, fmt.Errorf("empty list element")
+		}
+
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangePart = part[:idx]
+			n, err := strconv.Atoi(part[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part[idx+1:])
+			}
+			step = n
+		}
+
+		start, end := bounds.min, bounds.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			ends := strings.SplitN(rangePart, "-", 2)
+			lo, err := strconv.Atoi(ends[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range start %q", ends[0])
+			}
+			hi, err := strconv.Atoi(ends[1])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range end %q", ends[1])
+			}
+			start, end = lo, hi
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", rangePart)
+			}
+			start = v
+			if step == 1 {
+				end = v
+			}
+		}
+
+		if start < bounds.min || end > bounds.max || start > end {
+			return 0, fmt.Errorf("value out of range [%d-%d]: %q", bounds.min, bounds.max, part)
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first activation time strictly after the given time
+func (s *SpecSchedule) Next(after time.Time) time.Time {
+	t := after.In(s.location).Truncate(time.Second).Add(time.Second)
+
+	// Search at most five years ahead; impossible expressions (e.g. Feb 30) yield zero
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if s.second&(1<<uint(t.Second())) == 0 {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches applies standard cron semantics: when both day-of-month and
+// day-of-week are restricted, either one matching is enough
+func (s *SpecSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// String returns the original expression
+func (s *SpecSchedule) String() string {
+	return s.expr
+}
+
+// Next returns the time one interval after the given time
+func (e *EverySchedule) Next(after time.Time) time.Time {
+	return after.Add(e.Interval).Truncate(time.Second)
+}
+
+// String returns the expression form of the schedule
+func (e *EverySchedule) String() string {
+	return "@every " + e.Interval.String()
+}
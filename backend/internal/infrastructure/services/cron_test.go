@@ -0,0 +1,68 @@
+// path: backend/internal/infrastructure/services/cron_test.go
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule_Next(t *testing.T) {
+	base := time.Date(2025, time.January, 15, 10, 30, 15, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * * *", time.Date(2025, 1, 15, 10, 30, 30, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2025, 1, 16, 2, 0, 0, 0, time.UTC)},
+		{"0 */6 * * *", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"30 4 1,15 * *", time.Date(2025, 2, 1, 4, 30, 0, 0, time.UTC)},
+		{"@daily", time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"@every 30s", time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseCronSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseCronSchedule(%q) failed: %v", tt.expr, err)
+		}
+
+		if got := schedule.Next(base); !got.Equal(tt.want) {
+			t.Errorf("%q: expected next run %v, got %v", tt.expr, tt.want, got)
+		}
+	}
+}
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"@fortnightly",
+		"@every 10ms",
+	}
+
+	for _, expr := range invalid {
+		if _, err := ParseCronSchedule(expr); err == nil {
+			t.Errorf("Expected error for %q", expr)
+		}
+	}
+}
+
+func TestParseCronSchedule_ImpossibleDate(t *testing.T) {
+	schedule, err := ParseCronSchedule("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("ParseCronSchedule failed: %v", err)
+	}
+
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Expected zero time for Feb 30, got %v", next)
+	}
+}
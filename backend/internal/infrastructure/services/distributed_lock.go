@@ -0,0 +1,181 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/distributed_lock.go
+// PURPOSE: Cluster-wide locks backed by Redis or PostgreSQL advisory locks
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const LockKeyPrefix = "lock:"
+
+// DistributedLocker grants exclusive, expiring leases on named keys
+type DistributedLocker interface {
+	// TryAcquire takes the lock if it is free (non-blocking)
+	TryAcquire(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Refresh extends a lock already held by this process
+	Refresh(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Release gives up a lock held by this process
+	Release(ctx context.Context, key string) error
+}
+
+// ============================================================================
+// REDIS LOCKER
+// ============================================================================
+
+// Compare-and-delete so a process never releases a lock it no longer owns
+var redisReleaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Compare-and-expire so only the owner can extend the lease
+var redisRefreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// RedisLocker implements DistributedLocker with SET NX PX leases
+type RedisLocker struct {
+	client  *redis.Client
+	ownerID string
+}
+
+// NewRedisLocker creates a Redis-backed locker with a unique owner ID
+func NewRedisLocker(client *redis.Client) *RedisLocker {
+	return &RedisLocker{
+		client:  client,
+		ownerID: uuid.New().String(),
+	}
+}
+
+// OwnerID returns the identifier stored as the lock value
+func (l *RedisLocker) OwnerID() string {
+	return l.ownerID
+}
+
+// TryAcquire takes the lock if no other owner holds it
+func (l *RedisLocker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := l.client.SetNX(ctx, LockKeyPrefix+key, l.ownerID, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock %s: %w", key, err)
+	}
+	return ok, nil
+}
+
+// Refresh extends the lease if this process still owns it
+func (l *RedisLocker) Refresh(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	res, err := redisRefreshScript.Run(ctx, l.client, []string{LockKeyPrefix + key}, l.ownerID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to refresh lock %s: %w", key, err)
+	}
+	return res == 1, nil
+}
+
+// Release deletes the lock if this process still owns it
+func (l *RedisLocker) Release(ctx context.Context, key string) error {
+	if err := redisReleaseScript.Run(ctx, l.client, []string{LockKeyPrefix + key}, l.ownerID).Err(); err != nil && err != redis.Nil {
+		return fmt.Errorf("failed to release lock %s: %w", key, err)
+	}
+	return nil
+}
+
+// ============================================================================
+// POSTGRES ADVISORY LOCKER
+// ============================================================================
+
+// PostgresLocker implements DistributedLocker with session-level advisory
+// locks. Each held lock pins a dedicated connection; the lock is released
+// automatically by PostgreSQL if the process dies. TTLs are ignored.
+type PostgresLocker struct {
+	db    *sql.DB
+	mu    sync.Mutex
+	conns map[string]*sql.Conn
+}
+
+// NewPostgresLocker creates an advisory-lock based locker
+func NewPostgresLocker(db *sql.DB) *PostgresLocker {
+	return &PostgresLocker{
+		db:    db,
+		conns: make(map[string]*sql.Conn),
+	}
+}
+
+// TryAcquire takes the advisory lock for the key on a dedicated connection
+func (l *PostgresLocker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, held := l.conns[key]; held {
+		return true, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get connection for lock %s: %w", key, err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("failed to acquire advisory lock %s: %w", key, err)
+	}
+
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+
+	l.conns[key] = conn
+	return true, nil
+}
+
+// Refresh verifies the session holding the lock is still alive
+func (l *PostgresLocker) Refresh(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	conn, held := l.conns[key]
+	if !held {
+		return false, nil
+	}
+
+	if err := conn.PingContext(ctx); err != nil {
+		// Session is gone, so PostgreSQL has already dropped the lock
+		conn.Close()
+		delete(l.conns, key)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Release unlocks and returns the dedicated connection to the pool
+func (l *PostgresLocker) Release(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	conn, held := l.conns[key]
+	if !held {
+		return nil
+	}
+	delete(l.conns, key)
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, key); err != nil {
+		return fmt.Errorf("failed to release advisory lock %s: %w", key, err)
+	}
+	return nil
+}
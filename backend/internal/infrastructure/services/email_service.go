@@ -4,102 +4,196 @@ import (
 	"context"
 	"fmt"
 	"log"
+	netmail "net/mail"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/mail"
 )
 
 // EmailConfig for the services package
 type EmailConfig struct {
-	Provider    string
+	Provider    string // "smtp", "sendgrid" or "catcher" ("mock")
 	APIKey      string
 	FromAddress string
 	FromName    string
+
+	// SMTP specific
+	SMTPHost       string
+	SMTPPort       int
+	SMTPUser       string
+	SMTPPassword   string
+	SMTPRequireTLS bool
+}
+
+// LocaleResolver returns the locale of the user with an email address, or
+// "" if there is none
+type LocaleResolver interface {
+	Locale(ctx context.Context, email string) (string, error)
 }
 
-// EmailService implements common.EmailService
+// EmailService implements common.EmailService. Emails are rendered in the
+// recipient's locale and queued in the outbox, inside the caller's
+// transaction if any; the worker's MailDispatcher sends them.
 type EmailService struct {
 	config      EmailConfig
+	from        string
+	templates   *EmailTemplates
+	outbox      mail.Outbox
+	locales     LocaleResolver
+	logger      common.Logger
 	devMode     bool
 	devCode     string
 	frontendURL string
 }
 
 // NewEmailService creates a new email service
-func NewEmailService(config EmailConfig) common.EmailService {
+func NewEmailService(config EmailConfig, outbox mail.Outbox, locales LocaleResolver, logger common.Logger) (common.EmailService, error) {
+	if config.FromName == "" {
+		config.FromName = "SocialQueue"
+	}
+	from := (&netmail.Address{Name: config.FromName, Address: config.FromAddress}).String()
+	if _, err := netmail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", config.FromAddress, err)
+	}
+
+	templates, err := NewEmailTemplates()
+	if err != nil {
+		return nil, err
+	}
+
 	return &EmailService{
 		config:      config,
+		from:        from,
+		templates:   templates,
+		outbox:      outbox,
+		locales:     locales,
+		logger:      logger,
 		devMode:     os.Getenv("DEVELOPMENT_MODE") == "true",
 		devCode:     getEnvOrDefault("DEV_EMAIL_VERIFICATION_CODE", "123456"),
 		frontendURL: getEnvOrDefault("FRONTEND_URL", "http://localhost:3000"),
-	}
+	}, nil
 }
 
 // SendVerificationEmail sends an email verification token
 func (s *EmailService) SendVerificationEmail(ctx context.Context, email, token string) error {
-	verificationLink := fmt.Sprintf("%s/verify-email?token=%s", s.frontendURL, token)
-
-	if s.devMode {
+	if s.devMode && s.devCode != "" {
 		// For development, use simple code
-		if s.devCode != "" {
-			verificationLink = fmt.Sprintf("%s/verify-email?token=%s", s.frontendURL, s.devCode)
-			log.Printf("🔧 DEV MODE - Email Verification:")
-			log.Printf("  Email: %s", email)
-			log.Printf("  Token: %s", s.devCode)
-			log.Printf("  Link: %s", verificationLink)
-			log.Printf("  You can POST to /api/v2/auth/verify-email with {\"token\": \"%s\"}", s.devCode)
-			return nil
-		}
+		token = s.devCode
+		log.Printf("🔧 DEV MODE - Email Verification:")
+		log.Printf("  Email: %s", email)
+		log.Printf("  Token: %s", s.devCode)
+		log.Printf("  Link: %s", s.link("/verify-email", "token", token))
+		log.Printf("  You can POST to /api/v2/auth/verify-email with {\"token\": \"%s\"}", s.devCode)
 	}
 
-	log.Printf("📧 [%s] Sending verification email to %s", s.config.Provider, email)
-	log.Printf("  Verification link: %s", verificationLink)
-	// TODO: Implement actual email sending via SendGrid/SMTP
-	return nil
+	return s.send(ctx, email, EmailTemplateVerification, EmailData{
+		Link: s.link("/verify-email", "token", token),
+	})
 }
 
 // SendPasswordResetEmail sends a password reset email
 func (s *EmailService) SendPasswordResetEmail(ctx context.Context, email, token string) error {
-	resetLink := fmt.Sprintf("%s/reset-password?token=%s", s.frontendURL, token)
-
-	if s.devMode {
-		if s.devCode != "" {
-			resetLink = fmt.Sprintf("%s/reset-password?token=%s", s.frontendURL, s.devCode)
-			log.Printf("🔧 DEV MODE - Password Reset:")
-			log.Printf("  Email: %s", email)
-			log.Printf("  Token: %s", s.devCode)
-			log.Printf("  Link: %s", resetLink)
-			return nil
-		}
+	if s.devMode && s.devCode != "" {
+		token = s.devCode
+		log.Printf("🔧 DEV MODE - Password Reset:")
+		log.Printf("  Email: %s", email)
+		log.Printf("  Token: %s", s.devCode)
+		log.Printf("  Link: %s", s.link("/reset-password", "token", token))
 	}
 
-	log.Printf("📧 [%s] Sending password reset to %s with token %s", s.config.Provider, email, token)
-	log.Printf("  Reset link: %s", resetLink)
-	// TODO: Implement actual email sending
-	return nil
+	return s.send(ctx, email, EmailTemplatePasswordReset, EmailData{
+		Link: s.link("/reset-password", "token", token),
+	})
 }
 
 // SendWelcomeEmail sends a welcome email to new users
 func (s *EmailService) SendWelcomeEmail(ctx context.Context, email, firstName string) error {
-	log.Printf("📧 [%s] Sending welcome email to %s (%s)", s.config.Provider, firstName, email)
-	if s.devMode {
-		log.Printf("  DEV MODE: Welcome email would be sent to %s", email)
-	}
-	// TODO: Implement actual email sending
-	return nil
+	return s.send(ctx, email, EmailTemplateWelcome, EmailData{
+		FirstName: firstName,
+		Link:      s.link("/dashboard"),
+	})
 }
 
 // SendInvitationEmail sends a team invitation email
 func (s *EmailService) SendInvitationEmail(ctx context.Context, email, teamName, inviteToken string) error {
-	log.Printf("📧 [%s] Sending invitation to %s for team %s", s.config.Provider, email, teamName)
+	inviteLink := s.link("/invite", "token", inviteToken)
 	if s.devMode {
-		inviteLink := fmt.Sprintf("%s/invite?token=%s", s.frontendURL, inviteToken)
-		log.Printf("  DEV MODE: Invitation link: %s", inviteLink)
+		log.Printf("🔧 DEV MODE - Invitation link for %s: %s", email, inviteLink)
 	}
-	// TODO: Implement actual email sending
+
+	return s.send(ctx, email, EmailTemplateInvitation, EmailData{
+		TeamName: teamName,
+		Link:     inviteLink,
+	})
+}
+
+// SendAccountLockedEmail tells a user their account was locked after
+// repeated failed sign-ins
+func (s *EmailService) SendAccountLockedEmail(ctx context.Context, email string, lockedUntil time.Time) error {
+	return s.send(ctx, email, EmailTemplateAccountLocked, EmailData{
+		LockedUntil: lockedUntil,
+		Link:        s.link("/forgot-password"),
+	})
+}
+
+// SendNotificationDigest emails a user their pending notifications
+func (s *EmailService) SendNotificationDigest(ctx context.Context, email, firstName string, items []common.NotificationDigestItem) error {
+	data := EmailData{
+		FirstName: firstName,
+		Link:      s.link("/settings/notifications"),
+		Items:     make([]EmailDigestItem, 0, len(items)),
+	}
+	for _, item := range items {
+		digestItem := EmailDigestItem{Title: item.Title, Body: item.Body, At: item.At}
+		if item.Link != "" {
+			digestItem.URL = s.link(item.Link)
+		}
+		data.Items = append(data.Items, digestItem)
+	}
+	return s.send(ctx, email, EmailTemplateNotificationDigest, data)
+}
+
+// send renders an email in the recipient's locale and queues it
+func (s *EmailService) send(ctx context.Context, to, template string, data EmailData) error {
+	locale, err := s.locales.Locale(ctx, to)
+	if err != nil {
+		s.logger.Warn("Failed to find recipient locale, using default", "template", template, "error", err)
+	}
+	data.AppName = s.config.FromName
+	data.Locale = s.templates.Locale(locale)
+
+	subject, html, text, err := s.templates.Render(template, data)
+	if err != nil {
+		return fmt.Errorf("failed to render %s email: %w", template, err)
+	}
+	msg, err := mail.NewMessage(s.from, to, subject, html, text, template, data.Locale)
+	if err != nil {
+		return fmt.Errorf("failed to create %s email: %w", template, err)
+	}
+	if err := s.outbox.Enqueue(ctx, msg); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Email queued", "emailId", msg.ID, "template", template, "locale", data.Locale)
 	return nil
 }
 
+// link returns an absolute frontend URL with query parameters given as
+// key, value pairs
+func (s *EmailService) link(path string, query ...string) string {
+	values := url.Values{}
+	for i := 0; i+1 < len(query); i += 2 {
+		values.Set(query[i], query[i+1])
+	}
+	if len(values) == 0 {
+		return s.frontendURL + path
+	}
+	return s.frontendURL + path + "?" + values.Encode()
+}
+
 // Helper function
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,195 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/email_templates.go
+// PURPOSE: Localized HTML and plaintext email templates
+// ============================================================================
+
+package services
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// DefaultEmailLocale is used for recipients without a locale and locales
+// without translations
+const DefaultEmailLocale = "en"
+
+// Email template names
+const (
+	EmailTemplateVerification       = "verification"
+	EmailTemplatePasswordReset      = "password_reset"
+	EmailTemplateWelcome            = "welcome"
+	EmailTemplateInvitation         = "invitation"
+	EmailTemplateAccountLocked      = "account_locked"
+	EmailTemplateNotificationDigest = "notification_digest"
+)
+
+// templates/email holds a directory per locale with a file per email, each
+// defining a "subject", a "text" and an "html" block. The html block is
+// wrapped in layout.tmpl.
+//
+//go:embed templates/email
+var emailTemplateFS embed.FS
+
+const emailTemplateRoot = "templates/email"
+
+// EmailData is what email templates are rendered with. Each email uses the
+// fields it needs.
+type EmailData struct {
+	AppName     string
+	Locale      string
+	FirstName   string
+	TeamName    string
+	Link        string
+	LockedUntil time.Time
+	Items       []EmailDigestItem
+}
+
+// EmailDigestItem is a notification in a digest email. URL is absolute.
+type EmailDigestItem struct {
+	Title string
+	Body  string
+	URL   string
+	At    time.Time
+}
+
+// emailButton is the argument of the layout's "button" template
+type emailButton struct {
+	URL   string
+	Label string
+}
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// EmailTemplates renders emails in the recipient's locale
+type EmailTemplates struct {
+	locales map[string]map[string]*emailTemplate
+}
+
+// NewEmailTemplates parses the embedded templates. Every locale must
+// provide every email the default locale does.
+func NewEmailTemplates() (*EmailTemplates, error) {
+	return parseEmailTemplates(emailTemplateFS, emailTemplateRoot)
+}
+
+func parseEmailTemplates(fsys fs.FS, root string) (*EmailTemplates, error) {
+	funcs := map[string]interface{}{
+		"date":   func(t time.Time) string { return t.UTC().Format("Jan 2, 2006 15:04 MST") },
+		"button": func(url, label string) emailButton { return emailButton{URL: url, Label: label} },
+	}
+
+	layout, err := fs.ReadFile(fsys, path.Join(root, "layout.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read email layout: %w", err)
+	}
+	dirs, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read email templates: %w", err)
+	}
+
+	t := &EmailTemplates{locales: make(map[string]map[string]*emailTemplate)}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		locale := dir.Name()
+		files, err := fs.Glob(fsys, path.Join(root, locale, "*.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+
+		t.locales[locale] = make(map[string]*emailTemplate, len(files))
+		for _, file := range files {
+			source, err := fs.ReadFile(fsys, file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read email template %s: %w", file, err)
+			}
+			name := strings.TrimSuffix(path.Base(file), ".tmpl")
+
+			html, err := htmltemplate.New(name).Funcs(funcs).Parse(string(layout))
+			if err == nil {
+				html, err = html.Parse(string(source))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse email template %s: %w", file, err)
+			}
+			text, err := texttemplate.New(name).Funcs(funcs).Parse(string(source))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse email template %s: %w", file, err)
+			}
+			for _, block := range []string{"subject", "text", "html"} {
+				if text.Lookup(block) == nil {
+					return nil, fmt.Errorf("email template %s has no %q block", file, block)
+				}
+			}
+			t.locales[locale][name] = &emailTemplate{html: html, text: text}
+		}
+	}
+
+	defaults, ok := t.locales[DefaultEmailLocale]
+	if !ok {
+		return nil, fmt.Errorf("no email templates for locale %q", DefaultEmailLocale)
+	}
+	for locale, templates := range t.locales {
+		for name := range defaults {
+			if _, ok := templates[name]; !ok {
+				return nil, fmt.Errorf("email template %s is missing in locale %q", name, locale)
+			}
+		}
+	}
+	return t, nil
+}
+
+// Locale returns the locale a recipient's emails are written in: theirs,
+// else its language ("pt" for "pt-BR"), else DefaultEmailLocale
+func (t *EmailTemplates) Locale(locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if _, ok := t.locales[locale]; ok {
+		return locale
+	}
+	if lang, _, found := strings.Cut(locale, "-"); found {
+		if _, ok := t.locales[lang]; ok {
+			return lang
+		}
+	}
+	return DefaultEmailLocale
+}
+
+// Render renders an email in the locale of data, which Render sets
+func (t *EmailTemplates) Render(name string, data EmailData) (subject, html, text string, err error) {
+	data.Locale = t.Locale(data.Locale)
+	tmpl, ok := t.locales[data.Locale][name]
+	if !ok {
+		return "", "", "", fmt.Errorf("unknown email template %q", name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	subject = strings.Join(strings.Fields(buf.String()), " ")
+
+	buf.Reset()
+	if err := tmpl.text.ExecuteTemplate(&buf, "text", data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	text = strings.TrimSpace(buf.String()) + "\n"
+
+	buf.Reset()
+	if err := tmpl.html.ExecuteTemplate(&buf, "layout", data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+	html = buf.String()
+
+	return subject, html, text, nil
+}
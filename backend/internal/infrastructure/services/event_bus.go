@@ -0,0 +1,207 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/event_bus.go
+// PURPOSE: Domain event bus backed by a transactional outbox
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+// AllEvents subscribes a handler to every event type
+const AllEvents = "*"
+
+// EventSink receives every event after the in-process subscribers
+// handled it, e.g. the Redis stream read by the worker
+type EventSink interface {
+	Add(ctx context.Context, envelope *event.Envelope) error
+}
+
+// EventBusConfig tunes outbox relaying
+type EventBusConfig struct {
+	BatchSize    int           // events claimed per pass
+	PollInterval time.Duration // pause between passes when the outbox is empty
+	Lease        time.Duration // how long a claimed event is hidden from other relays
+
+	HandlerAttempts int           // tries per handler within one delivery
+	HandlerBackoff  time.Duration // first pause between handler tries
+
+	MaxAttempts int           // deliveries before an event is given up
+	RetryBase   time.Duration // first delay before redelivering a failed event
+	RetryMax    time.Duration
+}
+
+var DefaultEventBusConfig = EventBusConfig{
+	BatchSize:       100,
+	PollInterval:    time.Second,
+	Lease:           time.Minute,
+	HandlerAttempts: 3,
+	HandlerBackoff:  100 * time.Millisecond,
+	MaxAttempts:     10,
+	RetryBase:       5 * time.Second,
+	RetryMax:        time.Hour,
+}
+
+// EventBus implements common.EventBus. Publish appends to the outbox; Run
+// relays the outbox to in-process subscribers and then to the sink. An event
+// is marked dispatched only when every subscriber and the sink succeeded, so
+// delivery is at least once and subscribers must tolerate repeats.
+type EventBus struct {
+	outbox   event.Outbox
+	sink     EventSink // nil delivers in-process only
+	config   EventBusConfig
+	mu       sync.RWMutex
+	handlers map[string][]common.EventHandler
+	logger   common.Logger
+}
+
+// NewEventBus creates an event bus writing to outbox
+func NewEventBus(outbox event.Outbox, sink EventSink, config EventBusConfig, logger common.Logger) *EventBus {
+	return &EventBus{
+		outbox:   outbox,
+		sink:     sink,
+		config:   config,
+		handlers: make(map[string][]common.EventHandler),
+		logger:   logger,
+	}
+}
+
+// Publish stores the event in the outbox, inside the transaction carried by
+// ctx if there is one
+func (b *EventBus) Publish(ctx context.Context, e common.Event) error {
+	envelope, err := event.Seal(e)
+	if err != nil {
+		return err
+	}
+	return b.outbox.Append(ctx, envelope)
+}
+
+// Subscribe registers an in-process handler for an event type, or for
+// every type with AllEvents
+func (b *EventBus) Subscribe(eventType string, handler common.EventHandler) error {
+	if handler == nil {
+		return fmt.Errorf("event handler is nil")
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	return nil
+}
+
+// Run relays the outbox until ctx is canceled
+func (b *EventBus) Run(ctx context.Context) {
+	b.logger.Info("Event relay started")
+	for {
+		n, err := b.DispatchPending(ctx)
+		if err != nil && ctx.Err() == nil {
+			b.logger.Error("Failed to relay events", "error", err)
+		}
+		if n < b.config.BatchSize {
+			select {
+			case <-ctx.Done():
+				b.logger.Info("Event relay stopped")
+				return
+			case <-time.After(b.config.PollInterval):
+			}
+		}
+	}
+}
+
+// DispatchPending delivers one batch of due events and returns how many
+// were claimed
+func (b *EventBus) DispatchPending(ctx context.Context) (int, error) {
+	pending, err := b.outbox.Claim(ctx, b.config.BatchSize, b.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range pending {
+		if err := b.deliver(ctx, p.Envelope); err != nil {
+			b.fail(ctx, p, err)
+			continue
+		}
+		if err := b.outbox.MarkDispatched(ctx, p.ID); err != nil {
+			// Redelivered once the lease expires
+			b.logger.Warn("Failed to mark event dispatched", "eventId", p.ID, "error", err)
+		}
+	}
+	return len(pending), nil
+}
+
+func (b *EventBus) deliver(ctx context.Context, envelope *event.Envelope) error {
+	b.mu.RLock()
+	handlers := append(append([]common.EventHandler{}, b.handlers[envelope.EventType]...), b.handlers[AllEvents]...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := b.handle(ctx, handler, envelope); err != nil {
+			return err
+		}
+	}
+
+	if b.sink != nil {
+		if err := b.sink.Add(ctx, envelope); err != nil {
+			return fmt.Errorf("failed to forward event: %w", err)
+		}
+	}
+	return nil
+}
+
+// handle calls one handler, retrying with backoff
+func (b *EventBus) handle(ctx context.Context, handler common.EventHandler, envelope *event.Envelope) error {
+	var err error
+	for attempt := 0; attempt < b.config.HandlerAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff(b.config.HandlerBackoff, attempt-1, b.config.RetryBase)):
+			}
+		}
+		if err = callHandler(ctx, handler, envelope); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func callHandler(ctx context.Context, handler common.EventHandler, envelope *event.Envelope) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event handler panicked: %v", r)
+		}
+	}()
+	return handler(ctx, envelope)
+}
+
+// fail schedules a redelivery, or gives up after MaxAttempts
+func (b *EventBus) fail(ctx context.Context, p *event.Pending, cause error) {
+	var retryAt *time.Time
+	if p.Attempts < b.config.MaxAttempts {
+		at := time.Now().Add(backoff(b.config.RetryBase, p.Attempts-1, b.config.RetryMax))
+		retryAt = &at
+		b.logger.Warn("Event delivery failed, will retry",
+			"eventId", p.ID,
+			"type", p.EventType,
+			"attempt", p.Attempts,
+			"retryAt", at,
+			"error", cause)
+	} else {
+		b.logger.Error("Event delivery failed, giving up",
+			"eventId", p.ID,
+			"type", p.EventType,
+			"attempts", p.Attempts,
+			"error", cause)
+	}
+
+	if err := b.outbox.MarkFailed(ctx, p.ID, cause.Error(), retryAt); err != nil {
+		b.logger.Warn("Failed to record event failure", "eventId", p.ID, "error", err)
+	}
+}
@@ -0,0 +1,194 @@
+// path: backend/internal/infrastructure/services/event_bus_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+type testEvent struct {
+	event.Meta
+	Name string `json:"name"`
+}
+
+func (e testEvent) Type() string        { return "test.happened" }
+func (e testEvent) AggregateID() string { return e.Name }
+
+// memoryOutbox is an in-memory event.Outbox
+type memoryOutbox struct {
+	mu      sync.Mutex
+	entries []*outboxEntry
+}
+
+type outboxEntry struct {
+	envelope   *event.Envelope
+	attempts   int
+	dueAt      time.Time
+	dispatched bool
+	dead       bool
+	lastError  string
+}
+
+func (o *memoryOutbox) Append(ctx context.Context, env *event.Envelope) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.entries = append(o.entries, &outboxEntry{envelope: env})
+	return nil
+}
+
+func (o *memoryOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]*event.Pending, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var pending []*event.Pending
+	for _, e := range o.entries {
+		if len(pending) == limit {
+			break
+		}
+		if e.dispatched || e.dead || time.Now().Before(e.dueAt) {
+			continue
+		}
+		e.attempts++
+		e.dueAt = time.Now().Add(lease)
+		pending = append(pending, &event.Pending{Envelope: e.envelope, Attempts: e.attempts})
+	}
+	return pending, nil
+}
+
+func (o *memoryOutbox) find(id uuid.UUID) *outboxEntry {
+	for _, e := range o.entries {
+		if e.envelope.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (o *memoryOutbox) MarkDispatched(ctx context.Context, id uuid.UUID) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.find(id).dispatched = true
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	e := o.find(id)
+	e.lastError = reason
+	if retryAt == nil {
+		e.dead = true
+	} else {
+		e.dueAt = *retryAt
+	}
+	return nil
+}
+
+type memorySink struct{ added []*event.Envelope }
+
+func (s *memorySink) Add(ctx context.Context, env *event.Envelope) error {
+	s.added = append(s.added, env)
+	return nil
+}
+
+func TestEventBus(t *testing.T) {
+	ctx := context.Background()
+	cfg := EventBusConfig{
+		BatchSize:       10,
+		PollInterval:    time.Millisecond,
+		Lease:           time.Minute,
+		HandlerAttempts: 2,
+		HandlerBackoff:  time.Millisecond,
+		MaxAttempts:     2,
+		RetryBase:       0,
+		RetryMax:        0,
+	}
+
+	t.Run("delivers to subscribers and the sink", func(t *testing.T) {
+		outbox, sink := &memoryOutbox{}, &memorySink{}
+		bus := NewEventBus(outbox, sink, cfg, NewLogger())
+
+		var got testEvent
+		var all int
+		bus.Subscribe("test.happened", func(ctx context.Context, e common.Event) error {
+			return event.Decode(e, &got)
+		})
+		bus.Subscribe(AllEvents, func(ctx context.Context, e common.Event) error {
+			all++
+			return nil
+		})
+
+		teamID := uuid.New()
+		if err := bus.Publish(ctx, testEvent{Meta: event.NewMeta(teamID), Name: "a"}); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		if n, err := bus.DispatchPending(ctx); err != nil || n != 1 {
+			t.Fatalf("DispatchPending = %d, %v", n, err)
+		}
+
+		if got.Name != "a" || got.Team != teamID || all != 1 {
+			t.Fatalf("handlers got %+v, all=%d", got, all)
+		}
+		if len(sink.added) != 1 || sink.added[0].Team != teamID {
+			t.Fatalf("sink got %+v", sink.added)
+		}
+		if !outbox.entries[0].dispatched {
+			t.Fatal("event not marked dispatched")
+		}
+		if n, _ := bus.DispatchPending(ctx); n != 0 {
+			t.Fatalf("dispatched event claimed again")
+		}
+	})
+
+	t.Run("retries handlers within a delivery", func(t *testing.T) {
+		outbox := &memoryOutbox{}
+		bus := NewEventBus(outbox, nil, cfg, NewLogger())
+
+		calls := 0
+		bus.Subscribe("test.happened", func(ctx context.Context, e common.Event) error {
+			calls++
+			if calls == 1 {
+				return errors.New("transient")
+			}
+			return nil
+		})
+
+		bus.Publish(ctx, testEvent{Name: "b"})
+		bus.DispatchPending(ctx)
+		if calls != 2 || !outbox.entries[0].dispatched {
+			t.Fatalf("calls=%d dispatched=%v", calls, outbox.entries[0].dispatched)
+		}
+	})
+
+	t.Run("redelivers failed events then gives up", func(t *testing.T) {
+		outbox, sink := &memoryOutbox{}, &memorySink{}
+		bus := NewEventBus(outbox, sink, cfg, NewLogger())
+
+		calls := 0
+		bus.Subscribe("test.happened", func(ctx context.Context, e common.Event) error {
+			calls++
+			panic("broken handler")
+		})
+
+		bus.Publish(ctx, testEvent{Name: "c"})
+		bus.DispatchPending(ctx)
+		entry := outbox.entries[0]
+		if entry.dispatched || entry.dead || entry.lastError == "" {
+			t.Fatalf("after first delivery: %+v", entry)
+		}
+
+		bus.DispatchPending(ctx)
+		if !entry.dead {
+			t.Fatalf("event not given up after %d deliveries: %+v", cfg.MaxAttempts, entry)
+		}
+		if calls != cfg.MaxAttempts*cfg.HandlerAttempts || len(sink.added) != 0 {
+			t.Fatalf("calls=%d sink=%d", calls, len(sink.added))
+		}
+	})
+}
@@ -0,0 +1,241 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/event_stream.go
+// PURPOSE: Redis Stream carrying domain events from the API to the worker
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/event"
+)
+
+const (
+	// DefaultEventStream is the stream the outbox relay appends to
+	DefaultEventStream = "events:domain"
+	// Approximate number of entries the stream keeps
+	eventStreamMaxLen = 100000
+)
+
+// RedisEventStream appends events to a Redis Stream. It is the event bus
+// sink in the API.
+type RedisEventStream struct {
+	client *redis.Client
+	stream string
+}
+
+func NewRedisEventStream(client *redis.Client, stream string) *RedisEventStream {
+	return &RedisEventStream{client: client, stream: stream}
+}
+
+func (s *RedisEventStream) Add(ctx context.Context, envelope *event.Envelope) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	return s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: eventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{"type": envelope.EventType, "envelope": data},
+	}).Err()
+}
+
+// EventStreamConsumerConfig tunes stream consumption
+type EventStreamConsumerConfig struct {
+	Batch         int64         // entries read per call
+	Block         time.Duration // how long a read waits for new entries
+	ClaimIdle     time.Duration // unacknowledged entries older than this are retried
+	MaxDeliveries int64         // deliveries before an entry is dropped
+}
+
+var DefaultEventStreamConsumerConfig = EventStreamConsumerConfig{
+	Batch:         50,
+	Block:         5 * time.Second,
+	ClaimIdle:     time.Minute,
+	MaxDeliveries: 5,
+}
+
+// EventStreamConsumer reads the event stream in a consumer group, so each
+// event goes to one worker replica. An entry is acknowledged once every
+// handler succeeded; failed entries are claimed again after ClaimIdle and
+// dropped after MaxDeliveries.
+type EventStreamConsumer struct {
+	client   *redis.Client
+	stream   string
+	group    string
+	consumer string
+	config   EventStreamConsumerConfig
+	mu       sync.RWMutex
+	handlers map[string][]common.EventHandler
+	logger   common.Logger
+}
+
+func NewEventStreamConsumer(client *redis.Client, stream, group, consumer string, config EventStreamConsumerConfig, logger common.Logger) *EventStreamConsumer {
+	return &EventStreamConsumer{
+		client:   client,
+		stream:   stream,
+		group:    group,
+		consumer: consumer,
+		config:   config,
+		handlers: make(map[string][]common.EventHandler),
+		logger:   logger,
+	}
+}
+
+// Subscribe registers a handler for an event type, or for every type with
+// AllEvents
+func (c *EventStreamConsumer) Subscribe(eventType string, handler common.EventHandler) error {
+	if handler == nil {
+		return fmt.Errorf("event handler is nil")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.handlers[eventType] = append(c.handlers[eventType], handler)
+	return nil
+}
+
+// Run consumes the stream until ctx is canceled
+func (c *EventStreamConsumer) Run(ctx context.Context) error {
+	err := c.client.XGroupCreateMkStream(ctx, c.stream, c.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group: %w", err)
+	}
+	c.logger.Info("Event stream consumer started", "stream", c.stream, "group", c.group, "consumer", c.consumer)
+
+	lastClaim := time.Time{}
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= c.config.ClaimIdle/2 {
+			c.retryStale(ctx)
+			lastClaim = time.Now()
+		}
+
+		streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, ">"},
+			Count:    c.config.Batch,
+			Block:    c.config.Block,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			c.logger.Error("Failed to read event stream", "error", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		for _, s := range streams {
+			c.process(ctx, s.Messages)
+		}
+	}
+
+	c.logger.Info("Event stream consumer stopped")
+	return nil
+}
+
+// retryStale claims entries another consumer (or this one) failed to
+// acknowledge, dropping those delivered too often
+func (c *EventStreamConsumer) retryStale(ctx context.Context) {
+	pending, err := c.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.stream,
+		Group:  c.group,
+		Idle:   c.config.ClaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  c.config.Batch,
+	}).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("Failed to list pending events", "error", err)
+		}
+		return
+	}
+
+	var retry []string
+	for _, p := range pending {
+		if p.RetryCount >= c.config.MaxDeliveries {
+			c.logger.Error("Dropping event after repeated failures", "entryId", p.ID, "deliveries", p.RetryCount)
+			c.client.XAck(ctx, c.stream, c.group, p.ID)
+			continue
+		}
+		retry = append(retry, p.ID)
+	}
+	if len(retry) == 0 {
+		return
+	}
+
+	messages, err := c.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   c.stream,
+		Group:    c.group,
+		Consumer: c.consumer,
+		MinIdle:  c.config.ClaimIdle,
+		Messages: retry,
+	}).Result()
+	if err != nil {
+		c.logger.Warn("Failed to claim pending events", "error", err)
+		return
+	}
+	c.process(ctx, messages)
+}
+
+func (c *EventStreamConsumer) process(ctx context.Context, messages []redis.XMessage) {
+	for _, msg := range messages {
+		envelope, err := decodeStreamEnvelope(msg)
+		if err != nil {
+			// Unreadable entries never succeed; drop them
+			c.logger.Error("Dropping malformed event", "entryId", msg.ID, "error", err)
+			c.client.XAck(ctx, c.stream, c.group, msg.ID)
+			continue
+		}
+
+		if err := c.dispatch(ctx, envelope); err != nil {
+			c.logger.Warn("Event handler failed, will retry",
+				"entryId", msg.ID,
+				"eventId", envelope.ID,
+				"type", envelope.EventType,
+				"error", err)
+			continue
+		}
+		if err := c.client.XAck(ctx, c.stream, c.group, msg.ID).Err(); err != nil {
+			c.logger.Warn("Failed to acknowledge event", "entryId", msg.ID, "error", err)
+		}
+	}
+}
+
+func (c *EventStreamConsumer) dispatch(ctx context.Context, envelope *event.Envelope) error {
+	c.mu.RLock()
+	handlers := append(append([]common.EventHandler{}, c.handlers[envelope.EventType]...), c.handlers[AllEvents]...)
+	c.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := callHandler(ctx, handler, envelope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeStreamEnvelope(msg redis.XMessage) (*event.Envelope, error) {
+	raw, ok := msg.Values["envelope"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing envelope")
+	}
+	var envelope event.Envelope
+	if err := json.Unmarshal([]byte(raw), &envelope); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
@@ -0,0 +1,100 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/leader_election.go
+// PURPOSE: Lease-based leader election for singleton worker jobs
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	DefaultLeaderKey      = "worker:leader"
+	DefaultLeaderLeaseTTL = 15 * time.Second
+)
+
+// LeaderElector keeps trying to hold a cluster-wide lease. Exactly one
+// replica holds the lease at a time; it is handed over when the holder
+// stops renewing (crash, shutdown or lost connection).
+type LeaderElector struct {
+	locker   DistributedLocker
+	key      string
+	ttl      time.Duration
+	logger   common.Logger
+	isLeader atomic.Bool
+}
+
+// NewLeaderElector creates a leader elector on top of a distributed locker
+func NewLeaderElector(locker DistributedLocker, key string, ttl time.Duration, logger common.Logger) *LeaderElector {
+	if key == "" {
+		key = DefaultLeaderKey
+	}
+	if ttl <= 0 {
+		ttl = DefaultLeaderLeaseTTL
+	}
+	return &LeaderElector{
+		locker: locker,
+		key:    key,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// IsLeader reports whether this replica currently holds the lease
+func (e *LeaderElector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// Run campaigns for leadership until the context is cancelled, then
+// releases the lease so another replica can take over immediately
+func (e *LeaderElector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	e.campaign(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if e.isLeader.Swap(false) {
+				releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				if err := e.locker.Release(releaseCtx, e.key); err != nil {
+					e.logger.Warn(fmt.Sprintf("Failed to release leadership: %v", err))
+				}
+				cancel()
+				e.logger.Info("Leadership released")
+			}
+			return
+		case <-ticker.C:
+			e.campaign(ctx)
+		}
+	}
+}
+
+// campaign renews the lease when leading, or tries to acquire it otherwise
+func (e *LeaderElector) campaign(ctx context.Context) {
+	if e.isLeader.Load() {
+		held, err := e.locker.Refresh(ctx, e.key, e.ttl)
+		if err != nil || !held {
+			e.isLeader.Store(false)
+			e.logger.Warn(fmt.Sprintf("Lost leadership (err: %v)", err))
+		}
+		return
+	}
+
+	acquired, err := e.locker.TryAcquire(ctx, e.key, e.ttl)
+	if err != nil {
+		e.logger.Warn(fmt.Sprintf("Leader election attempt failed: %v", err))
+		return
+	}
+	if acquired {
+		e.isLeader.Store(true)
+		e.logger.Info("👑 Acquired leadership")
+	}
+}
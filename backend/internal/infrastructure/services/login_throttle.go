@@ -0,0 +1,243 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/login_throttle.go
+// PURPOSE: Brute-force protection for password sign-in: progressive delays,
+//          temporary account lockout and per-IP blocking
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const LoginThrottleKeyPrefix = "login:"
+
+// LoginThrottleConfig sets when failed sign-ins start to slow down, require
+// a CAPTCHA, and lock the account or block the client IP
+type LoginThrottleConfig struct {
+	// Window is how long a failure is remembered after the last one
+	Window time.Duration
+
+	// CaptchaAfter account failures, the client is asked for a CAPTCHA
+	CaptchaAfter int
+	// DelayAfter account failures, each further attempt must wait BaseDelay,
+	// doubling per failure up to MaxDelay
+	DelayAfter int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// LockAfter account failures, the account is locked for LockDuration,
+	// doubling for each lockout within a day up to MaxLockDuration
+	LockAfter       int
+	LockDuration    time.Duration
+	MaxLockDuration time.Duration
+
+	// IPCaptchaAfter and IPBlockAfter apply to failures from one IP across
+	// all accounts
+	IPCaptchaAfter  int
+	IPBlockAfter    int
+	IPBlockDuration time.Duration
+}
+
+// DefaultLoginThrottleConfig is used by the API
+var DefaultLoginThrottleConfig = LoginThrottleConfig{
+	Window:          15 * time.Minute,
+	CaptchaAfter:    3,
+	DelayAfter:      5,
+	BaseDelay:       time.Second,
+	MaxDelay:        30 * time.Second,
+	LockAfter:       10,
+	LockDuration:    15 * time.Minute,
+	MaxLockDuration: 24 * time.Hour,
+	IPCaptchaAfter:  20,
+	IPBlockAfter:    100,
+	IPBlockDuration: 15 * time.Minute,
+}
+
+// How long lockouts count towards the next lockout's duration
+const loginLockoutMemory = 24 * time.Hour
+
+// LoginThrottle implements common.LoginThrottle on top of the cache, so
+// counts are shared between API replicas when the cache is Redis
+type LoginThrottle struct {
+	cache  common.CacheService
+	config LoginThrottleConfig
+}
+
+// NewLoginThrottle creates a login throttle
+func NewLoginThrottle(cache common.CacheService, config LoginThrottleConfig) *LoginThrottle {
+	return &LoginThrottle{cache: cache, config: config}
+}
+
+// Check reports whether a sign-in may be attempted now
+func (t *LoginThrottle) Check(ctx context.Context, account, ip string) (common.LoginThrottleStatus, error) {
+	var status common.LoginThrottleStatus
+	now := time.Now()
+
+	failures, err := t.count(ctx, t.key("fail:acct:", account))
+	if err != nil {
+		return status, err
+	}
+	status.Failures = failures
+	status.CaptchaRequired = failures >= t.config.CaptchaAfter
+
+	lockedUntil, err := t.until(ctx, t.key("lock:acct:", account), now)
+	if err != nil {
+		return status, err
+	}
+	if lockedUntil != nil {
+		status.LockedUntil = lockedUntil
+		status.RetryAfter = lockedUntil.Sub(now)
+	}
+
+	nextAttempt, err := t.until(ctx, t.key("next:acct:", account), now)
+	if err != nil {
+		return status, err
+	}
+	if nextAttempt != nil && nextAttempt.Sub(now) > status.RetryAfter {
+		status.RetryAfter = nextAttempt.Sub(now)
+	}
+
+	if ip == "" {
+		return status, nil
+	}
+
+	ipFailures, err := t.count(ctx, t.key("fail:ip:", ip))
+	if err != nil {
+		return status, err
+	}
+	if ipFailures >= t.config.IPCaptchaAfter {
+		status.CaptchaRequired = true
+	}
+
+	blockedUntil, err := t.until(ctx, t.key("block:ip:", ip), now)
+	if err != nil {
+		return status, err
+	}
+	if blockedUntil != nil && blockedUntil.Sub(now) > status.RetryAfter {
+		status.RetryAfter = blockedUntil.Sub(now)
+	}
+
+	return status, nil
+}
+
+// RecordFailure counts a failed sign-in against the account and the IP
+func (t *LoginThrottle) RecordFailure(ctx context.Context, account, ip string) (common.LoginThrottleStatus, error) {
+	now := time.Now()
+
+	if ip != "" {
+		ipFailures, err := t.cache.Increment(ctx, t.key("fail:ip:", ip), t.config.Window)
+		if err != nil {
+			return common.LoginThrottleStatus{}, err
+		}
+		if int(ipFailures) == t.config.IPBlockAfter {
+			if err := t.setUntil(ctx, t.key("block:ip:", ip), now.Add(t.config.IPBlockDuration), t.config.IPBlockDuration); err != nil {
+				return common.LoginThrottleStatus{}, err
+			}
+		}
+	}
+
+	failures, err := t.cache.Increment(ctx, t.key("fail:acct:", account), t.config.Window)
+	if err != nil {
+		return common.LoginThrottleStatus{}, err
+	}
+
+	var newlyLocked bool
+	switch {
+	case int(failures) >= t.config.LockAfter:
+		lockouts, err := t.cache.Increment(ctx, t.key("lockouts:acct:", account), loginLockoutMemory)
+		if err != nil {
+			return common.LoginThrottleStatus{}, err
+		}
+		duration := backoff(t.config.LockDuration, int(lockouts)-1, t.config.MaxLockDuration)
+		if err := t.setUntil(ctx, t.key("lock:acct:", account), now.Add(duration), duration); err != nil {
+			return common.LoginThrottleStatus{}, err
+		}
+		// Start counting afresh once the lockout ends
+		if err := t.cache.Delete(ctx, t.key("fail:acct:", account)); err != nil {
+			return common.LoginThrottleStatus{}, err
+		}
+		newlyLocked = true
+
+	case int(failures) >= t.config.DelayAfter:
+		delay := backoff(t.config.BaseDelay, int(failures)-t.config.DelayAfter, t.config.MaxDelay)
+		if err := t.setUntil(ctx, t.key("next:acct:", account), now.Add(delay), delay); err != nil {
+			return common.LoginThrottleStatus{}, err
+		}
+	}
+
+	status, err := t.Check(ctx, account, ip)
+	if err != nil {
+		return status, err
+	}
+	if newlyLocked {
+		// The failure count was cleared, but the client has earned a CAPTCHA
+		status.Failures = int(failures)
+		status.CaptchaRequired = true
+	}
+	status.NewlyLocked = newlyLocked
+	return status, nil
+}
+
+// Reset clears an account's failures, delay, lockout and lockout history
+func (t *LoginThrottle) Reset(ctx context.Context, account string) error {
+	for _, prefix := range []string{"fail:acct:", "next:acct:", "lock:acct:", "lockouts:acct:"} {
+		if err := t.cache.Delete(ctx, t.key(prefix, account)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *LoginThrottle) key(kind, id string) string {
+	return LoginThrottleKeyPrefix + kind + id
+}
+
+func (t *LoginThrottle) count(ctx context.Context, key string) (int, error) {
+	value, err := t.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid login throttle counter %s: %w", key, err)
+	}
+	return n, nil
+}
+
+// until reads a deadline, returning nil once it has passed
+func (t *LoginThrottle) until(ctx context.Context, key string, now time.Time) (*time.Time, error) {
+	value, err := t.cache.Get(ctx, key)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid login throttle deadline %s: %w", key, err)
+	}
+	deadline := time.UnixMilli(ms).UTC()
+	if !deadline.After(now) {
+		return nil, nil
+	}
+	return &deadline, nil
+}
+
+func (t *LoginThrottle) setUntil(ctx context.Context, key string, deadline time.Time, ttl time.Duration) error {
+	return t.cache.Set(ctx, key, strconv.FormatInt(deadline.UnixMilli(), 10), ttl)
+}
+
+// backoff doubles base n times, capped at max
+func backoff(base time.Duration, n int, max time.Duration) time.Duration {
+	d := base
+	for i := 0; i < n && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
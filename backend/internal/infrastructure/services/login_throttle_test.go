@@ -0,0 +1,152 @@
+// path: backend/internal/infrastructure/services/login_throttle_test.go
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	ctx := context.Background()
+	cfg := LoginThrottleConfig{
+		Window:          time.Minute,
+		CaptchaAfter:    2,
+		DelayAfter:      3,
+		BaseDelay:       20 * time.Millisecond,
+		MaxDelay:        50 * time.Millisecond,
+		LockAfter:       5,
+		LockDuration:    100 * time.Millisecond,
+		MaxLockDuration: time.Second,
+		IPCaptchaAfter:  4,
+		IPBlockAfter:    6,
+		IPBlockDuration: time.Minute,
+	}
+
+	fail := func(t *testing.T, th *LoginThrottle, account, ip string, n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			if _, err := th.RecordFailure(ctx, account, ip); err != nil {
+				t.Fatalf("RecordFailure: %v", err)
+			}
+		}
+	}
+
+	t.Run("captcha then progressive delay", func(t *testing.T) {
+		th := NewLoginThrottle(NewInMemoryCacheService(), cfg)
+
+		fail(t, th, "alice", "10.0.0.1", 1)
+		status, _ := th.Check(ctx, "alice", "10.0.0.1")
+		if status.Failures != 1 || status.CaptchaRequired || status.RetryAfter != 0 {
+			t.Fatalf("after 1 failure: %+v", status)
+		}
+
+		fail(t, th, "alice", "10.0.0.1", 1)
+		status, _ = th.Check(ctx, "alice", "10.0.0.1")
+		if !status.CaptchaRequired || status.RetryAfter != 0 {
+			t.Fatalf("after 2 failures: %+v", status)
+		}
+
+		status, _ = th.RecordFailure(ctx, "alice", "10.0.0.1")
+		if status.RetryAfter <= 0 || status.RetryAfter > cfg.BaseDelay {
+			t.Fatalf("after 3 failures: retry after %v, want (0, %v]", status.RetryAfter, cfg.BaseDelay)
+		}
+
+		status, _ = th.RecordFailure(ctx, "alice", "10.0.0.1")
+		if status.RetryAfter <= cfg.BaseDelay || status.RetryAfter > 2*cfg.BaseDelay {
+			t.Fatalf("after 4 failures: retry after %v, want (%v, %v]", status.RetryAfter, cfg.BaseDelay, 2*cfg.BaseDelay)
+		}
+
+		time.Sleep(2 * cfg.BaseDelay)
+		status, _ = th.Check(ctx, "alice", "10.0.0.1")
+		if status.RetryAfter != 0 {
+			t.Fatalf("delay did not expire: %+v", status)
+		}
+	})
+
+	t.Run("lockout escalates and expires", func(t *testing.T) {
+		th := NewLoginThrottle(NewInMemoryCacheService(), cfg)
+
+		fail(t, th, "bob", "", cfg.LockAfter-1)
+		status, _ := th.RecordFailure(ctx, "bob", "")
+		if !status.NewlyLocked || status.LockedUntil == nil || !status.CaptchaRequired {
+			t.Fatalf("expected lockout: %+v", status)
+		}
+		if status.RetryAfter <= 0 || status.RetryAfter > cfg.LockDuration {
+			t.Fatalf("retry after %v, want (0, %v]", status.RetryAfter, cfg.LockDuration)
+		}
+
+		// Failing while locked keeps counting but does not lock again
+		status, _ = th.RecordFailure(ctx, "bob", "")
+		if status.NewlyLocked || status.LockedUntil == nil {
+			t.Fatalf("failure while locked: %+v", status)
+		}
+
+		time.Sleep(cfg.LockDuration)
+		status, _ = th.Check(ctx, "bob", "")
+		if status.LockedUntil != nil {
+			t.Fatalf("lockout did not expire: %+v", status)
+		}
+
+		fail(t, th, "bob", "", cfg.LockAfter-2)
+		status, _ = th.RecordFailure(ctx, "bob", "")
+		if !status.NewlyLocked || status.RetryAfter <= cfg.LockDuration {
+			t.Fatalf("second lockout should be longer than %v: %+v", cfg.LockDuration, status)
+		}
+	})
+
+	t.Run("reset clears the account", func(t *testing.T) {
+		th := NewLoginThrottle(NewInMemoryCacheService(), cfg)
+
+		fail(t, th, "carol", "", cfg.LockAfter)
+		if err := th.Reset(ctx, "carol"); err != nil {
+			t.Fatalf("Reset: %v", err)
+		}
+		status, _ := th.Check(ctx, "carol", "")
+		if status.Failures != 0 || status.LockedUntil != nil || status.RetryAfter != 0 || status.CaptchaRequired {
+			t.Fatalf("after reset: %+v", status)
+		}
+	})
+
+	t.Run("ip failures span accounts", func(t *testing.T) {
+		th := NewLoginThrottle(NewInMemoryCacheService(), cfg)
+
+		for _, account := range []string{"a", "b", "c", "d"} {
+			fail(t, th, account, "10.0.0.2", 1)
+		}
+		status, _ := th.Check(ctx, "e", "10.0.0.2")
+		if !status.CaptchaRequired || status.RetryAfter != 0 {
+			t.Fatalf("after %d ip failures: %+v", cfg.IPCaptchaAfter, status)
+		}
+
+		fail(t, th, "f", "10.0.0.2", 1)
+		fail(t, th, "g", "10.0.0.2", 1)
+		status, _ = th.Check(ctx, "h", "10.0.0.2")
+		if status.RetryAfter <= 0 {
+			t.Fatalf("ip not blocked: %+v", status)
+		}
+
+		// Other clients can still sign in to the same accounts
+		status, _ = th.Check(ctx, "h", "10.0.0.3")
+		if status.RetryAfter != 0 || status.CaptchaRequired {
+			t.Fatalf("other ip affected: %+v", status)
+		}
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		n    int
+		want time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{10, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := backoff(time.Second, tt.n, 30*time.Second); got != tt.want {
+			t.Errorf("backoff(1s, %d, 30s) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/mail_dispatcher.go
+// PURPOSE: Delivering the email outbox with retries
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/mail"
+)
+
+// MailDispatcherConfig tunes outbox delivery
+type MailDispatcherConfig struct {
+	BatchSize int           // emails claimed per pass
+	Lease     time.Duration // how long a claimed email is hidden from other senders
+
+	MaxAttempts int           // deliveries before an email is given up
+	RetryBase   time.Duration // first delay before retrying a failed email
+	RetryMax    time.Duration
+}
+
+var DefaultMailDispatcherConfig = MailDispatcherConfig{
+	BatchSize:   50,
+	Lease:       2 * time.Minute,
+	MaxAttempts: 8,
+	RetryBase:   30 * time.Second,
+	RetryMax:    6 * time.Hour,
+}
+
+// MailDispatcher sends the emails queued in the outbox. An email is sent
+// at least once: one sent but not marked sent goes out again when its
+// lease expires.
+type MailDispatcher struct {
+	outbox    mail.Outbox
+	transport MailTransport
+	config    MailDispatcherConfig
+	logger    common.Logger
+}
+
+func NewMailDispatcher(outbox mail.Outbox, transport MailTransport, config MailDispatcherConfig, logger common.Logger) *MailDispatcher {
+	return &MailDispatcher{outbox: outbox, transport: transport, config: config, logger: logger}
+}
+
+// DispatchPending sends one batch of due emails and returns how many were
+// claimed
+func (d *MailDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	pending, err := d.outbox.Claim(ctx, d.config.BatchSize, d.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			break // the rest come back when their lease expires
+		}
+		if err := d.transport.Send(ctx, p.Message); err != nil {
+			d.fail(ctx, p, err)
+			continue
+		}
+		if err := d.outbox.MarkSent(ctx, p.ID); err != nil {
+			d.logger.Warn("Failed to mark email sent", "emailId", p.ID, "error", err)
+		}
+	}
+	return len(pending), nil
+}
+
+// fail schedules a retry, or gives up after MaxAttempts or a permanent
+// failure
+func (d *MailDispatcher) fail(ctx context.Context, p *mail.Pending, cause error) {
+	var retryAt *time.Time
+	if p.Attempts < d.config.MaxAttempts && !errors.Is(cause, mail.ErrPermanent) {
+		at := time.Now().Add(backoff(d.config.RetryBase, p.Attempts-1, d.config.RetryMax))
+		retryAt = &at
+		d.logger.Warn("Email delivery failed, will retry",
+			"emailId", p.ID,
+			"template", p.Template,
+			"attempt", p.Attempts,
+			"retryAt", at,
+			"error", cause)
+	} else {
+		d.logger.Error("Email delivery failed, giving up",
+			"emailId", p.ID,
+			"template", p.Template,
+			"attempts", p.Attempts,
+			"error", cause)
+	}
+
+	if err := d.outbox.MarkFailed(ctx, p.ID, cause.Error(), retryAt); err != nil {
+		d.logger.Warn("Failed to record email failure", "emailId", p.ID, "error", err)
+	}
+}
@@ -0,0 +1,408 @@
+// path: backend/internal/infrastructure/services/mail_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	netmail "net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/mail"
+)
+
+// memoryMailOutbox is an in-memory mail.Outbox
+type memoryMailOutbox struct {
+	mu      sync.Mutex
+	entries []*mailEntry
+}
+
+type mailEntry struct {
+	msg       *mail.Message
+	attempts  int
+	dueAt     time.Time
+	sent      bool
+	dead      bool
+	lastError string
+}
+
+func (o *memoryMailOutbox) Enqueue(ctx context.Context, msg *mail.Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.entries = append(o.entries, &mailEntry{msg: msg})
+	return nil
+}
+
+func (o *memoryMailOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]*mail.Pending, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var pending []*mail.Pending
+	for _, e := range o.entries {
+		if len(pending) == limit {
+			break
+		}
+		if e.sent || e.dead || time.Now().Before(e.dueAt) {
+			continue
+		}
+		e.attempts++
+		e.dueAt = time.Now().Add(lease)
+		pending = append(pending, &mail.Pending{Message: e.msg, Attempts: e.attempts})
+	}
+	return pending, nil
+}
+
+func (o *memoryMailOutbox) find(id uuid.UUID) *mailEntry {
+	for _, e := range o.entries {
+		if e.msg.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (o *memoryMailOutbox) MarkSent(ctx context.Context, id uuid.UUID) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.find(id).sent = true
+	return nil
+}
+
+func (o *memoryMailOutbox) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt *time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	e := o.find(id)
+	e.lastError = reason
+	if retryAt == nil {
+		e.dead = true
+	} else {
+		e.dueAt = *retryAt
+	}
+	return nil
+}
+
+type staticLocales map[string]string
+
+func (l staticLocales) Locale(ctx context.Context, email string) (string, error) {
+	return l[email], nil
+}
+
+type failingTransport struct {
+	err   error
+	calls int
+}
+
+func (t *failingTransport) Send(ctx context.Context, msg *mail.Message) error {
+	t.calls++
+	return t.err
+}
+
+func testMessage(t *testing.T) *mail.Message {
+	t.Helper()
+	msg, err := mail.NewMessage(`"Social Queue" <noreply@example.com>`, "ana@example.com",
+		"Olá, Ana", "<p>Hi <b>Ana</b></p>", "Hi Ana\n", EmailTemplateWelcome, "en")
+	if err != nil {
+		t.Fatalf("NewMessage: %v", err)
+	}
+	return msg
+}
+
+func TestEmailTemplates(t *testing.T) {
+	templates, err := NewEmailTemplates()
+	if err != nil {
+		t.Fatalf("NewEmailTemplates: %v", err)
+	}
+
+	t.Run("falls back from region to language to default", func(t *testing.T) {
+		for locale, want := range map[string]string{"es": "es", "es_MX": "es", "ES-ar": "es", "fr": "en", "": "en"} {
+			if got := templates.Locale(locale); got != want {
+				t.Errorf("Locale(%q) = %q, want %q", locale, got, want)
+			}
+		}
+	})
+
+	t.Run("renders every email in every locale", func(t *testing.T) {
+		for locale, names := range templates.locales {
+			for name := range names {
+				subject, html, text, err := templates.Render(name, EmailData{
+					AppName: "SocialQueue", Locale: locale, Link: "https://app.test/x",
+					Items: []EmailDigestItem{{Title: "t", At: time.Now()}},
+				})
+				if err != nil {
+					t.Fatalf("%s/%s: %v", locale, name, err)
+				}
+				if subject == "" || !strings.Contains(html, `lang="`+locale+`"`) || !strings.Contains(text, "https://app.test/x") {
+					t.Errorf("%s/%s: subject=%q text=%q", locale, name, subject, text)
+				}
+			}
+		}
+	})
+
+	t.Run("escapes HTML but not plaintext", func(t *testing.T) {
+		subject, html, text, err := templates.Render(EmailTemplateInvitation, EmailData{
+			AppName: "SocialQueue", Locale: "es", TeamName: `<Tom & Jerry>`, Link: "https://app.test/invite?token=a&b=c",
+		})
+		if err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+		if subject != "Te invitaron a unirte a <Tom & Jerry> en SocialQueue" {
+			t.Errorf("subject = %q", subject)
+		}
+		if !strings.Contains(html, "&lt;Tom &amp; Jerry&gt;") || strings.Contains(html, "<Tom") {
+			t.Errorf("team name not escaped in html")
+		}
+		if !strings.Contains(html, `href="https://app.test/invite?token=a&amp;b=c"`) {
+			t.Errorf("link not in html")
+		}
+		if !strings.Contains(text, "<Tom & Jerry>") {
+			t.Errorf("text = %q", text)
+		}
+	})
+}
+
+func TestEmailService(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("FRONTEND_URL", "https://app.test")
+	t.Setenv("DEVELOPMENT_MODE", "false")
+
+	outbox := &memoryMailOutbox{}
+	service, err := NewEmailService(EmailConfig{FromAddress: "noreply@example.com", FromName: "SocialQueue"},
+		outbox, staticLocales{"ana@example.com": "es-MX"}, NewLogger())
+	if err != nil {
+		t.Fatalf("NewEmailService: %v", err)
+	}
+
+	if err := service.SendPasswordResetEmail(ctx, "ana@example.com", "tok en"); err != nil {
+		t.Fatalf("SendPasswordResetEmail: %v", err)
+	}
+	if err := service.SendNotificationDigest(ctx, "bob@example.com", "Bob", []common.NotificationDigestItem{
+		{Title: "A post failed to publish", Link: "/posts/1", At: time.Now()},
+	}); err != nil {
+		t.Fatalf("SendNotificationDigest: %v", err)
+	}
+
+	if len(outbox.entries) != 2 {
+		t.Fatalf("queued %d emails, want 2", len(outbox.entries))
+	}
+	reset, digest := outbox.entries[0].msg, outbox.entries[1].msg
+	if reset.Locale != "es" || reset.Subject != "Restablece tu contraseña" || reset.From != `"SocialQueue" <noreply@example.com>` {
+		t.Errorf("reset email = %+v", reset)
+	}
+	if !strings.Contains(reset.Text, "https://app.test/reset-password?token=tok+en") {
+		t.Errorf("reset link missing from %q", reset.Text)
+	}
+	if digest.Locale != "en" || digest.Subject != "1 new notification on SocialQueue" || !strings.Contains(digest.HTML, `href="https://app.test/posts/1"`) {
+		t.Errorf("digest email = %q %q", digest.Subject, digest.HTML)
+	}
+
+	if err := service.SendWelcomeEmail(ctx, "not an address", "X"); !errors.Is(err, mail.ErrInvalidAddress) {
+		t.Errorf("err = %v, want %v", err, mail.ErrInvalidAddress)
+	}
+}
+
+func TestMailDispatcher(t *testing.T) {
+	ctx := context.Background()
+	cfg := MailDispatcherConfig{BatchSize: 10, Lease: time.Minute, MaxAttempts: 3}
+
+	t.Run("sends and marks sent", func(t *testing.T) {
+		outbox := &memoryMailOutbox{}
+		catcher := NewMailCatcher(10, nil)
+		msg := testMessage(t)
+		outbox.Enqueue(ctx, msg)
+
+		n, err := NewMailDispatcher(outbox, catcher, cfg, NewLogger()).DispatchPending(ctx)
+		if err != nil || n != 1 {
+			t.Fatalf("DispatchPending = %d, %v", n, err)
+		}
+		if !outbox.entries[0].sent || catcher.Last("ANA@example.com").ID != msg.ID {
+			t.Fatal("email not sent")
+		}
+	})
+
+	t.Run("retries until MaxAttempts", func(t *testing.T) {
+		outbox := &memoryMailOutbox{}
+		outbox.Enqueue(ctx, testMessage(t))
+		transport := &failingTransport{err: errors.New("connection refused")}
+		dispatcher := NewMailDispatcher(outbox, transport, cfg, NewLogger())
+
+		for i := 0; i < 5; i++ {
+			dispatcher.DispatchPending(ctx)
+		}
+		e := outbox.entries[0]
+		if transport.calls != 3 || !e.dead || e.lastError != "connection refused" {
+			t.Fatalf("calls=%d dead=%v lastError=%q", transport.calls, e.dead, e.lastError)
+		}
+	})
+
+	t.Run("gives up on permanent failures", func(t *testing.T) {
+		outbox := &memoryMailOutbox{}
+		outbox.Enqueue(ctx, testMessage(t))
+		transport := &failingTransport{err: mail.ErrPermanent}
+
+		NewMailDispatcher(outbox, transport, cfg, NewLogger()).DispatchPending(ctx)
+		if !outbox.entries[0].dead {
+			t.Fatal("permanent failure retried")
+		}
+	})
+}
+
+// fakeSMTPServer accepts one session without TLS and records the data
+type fakeSMTPServer struct {
+	addr       string
+	rejectRcpt bool
+	data       chan string
+}
+
+func newFakeSMTPServer(t *testing.T, rejectRcpt bool) *fakeSMTPServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &fakeSMTPServer{addr: ln.Addr().String(), rejectRcpt: rejectRcpt, data: make(chan string, 1)}
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		conn := textproto.NewConn(c)
+		conn.PrintfLine("220 fake ESMTP")
+		for {
+			line, err := conn.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+			case "EHLO":
+				conn.PrintfLine("250-fake")
+				conn.PrintfLine("250 8BITMIME")
+			case "RCPT":
+				if s.rejectRcpt {
+					conn.PrintfLine("550 no such user")
+				} else {
+					conn.PrintfLine("250 ok")
+				}
+			case "DATA":
+				conn.PrintfLine("354 go ahead")
+				data, err := conn.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				s.data <- string(data)
+				conn.PrintfLine("250 queued")
+			case "QUIT":
+				conn.PrintfLine("221 bye")
+				return
+			default:
+				conn.PrintfLine("250 ok")
+			}
+		}
+	}()
+	return s
+}
+
+func TestSMTPTransport(t *testing.T) {
+	ctx := context.Background()
+
+	newTransport := func(addr string, requireTLS bool) *SMTPTransport {
+		host, port, _ := net.SplitHostPort(addr)
+		p, _ := strconv.Atoi(port)
+		return NewSMTPTransport(SMTPConfig{Host: host, Port: p, RequireTLS: requireTLS, Timeout: 5 * time.Second})
+	}
+
+	t.Run("sends a multipart message", func(t *testing.T) {
+		server := newFakeSMTPServer(t, false)
+		if err := newTransport(server.addr, false).Send(ctx, testMessage(t)); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+
+		parsed, err := netmail.ReadMessage(strings.NewReader(<-server.data))
+		if err != nil {
+			t.Fatalf("ReadMessage: %v", err)
+		}
+		subject, _ := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		if subject != "Olá, Ana" || parsed.Header.Get("To") != "ana@example.com" {
+			t.Errorf("headers = %v", parsed.Header)
+		}
+		_, params, _ := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		parts := multipart.NewReader(parsed.Body, params["boundary"])
+		var types []string
+		for {
+			part, err := parts.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("NextPart: %v", err)
+			}
+			types = append(types, part.Header.Get("Content-Type"))
+		}
+		if strings.Join(types, ",") != "text/plain; charset=utf-8,text/html; charset=utf-8" {
+			t.Errorf("parts = %v", types)
+		}
+	})
+
+	t.Run("rejected recipients are permanent failures", func(t *testing.T) {
+		server := newFakeSMTPServer(t, true)
+		err := newTransport(server.addr, false).Send(ctx, testMessage(t))
+		if !errors.Is(err, mail.ErrPermanent) {
+			t.Fatalf("err = %v, want %v", err, mail.ErrPermanent)
+		}
+	})
+
+	t.Run("refuses servers without STARTTLS when TLS is required", func(t *testing.T) {
+		server := newFakeSMTPServer(t, false)
+		err := newTransport(server.addr, true).Send(ctx, testMessage(t))
+		if err == nil || errors.Is(err, mail.ErrPermanent) {
+			t.Fatalf("err = %v", err)
+		}
+	})
+}
+
+func TestHTTPMailTransport(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		status    int
+		wantErr   bool
+		permanent bool
+	}{
+		{http.StatusAccepted, false, false},
+		{http.StatusBadRequest, true, true},
+		{http.StatusTooManyRequests, true, false},
+		{http.StatusBadGateway, true, false},
+	} {
+		var auth, body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw, _ := io.ReadAll(r.Body)
+			auth, body = r.Header.Get("Authorization"), string(raw)
+			w.WriteHeader(tc.status)
+		}))
+
+		provider := NewSendGridProvider("sg-key")
+		provider.endpoint = server.URL
+		err := NewHTTPMailTransport(provider, time.Second).Send(ctx, testMessage(t))
+		server.Close()
+
+		if (err != nil) != tc.wantErr || errors.Is(err, mail.ErrPermanent) != tc.permanent {
+			t.Errorf("status %d: err = %v", tc.status, err)
+		}
+		if auth != "Bearer sg-key" || !strings.Contains(body, `"email":"ana@example.com"`) || !strings.Contains(body, `"name":"Social Queue"`) {
+			t.Errorf("status %d: auth=%q body=%s", tc.status, auth, body)
+		}
+	}
+}
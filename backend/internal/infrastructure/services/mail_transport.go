@@ -0,0 +1,397 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/mail_transport.go
+// PURPOSE: Email delivery over SMTP, provider HTTP APIs or a mail catcher
+// ============================================================================
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	netmail "net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/mail"
+)
+
+// MailTransport delivers rendered emails. Failures wrapping
+// mail.ErrPermanent are not retried.
+type MailTransport interface {
+	Send(ctx context.Context, msg *mail.Message) error
+}
+
+// NewMailTransport selects the transport from config.Provider: "smtp",
+// "sendgrid", or "catcher" (also "mock" and empty), which keeps messages
+// in memory instead of sending them
+func NewMailTransport(config EmailConfig, logger common.Logger) (MailTransport, error) {
+	switch config.Provider {
+	case "smtp":
+		if config.SMTPHost == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required for the smtp email provider")
+		}
+		return NewSMTPTransport(SMTPConfig{
+			Host:       config.SMTPHost,
+			Port:       config.SMTPPort,
+			Username:   config.SMTPUser,
+			Password:   config.SMTPPassword,
+			RequireTLS: config.SMTPRequireTLS,
+		}), nil
+	case "sendgrid":
+		if config.APIKey == "" {
+			return nil, fmt.Errorf("SENDGRID_API_KEY is required for the sendgrid email provider")
+		}
+		return NewHTTPMailTransport(NewSendGridProvider(config.APIKey), DefaultMailHTTPTimeout), nil
+	case "catcher", "mock", "":
+		return NewMailCatcher(DefaultMailCatcherSize, logger), nil
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", config.Provider)
+	}
+}
+
+// ============================================================================
+// MIME
+// ============================================================================
+
+// buildMIMEMessage encodes an email as multipart/alternative with a
+// plaintext and an HTML part
+func buildMIMEMessage(msg *mail.Message, now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	domain := "localhost"
+	if from, err := netmail.ParseAddress(msg.From); err == nil {
+		if _, host, found := strings.Cut(from.Address, "@"); found {
+			domain = host
+		}
+	}
+
+	var out bytes.Buffer
+	for _, header := range [][2]string{
+		{"From", msg.From},
+		{"To", msg.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", msg.ID, domain)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + parts.Boundary()},
+	} {
+		fmt.Fprintf(&out, "%s: %s\r\n", header[0], header[1])
+	}
+	out.WriteString("\r\n")
+	out.Write(body.Bytes())
+	return out.Bytes(), nil
+}
+
+// ============================================================================
+// SMTP
+// ============================================================================
+
+const DefaultSMTPTimeout = 30 * time.Second
+
+// SMTPConfig configures an SMTP relay. Port 465 uses implicit TLS; other
+// ports upgrade with STARTTLS when the server offers it.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // empty skips authentication
+	Password string
+	// RequireTLS refuses to send over servers not offering STARTTLS
+	RequireTLS bool
+	Timeout    time.Duration
+	// TLSConfig overrides the TLS settings, e.g. to trust a test server
+	TLSConfig *tls.Config
+}
+
+// SMTPTransport sends each email over its own SMTP connection
+type SMTPTransport struct {
+	config SMTPConfig
+}
+
+func NewSMTPTransport(config SMTPConfig) *SMTPTransport {
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultSMTPTimeout
+	}
+	return &SMTPTransport{config: config}
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, msg *mail.Message) error {
+	from, err := netmail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("%w: invalid sender: %v", mail.ErrPermanent, err)
+	}
+	data, err := buildMIMEMessage(msg, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to encode email: %w", err)
+	}
+
+	err = t.send(ctx, from.Address, msg.To, data)
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) && smtpErr.Code >= 500 {
+		return fmt.Errorf("%w: %v", mail.ErrPermanent, err)
+	}
+	return err
+}
+
+func (t *SMTPTransport) send(ctx context.Context, from, to string, data []byte) error {
+	addr := net.JoinHostPort(t.config.Host, strconv.Itoa(t.config.Port))
+	tlsConfig := t.config.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{ServerName: t.config.Host, MinVersion: tls.VersionTLS12}
+	}
+
+	dialer := net.Dialer{Timeout: t.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	deadline := time.Now().Add(t.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	implicitTLS := t.config.Port == 465
+	if implicitTLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+	client, err := smtp.NewClient(conn, t.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if !implicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("failed to start TLS: %w", err)
+			}
+		} else if t.config.RequireTLS {
+			return fmt.Errorf("SMTP server does not offer STARTTLS")
+		}
+	}
+
+	if t.config.Username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server does not offer authentication")
+		}
+		if err := client.Auth(smtp.PlainAuth("", t.config.Username, t.config.Password, t.config.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate to SMTP server: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("sender refused: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("recipient refused: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("email refused: %w", err)
+	}
+	return client.Quit()
+}
+
+// ============================================================================
+// HTTP PROVIDERS
+// ============================================================================
+
+const DefaultMailHTTPTimeout = 15 * time.Second
+
+// HTTPMailProvider builds the API request sending an email through a
+// provider such as SendGrid
+type HTTPMailProvider interface {
+	Name() string
+	NewRequest(ctx context.Context, msg *mail.Message) (*http.Request, error)
+}
+
+// HTTPMailTransport sends emails through an HTTP provider. Rejected
+// requests (4xx other than 429) are permanent failures.
+type HTTPMailTransport struct {
+	provider HTTPMailProvider
+	client   *http.Client
+}
+
+func NewHTTPMailTransport(provider HTTPMailProvider, timeout time.Duration) *HTTPMailTransport {
+	return &HTTPMailTransport{provider: provider, client: &http.Client{Timeout: timeout}}
+}
+
+func (t *HTTPMailTransport) Send(ctx context.Context, msg *mail.Message) error {
+	req, err := t.provider.NewRequest(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("failed to build %s request: %w", t.provider.Name(), err)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", t.provider.Name(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+	err = fmt.Errorf("%s returned %d: %s", t.provider.Name(), resp.StatusCode, strings.TrimSpace(string(detail)))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %v", mail.ErrPermanent, err)
+	}
+	return err
+}
+
+const sendGridEndpoint = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridProvider sends through the SendGrid v3 API
+type SendGridProvider struct {
+	apiKey   string
+	endpoint string
+}
+
+func NewSendGridProvider(apiKey string) *SendGridProvider {
+	return &SendGridProvider{apiKey: apiKey, endpoint: sendGridEndpoint}
+}
+
+func (p *SendGridProvider) Name() string { return "sendgrid" }
+
+func (p *SendGridProvider) NewRequest(ctx context.Context, msg *mail.Message) (*http.Request, error) {
+	from, err := netmail.ParseAddress(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	type address struct {
+		Email string `json:"email"`
+		Name  string `json:"name,omitempty"`
+	}
+	type content struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"personalizations": []map[string]interface{}{{"to": []address{{Email: msg.To}}}},
+		"from":             address{Email: from.Address, Name: from.Name},
+		"subject":          msg.Subject,
+		"content":          []content{{"text/plain", msg.Text}, {"text/html", msg.HTML}},
+		"custom_args":      map[string]string{"message_id": msg.ID.String(), "template": msg.Template},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// ============================================================================
+// MAIL CATCHER
+// ============================================================================
+
+const DefaultMailCatcherSize = 100
+
+// MailCatcher keeps the last emails it was given instead of sending them,
+// for development and tests
+type MailCatcher struct {
+	mu       sync.Mutex
+	messages []*mail.Message
+	size     int
+	logger   common.Logger // nil keeps quiet
+}
+
+func NewMailCatcher(size int, logger common.Logger) *MailCatcher {
+	return &MailCatcher{size: size, logger: logger}
+}
+
+func (c *MailCatcher) Send(ctx context.Context, msg *mail.Message) error {
+	caught := *msg
+
+	c.mu.Lock()
+	c.messages = append(c.messages, &caught)
+	if len(c.messages) > c.size {
+		c.messages = c.messages[len(c.messages)-c.size:]
+	}
+	c.mu.Unlock()
+
+	if c.logger != nil {
+		c.logger.Info("📧 Email caught", "to", msg.To, "template", msg.Template, "subject", msg.Subject)
+	}
+	return nil
+}
+
+// Messages returns the caught emails, oldest first
+func (c *MailCatcher) Messages() []*mail.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*mail.Message(nil), c.messages...)
+}
+
+// Last returns the latest email caught for an address, or nil
+func (c *MailCatcher) Last(to string) *mail.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.messages) - 1; i >= 0; i-- {
+		if strings.EqualFold(c.messages[i].To, to) {
+			return c.messages[i]
+		}
+	}
+	return nil
+}
+
+// Reset forgets the caught emails
+func (c *MailCatcher) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = nil
+}
@@ -0,0 +1,488 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/oidc.go
+// PURPOSE: OpenID Connect and GitHub OAuth sign-in providers
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+// ErrOIDCVerification is returned when a provider response cannot be trusted
+var ErrOIDCVerification = errors.New("identity provider verification failed")
+
+const (
+	oidcDiscoveryTTL = 24 * time.Hour
+	oidcJWKSMinAge   = time.Minute // throttles refetches for unknown key IDs
+	oidcMaxBody      = 1 << 20
+)
+
+// Well-known issuers
+const (
+	GoogleIssuer          = "https://accounts.google.com"
+	MicrosoftIssuerFormat = "https://login.microsoftonline.com/%s/v2.0"
+)
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider implements common.IdentityProvider for any OpenID Connect
+// provider that publishes a discovery document
+type OIDCProvider struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	httpClient   *http.Client
+
+	// emailVerified reads whether the provider verified the email claim
+	emailVerified func(jwt.MapClaims) bool
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	discoveredAt  time.Time
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewOIDCProvider creates a provider for issuer. The discovery document and
+// signing keys are fetched on first use and cached.
+func NewOIDCProvider(name, issuer, clientID, clientSecret, redirectURL string, httpClient *http.Client) *OIDCProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &OIDCProvider{
+		name:         name,
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		httpClient:   httpClient,
+
+		emailVerified: func(claims jwt.MapClaims) bool { return claimBool(claims, "email_verified") },
+	}
+}
+
+// NewMicrosoftProvider creates a provider for Microsoft Entra ID. Its
+// id_tokens have no email_verified claim; an email is trusted when the
+// xms_edov optional claim says the tenant verified the email's domain. The
+// app registration must add the email and xms_edov optional claims.
+func NewMicrosoftProvider(issuer, clientID, clientSecret, redirectURL string, httpClient *http.Client) *OIDCProvider {
+	p := NewOIDCProvider("microsoft", issuer, clientID, clientSecret, redirectURL, httpClient)
+	p.emailVerified = microsoftEmailVerified
+	return p
+}
+
+// microsoftEmailVerified trusts email_verified when present, else xms_edov
+// ("email domain owner verified"). Without either the email could be any
+// address a tenant admin typed in.
+func microsoftEmailVerified(claims jwt.MapClaims) bool {
+	if _, ok := claims["email_verified"]; ok {
+		return claimBool(claims, "email_verified")
+	}
+	return claimBool(claims, "xms_edov")
+}
+
+func (p *OIDCProvider) Name() string { return p.name }
+
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.clientID},
+		"redirect_uri":          {p.redirectURL},
+		"scope":                 {"openid email profile"},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	return appendQuery(doc.AuthorizationEndpoint, params), nil
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*common.ExternalIdentity, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	err = postTokenRequest(ctx, p.httpClient, doc.TokenEndpoint, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.redirectURL},
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code_verifier": {codeVerifier},
+	}, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in token response", ErrOIDCVerification)
+	}
+
+	claims, err := p.verifyIDToken(ctx, doc, tokens.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &common.ExternalIdentity{
+		Subject:       claimString(claims, "sub"),
+		Email:         claimString(claims, "email"),
+		EmailVerified: p.emailVerified(claims),
+		FirstName:     claimString(claims, "given_name"),
+		LastName:      claimString(claims, "family_name"),
+		AvatarURL:     claimString(claims, "picture"),
+	}
+	if identity.Subject == "" {
+		return nil, fmt.Errorf("%w: id_token has no subject", ErrOIDCVerification)
+	}
+	return identity, nil
+}
+
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, doc *oidcDiscovery, raw, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.signingKey(ctx, doc, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOIDCVerification, err)
+	}
+
+	// Multi-tenant Microsoft endpoints publish an issuer template
+	issuer := strings.ReplaceAll(doc.Issuer, "{tenantid}", claimString(claims, "tid"))
+	if claimString(claims, "iss") != issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrOIDCVerification)
+	}
+	if claimString(claims, "nonce") != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOIDCVerification)
+	}
+	return claims, nil
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil && time.Since(p.discoveredAt) < oidcDiscoveryTTL {
+		return p.discovery, nil
+	}
+
+	var doc oidcDiscovery
+	if err := getJSON(ctx, p.httpClient, p.issuer+"/.well-known/openid-configuration", nil, &doc); err != nil {
+		return nil, fmt.Errorf("failed to discover %s: %w", p.name, err)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("failed to discover %s: incomplete discovery document", p.name)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.issuer && !strings.Contains(doc.Issuer, "{tenantid}") {
+		return nil, fmt.Errorf("failed to discover %s: issuer mismatch", p.name)
+	}
+
+	p.discovery = &doc
+	p.discoveredAt = time.Now()
+	return p.discovery, nil
+}
+
+// signingKey returns the JWKS key with the given ID, refetching the key set
+// once when the ID is unknown (providers rotate keys)
+func (p *OIDCProvider) signingKey(ctx context.Context, doc *oidcDiscovery, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if p.keys != nil && time.Since(p.keysFetchedAt) < oidcJWKSMinAge {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(ctx, p.httpClient, doc.JWKSURI, nil, &set); err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("EC point not on curve")
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// ============================================================================
+// GITHUB
+// ============================================================================
+
+// GitHubProvider implements common.IdentityProvider with GitHub's OAuth
+// apps, which do not speak OpenID Connect. The identity comes from the REST
+// API, using the primary verified email.
+type GitHubProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	httpClient   *http.Client
+
+	authURL  string
+	tokenURL string
+	apiURL   string
+}
+
+func NewGitHubProvider(clientID, clientSecret, redirectURL string, httpClient *http.Client) *GitHubProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &GitHubProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		httpClient:   httpClient,
+		authURL:      "https://github.com/login/oauth/authorize",
+		tokenURL:     "https://github.com/login/oauth/access_token",
+		apiURL:       "https://api.github.com",
+	}
+}
+
+func (p *GitHubProvider) Name() string { return "github" }
+
+func (p *GitHubProvider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	params := url.Values{
+		"client_id":             {p.clientID},
+		"redirect_uri":          {p.redirectURL},
+		"scope":                 {"read:user user:email"},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	return appendQuery(p.authURL, params), nil
+}
+
+func (p *GitHubProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*common.ExternalIdentity, error) {
+	var tokens struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}
+	err := postTokenRequest(ctx, p.httpClient, p.tokenURL, url.Values{
+		"code":          {code},
+		"redirect_uri":  {p.redirectURL},
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code_verifier": {codeVerifier},
+	}, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	if tokens.AccessToken == "" {
+		return nil, fmt.Errorf("%w: %s", ErrOIDCVerification, tokens.Error)
+	}
+
+	auth := http.Header{"Authorization": {"Bearer " + tokens.AccessToken}}
+
+	var profile struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, p.httpClient, p.apiURL+"/user", auth, &profile); err != nil {
+		return nil, fmt.Errorf("failed to fetch GitHub profile: %w", err)
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, p.httpClient, p.apiURL+"/user/emails", auth, &emails); err != nil {
+		return nil, fmt.Errorf("failed to fetch GitHub emails: %w", err)
+	}
+
+	identity := &common.ExternalIdentity{
+		Subject:   fmt.Sprintf("%d", profile.ID),
+		AvatarURL: profile.AvatarURL,
+	}
+	identity.FirstName, identity.LastName, _ = strings.Cut(strings.TrimSpace(profile.Name), " ")
+	if identity.FirstName == "" {
+		identity.FirstName = profile.Login
+	}
+	for _, e := range emails {
+		if e.Primary {
+			identity.Email = e.Email
+			identity.EmailVerified = e.Verified
+		}
+	}
+	return identity, nil
+}
+
+// ============================================================================
+// HTTP HELPERS
+// ============================================================================
+
+func postTokenRequest(ctx context.Context, client *http.Client, endpoint string, form url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxBody))
+	if err != nil {
+		return fmt.Errorf("token request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: token endpoint returned %d", ErrOIDCVerification, resp.StatusCode)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("invalid token response: %w", err)
+	}
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, endpoint string, header http.Header, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %d", endpoint, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, oidcMaxBody)).Decode(out)
+}
+
+func appendQuery(endpoint string, params url.Values) string {
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	return endpoint + sep + params.Encode()
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// claimBool accepts both JSON booleans and "true"; some providers send the
+// latter for email_verified
+func claimBool(claims jwt.MapClaims, name string) bool {
+	switch v := claims[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true" || v == "1"
+	case float64:
+		return v == 1
+	}
+	return false
+}
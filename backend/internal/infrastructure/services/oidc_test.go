@@ -0,0 +1,212 @@
+// path: backend/internal/infrastructure/services/oidc_test.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockOIDCProvider is a local OpenID Connect provider. Authorization codes
+// are registered directly with issue, standing in for the browser step.
+type mockOIDCProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	// issuer is the discovery document's, and idToken adjusts the claims
+	// of issued id_tokens; both default to a generic provider
+	issuer  string
+	idToken func(claims jwt.MapClaims)
+
+	mu    sync.Mutex
+	codes map[string]mockAuthorization
+}
+
+type mockAuthorization struct {
+	challenge string
+	nonce     string
+	subject   string
+	email     string
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mockOIDCProvider{key: key, codes: map[string]mockAuthorization{}}
+	mux := http.NewServeMux()
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	m.issuer = m.server.URL
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.issuer,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test-key",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		m.mu.Lock()
+		auth, ok := m.codes[r.Form.Get("code")]
+		delete(m.codes, r.Form.Get("code"))
+		m.mu.Unlock()
+
+		sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != auth.challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		claims := jwt.MapClaims{
+			"iss":            m.server.URL,
+			"aud":            r.Form.Get("client_id"),
+			"sub":            auth.subject,
+			"email":          auth.email,
+			"email_verified": true,
+			"given_name":     "Ada",
+			"family_name":    "Lovelace",
+			"nonce":          auth.nonce,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		}
+		if m.idToken != nil {
+			m.idToken(claims)
+		}
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		idToken.Header["kid"] = "test-key"
+		signed, _ := idToken.SignedString(key)
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "id_token": signed})
+	})
+	return m
+}
+
+func (m *mockOIDCProvider) issue(code, challenge, nonce string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.codes[code] = mockAuthorization{challenge: challenge, nonce: nonce, subject: "user-123", email: "ada@example.com"}
+}
+
+func TestOIDCProvider(t *testing.T) {
+	mock := newMockOIDCProvider(t)
+	provider := NewOIDCProvider("mock", mock.server.URL, "client-1", "secret", "https://app.example.com/callback", mock.server.Client())
+	ctx := context.Background()
+
+	const verifier = "a-code-verifier-long-enough-for-pkce-0123456789"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", challenge)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	u, _ := url.Parse(authURL)
+	if q := u.Query(); q.Get("code_challenge") != challenge || q.Get("code_challenge_method") != "S256" || q.Get("nonce") != "nonce-1" {
+		t.Errorf("authorization URL missing PKCE or nonce: %s", authURL)
+	}
+
+	mock.issue("code-1", challenge, "nonce-1")
+	identity, err := provider.Exchange(ctx, "code-1", verifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if identity.Subject != "user-123" || identity.Email != "ada@example.com" || !identity.EmailVerified || identity.FirstName != "Ada" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	mock.issue("code-2", challenge, "nonce-2")
+	if _, err := provider.Exchange(ctx, "code-2", verifier, "nonce-1"); !errors.Is(err, ErrOIDCVerification) {
+		t.Errorf("nonce mismatch: err = %v", err)
+	}
+
+	mock.issue("code-3", challenge, "nonce-3")
+	if _, err := provider.Exchange(ctx, "code-3", "wrong-verifier", "nonce-3"); !errors.Is(err, ErrOIDCVerification) {
+		t.Errorf("wrong PKCE verifier: err = %v", err)
+	}
+}
+
+func TestMicrosoftProvider(t *testing.T) {
+	const tenantID = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	mock := newMockOIDCProvider(t)
+	// Entra ID's multi-tenant discovery document publishes an issuer template
+	mock.issuer = mock.server.URL + "/{tenantid}/v2.0"
+
+	edov := interface{}(true)
+	mock.idToken = func(claims jwt.MapClaims) {
+		// A v2.0 id_token with the email and xms_edov optional claims: no
+		// email_verified and no given or family name
+		delete(claims, "email_verified")
+		delete(claims, "given_name")
+		delete(claims, "family_name")
+		claims["iss"] = mock.server.URL + "/" + tenantID + "/v2.0"
+		claims["tid"] = tenantID
+		claims["ver"] = "2.0"
+		claims["oid"] = "00000000-0000-0000-66f3-3332eca7ea81"
+		claims["name"] = "Ada Lovelace"
+		claims["preferred_username"] = "ada@example.com"
+		claims["rh"] = "0.ARoAv4j5cvGGr0GRqy180BHbR1c1xu2p7JVEuYHdx1cSf9QaAAA."
+		claims["uti"] = "fqiBqXLPj0eQa82S-IYFAA"
+		claims["nbf"] = claims["iat"]
+		if edov != nil {
+			claims["xms_edov"] = edov
+		}
+	}
+
+	provider := NewMicrosoftProvider(mock.server.URL, "client-1", "secret", "https://app.example.com/callback", mock.server.Client())
+	ctx := context.Background()
+
+	const verifier = "a-code-verifier-long-enough-for-pkce-0123456789"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	tests := []struct {
+		name         string
+		edov         interface{}
+		wantVerified bool
+	}{
+		{"domain verified by the tenant", true, true},
+		{"domain verified, string claim", "1", true},
+		{"domain not verified", false, false},
+		{"no xms_edov claim", nil, false},
+	}
+	for i, tt := range tests {
+		edov = tt.edov
+		code, nonce := fmt.Sprintf("code-%d", i), fmt.Sprintf("nonce-%d", i)
+		mock.issue(code, challenge, nonce)
+
+		identity, err := provider.Exchange(ctx, code, verifier, nonce)
+		if err != nil {
+			t.Fatalf("%s: Exchange: %v", tt.name, err)
+		}
+		if identity.Email != "ada@example.com" || identity.EmailVerified != tt.wantVerified {
+			t.Errorf("%s: email %q verified = %v, want verified = %v", tt.name, identity.Email, identity.EmailVerified, tt.wantVerified)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/platform_rate_limiter.go
+// PURPOSE: Redis token buckets for social platform API quotas, shared by the
+//          API and worker replicas
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const PlatformRateLimitKeyPrefix = "ratelimit:platform:"
+
+// bucketConfig is the default quota for one endpoint class
+type bucketConfig struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Defaults used until a platform reports its own numbers via headers
+var platformBucketConfigs = map[string]map[string]bucketConfig{
+	"twitter": {
+		common.RateClassPublish: {Limit: 200, Window: 15 * time.Minute},
+		common.RateClassRead:    {Limit: 900, Window: 15 * time.Minute},
+	},
+	"facebook": {
+		common.RateClassPublish: {Limit: 200, Window: time.Hour},
+		common.RateClassRead:    {Limit: 200, Window: time.Hour},
+	},
+	"instagram": {
+		common.RateClassPublish: {Limit: 25, Window: 24 * time.Hour},
+		common.RateClassRead:    {Limit: 200, Window: time.Hour},
+	},
+	"linkedin": {
+		common.RateClassPublish: {Limit: 100, Window: 24 * time.Hour},
+		common.RateClassRead:    {Limit: 500, Window: 24 * time.Hour},
+	},
+}
+
+var defaultBucketConfig = bucketConfig{Limit: 60, Window: time.Minute}
+
+// Refill and take one token. A bucket blocked by an exhausted platform quota
+// stays empty until the reported reset, then refills completely.
+// Returns {allowed, remaining, retry_after_ms}.
+var tokenBucketTakeScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local capacity = tonumber(ARGV[2])
+local window = tonumber(ARGV[3])
+
+local b = redis.call("HMGET", KEYS[1], "tokens", "updated", "limit", "blocked_until")
+local limit = tonumber(b[3]) or capacity
+local tokens = tonumber(b[1]) or limit
+local updated = tonumber(b[2]) or now
+local blocked = tonumber(b[4]) or 0
+local rate = limit / window
+
+if blocked > now then
+	return {0, 0, blocked - now}
+end
+if blocked > 0 then
+	tokens = limit
+	updated = now
+	redis.call("HDEL", KEYS[1], "blocked_until", "reset_at")
+end
+
+tokens = math.min(limit, tokens + (now - updated) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", now, "limit", limit)
+redis.call("PEXPIRE", KEYS[1], window * 2)
+return {allowed, math.floor(tokens), retry}
+`)
+
+// Overwrite the bucket with what the platform reported
+var tokenBucketObserveScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local remaining = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local reset = tonumber(ARGV[4])
+local window = tonumber(ARGV[5])
+
+redis.call("HSET", KEYS[1], "tokens", remaining, "updated", now)
+if limit > 0 then
+	redis.call("HSET", KEYS[1], "limit", limit)
+end
+if reset > now then
+	redis.call("HSET", KEYS[1], "reset_at", reset)
+end
+if remaining <= 0 and reset > now then
+	redis.call("HSET", KEYS[1], "blocked_until", reset)
+else
+	redis.call("HDEL", KEYS[1], "blocked_until")
+end
+
+local ttl = window * 2
+if reset - now + window > ttl then
+	ttl = reset - now + window
+end
+redis.call("PEXPIRE", KEYS[1], ttl)
+return 1
+`)
+
+// PlatformRateLimiter implements common.PlatformRateLimiter with one Redis
+// token bucket per (platform, account, endpoint class)
+type PlatformRateLimiter struct {
+	client *redis.Client
+}
+
+// NewPlatformRateLimiter creates a Redis-backed platform rate limiter
+func NewPlatformRateLimiter(client *redis.Client) *PlatformRateLimiter {
+	return &PlatformRateLimiter{client: client}
+}
+
+// Take consumes one request from the bucket if available
+func (l *PlatformRateLimiter) Take(ctx context.Context, platform, accountID, class string) (common.RateLimitDecision, error) {
+	cfg := bucketConfigFor(platform, class)
+
+	res, err := tokenBucketTakeScript.Run(ctx, l.client,
+		[]string{bucketKey(platform, accountID, class)},
+		time.Now().UnixMilli(), cfg.Limit, cfg.Window.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return common.RateLimitDecision{}, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+
+	return common.RateLimitDecision{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+// Observe overrides the bucket with the platform's reported quota. A zero
+// limit keeps the current capacity; a zero resetAt means unknown.
+func (l *PlatformRateLimiter) Observe(ctx context.Context, platform, accountID, class string, remaining, limit int, resetAt time.Time) error {
+	cfg := bucketConfigFor(platform, class)
+
+	var reset int64
+	if !resetAt.IsZero() {
+		reset = resetAt.UnixMilli()
+	}
+
+	if err := tokenBucketObserveScript.Run(ctx, l.client,
+		[]string{bucketKey(platform, accountID, class)},
+		time.Now().UnixMilli(), remaining, limit, reset, cfg.Window.Milliseconds(),
+	).Err(); err != nil {
+		return fmt.Errorf("failed to record rate limit: %w", err)
+	}
+	return nil
+}
+
+// ObserveUsage overrides the bucket from the share of its budget a platform
+// reported as used. Such platforms don't disclose the budget, so the
+// configured limit stands for 100% and the tokens left are scaled from it.
+func (l *PlatformRateLimiter) ObserveUsage(ctx context.Context, platform, accountID, class string, usedPercent int, resetAt time.Time) error {
+	cfg := bucketConfigFor(platform, class)
+	usedPercent = min(max(usedPercent, 0), 100)
+	remaining := cfg.Limit * (100 - usedPercent) / 100
+
+	return l.Observe(ctx, platform, accountID, class, remaining, cfg.Limit, resetAt)
+}
+
+// Quota returns the current state of every endpoint class for an account
+func (l *PlatformRateLimiter) Quota(ctx context.Context, platform, accountID string) ([]common.RateLimitQuota, error) {
+	classes := []string{common.RateClassPublish, common.RateClassRead}
+	quotas := make([]common.RateLimitQuota, 0, len(classes))
+	now := time.Now()
+
+	for _, class := range classes {
+		cfg := bucketConfigFor(platform, class)
+
+		fields, err := l.client.HGetAll(ctx, bucketKey(platform, accountID, class)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rate limit: %w", err)
+		}
+
+		quotas = append(quotas, bucketQuota(class, cfg, fields, now))
+	}
+
+	return quotas, nil
+}
+
+// bucketQuota computes the refilled state of a bucket without modifying it
+func bucketQuota(class string, cfg bucketConfig, fields map[string]string, now time.Time) common.RateLimitQuota {
+	limit := cfg.Limit
+	if v, err := strconv.Atoi(fields["limit"]); err == nil && v > 0 {
+		limit = v
+	}
+
+	quota := common.RateLimitQuota{Class: class, Limit: limit, Remaining: limit}
+	if len(fields) == 0 {
+		return quota
+	}
+
+	nowMs := now.UnixMilli()
+	if blocked, err := strconv.ParseInt(fields["blocked_until"], 10, 64); err == nil && blocked > nowMs {
+		resetAt := time.UnixMilli(blocked).UTC()
+		quota.Remaining = 0
+		quota.ResetAt = &resetAt
+		return quota
+	}
+
+	tokens, err := strconv.ParseFloat(fields["tokens"], 64)
+	if err != nil {
+		return quota
+	}
+	updated, err := strconv.ParseInt(fields["updated"], 10, 64)
+	if err != nil {
+		updated = nowMs
+	}
+
+	rate := float64(limit) / float64(cfg.Window.Milliseconds())
+	tokens = math.Min(float64(limit), tokens+float64(nowMs-updated)*rate)
+	quota.Remaining = int(math.Floor(tokens))
+
+	if reset, err := strconv.ParseInt(fields["reset_at"], 10, 64); err == nil && reset > nowMs {
+		resetAt := time.UnixMilli(reset).UTC()
+		quota.ResetAt = &resetAt
+	} else if tokens < float64(limit) {
+		resetAt := now.Add(time.Duration((float64(limit)-tokens)/rate) * time.Millisecond).UTC()
+		quota.ResetAt = &resetAt
+	}
+
+	return quota
+}
+
+func bucketConfigFor(platform, class string) bucketConfig {
+	if classes, ok := platformBucketConfigs[platform]; ok {
+		if cfg, ok := classes[class]; ok {
+			return cfg
+		}
+	}
+	return defaultBucketConfig
+}
+
+func bucketKey(platform, accountID, class string) string {
+	return PlatformRateLimitKeyPrefix + platform + ":" + accountID + ":" + class
+}
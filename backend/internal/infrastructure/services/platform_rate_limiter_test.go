@@ -0,0 +1,146 @@
+// path: backend/internal/infrastructure/services/platform_rate_limiter_test.go
+package services
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+func TestBucketQuota(t *testing.T) {
+	now := time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC)
+	cfg := bucketConfig{Limit: 100, Window: 100 * time.Second} // 1 token/s
+	ms := func(t time.Time) string { return strconv.FormatInt(t.UnixMilli(), 10) }
+
+	t.Run("unused bucket is full", func(t *testing.T) {
+		q := bucketQuota("publish", cfg, map[string]string{}, now)
+		if q.Limit != 100 || q.Remaining != 100 || q.ResetAt != nil {
+			t.Fatalf("got %+v", q)
+		}
+	})
+
+	t.Run("refills since last update", func(t *testing.T) {
+		q := bucketQuota("publish", cfg, map[string]string{
+			"tokens":  "10",
+			"updated": ms(now.Add(-20 * time.Second)),
+		}, now)
+		if q.Remaining != 30 {
+			t.Fatalf("remaining = %d, want 30", q.Remaining)
+		}
+		if q.ResetAt == nil || !q.ResetAt.Equal(now.Add(70*time.Second)) {
+			t.Fatalf("resetAt = %v, want %v", q.ResetAt, now.Add(70*time.Second))
+		}
+	})
+
+	t.Run("blocked until platform reset", func(t *testing.T) {
+		reset := now.Add(5 * time.Minute)
+		q := bucketQuota("publish", cfg, map[string]string{
+			"tokens":        "0",
+			"updated":       ms(now.Add(-time.Hour)),
+			"limit":         "300",
+			"blocked_until": ms(reset),
+		}, now)
+		if q.Limit != 300 || q.Remaining != 0 {
+			t.Fatalf("got %+v", q)
+		}
+		if q.ResetAt == nil || !q.ResetAt.Equal(reset) {
+			t.Fatalf("resetAt = %v, want %v", q.ResetAt, reset)
+		}
+	})
+}
+
+func newTestRateLimiter(t *testing.T) (*PlatformRateLimiter, *redis.Client) {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewPlatformRateLimiter(client), client
+}
+
+// takeAt runs the take script as if at now
+func takeAt(t *testing.T, client *redis.Client, key string, cfg bucketConfig, now time.Time) (allowed bool, remaining, retryMs int64) {
+	t.Helper()
+	res, err := tokenBucketTakeScript.Run(context.Background(), client, []string{key},
+		now.UnixMilli(), cfg.Limit, cfg.Window.Milliseconds()).Int64Slice()
+	if err != nil {
+		t.Fatalf("take: %v", err)
+	}
+	return res[0] == 1, res[1], res[2]
+}
+
+func TestTokenBucketTakeScript(t *testing.T) {
+	_, client := newTestRateLimiter(t)
+	cfg := bucketConfig{Limit: 3, Window: 3 * time.Second} // 1 token/s
+	now := time.Date(2025, time.January, 15, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		if allowed, remaining, _ := takeAt(t, client, "bucket", cfg, now); !allowed || remaining != int64(2-i) {
+			t.Fatalf("take %d: allowed = %v, remaining = %d", i+1, allowed, remaining)
+		}
+	}
+
+	allowed, _, retryMs := takeAt(t, client, "bucket", cfg, now)
+	if allowed || retryMs != 1000 {
+		t.Fatalf("empty bucket: allowed = %v, retry = %dms, want denied for 1000ms", allowed, retryMs)
+	}
+
+	if allowed, _, _ := takeAt(t, client, "bucket", cfg, now.Add(time.Second)); !allowed {
+		t.Fatal("refilled token was not allowed")
+	}
+}
+
+func TestTokenBucketObserveScript(t *testing.T) {
+	ctx := context.Background()
+	limiter, client := newTestRateLimiter(t)
+	cfg := bucketConfigFor("twitter", common.RateClassPublish)
+	key := bucketKey("twitter", "acct", common.RateClassPublish)
+	reset := time.Now().Add(time.Hour)
+
+	if err := limiter.Observe(ctx, "twitter", "acct", common.RateClassPublish, 0, 300, reset); err != nil {
+		t.Fatalf("observe: %v", err)
+	}
+
+	allowed, _, retryMs := takeAt(t, client, key, cfg, reset.Add(-time.Minute))
+	if allowed || retryMs < time.Minute.Milliseconds()-1000 {
+		t.Fatalf("before reset: allowed = %v, retry = %dms, want blocked until reset", allowed, retryMs)
+	}
+
+	// The platform's reset refills the bucket to the reported limit
+	allowed, remaining, _ := takeAt(t, client, key, cfg, reset.Add(time.Second))
+	if !allowed || remaining != 299 {
+		t.Fatalf("after reset: allowed = %v, remaining = %d, want 299", allowed, remaining)
+	}
+}
+
+func TestObserveUsage(t *testing.T) {
+	ctx := context.Background()
+	limiter, _ := newTestRateLimiter(t)
+	cfg := bucketConfigFor("facebook", common.RateClassPublish)
+
+	// 90% of the undisclosed budget is used: a tenth of the configured one is left
+	if err := limiter.ObserveUsage(ctx, "facebook", "page", common.RateClassPublish, 90, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("observe usage: %v", err)
+	}
+	quotas, err := limiter.Quota(ctx, "facebook", "page")
+	if err != nil {
+		t.Fatalf("quota: %v", err)
+	}
+	if q := quotas[0]; q.Limit != cfg.Limit || q.Remaining != cfg.Limit/10 {
+		t.Fatalf("got %+v, want %d of %d left", q, cfg.Limit/10, cfg.Limit)
+	}
+
+	if err := limiter.ObserveUsage(ctx, "facebook", "page", common.RateClassPublish, 100, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("observe usage: %v", err)
+	}
+	decision, err := limiter.Take(ctx, "facebook", "page", common.RateClassPublish)
+	if err != nil {
+		t.Fatalf("take: %v", err)
+	}
+	if decision.Allowed || decision.RetryAfter < 59*time.Minute {
+		t.Fatalf("used up: got %+v, want blocked for the hour", decision)
+	}
+}
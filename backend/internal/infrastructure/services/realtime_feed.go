@@ -0,0 +1,284 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/realtime_feed.go
+// PURPOSE: Redis pub/sub fan-out of realtime events to API replicas, with a
+//          short per-team stream for resuming clients
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	RealtimeKeyPrefix = "realtime:team:"
+
+	// Approximate number of events each team's history keeps, and how long
+	// a team's history outlives its last event
+	realtimeHistoryLen = 500
+	realtimeHistoryTTL = time.Hour
+
+	// realtimeBuffer is how many events a subscriber may fall behind before
+	// it is dropped. A dropped client resumes from its Last-Event-ID.
+	realtimeBuffer = 256
+)
+
+// RedisRealtimeFeed implements common.RealtimeFeed. Events are appended to
+// a capped stream per team and published on the team's channel; each
+// replica holds one pattern subscription and hands events to its local
+// subscribers.
+type RedisRealtimeFeed struct {
+	client *redis.Client
+	hub    *realtimeHub
+	start  sync.Once
+	logger common.Logger
+}
+
+func NewRedisRealtimeFeed(client *redis.Client, logger common.Logger) *RedisRealtimeFeed {
+	return &RedisRealtimeFeed{client: client, hub: newRealtimeHub(), logger: logger}
+}
+
+func (f *RedisRealtimeFeed) Publish(ctx context.Context, e *common.RealtimeEvent) error {
+	key := RealtimeKeyPrefix + e.TeamID
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode realtime event: %w", err)
+	}
+	id, err := f.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: realtimeHistoryLen,
+		Approx: true,
+		Values: map[string]interface{}{"event": body},
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to append realtime event: %w", err)
+	}
+
+	e.ID = id
+	if body, err = json.Marshal(e); err != nil {
+		return fmt.Errorf("failed to encode realtime event: %w", err)
+	}
+
+	pipe := f.client.Pipeline()
+	pipe.Expire(ctx, key, realtimeHistoryTTL)
+	pipe.Publish(ctx, key, body)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to publish realtime event: %w", err)
+	}
+	return nil
+}
+
+func (f *RedisRealtimeFeed) Subscribe(ctx context.Context, teamID, lastEventID string) (<-chan *common.RealtimeEvent, error) {
+	f.start.Do(func() { go f.listen() })
+
+	// Live events are buffered from now on, so none are lost while the
+	// history is replayed
+	live, cancel := f.hub.add(teamID)
+
+	var replay []*common.RealtimeEvent
+	if lastEventID != "" {
+		var err error
+		if replay, err = f.history(ctx, teamID, lastEventID); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+
+	out := make(chan *common.RealtimeEvent, realtimeBuffer)
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		last := lastEventID
+		for _, e := range replay {
+			select {
+			case out <- e:
+				if e.ID != "" {
+					last = e.ID
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case e, ok := <-live:
+				if !ok {
+					return
+				}
+				if last != "" && !streamIDAfter(e.ID, last) {
+					continue // already replayed
+				}
+				select {
+				case out <- e:
+				default:
+					return // too far behind
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// history returns the team's events after lastEventID, starting with a
+// reset event if some were dropped from the history
+func (f *RedisRealtimeFeed) history(ctx context.Context, teamID, lastEventID string) ([]*common.RealtimeEvent, error) {
+	key := RealtimeKeyPrefix + teamID
+	reset := &common.RealtimeEvent{Type: common.RealtimeResetEvent, TeamID: teamID, Data: json.RawMessage(`{}`), At: time.Now().UTC()}
+
+	if _, _, ok := parseStreamID(lastEventID); !ok {
+		return []*common.RealtimeEvent{reset}, nil
+	}
+
+	oldest, err := f.client.XRangeN(ctx, key, "-", "+", 1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read realtime history: %w", err)
+	}
+	if len(oldest) == 0 || streamIDAfter(oldest[0].ID, lastEventID) {
+		return []*common.RealtimeEvent{reset}, nil
+	}
+
+	entries, err := f.client.XRange(ctx, key, "("+lastEventID, "+").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read realtime history: %w", err)
+	}
+
+	events := make([]*common.RealtimeEvent, 0, len(entries))
+	for _, entry := range entries {
+		e, err := decodeRealtimeEntry(entry)
+		if err != nil {
+			f.logger.Warn("Skipping malformed realtime event", "entryId", entry.ID, "error", err)
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// listen hands every published event to the local subscribers of its
+// team. The subscription reconnects by itself after Redis errors.
+func (f *RedisRealtimeFeed) listen() {
+	ctx := context.Background()
+	pubsub := f.client.PSubscribe(ctx, RealtimeKeyPrefix+"*")
+	defer pubsub.Close()
+
+	f.logger.Info("Realtime feed subscribed", "pattern", RealtimeKeyPrefix+"*")
+	for msg := range pubsub.Channel() {
+		var e common.RealtimeEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+			f.logger.Warn("Dropping malformed realtime event", "channel", msg.Channel, "error", err)
+			continue
+		}
+		f.hub.broadcast(&e)
+	}
+}
+
+func decodeRealtimeEntry(entry redis.XMessage) (*common.RealtimeEvent, error) {
+	raw, ok := entry.Values["event"].(string)
+	if !ok {
+		return nil, errors.New("missing event")
+	}
+	var e common.RealtimeEvent
+	if err := json.Unmarshal([]byte(raw), &e); err != nil {
+		return nil, err
+	}
+	e.ID = entry.ID
+	return &e, nil
+}
+
+// realtimeHub tracks the subscribers of this replica by team
+type realtimeHub struct {
+	mu   sync.RWMutex
+	subs map[string]map[chan *common.RealtimeEvent]struct{}
+}
+
+func newRealtimeHub() *realtimeHub {
+	return &realtimeHub{subs: make(map[string]map[chan *common.RealtimeEvent]struct{})}
+}
+
+// add registers a subscriber of a team. The returned func removes it.
+func (h *realtimeHub) add(teamID string) (chan *common.RealtimeEvent, func()) {
+	ch := make(chan *common.RealtimeEvent, realtimeBuffer)
+
+	h.mu.Lock()
+	if h.subs[teamID] == nil {
+		h.subs[teamID] = make(map[chan *common.RealtimeEvent]struct{})
+	}
+	h.subs[teamID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if _, ok := h.subs[teamID][ch]; ok {
+				delete(h.subs[teamID], ch)
+				close(ch)
+			}
+			if len(h.subs[teamID]) == 0 {
+				delete(h.subs, teamID)
+			}
+		})
+	}
+}
+
+// broadcast sends an event to the team's subscribers. A subscriber whose
+// buffer is full is closed rather than blocking the others.
+func (h *realtimeHub) broadcast(e *common.RealtimeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[e.TeamID] {
+		select {
+		case ch <- e:
+		default:
+			delete(h.subs[e.TeamID], ch)
+			close(ch)
+		}
+	}
+	if len(h.subs[e.TeamID]) == 0 {
+		delete(h.subs, e.TeamID)
+	}
+}
+
+// streamIDAfter reports whether stream entry ID a comes after b
+func streamIDAfter(a, b string) bool {
+	aMs, aSeq, okA := parseStreamID(a)
+	bMs, bSeq, okB := parseStreamID(b)
+	if !okA || !okB {
+		return okA
+	}
+	return aMs > bMs || (aMs == bMs && aSeq > bSeq)
+}
+
+// parseStreamID splits a Redis stream entry ID "<ms>-<seq>"
+func parseStreamID(id string) (ms, seq uint64, ok bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
@@ -0,0 +1,57 @@
+// path: backend/internal/infrastructure/services/realtime_feed_test.go
+package services
+
+import (
+	"testing"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+func TestStreamIDAfter(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1700000000001-0", "1700000000000-5", true},
+		{"1700000000000-6", "1700000000000-5", true},
+		{"1700000000000-5", "1700000000000-5", false},
+		{"1700000000000-10", "1700000000000-9", true}, // numeric, not lexical
+		{"999-0", "1000-0", false},
+		{"1000-0", "garbage", true},
+		{"garbage", "1000-0", false},
+	}
+	for _, tt := range tests {
+		if got := streamIDAfter(tt.a, tt.b); got != tt.want {
+			t.Errorf("streamIDAfter(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestRealtimeHubDropsSlowSubscribers(t *testing.T) {
+	hub := newRealtimeHub()
+	slow, cancelSlow := hub.add("team-a")
+	other, cancelOther := hub.add("team-b")
+	defer cancelSlow()
+	defer cancelOther()
+
+	for i := 0; i < realtimeBuffer; i++ {
+		hub.broadcast(&common.RealtimeEvent{TeamID: "team-a"})
+	}
+	if len(slow) != realtimeBuffer {
+		t.Fatalf("buffered %d events, want %d", len(slow), realtimeBuffer)
+	}
+	if len(other) != 0 {
+		t.Fatalf("other team received %d events", len(other))
+	}
+
+	// One more than the buffer holds closes the subscriber
+	hub.broadcast(&common.RealtimeEvent{TeamID: "team-a"})
+	for range slow {
+	}
+	if _, ok := hub.subs["team-a"]; ok {
+		t.Error("slow subscriber still registered")
+	}
+
+	// Canceling after the hub dropped the subscriber is harmless
+	cancelSlow()
+}
@@ -0,0 +1,726 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/saml.go
+// PURPOSE: SAML 2.0 service provider (HTTP-Redirect requests, signed
+//          HTTP-POST responses) with XML signature verification
+// ============================================================================
+
+package services
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha256" // registers SHA-256 for crypto.Hash
+	_ "crypto/sha512" // registers SHA-512 for crypto.Hash
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+var (
+	// ErrSAMLVerification is returned when an IdP response cannot be trusted
+	ErrSAMLVerification = errors.New("saml response verification failed")
+
+	// ErrSAMLMetadata is returned for unusable IdP metadata or certificates
+	ErrSAMLMetadata = errors.New("invalid saml identity provider metadata")
+)
+
+const (
+	samlProtocolNS  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	samlAssertionNS = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlMetadataNS  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	xmlDSigNS       = "http://www.w3.org/2000/09/xmldsig#"
+	xmlNamespace    = "http://www.w3.org/XML/1998/namespace"
+
+	samlBindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	samlBindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlStatusSuccess   = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlBearer          = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlNameIDEmail     = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+
+	excC14NAlgorithm            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	envelopedSignatureAlgorithm = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+
+	samlClockSkew = 2 * time.Minute
+)
+
+var samlSignatureHashes = map[string]crypto.Hash{
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256": crypto.SHA256,
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha512": crypto.SHA512,
+}
+
+var samlDigestHashes = map[string]crypto.Hash{
+	"http://www.w3.org/2001/04/xmlenc#sha256": crypto.SHA256,
+	"http://www.w3.org/2001/04/xmlenc#sha512": crypto.SHA512,
+}
+
+// SAMLServiceProvider serves every team from one base URL: the team's
+// entity ID is {baseURL}/{teamID}/metadata and its ACS is {baseURL}/{teamID}/acs
+type SAMLServiceProvider struct {
+	baseURL string
+	now     func() time.Time
+}
+
+func NewSAMLServiceProvider(baseURL string) *SAMLServiceProvider {
+	return &SAMLServiceProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		now:     time.Now,
+	}
+}
+
+// EntityID returns the SP entity ID for a team
+func (p *SAMLServiceProvider) EntityID(teamID string) string {
+	return p.baseURL + "/" + teamID + "/metadata"
+}
+
+// ACSURL returns the assertion consumer service URL for a team
+func (p *SAMLServiceProvider) ACSURL(teamID string) string {
+	return p.baseURL + "/" + teamID + "/acs"
+}
+
+func (p *SAMLServiceProvider) Metadata(teamID string) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	fmt.Fprintf(&b, `<md:EntityDescriptor xmlns:md="%s" entityID="%s">`, samlMetadataNS, xmlEscape(p.EntityID(teamID)))
+	fmt.Fprintf(&b, `<md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="%s">`, samlProtocolNS)
+	fmt.Fprintf(&b, `<md:NameIDFormat>%s</md:NameIDFormat>`, samlNameIDEmail)
+	fmt.Fprintf(&b, `<md:AssertionConsumerService Binding="%s" Location="%s" index="0" isDefault="true"/>`, samlBindingPOST, xmlEscape(p.ACSURL(teamID)))
+	b.WriteString(`</md:SPSSODescriptor></md:EntityDescriptor>`)
+	return b.Bytes(), nil
+}
+
+type samlEntityDescriptor struct {
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptor  *samlIDPSSODescriptor  `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+	EntityDescriptors []samlEntityDescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors []struct {
+		Use          string   `xml:"use,attr"`
+		Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+	SingleSignOnServices []struct {
+		Binding  string `xml:"Binding,attr"`
+		Location string `xml:"Location,attr"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+func (p *SAMLServiceProvider) ParseIdPMetadata(metadata []byte) (*common.SAMLIdentityProvider, error) {
+	var doc samlEntityDescriptor
+	if err := xml.Unmarshal(metadata, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSAMLMetadata, err)
+	}
+
+	// An EntitiesDescriptor may wrap several entities; use the first IdP
+	entity := &doc
+	for i := range doc.EntityDescriptors {
+		if entity.IDPSSODescriptor != nil {
+			break
+		}
+		entity = &doc.EntityDescriptors[i]
+	}
+	if entity.IDPSSODescriptor == nil {
+		return nil, fmt.Errorf("%w: no IDPSSODescriptor", ErrSAMLMetadata)
+	}
+
+	idp := &common.SAMLIdentityProvider{EntityID: strings.TrimSpace(entity.EntityID)}
+	for _, s := range entity.IDPSSODescriptor.SingleSignOnServices {
+		if s.Binding == samlBindingRedirect {
+			idp.SSOURL = s.Location
+			break
+		}
+	}
+	for _, kd := range entity.IDPSSODescriptor.KeyDescriptors {
+		if kd.Use != "" && kd.Use != "signing" {
+			continue
+		}
+		for _, c := range kd.Certificates {
+			cert, err := p.NormalizeCertificate(c)
+			if err != nil {
+				return nil, err
+			}
+			idp.Certificates = append(idp.Certificates, cert)
+		}
+	}
+
+	switch {
+	case idp.EntityID == "":
+		return nil, fmt.Errorf("%w: missing entityID", ErrSAMLMetadata)
+	case idp.SSOURL == "":
+		return nil, fmt.Errorf("%w: no HTTP-Redirect SingleSignOnService", ErrSAMLMetadata)
+	case len(idp.Certificates) == 0:
+		return nil, fmt.Errorf("%w: no signing certificate", ErrSAMLMetadata)
+	}
+	return idp, nil
+}
+
+func (p *SAMLServiceProvider) NormalizeCertificate(certificate string) (string, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(strings.TrimSpace(certificate))); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(stripSpace(certificate))
+		if err != nil {
+			return "", fmt.Errorf("%w: certificate is not PEM or base64", ErrSAMLMetadata)
+		}
+		der = decoded
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrSAMLMetadata, err)
+	}
+	if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+		return "", fmt.Errorf("%w: only RSA signing certificates are supported", ErrSAMLMetadata)
+	}
+	return base64.StdEncoding.EncodeToString(der), nil
+}
+
+func (p *SAMLServiceProvider) AuthnRequestURL(idp *common.SAMLIdentityProvider, teamID, relayState string) (string, string, error) {
+	id, err := samlID()
+	if err != nil {
+		return "", "", err
+	}
+
+	request := fmt.Sprintf(
+		`<samlp:AuthnRequest xmlns:samlp="%s" xmlns:saml="%s" ID="%s" Version="2.0" IssueInstant="%s" Destination="%s" AssertionConsumerServiceURL="%s" ProtocolBinding="%s">`+
+			`<saml:Issuer>%s</saml:Issuer><samlp:NameIDPolicy AllowCreate="true"/></samlp:AuthnRequest>`,
+		samlProtocolNS, samlAssertionNS, id,
+		p.now().UTC().Format(time.RFC3339),
+		xmlEscape(idp.SSOURL), xmlEscape(p.ACSURL(teamID)), samlBindingPOST,
+		xmlEscape(p.EntityID(teamID)),
+	)
+
+	// HTTP-Redirect binding: raw DEFLATE, then base64
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := w.Write([]byte(request)); err != nil {
+		return "", "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", "", err
+	}
+
+	params := url.Values{"SAMLRequest": {base64.StdEncoding.EncodeToString(buf.Bytes())}}
+	if relayState != "" {
+		params.Set("RelayState", relayState)
+	}
+	return appendQuery(idp.SSOURL, params), id, nil
+}
+
+// ParseResponse verifies a response posted to a team's ACS. Either the
+// response or its single assertion must be signed by one of the configured
+// certificates; certificates in the document's KeyInfo are ignored.
+func (p *SAMLServiceProvider) ParseResponse(idp *common.SAMLIdentityProvider, teamID, samlResponse string) (*common.SAMLAssertion, error) {
+	raw, err := base64.StdEncoding.DecodeString(stripSpace(samlResponse))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid encoding", ErrSAMLVerification)
+	}
+	root, err := parseXMLDocument(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSAMLVerification, err)
+	}
+	if root.namespace() != samlProtocolNS || root.local != "Response" {
+		return nil, fmt.Errorf("%w: not a SAML response", ErrSAMLVerification)
+	}
+	// Duplicate IDs are how signature wrapping attacks point a valid
+	// reference at one element while the consumer reads another
+	if err := checkUniqueIDs(root, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	certs := make([]*x509.Certificate, 0, len(idp.Certificates))
+	for _, c := range idp.Certificates {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid configured certificate", ErrSAMLMetadata)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSAMLMetadata, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	acs := p.ACSURL(teamID)
+	if d := root.attr("Destination"); d != "" && d != acs {
+		return nil, fmt.Errorf("%w: wrong destination", ErrSAMLVerification)
+	}
+	if status := root.child(samlProtocolNS, "Status").child(samlProtocolNS, "StatusCode").attr("Value"); status != samlStatusSuccess {
+		return nil, fmt.Errorf("%w: identity provider returned status %s", ErrSAMLVerification, status)
+	}
+	if root.child(samlAssertionNS, "EncryptedAssertion") != nil {
+		return nil, fmt.Errorf("%w: encrypted assertions are not supported", ErrSAMLVerification)
+	}
+	assertions := root.children(samlAssertionNS, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one assertion", ErrSAMLVerification)
+	}
+	assertion := assertions[0]
+
+	if err := verifyEnvelopedSignature(assertion, certs); err != nil {
+		if verifyEnvelopedSignature(root, certs) != nil {
+			return nil, err
+		}
+	}
+
+	now := p.now()
+	if issuer := strings.TrimSpace(assertion.child(samlAssertionNS, "Issuer").text()); issuer != idp.EntityID {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrSAMLVerification)
+	}
+
+	subject := assertion.child(samlAssertionNS, "Subject")
+	result := &common.SAMLAssertion{
+		NameID:       strings.TrimSpace(subject.child(samlAssertionNS, "NameID").text()),
+		SessionIndex: assertion.child(samlAssertionNS, "AuthnStatement").attr("SessionIndex"),
+		Attributes:   map[string][]string{},
+	}
+	if result.NameID == "" {
+		return nil, fmt.Errorf("%w: missing NameID", ErrSAMLVerification)
+	}
+
+	confirmed := false
+	for _, sc := range subject.children(samlAssertionNS, "SubjectConfirmation") {
+		data := sc.child(samlAssertionNS, "SubjectConfirmationData")
+		if sc.attr("Method") != samlBearer || data.attr("Recipient") != acs || !samlNotExpired(data.attr("NotOnOrAfter"), now) {
+			continue
+		}
+		result.InResponseTo = data.attr("InResponseTo")
+		confirmed = true
+		break
+	}
+	if !confirmed {
+		return nil, fmt.Errorf("%w: no valid bearer subject confirmation", ErrSAMLVerification)
+	}
+	if r := root.attr("InResponseTo"); r != "" && r != result.InResponseTo {
+		return nil, fmt.Errorf("%w: InResponseTo mismatch", ErrSAMLVerification)
+	}
+
+	conditions := assertion.child(samlAssertionNS, "Conditions")
+	if conditions == nil {
+		return nil, fmt.Errorf("%w: missing conditions", ErrSAMLVerification)
+	}
+	if nb := conditions.attr("NotBefore"); nb != "" {
+		t, err := time.Parse(time.RFC3339, nb)
+		if err != nil || now.Add(samlClockSkew).Before(t) {
+			return nil, fmt.Errorf("%w: assertion not yet valid", ErrSAMLVerification)
+		}
+	}
+	if na := conditions.attr("NotOnOrAfter"); na != "" && !samlNotExpired(na, now) {
+		return nil, fmt.Errorf("%w: assertion expired", ErrSAMLVerification)
+	}
+	restrictions := conditions.children(samlAssertionNS, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, fmt.Errorf("%w: missing audience restriction", ErrSAMLVerification)
+	}
+	for _, r := range restrictions {
+		if !slices.ContainsFunc(r.children(samlAssertionNS, "Audience"), func(a *xmlElement) bool {
+			return strings.TrimSpace(a.text()) == p.EntityID(teamID)
+		}) {
+			return nil, fmt.Errorf("%w: wrong audience", ErrSAMLVerification)
+		}
+	}
+
+	for _, stmt := range assertion.children(samlAssertionNS, "AttributeStatement") {
+		for _, attr := range stmt.children(samlAssertionNS, "Attribute") {
+			var values []string
+			for _, v := range attr.children(samlAssertionNS, "AttributeValue") {
+				values = append(values, strings.TrimSpace(v.text()))
+			}
+			for _, name := range []string{attr.attr("Name"), attr.attr("FriendlyName")} {
+				if name != "" {
+					result.Attributes[name] = append(result.Attributes[name], values...)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// verifyEnvelopedSignature checks the ds:Signature that is a direct child of
+// el and references el itself
+func verifyEnvelopedSignature(el *xmlElement, certs []*x509.Certificate) error {
+	sig := el.child(xmlDSigNS, "Signature")
+	signedInfo := sig.child(xmlDSigNS, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: %s is not signed", ErrSAMLVerification, el.local)
+	}
+
+	c14nMethod := signedInfo.child(xmlDSigNS, "CanonicalizationMethod")
+	if c14nMethod.attr("Algorithm") != excC14NAlgorithm {
+		return fmt.Errorf("%w: unsupported canonicalization", ErrSAMLVerification)
+	}
+	signatureHash, ok := samlSignatureHashes[signedInfo.child(xmlDSigNS, "SignatureMethod").attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported signature method", ErrSAMLVerification)
+	}
+
+	refs := signedInfo.children(xmlDSigNS, "Reference")
+	if len(refs) != 1 {
+		return fmt.Errorf("%w: expected exactly one reference", ErrSAMLVerification)
+	}
+	ref := refs[0]
+	if id := el.attr("ID"); id == "" || ref.attr("URI") != "#"+id {
+		return fmt.Errorf("%w: signature does not reference the signed element", ErrSAMLVerification)
+	}
+
+	var inclusive []string
+	enveloped := false
+	for _, t := range ref.child(xmlDSigNS, "Transforms").children(xmlDSigNS, "Transform") {
+		switch t.attr("Algorithm") {
+		case envelopedSignatureAlgorithm:
+			enveloped = true
+		case excC14NAlgorithm:
+			inclusive = inclusivePrefixes(t)
+		default:
+			return fmt.Errorf("%w: unsupported transform", ErrSAMLVerification)
+		}
+	}
+	if !enveloped {
+		return fmt.Errorf("%w: missing enveloped signature transform", ErrSAMLVerification)
+	}
+
+	digestHash, ok := samlDigestHashes[ref.child(xmlDSigNS, "DigestMethod").attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported digest method", ErrSAMLVerification)
+	}
+	wantDigest, err := base64.StdEncoding.DecodeString(stripSpace(ref.child(xmlDSigNS, "DigestValue").text()))
+	if err != nil {
+		return fmt.Errorf("%w: invalid digest value", ErrSAMLVerification)
+	}
+	h := digestHash.New()
+	h.Write(canonicalize(el, inclusive, sig))
+	if !bytes.Equal(h.Sum(nil), wantDigest) {
+		return fmt.Errorf("%w: digest mismatch", ErrSAMLVerification)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(stripSpace(sig.child(xmlDSigNS, "SignatureValue").text()))
+	if err != nil {
+		return fmt.Errorf("%w: invalid signature value", ErrSAMLVerification)
+	}
+	h = signatureHash.New()
+	h.Write(canonicalize(signedInfo, inclusivePrefixes(c14nMethod), nil))
+	sum := h.Sum(nil)
+	for _, cert := range certs {
+		if pub, ok := cert.PublicKey.(*rsa.PublicKey); ok && rsa.VerifyPKCS1v15(pub, signatureHash, sum, signature) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: bad signature", ErrSAMLVerification)
+}
+
+func inclusivePrefixes(transform *xmlElement) []string {
+	return strings.Fields(transform.child(excC14NAlgorithm, "InclusiveNamespaces").attr("PrefixList"))
+}
+
+func checkUniqueIDs(el *xmlElement, seen map[string]bool) error {
+	if id := el.attr("ID"); id != "" {
+		if seen[id] {
+			return fmt.Errorf("%w: duplicate ID %q", ErrSAMLVerification, id)
+		}
+		seen[id] = true
+	}
+	for _, c := range el.content {
+		if c.element != nil {
+			if err := checkUniqueIDs(c.element, seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func samlNotExpired(notOnOrAfter string, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, notOnOrAfter)
+	return err == nil && now.Add(-samlClockSkew).Before(t)
+}
+
+func samlID() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate request id: %w", err)
+	}
+	// IDs are xs:ID and must not start with a digit
+	return "_" + hex.EncodeToString(b), nil
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func stripSpace(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+// ============================================================================
+// XML TREE AND EXCLUSIVE CANONICALIZATION
+// ============================================================================
+
+// xmlElement is a minimal DOM that keeps namespace prefixes, which
+// encoding/xml's namespace-resolving decoder discards but canonicalization
+// needs
+type xmlElement struct {
+	prefix  string
+	local   string
+	attrs   []xmlAttr         // excluding namespace declarations
+	scope   map[string]string // in-scope namespaces; "" is the default
+	content []xmlContent
+	parent  *xmlElement
+}
+
+type xmlAttr struct {
+	prefix string
+	local  string
+	value  string
+}
+
+// xmlContent is either a child element or character data
+type xmlContent struct {
+	element *xmlElement
+	text    string
+}
+
+func parseXMLDocument(data []byte) (*xmlElement, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var root, cur *xmlElement
+
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if cur == nil && root != nil {
+				return nil, errors.New("multiple root elements")
+			}
+			el := &xmlElement{
+				prefix: t.Name.Space,
+				local:  t.Name.Local,
+				scope:  map[string]string{"xml": xmlNamespace},
+				parent: cur,
+			}
+			if cur != nil {
+				maps.Copy(el.scope, cur.scope)
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					el.scope[""] = a.Value
+				case a.Name.Space == "xmlns":
+					el.scope[a.Name.Local] = a.Value
+				default:
+					el.attrs = append(el.attrs, xmlAttr{prefix: a.Name.Space, local: a.Name.Local, value: a.Value})
+				}
+			}
+			if _, ok := el.scope[el.prefix]; el.prefix != "" && !ok {
+				return nil, fmt.Errorf("undeclared namespace prefix %q", el.prefix)
+			}
+			if cur == nil {
+				root = el
+			} else {
+				cur.content = append(cur.content, xmlContent{element: el})
+			}
+			cur = el
+
+		case xml.EndElement:
+			if cur == nil || t.Name.Space != cur.prefix || t.Name.Local != cur.local {
+				return nil, errors.New("mismatched end element")
+			}
+			cur = cur.parent
+
+		case xml.CharData:
+			if cur != nil {
+				cur.content = append(cur.content, xmlContent{text: string(t)})
+			}
+
+		case xml.Directive:
+			return nil, errors.New("document type declarations are not allowed")
+		}
+	}
+
+	if root == nil || cur != nil {
+		return nil, errors.New("incomplete document")
+	}
+	return root, nil
+}
+
+func (e *xmlElement) namespace() string {
+	return e.scope[e.prefix]
+}
+
+func (e *xmlElement) attrNamespace(a xmlAttr) string {
+	if a.prefix == "" {
+		return ""
+	}
+	return e.scope[a.prefix]
+}
+
+// attr returns an unqualified attribute; nil elements have no attributes
+func (e *xmlElement) attr(local string) string {
+	if e == nil {
+		return ""
+	}
+	for _, a := range e.attrs {
+		if a.prefix == "" && a.local == local {
+			return a.value
+		}
+	}
+	return ""
+}
+
+func (e *xmlElement) children(namespace, local string) []*xmlElement {
+	if e == nil {
+		return nil
+	}
+	var out []*xmlElement
+	for _, c := range e.content {
+		if c.element != nil && c.element.local == local && c.element.namespace() == namespace {
+			out = append(out, c.element)
+		}
+	}
+	return out
+}
+
+func (e *xmlElement) child(namespace, local string) *xmlElement {
+	if found := e.children(namespace, local); len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+func (e *xmlElement) text() string {
+	if e == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, c := range e.content {
+		if c.element == nil {
+			b.WriteString(c.text)
+		}
+	}
+	return b.String()
+}
+
+func (e *xmlElement) qualifiedName() string {
+	if e.prefix == "" {
+		return e.local
+	}
+	return e.prefix + ":" + e.local
+}
+
+// canonicalize returns the Exclusive XML Canonicalization (without
+// comments) of el. inclusive lists prefixes handled as in inclusive
+// canonicalization ("#default" for the default namespace). skip is left
+// out of the output, which implements the enveloped signature transform.
+func canonicalize(el *xmlElement, inclusive []string, skip *xmlElement) []byte {
+	var b bytes.Buffer
+	canonicalizeElement(&b, el, map[string]string{}, inclusive, skip)
+	return b.Bytes()
+}
+
+func canonicalizeElement(b *bytes.Buffer, el *xmlElement, rendered map[string]string, inclusive []string, skip *xmlElement) {
+	// Namespaces visibly used by the element and its attributes, plus the
+	// inclusive ones in scope
+	prefixes := []string{el.prefix}
+	for _, a := range el.attrs {
+		if a.prefix != "" && a.prefix != "xml" {
+			prefixes = append(prefixes, a.prefix)
+		}
+	}
+	for _, p := range inclusive {
+		if p == "#default" {
+			p = ""
+		}
+		if _, ok := el.scope[p]; ok {
+			prefixes = append(prefixes, p)
+		}
+	}
+
+	var decls []xmlAttr
+	next := rendered
+	for _, p := range prefixes {
+		if slices.ContainsFunc(decls, func(d xmlAttr) bool { return d.local == p }) {
+			continue
+		}
+		uri := el.scope[p]
+		prev, ok := rendered[p]
+		if (ok && prev == uri) || (!ok && p == "" && uri == "") {
+			continue
+		}
+		if len(decls) == 0 {
+			next = maps.Clone(rendered)
+		}
+		next[p] = uri
+		decls = append(decls, xmlAttr{local: p, value: uri})
+	}
+	slices.SortFunc(decls, func(a, b xmlAttr) int { return strings.Compare(a.local, b.local) })
+
+	attrs := slices.Clone(el.attrs)
+	slices.SortFunc(attrs, func(a, b xmlAttr) int {
+		if c := strings.Compare(el.attrNamespace(a), el.attrNamespace(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.local, b.local)
+	})
+
+	b.WriteString("<" + el.qualifiedName())
+	for _, d := range decls {
+		if d.local == "" {
+			b.WriteString(` xmlns="`)
+		} else {
+			b.WriteString(` xmlns:` + d.local + `="`)
+		}
+		b.WriteString(escapeC14NAttr(d.value) + `"`)
+	}
+	for _, a := range attrs {
+		name := a.local
+		if a.prefix != "" {
+			name = a.prefix + ":" + a.local
+		}
+		b.WriteString(" " + name + `="` + escapeC14NAttr(a.value) + `"`)
+	}
+	b.WriteString(">")
+
+	for _, c := range el.content {
+		switch {
+		case c.element == nil:
+			b.WriteString(escapeC14NText(c.text))
+		case c.element != skip:
+			canonicalizeElement(b, c.element, next, inclusive, skip)
+		}
+	}
+	b.WriteString("</" + el.qualifiedName() + ">")
+}
+
+var (
+	c14nTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	c14nAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeC14NText(s string) string { return c14nTextEscaper.Replace(s) }
+func escapeC14NAttr(s string) string { return c14nAttrEscaper.Replace(s) }
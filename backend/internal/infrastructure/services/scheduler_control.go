@@ -0,0 +1,153 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/scheduler_control.go
+// PURPOSE: Redis-backed job registry, run history and manual triggers shared
+//          by the worker scheduler and the admin API
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	SchedulerTriggerJobType  = "scheduler_trigger"
+	SchedulerJobsKey         = "scheduler:jobs"
+	SchedulerStatusKeyPrefix = "scheduler:status:"
+)
+
+// SchedulerControl implements common.JobScheduler
+type SchedulerControl struct {
+	client *redis.Client
+	queue  *WorkerQueueService
+	logger common.Logger
+}
+
+// NewSchedulerControl creates a new scheduler control service
+func NewSchedulerControl(client *redis.Client, queue *WorkerQueueService, logger common.Logger) *SchedulerControl {
+	return &SchedulerControl{
+		client: client,
+		queue:  queue,
+		logger: logger,
+	}
+}
+
+// RegisterJob records a job definition so it can be listed and triggered
+func (c *SchedulerControl) RegisterJob(ctx context.Context, name, schedule string, singleton bool, nextRun time.Time) error {
+	pipe := c.client.TxPipeline()
+	pipe.SAdd(ctx, SchedulerJobsKey, name)
+	pipe.HSet(ctx, SchedulerStatusKeyPrefix+name, map[string]interface{}{
+		"schedule":  schedule,
+		"singleton": strconv.FormatBool(singleton),
+		"next_run":  nextRun.Unix(),
+	})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to register job %s: %w", name, err)
+	}
+	return nil
+}
+
+// MarkRunning flags a job as currently executing
+func (c *SchedulerControl) MarkRunning(ctx context.Context, name string, running bool) error {
+	if err := c.client.HSet(ctx, SchedulerStatusKeyPrefix+name, "running", strconv.FormatBool(running)).Err(); err != nil {
+		return fmt.Errorf("failed to update job %s: %w", name, err)
+	}
+	return nil
+}
+
+// RecordRun stores the outcome of a job execution
+func (c *SchedulerControl) RecordRun(ctx context.Context, name string, startedAt time.Time, duration time.Duration, runErr error) error {
+	lastError := ""
+	if runErr != nil {
+		lastError = runErr.Error()
+	}
+
+	if err := c.client.HSet(ctx, SchedulerStatusKeyPrefix+name, map[string]interface{}{
+		"running":       "false",
+		"last_run":      startedAt.Unix(),
+		"last_duration": duration.Round(time.Millisecond).String(),
+		"last_error":    lastError,
+	}).Err(); err != nil {
+		return fmt.Errorf("failed to record run for job %s: %w", name, err)
+	}
+	return nil
+}
+
+// RecordNextRun stores the next planned activation of a job
+func (c *SchedulerControl) RecordNextRun(ctx context.Context, name string, nextRun time.Time) error {
+	if err := c.client.HSet(ctx, SchedulerStatusKeyPrefix+name, "next_run", nextRun.Unix()).Err(); err != nil {
+		return fmt.Errorf("failed to record next run for job %s: %w", name, err)
+	}
+	return nil
+}
+
+// TriggerJob asks the worker cluster to run a job as soon as possible
+func (c *SchedulerControl) TriggerJob(ctx context.Context, name string) error {
+	registered, err := c.client.SIsMember(ctx, SchedulerJobsKey, name).Result()
+	if err != nil {
+		return fmt.Errorf("failed to look up job %s: %w", name, err)
+	}
+	if !registered {
+		return fmt.Errorf("%w: job %s", common.ErrNotFound, name)
+	}
+
+	if _, err := c.queue.Enqueue(ctx, SchedulerTriggerJobType, map[string]interface{}{
+		"job": name,
+	}); err != nil {
+		return fmt.Errorf("failed to trigger job %s: %w", name, err)
+	}
+
+	c.logger.Info(fmt.Sprintf("Manual run requested for job %s", name))
+	return nil
+}
+
+// ListJobs returns the status of every registered job
+func (c *SchedulerControl) ListJobs(ctx context.Context) ([]common.JobStatus, error) {
+	names, err := c.client.SMembers(ctx, SchedulerJobsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	sort.Strings(names)
+
+	jobs := make([]common.JobStatus, 0, len(names))
+	for _, name := range names {
+		fields, err := c.client.HGetAll(ctx, SchedulerStatusKeyPrefix+name).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get status for job %s: %w", name, err)
+		}
+
+		status := common.JobStatus{
+			Name:         name,
+			Schedule:     fields["schedule"],
+			Singleton:    fields["singleton"] == "true",
+			Running:      fields["running"] == "true",
+			LastDuration: fields["last_duration"],
+			LastError:    fields["last_error"],
+			LastRunAt:    parseUnixField(fields["last_run"]),
+			NextRunAt:    parseUnixField(fields["next_run"]),
+		}
+		jobs = append(jobs, status)
+	}
+
+	return jobs, nil
+}
+
+// parseUnixField converts a stored unix timestamp into a time pointer
+func parseUnixField(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	secs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || secs <= 0 {
+		return nil
+	}
+	t := time.Unix(secs, 0).UTC()
+	return &t
+}
@@ -1,12 +1,12 @@
 package services
 
 import (
-	"context"
 	"fmt"
-	"log"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
 )
 
@@ -46,6 +46,7 @@ func (s *JWTTokenService) GenerateRefreshToken(userID string) (string, error) {
 		"exp":     time.Now().Add(30 * 24 * time.Hour).Unix(),
 		"iat":     time.Now().Unix(),
 		"type":    "refresh",
+		"jti":     uuid.New().String(), // Unique per token; only its hash is stored
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -71,24 +72,58 @@ func (s *JWTTokenService) ValidateAccessToken(tokenString string) (*common.Token
 			return nil, fmt.Errorf("invalid token type")
 		}
 
-		// Return claims without EmailVerified (not in TokenClaims struct)
-		return &common.TokenClaims{
-			UserID: claims["user_id"].(string),
-			Email:  claims["email"].(string),
-			Role:   claims["role"].(string),
-			// TeamID is optional - check if exists
-			TeamID: func() string {
-				if teamID, ok := claims["team_id"].(string); ok {
-					return teamID
-				}
-				return ""
-			}(),
-		}, nil
+		userID := stringClaim(claims, "user_id")
+		if userID == "" {
+			return nil, fmt.Errorf("invalid token")
+		}
+
+		// Return claims without EmailVerified (not in TokenClaims struct).
+		// OAuth access tokens carry no email or role.
+		result := &common.TokenClaims{
+			UserID:   userID,
+			Email:    stringClaim(claims, "email"),
+			Role:     stringClaim(claims, "role"),
+			TeamID:   stringClaim(claims, "team_id"),
+			ClientID: stringClaim(claims, "client_id"),
+			Scopes:   strings.Fields(stringClaim(claims, "scope")),
+		}
+		if exp, ok := claims["exp"].(float64); ok {
+			result.ExpiresAt = time.Unix(int64(exp), 0)
+		}
+		if iat, ok := claims["iat"].(float64); ok {
+			result.IssuedAt = time.Unix(int64(iat), 0)
+		}
+		return result, nil
 	}
 
 	return nil, fmt.Errorf("invalid token")
 }
 
+// GenerateOAuthAccessToken issues an access token for an OAuth client. It
+// is an access token like any other, narrowed by client_id, team_id and
+// scope (RFC 9068 claim names).
+func (s *JWTTokenService) GenerateOAuthAccessToken(userID, teamID, clientID string, scopes []string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user_id":   userID,
+		"team_id":   teamID,
+		"client_id": clientID,
+		"scope":     strings.Join(scopes, " "),
+		"exp":       now.Add(ttl).Unix(),
+		"iat":       now.Unix(),
+		"jti":       uuid.New().String(),
+		"type":      "access",
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.accessSecret))
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
 // ValidateRefreshToken validates a refresh token and returns claims
 func (s *JWTTokenService) ValidateRefreshToken(tokenString string) (*common.TokenClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -118,18 +153,3 @@ func (s *JWTTokenService) ValidateRefreshToken(tokenString string) (*common.Toke
 
 	return nil, fmt.Errorf("invalid token")
 }
-
-// RevokeRefreshToken revokes a refresh token (placeholder implementation)
-func (s *JWTTokenService) RevokeRefreshToken(ctx context.Context, token string) error {
-	// TODO: Implement token blacklist in Redis/database when available
-	log.Printf("🔐 Revoking refresh token: %s...", token[:min(20, len(token))])
-	return nil
-}
-
-// Helper function for min (if not available in your Go version)
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
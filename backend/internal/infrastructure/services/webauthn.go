@@ -0,0 +1,380 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/webauthn.go
+// PURPOSE: WebAuthn (passkey) registration and assertion verification
+// ============================================================================
+
+package services
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+// Authenticator data flags
+const (
+	webauthnFlagUserPresent        = 0x01
+	webauthnFlagUserVerified       = 0x04
+	webauthnFlagAttestedCredential = 0x40
+)
+
+// COSE algorithms we accept, in order of preference
+const (
+	COSEAlgES256 = -7
+	COSEAlgEdDSA = -8
+	COSEAlgRS256 = -257
+)
+
+// ErrWebAuthnVerification is returned for any ceremony that does not verify
+var ErrWebAuthnVerification = errors.New("webauthn verification failed")
+
+// WebAuthnService implements common.WebAuthnVerifier. Attestation
+// statements are not checked: registration asks for "none" attestation, so
+// the authenticator's make and model are not trusted for anything.
+type WebAuthnService struct {
+	rpID            string
+	rpName          string
+	origins         []string
+	requireVerified bool
+}
+
+// NewWebAuthnService creates a verifier for an RP ID (a registrable domain)
+// and the origins allowed to use it
+func NewWebAuthnService(rpID, rpName string, origins []string, requireUserVerification bool) *WebAuthnService {
+	return &WebAuthnService{
+		rpID:            rpID,
+		rpName:          rpName,
+		origins:         origins,
+		requireVerified: requireUserVerification,
+	}
+}
+
+// RelyingParty returns the RP ID and display name
+func (s *WebAuthnService) RelyingParty() (string, string) {
+	return s.rpID, s.rpName
+}
+
+// VerifyRegistration checks a credential creation response
+func (s *WebAuthnService) VerifyRegistration(resp common.WebAuthnRegistration, challenge []byte) (*common.WebAuthnCredential, error) {
+	if resp.Type != "public-key" {
+		return nil, webauthnError("unexpected credential type %q", resp.Type)
+	}
+
+	clientData, err := decodeBase64URL(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, webauthnError("invalid clientDataJSON")
+	}
+	if err := s.verifyClientData(clientData, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	attObjBytes, err := decodeBase64URL(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, webauthnError("invalid attestationObject")
+	}
+	attObj, _, err := decodeCBOR(attObjBytes)
+	if err != nil {
+		return nil, webauthnError("invalid attestationObject: %v", err)
+	}
+	fields, ok := attObj.(map[interface{}]interface{})
+	if !ok {
+		return nil, webauthnError("invalid attestationObject")
+	}
+	rawAuthData, ok := fields["authData"].([]byte)
+	if !ok {
+		return nil, webauthnError("missing authData")
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+	if authData.credentialID == nil {
+		return nil, webauthnError("no attested credential")
+	}
+	if _, err := parseCOSEKey(authData.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &common.WebAuthnCredential{
+		CredentialID: authData.credentialID,
+		PublicKey:    authData.publicKey,
+		SignCount:    authData.signCount,
+		AAGUID:       authData.aaguid,
+		Transports:   resp.Response.Transports,
+		UserVerified: authData.flags&webauthnFlagUserVerified != 0,
+	}, nil
+}
+
+// VerifyAssertion checks an authentication response
+func (s *WebAuthnService) VerifyAssertion(resp common.WebAuthnAssertion, challenge, publicKey []byte) (*common.WebAuthnAssertionResult, error) {
+	if resp.Type != "public-key" {
+		return nil, webauthnError("unexpected credential type %q", resp.Type)
+	}
+
+	credentialID, err := decodeBase64URL(resp.RawID)
+	if err != nil {
+		return nil, webauthnError("invalid rawId")
+	}
+
+	clientData, err := decodeBase64URL(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, webauthnError("invalid clientDataJSON")
+	}
+	if err := s.verifyClientData(clientData, "webauthn.get", challenge); err != nil {
+		return nil, err
+	}
+
+	rawAuthData, err := decodeBase64URL(resp.Response.AuthenticatorData)
+	if err != nil {
+		return nil, webauthnError("invalid authenticatorData")
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyAuthenticatorData(authData); err != nil {
+		return nil, err
+	}
+
+	signature, err := decodeBase64URL(resp.Response.Signature)
+	if err != nil {
+		return nil, webauthnError("invalid signature encoding")
+	}
+
+	key, err := parseCOSEKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientData)
+	signed := append(append([]byte{}, rawAuthData...), clientDataHash[:]...)
+	if err := key.verify(signed, signature); err != nil {
+		return nil, err
+	}
+
+	var userHandle []byte
+	if resp.Response.UserHandle != "" {
+		if userHandle, err = decodeBase64URL(resp.Response.UserHandle); err != nil {
+			return nil, webauthnError("invalid userHandle")
+		}
+	}
+
+	return &common.WebAuthnAssertionResult{
+		CredentialID: credentialID,
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&webauthnFlagUserVerified != 0,
+		UserHandle:   userHandle,
+	}, nil
+}
+
+// ClientDataChallenge extracts the challenge a response was made for, so
+// the server-side ceremony state can be looked up before verification
+func (s *WebAuthnService) ClientDataChallenge(clientDataJSON string) ([]byte, error) {
+	raw, err := decodeBase64URL(clientDataJSON)
+	if err != nil {
+		return nil, webauthnError("invalid clientDataJSON")
+	}
+
+	var cd struct {
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return nil, webauthnError("invalid clientDataJSON")
+	}
+	return decodeBase64URL(cd.Challenge)
+}
+
+func (s *WebAuthnService) verifyClientData(raw []byte, ceremony string, challenge []byte) error {
+	var cd struct {
+		Type        string `json:"type"`
+		Challenge   string `json:"challenge"`
+		Origin      string `json:"origin"`
+		CrossOrigin bool   `json:"crossOrigin"`
+	}
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return webauthnError("invalid clientDataJSON")
+	}
+
+	if cd.Type != ceremony {
+		return webauthnError("unexpected ceremony %q", cd.Type)
+	}
+
+	got, err := decodeBase64URL(cd.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return webauthnError("challenge mismatch")
+	}
+
+	if cd.CrossOrigin {
+		return webauthnError("cross-origin ceremony")
+	}
+	for _, origin := range s.origins {
+		if cd.Origin == origin {
+			return nil
+		}
+	}
+	return webauthnError("origin %q not allowed", cd.Origin)
+}
+
+func (s *WebAuthnService) verifyAuthenticatorData(authData *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(s.rpID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return webauthnError("RP ID mismatch")
+	}
+	if authData.flags&webauthnFlagUserPresent == 0 {
+		return webauthnError("user not present")
+	}
+	if s.requireVerified && authData.flags&webauthnFlagUserVerified == 0 {
+		return webauthnError("user not verified")
+	}
+	return nil
+}
+
+// authenticatorData is the parsed binary structure from WebAuthn §6.1
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, webauthnError("authenticator data too short")
+	}
+
+	ad := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if ad.flags&webauthnFlagAttestedCredential == 0 {
+		return ad, nil
+	}
+
+	rest := data[37:]
+	if len(rest) < 18 {
+		return nil, webauthnError("attested credential data too short")
+	}
+	ad.aaguid = rest[:16]
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if idLen == 0 || idLen > 1023 || len(rest) < idLen {
+		return nil, webauthnError("invalid credential ID")
+	}
+	ad.credentialID = rest[:idLen]
+	rest = rest[idLen:]
+
+	// The COSE key is followed by optional extensions; keep only the key
+	_, n, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, webauthnError("invalid credential public key: %v", err)
+	}
+	ad.publicKey = rest[:n]
+
+	return ad, nil
+}
+
+// coseKey is a parsed credential public key
+type coseKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+func parseCOSEKey(data []byte) (*coseKey, error) {
+	v, _, err := decodeCBOR(data)
+	if err != nil {
+		return nil, webauthnError("invalid public key: %v", err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, webauthnError("invalid public key")
+	}
+
+	kty, _ := m[int64(1)].(int64)
+	alg, _ := m[int64(3)].(int64)
+
+	switch {
+	case kty == 2 && alg == COSEAlgES256:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		y, _ := m[int64(-3)].([]byte)
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, webauthnError("invalid P-256 key")
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, webauthnError("invalid P-256 key")
+		}
+		return &coseKey{alg: alg, key: pub}, nil
+
+	case kty == 1 && alg == COSEAlgEdDSA:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		if crv != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, webauthnError("invalid Ed25519 key")
+		}
+		return &coseKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+
+	case kty == 3 && alg == COSEAlgRS256:
+		n, _ := m[int64(-1)].([]byte)
+		e, _ := m[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, webauthnError("invalid RSA key")
+		}
+		exp := 0
+		for _, b := range e {
+			exp = exp<<8 | int(b)
+		}
+		return &coseKey{alg: alg, key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exp}}, nil
+	}
+
+	return nil, webauthnError("unsupported key type %d / algorithm %d", kty, alg)
+}
+
+func (k *coseKey) verify(data, signature []byte) error {
+	ok := false
+	switch pub := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = ecdsa.VerifyASN1(pub, digest[:], signature)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(pub, data, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		ok = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil
+	}
+	if !ok {
+		return webauthnError("bad signature")
+	}
+	return nil
+}
+
+// decodeBase64URL accepts base64url with or without padding
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func webauthnError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrWebAuthnVerification, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,178 @@
+// path: backend/internal/infrastructure/services/webauthn_test.go
+package services
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+// softAuthenticator is a software passkey holding one P-256 credential
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{key: key, credentialID: []byte("soft-credential-1")}
+}
+
+func (a *softAuthenticator) create(rpID, origin string, challenge []byte) common.WebAuthnRegistration {
+	x, y := a.key.X.FillBytes(make([]byte, 32)), a.key.Y.FillBytes(make([]byte, 32))
+	coseKey := cborMap(
+		cborInt(1), cborInt(2), // kty: EC2
+		cborInt(3), cborInt(COSEAlgES256),
+		cborInt(-1), cborInt(1), // crv: P-256
+		cborInt(-2), cborBytes(x),
+		cborInt(-3), cborBytes(y),
+	)
+
+	authData := a.authData(rpID, webauthnFlagUserPresent|webauthnFlagUserVerified|webauthnFlagAttestedCredential)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, coseKey...)
+
+	attObj := cborMap(
+		cborText("fmt"), cborText("none"),
+		cborText("attStmt"), cborMap(),
+		cborText("authData"), cborBytes(authData),
+	)
+
+	var resp common.WebAuthnRegistration
+	resp.ID = b64(a.credentialID)
+	resp.RawID = b64(a.credentialID)
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = b64(clientData("webauthn.create", origin, challenge))
+	resp.Response.AttestationObject = b64(attObj)
+	return resp
+}
+
+func (a *softAuthenticator) get(t *testing.T, rpID, origin string, challenge []byte) common.WebAuthnAssertion {
+	a.signCount++
+	authData := a.authData(rpID, webauthnFlagUserPresent|webauthnFlagUserVerified)
+	cd := clientData("webauthn.get", origin, challenge)
+	cdHash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), cdHash[:]...))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp common.WebAuthnAssertion
+	resp.ID = b64(a.credentialID)
+	resp.RawID = b64(a.credentialID)
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = b64(cd)
+	resp.Response.AuthenticatorData = b64(authData)
+	resp.Response.Signature = b64(sig)
+	return resp
+}
+
+func (a *softAuthenticator) authData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.signCount)
+}
+
+func TestWebAuthnCeremonies(t *testing.T) {
+	const rpID, origin = "app.example.com", "https://app.example.com"
+	svc := NewWebAuthnService(rpID, "Example", []string{origin}, true)
+	auth := newSoftAuthenticator(t)
+
+	challenge := []byte("registration-challenge-0123456789")
+	cred, err := svc.VerifyRegistration(auth.create(rpID, origin, challenge), challenge)
+	if err != nil {
+		t.Fatalf("VerifyRegistration: %v", err)
+	}
+	if string(cred.CredentialID) != string(auth.credentialID) || !cred.UserVerified {
+		t.Errorf("unexpected credential %+v", cred)
+	}
+
+	if _, err := svc.VerifyRegistration(auth.create(rpID, "https://evil.example", challenge), challenge); !errors.Is(err, ErrWebAuthnVerification) {
+		t.Errorf("foreign origin: err = %v", err)
+	}
+
+	challenge = []byte("login-challenge-0123456789")
+	assertion := auth.get(t, rpID, origin, challenge)
+
+	got, err := svc.ClientDataChallenge(assertion.Response.ClientDataJSON)
+	if err != nil || string(got) != string(challenge) {
+		t.Fatalf("ClientDataChallenge = %q, %v", got, err)
+	}
+
+	result, err := svc.VerifyAssertion(assertion, challenge, cred.PublicKey)
+	if err != nil {
+		t.Fatalf("VerifyAssertion: %v", err)
+	}
+	if result.SignCount != 1 {
+		t.Errorf("SignCount = %d, want 1", result.SignCount)
+	}
+
+	if _, err := svc.VerifyAssertion(assertion, []byte("another-challenge"), cred.PublicKey); !errors.Is(err, ErrWebAuthnVerification) {
+		t.Errorf("wrong challenge: err = %v", err)
+	}
+
+	tampered := auth.get(t, rpID, origin, challenge)
+	tampered.Response.Signature = assertion.Response.Signature
+	if _, err := svc.VerifyAssertion(tampered, challenge, cred.PublicKey); !errors.Is(err, ErrWebAuthnVerification) {
+		t.Errorf("mismatched signature: err = %v", err)
+	}
+}
+
+func clientData(ceremony, origin string, challenge []byte) []byte {
+	b, _ := json.Marshal(map[string]interface{}{
+		"type":      ceremony,
+		"challenge": b64(challenge),
+		"origin":    origin,
+	})
+	return b
+}
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+// Minimal CBOR encoding for the software authenticator
+
+func cborHead(major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n < 1<<8:
+		return []byte{major<<5 | 24, byte(n)}
+	default:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+	}
+}
+
+func cborInt(n int64) []byte {
+	if n < 0 {
+		return cborHead(1, uint64(-1-n))
+	}
+	return cborHead(0, uint64(n))
+}
+
+func cborBytes(b []byte) []byte { return append(cborHead(2, uint64(len(b))), b...) }
+func cborText(s string) []byte  { return append(cborHead(3, uint64(len(s))), s...) }
+
+func cborMap(kv ...[]byte) []byte {
+	out := cborHead(5, uint64(len(kv)/2))
+	for _, item := range kv {
+		out = append(out, item...)
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/webhook_sender.go
+// PURPOSE: HTTP client posting outgoing webhooks to team endpoints
+// ============================================================================
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	// DefaultWebhookTimeout bounds a whole webhook request
+	DefaultWebhookTimeout = 10 * time.Second
+	// How much of a response body is read
+	webhookResponseLimit = 4096
+)
+
+// errPrivateAddress is returned for endpoints resolving to internal networks
+var errPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// HTTPWebhookSender implements common.WebhookSender. Redirects are not
+// followed, and unless allowPrivate is set, endpoints resolving to
+// loopback, private or link-local addresses are refused so team webhooks
+// cannot reach internal services.
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookSender creates a sender. allowPrivate is meant for local
+// development only.
+func NewHTTPWebhookSender(timeout time.Duration, allowPrivate bool) *HTTPWebhookSender {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivate {
+		// Checked on the resolved address at connect time, so DNS cannot
+		// point an approved hostname somewhere else afterwards
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return errPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HTTPWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (*common.WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "SocialQueue-Webhooks/1.0")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseLimit))
+	return &common.WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       string(data),
+		Duration:   time.Since(start),
+	}, nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, which
+// net.IP.IsPrivate leaves out but cloud providers use internally
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		sharedAddressSpace.Contains(ip) ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified()
+}
@@ -0,0 +1,116 @@
+// path: backend/internal/infrastructure/services/webhook_sender_test.go
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+func TestHTTPWebhookSender(t *testing.T) {
+	ctx := context.Background()
+	secret := "whsec_test"
+
+	t.Run("posts a payload the receiver can verify", func(t *testing.T) {
+		var verified bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			verified = team.VerifyWebhookSignature(secret,
+				r.Header.Get(team.WebhookSignatureHeader),
+				r.Header.Get(team.WebhookTimestampHeader),
+				body, 5*time.Minute, time.Now())
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(strings.Repeat("x", 2*webhookResponseLimit)))
+		}))
+		defer server.Close()
+
+		body := []byte(`{"type":"post.published"}`)
+		ts := time.Now().Unix()
+		headers := map[string]string{
+			team.WebhookSignatureHeader: team.SignWebhook(secret, ts, body),
+			team.WebhookTimestampHeader: strconv.FormatInt(ts, 10),
+		}
+
+		resp, err := NewHTTPWebhookSender(time.Second, true).Send(ctx, server.URL, headers, body)
+		if err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+		if !verified {
+			t.Fatal("receiver could not verify the signature")
+		}
+		if resp.StatusCode != http.StatusAccepted || len(resp.Body) != webhookResponseLimit {
+			t.Fatalf("status=%d body=%d bytes", resp.StatusCode, len(resp.Body))
+		}
+	})
+
+	t.Run("rejects tampered and stale payloads", func(t *testing.T) {
+		body := []byte(`{"a":1}`)
+		now := time.Now()
+		sig := team.SignWebhook(secret, now.Unix(), body)
+		ts := strconv.FormatInt(now.Unix(), 10)
+
+		if team.VerifyWebhookSignature(secret, sig, ts, []byte(`{"a":2}`), time.Minute, now) {
+			t.Error("tampered body verified")
+		}
+		if team.VerifyWebhookSignature("whsec_other", sig, ts, body, time.Minute, now) {
+			t.Error("wrong secret verified")
+		}
+		if team.VerifyWebhookSignature(secret, sig, ts, body, time.Minute, now.Add(2*time.Minute)) {
+			t.Error("stale timestamp verified")
+		}
+	})
+
+	t.Run("refuses private addresses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request reached a loopback server")
+		}))
+		defer server.Close()
+
+		_, err := NewHTTPWebhookSender(time.Second, false).Send(ctx, server.URL, nil, []byte("{}"))
+		if !errors.Is(err, errPrivateAddress) {
+			t.Fatalf("err = %v, want %v", err, errPrivateAddress)
+		}
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		}))
+		defer server.Close()
+
+		resp, err := NewHTTPWebhookSender(time.Second, true).Send(ctx, server.URL, nil, []byte("{}"))
+		if err != nil || resp.StatusCode != http.StatusFound {
+			t.Fatalf("resp=%+v err=%v", resp, err)
+		}
+	})
+}
+
+func TestIsPrivateIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"127.0.0.1", true},
+		{"10.1.2.3", true},
+		{"169.254.169.254", true},
+		{"100.64.0.1", true},
+		{"100.127.255.254", true},
+		{"::ffff:100.100.100.200", true},
+		{"100.128.0.1", false},
+		{"8.8.8.8", false},
+	}
+	for _, tt := range tests {
+		if got := isPrivateIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("isPrivateIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+// ============================================================================
+// FILE: backend/internal/infrastructure/services/worker_pool.go
+// PURPOSE: Bounded worker pool with per-group fairness and per-key
+//          serialization (used by worker job processors)
+// ============================================================================
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/techappsUT/social-queue/internal/application/common"
+)
+
+const (
+	SerialLockKeyPrefix  = "pool:serial:"
+	DefaultSerialLockTTL = 5 * time.Minute
+)
+
+// PoolTask is a unit of work submitted to a FairWorkerPool
+type PoolTask struct {
+	// ID deduplicates submissions; a task with the same ID is ignored while
+	// an earlier one is pending or running
+	ID string
+	// GroupKey is the fairness group (e.g. team); groups are served round-robin
+	GroupKey string
+	// SerialKey tasks sharing a key never run concurrently (e.g. social account).
+	// Empty means no serialization.
+	SerialKey string
+	// Run performs the work
+	Run func(ctx context.Context) error
+}
+
+// FairWorkerPool runs tasks on a fixed number of goroutines. Pending tasks are
+// kept in one queue per group and dispatched round-robin, so a group with a
+// large backlog only gets its fair share of workers. When a locker is set,
+// serial keys are also locked cluster-wide so replicas don't overlap.
+type FairWorkerPool struct {
+	name      string
+	locker    DistributedLocker
+	serialTTL time.Duration
+	logger    common.Logger
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	groups  map[string][]*PoolTask
+	order   []string
+	next    int
+	tracked map[string]bool // task IDs pending or running
+	busy    map[string]bool // serial keys running in this process
+	running int
+	closed  bool
+
+	runCtx    context.Context
+	cancelRun context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewFairWorkerPool starts a pool with the given number of workers.
+// locker may be nil to serialize only within this process.
+func NewFairWorkerPool(name string, size int, locker DistributedLocker, logger common.Logger) *FairWorkerPool {
+	if size <= 0 {
+		size = 1
+	}
+
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	p := &FairWorkerPool{
+		name:      name,
+		locker:    locker,
+		serialTTL: DefaultSerialLockTTL,
+		logger:    logger,
+		groups:    make(map[string][]*PoolTask),
+		tracked:   make(map[string]bool),
+		busy:      make(map[string]bool),
+		runCtx:    runCtx,
+		cancelRun: cancelRun,
+	}
+	p.cond = sync.NewCond(&p.mu)
+
+	for i := 0; i < size; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+
+	return p
+}
+
+// Submit queues a task. It returns false if the pool is stopped or a task
+// with the same ID is already pending or running.
+func (p *FairWorkerPool) Submit(task *PoolTask) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed || (task.ID != "" && p.tracked[task.ID]) {
+		return false
+	}
+
+	if _, ok := p.groups[task.GroupKey]; !ok {
+		p.order = append(p.order, task.GroupKey)
+	}
+	p.groups[task.GroupKey] = append(p.groups[task.GroupKey], task)
+	if task.ID != "" {
+		p.tracked[task.ID] = true
+	}
+
+	p.cond.Signal()
+	return true
+}
+
+// Stats returns the number of pending and running tasks
+func (p *FairWorkerPool) Stats() (pending, running int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, tasks := range p.groups {
+		pending += len(tasks)
+	}
+	return pending, p.running
+}
+
+// Stop rejects new tasks, drops tasks that have not started and waits for
+// in-flight tasks to finish. If ctx expires first, in-flight tasks are
+// cancelled and an error is returned.
+func (p *FairWorkerPool) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	p.closed = true
+	dropped := 0
+	for _, tasks := range p.groups {
+		dropped += len(tasks)
+		for _, t := range tasks {
+			delete(p.tracked, t.ID)
+		}
+	}
+	p.groups = make(map[string][]*PoolTask)
+	p.order = nil
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	if dropped > 0 {
+		p.logger.Info(fmt.Sprintf("Pool %s: dropped %d pending tasks on shutdown", p.name, dropped))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancelRun()
+		return nil
+	case <-ctx.Done():
+		p.cancelRun()
+		return fmt.Errorf("pool %s did not drain in time: %w", p.name, ctx.Err())
+	}
+}
+
+// work is the worker goroutine loop
+func (p *FairWorkerPool) work() {
+	defer p.wg.Done()
+
+	for {
+		p.mu.Lock()
+		task := p.pickLocked()
+		for task == nil {
+			if p.closed {
+				p.mu.Unlock()
+				return
+			}
+			p.cond.Wait()
+			task = p.pickLocked()
+		}
+		p.running++
+		p.mu.Unlock()
+
+		p.execute(task)
+
+		p.mu.Lock()
+		p.running--
+		if task.SerialKey != "" {
+			delete(p.busy, task.SerialKey)
+		}
+		delete(p.tracked, task.ID)
+		// A finished serial key may unblock tasks other workers skipped
+		p.cond.Broadcast()
+		p.mu.Unlock()
+	}
+}
+
+// pickLocked selects the next runnable task, visiting groups round-robin and
+// skipping tasks whose serial key is busy. Caller must hold p.mu.
+func (p *FairWorkerPool) pickLocked() *PoolTask {
+	for i := 0; i < len(p.order); i++ {
+		idx := (p.next + i) % len(p.order)
+		group := p.order[idx]
+		tasks := p.groups[group]
+
+		for j, task := range tasks {
+			if task.SerialKey != "" && p.busy[task.SerialKey] {
+				continue
+			}
+
+			p.groups[group] = append(tasks[:j:j], tasks[j+1:]...)
+			if len(p.groups[group]) == 0 {
+				delete(p.groups, group)
+				p.order = append(p.order[:idx:idx], p.order[idx+1:]...)
+				p.next = idx
+			} else {
+				p.next = idx + 1
+			}
+			if len(p.order) > 0 {
+				p.next %= len(p.order)
+			} else {
+				p.next = 0
+			}
+
+			if task.SerialKey != "" {
+				p.busy[task.SerialKey] = true
+			}
+			return task
+		}
+	}
+	return nil
+}
+
+// execute runs a task under its cluster-wide serial lock, if any
+func (p *FairWorkerPool) execute(task *PoolTask) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.logger.Error(fmt.Sprintf("Pool %s: task %s panicked: %v", p.name, task.ID, r))
+		}
+	}()
+
+	if task.SerialKey != "" && p.locker != nil {
+		lockKey := SerialLockKeyPrefix + task.SerialKey
+		acquired, err := p.locker.TryAcquire(p.runCtx, lockKey, p.serialTTL)
+		if err != nil {
+			p.logger.Error(fmt.Sprintf("Pool %s: failed to lock %s: %v", p.name, task.SerialKey, err))
+			return
+		}
+		if !acquired {
+			// Another replica is working on this key; the task will be
+			// picked up again on the next scheduling pass
+			p.logger.Debug(fmt.Sprintf("Pool %s: %s busy on another replica, skipping task %s", p.name, task.SerialKey, task.ID))
+			return
+		}
+		defer p.locker.Release(context.Background(), lockKey)
+	}
+
+	if err := task.Run(p.runCtx); err != nil {
+		p.logger.Error(fmt.Sprintf("Pool %s: task %s failed: %v", p.name, task.ID, err))
+	}
+}
@@ -0,0 +1,125 @@
+// path: backend/internal/infrastructure/services/worker_pool_test.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFairWorkerPool_RoundRobinAcrossGroups(t *testing.T) {
+	pool := NewFairWorkerPool("test", 1, nil, NewLogger())
+
+	// Block the single worker so the queue builds up before dispatching
+	release := make(chan struct{})
+	pool.Submit(&PoolTask{ID: "block", GroupKey: "x", Run: func(ctx context.Context) error {
+		<-release
+		return nil
+	}})
+
+	var mu sync.Mutex
+	var order []string
+	record := func(group string) func(context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			order = append(order, group)
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		pool.Submit(&PoolTask{ID: fmt.Sprintf("a%d", i), GroupKey: "a", Run: record("a")})
+	}
+	pool.Submit(&PoolTask{ID: "b0", GroupKey: "b", Run: record("b")})
+	close(release)
+
+	if err := waitForPool(pool); err != nil {
+		t.Fatal(err)
+	}
+
+	got := fmt.Sprint(order)
+	if want := "[a b a a]"; got != want {
+		t.Errorf("dispatch order = %s, want %s", got, want)
+	}
+}
+
+func TestFairWorkerPool_SerialKey(t *testing.T) {
+	pool := NewFairWorkerPool("test", 4, nil, NewLogger())
+
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	for i := 0; i < 6; i++ {
+		pool.Submit(&PoolTask{
+			ID:        fmt.Sprintf("t%d", i),
+			GroupKey:  fmt.Sprintf("g%d", i%3),
+			SerialKey: "account",
+			Run: func(ctx context.Context) error {
+				mu.Lock()
+				active++
+				if active > maxActive {
+					maxActive = active
+				}
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+				return nil
+			},
+		})
+	}
+
+	if err := waitForPool(pool); err != nil {
+		t.Fatal(err)
+	}
+	if maxActive != 1 {
+		t.Errorf("max concurrent tasks for one serial key = %d, want 1", maxActive)
+	}
+}
+
+func TestFairWorkerPool_DedupAndStop(t *testing.T) {
+	pool := NewFairWorkerPool("test", 1, nil, NewLogger())
+
+	started := make(chan struct{})
+	finished := false
+	pool.Submit(&PoolTask{ID: "p1", Run: func(ctx context.Context) error {
+		close(started)
+		time.Sleep(20 * time.Millisecond)
+		finished = true
+		return nil
+	}})
+	<-started
+
+	if pool.Submit(&PoolTask{ID: "p1", Run: func(ctx context.Context) error { return nil }}) {
+		t.Error("duplicate task ID accepted while running")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := pool.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if !finished {
+		t.Error("Stop() returned before in-flight task finished")
+	}
+	if pool.Submit(&PoolTask{ID: "p2", Run: func(ctx context.Context) error { return nil }}) {
+		t.Error("task accepted after Stop")
+	}
+}
+
+// waitForPool polls until the pool has no pending or running tasks
+func waitForPool(pool *FairWorkerPool) error {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if pending, running := pool.Stats(); pending == 0 && running == 0 {
+			return nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return fmt.Errorf("pool did not become idle")
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/techappsUT/social-queue/internal/domain/audit"
+)
+
+// AuditContext records the client of each request in its context, so audit
+// log entries written while handling it carry the IP address and user agent
+func AuditContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := audit.WithRequest(r.Context(), audit.RequestInfo{
+			IPAddress: extractIP(r),
+			UserAgent: r.UserAgent(),
+			Method:    r.Method,
+			Path:      r.URL.Path,
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/techappsUT/social-queue/internal/application/common"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
 type contextKey string
@@ -19,19 +20,27 @@ const (
 	TeamIDKey    contextKey = "team_id"
 )
 
-// AuthMiddleware handles JWT authentication
+// APIKeyAuthenticator resolves API keys presented as bearer tokens
+type APIKeyAuthenticator interface {
+	Execute(ctx context.Context, rawKey string) (*team.APIKey, error)
+}
+
+// AuthMiddleware handles JWT and API key authentication
 type AuthMiddleware struct {
 	tokenService common.TokenService
+	apiKeys      APIKeyAuthenticator // nil disables API keys
 }
 
 // NewAuthMiddleware creates a new auth middleware
-func NewAuthMiddleware(tokenService common.TokenService) *AuthMiddleware {
+func NewAuthMiddleware(tokenService common.TokenService, apiKeys APIKeyAuthenticator) *AuthMiddleware {
 	return &AuthMiddleware{
 		tokenService: tokenService,
+		apiKeys:      apiKeys,
 	}
 }
 
-// RequireAuth validates JWT token and adds user info to context
+// RequireAuth validates a JWT, OAuth access token or API key and adds user
+// info to context
 func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		authHeader := r.Header.Get("Authorization")
@@ -47,27 +56,74 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		token := parts[1]
-		claims, err := m.tokenService.ValidateAccessToken(token)
+		ctx, err := m.authenticate(r.Context(), parts[1])
 		if err != nil {
 			http.Error(w, `{"error":"Invalid or expired token"}`, http.StatusUnauthorized)
 			return
 		}
 
-		// Add user info to context
-		ctx := r.Context()
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
-		ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
-		if claims.TeamID != "" {
-			ctx = context.WithValue(ctx, TeamIDKey, claims.TeamID)
-		}
-
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-// OptionalAuth validates token if present but doesn't require it
+// RequireSession is RequireAuth for routes API keys and OAuth clients must
+// not reach, such as account security settings and key management itself
+func (m *AuthMiddleware) RequireSession(next http.Handler) http.Handler {
+	return m.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if IsScopedRequest(r.Context()) {
+			http.Error(w, `{"error":"API keys and OAuth tokens cannot be used for this endpoint"}`, http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// authenticate validates a bearer token and returns the context carrying
+// who made the request. API keys and OAuth access tokens add their scope,
+// which the team Authorizer applies to every permission check.
+func (m *AuthMiddleware) authenticate(ctx context.Context, token string) (context.Context, error) {
+	if m.apiKeys != nil && team.IsAPIKey(token) {
+		key, err := m.apiKeys.Execute(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, UserIDKey, key.UserID().String())
+		if !key.IsPersonal() {
+			ctx = context.WithValue(ctx, TeamIDKey, key.TeamID().String())
+		}
+		return team.WithScope(ctx, key.Scope()), nil
+	}
+
+	claims, err := m.tokenService.ValidateAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
+	ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+	if claims.TeamID != "" {
+		ctx = context.WithValue(ctx, TeamIDKey, claims.TeamID)
+	}
+
+	// OAuth access tokens only reach their team, with their scopes
+	if claims.ClientID != "" {
+		teamID, err := uuid.Parse(claims.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		permissions := make([]team.Permission, 0, len(claims.Scopes))
+		for _, s := range claims.Scopes {
+			permissions = append(permissions, team.Permission(s))
+		}
+		ctx = team.WithScope(ctx, team.Scope{TeamID: teamID, Permissions: permissions})
+	}
+	return ctx, nil
+}
+
+// OptionalAuth validates token if present but doesn't require it. It only
+// serves sign-in flows, so API keys and OAuth tokens are treated as
+// anonymous.
 func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		authHeader := r.Header.Get("Authorization")
@@ -77,26 +133,17 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 		}
 
 		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
+		if len(parts) != 2 || parts[0] != "Bearer" || team.IsAPIKey(parts[1]) {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		token := parts[1]
-		claims, err := m.tokenService.ValidateAccessToken(token)
-		if err != nil {
+		ctx, err := m.authenticate(r.Context(), parts[1])
+		if err != nil || IsScopedRequest(ctx) {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		ctx := r.Context()
-		ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, UserEmailKey, claims.Email)
-		ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
-		if claims.TeamID != "" {
-			ctx = context.WithValue(ctx, TeamIDKey, claims.TeamID)
-		}
-
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -142,6 +189,13 @@ func GetUserRole(ctx context.Context) (string, bool) {
 	return role, ok
 }
 
+// IsScopedRequest reports whether the request authenticated with an API key
+// or an OAuth access token rather than a user session
+func IsScopedRequest(ctx context.Context) bool {
+	_, ok := team.ScopeFromContext(ctx)
+	return ok
+}
+
 // GetTeamID extracts team ID from context
 func GetTeamID(ctx context.Context) (uuid.UUID, bool) {
 	teamIDStr, ok := ctx.Value(TeamIDKey).(string)
@@ -2,12 +2,16 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
 )
 
-// RequireRole checks if user has required role
+// RequireRole checks the user's platform role; team permissions are
+// enforced by PolicyMiddleware
 func RequireRole(allowedRoles ...string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -36,21 +40,70 @@ func RequireRole(allowedRoles ...string) func(http.Handler) http.Handler {
 	}
 }
 
-// RequireTeamMembership ensures user belongs to a team
-func RequireTeamMembership(next http.Handler) http.Handler {
+// PolicyMiddleware enforces team permissions on routes that carry the team
+// in the URL
+type PolicyMiddleware struct {
+	authorizer *team.Authorizer
+}
+
+// NewPolicyMiddleware creates the team policy middleware
+func NewPolicyMiddleware(authorizer *team.Authorizer) *PolicyMiddleware {
+	return &PolicyMiddleware{authorizer: authorizer}
+}
+
+// RequireTeamMembership ensures the user is an active member of the team
+// in the URL
+func (p *PolicyMiddleware) RequireTeamMembership(next http.Handler) http.Handler {
+	return p.require("", next)
+}
+
+// RequirePermission ensures the user's role in the team in the URL grants
+// the permission
+func (p *PolicyMiddleware) RequirePermission(permission team.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return p.require(permission, next)
+	}
+}
+
+func (p *PolicyMiddleware) require(permission team.Permission, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Get team ID from context
-		userTeamID, ok := GetTeamID(r.Context())
-		if !ok || userTeamID == uuid.Nil {
-			http.Error(w, `{"error":"Forbidden: no team membership"}`, http.StatusForbidden)
+		userID, ok := GetUserID(r.Context())
+		if !ok {
+			http.Error(w, `{"error":"Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+
+		teamID, err := uuid.Parse(teamURLParam(r))
+		if err != nil {
+			http.Error(w, `{"error":"Invalid team ID"}`, http.StatusBadRequest)
 			return
 		}
 
-		// You can add additional logic here to verify team access
-		// For example, extract team_id from URL and compare with user's team_id
+		role, err := p.authorizer.Role(r.Context(), teamID, userID)
+		if err == team.ErrMemberNotFound {
+			http.Error(w, `{"error":"Forbidden: not a member of this team"}`, http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			http.Error(w, `{"error":"Internal server error"}`, http.StatusInternalServerError)
+			return
+		}
+		if permission != "" && !role.Allows(permission) {
+			http.Error(w, `{"error":"Forbidden: insufficient permissions"}`, http.StatusForbidden)
+			return
+		}
 
-		next.ServeHTTP(w, r)
+		ctx := context.WithValue(r.Context(), TeamIDKey, teamID.String())
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// teamURLParam reads the team from {teamId}, or {id} on /teams routes
+func teamURLParam(r *http.Request) string {
+	if id := chi.URLParam(r, "teamId"); id != "" {
+		return id
+	}
+	return chi.URLParam(r, "id")
+}
+
 // Note: RequireAdmin is now in auth.go to avoid duplication
@@ -0,0 +1,95 @@
+// path: backend/internal/middleware/rbac_test.go
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/techappsUT/social-queue/internal/domain/team"
+)
+
+type membersByUser struct {
+	team.MemberRepository
+	members map[uuid.UUID]*team.Member
+}
+
+func (r membersByUser) FindMember(ctx context.Context, teamID, userID uuid.UUID) (*team.Member, error) {
+	if m, ok := r.members[userID]; ok && m.TeamID() == teamID {
+		return m, nil
+	}
+	return nil, team.ErrMemberNotFound
+}
+
+// editorsPost gives editors post permissions and viewers only read access
+type editorsPost struct {
+	team.RoleRepository
+}
+
+func (editorsPost) FindByName(ctx context.Context, teamID uuid.UUID, name team.MemberRole) (*team.Role, error) {
+	permissions := []team.Permission{team.PermPostsView}
+	if name == team.MemberRoleEditor {
+		permissions = append(permissions, team.PermPostsCreate)
+	}
+	return team.ReconstructRole(uuid.New(), teamID, name, "", permissions, true, time.Now(), time.Now()), nil
+}
+
+func TestPolicyMiddleware(t *testing.T) {
+	teamID := uuid.New()
+	editor, viewer, deactivated := uuid.New(), uuid.New(), uuid.New()
+	member := func(userID uuid.UUID, role team.MemberRole, status team.MemberStatus) *team.Member {
+		joined := time.Now()
+		return team.ReconstructMember(uuid.New(), teamID, userID, role, status, userID, joined, &joined, nil)
+	}
+	members := membersByUser{members: map[uuid.UUID]*team.Member{
+		editor:      member(editor, team.MemberRoleEditor, team.MemberStatusActive),
+		viewer:      member(viewer, team.MemberRoleViewer, team.MemberStatusActive),
+		deactivated: member(deactivated, team.MemberRoleEditor, team.MemberStatusInactive),
+	}}
+	policy := NewPolicyMiddleware(team.NewAuthorizer(members, editorsPost{}))
+
+	var seen uuid.UUID
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen, _ = GetTeamID(r.Context())
+	})
+	r := chi.NewRouter()
+	r.With(policy.RequireTeamMembership).Get("/teams/{id}", ok)
+	r.With(policy.RequirePermission(team.PermPostsCreate)).Post("/teams/{teamId}/posts", ok)
+
+	tests := []struct {
+		name, method, path string
+		userID             uuid.UUID
+		want               int
+	}{
+		{"member", http.MethodGet, "/teams/" + teamID.String(), viewer, http.StatusOK},
+		{"deactivated member", http.MethodGet, "/teams/" + teamID.String(), deactivated, http.StatusForbidden},
+		{"outsider", http.MethodGet, "/teams/" + teamID.String(), uuid.New(), http.StatusForbidden},
+		{"other team", http.MethodGet, "/teams/" + uuid.NewString(), editor, http.StatusForbidden},
+		{"bad team ID", http.MethodGet, "/teams/acme", editor, http.StatusBadRequest},
+		{"permitted", http.MethodPost, "/teams/" + teamID.String() + "/posts", editor, http.StatusOK},
+		{"not permitted", http.MethodPost, "/teams/" + teamID.String() + "/posts", viewer, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		seen = uuid.Nil
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserIDKey, tt.userID.String()))
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.want)
+		}
+		if tt.want == http.StatusOK && seen != teamID {
+			t.Errorf("%s: team in context = %s, want %s", tt.name, seen, teamID)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/teams/"+teamID.String(), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("anonymous: status = %d, want 401", rec.Code)
+	}
+}
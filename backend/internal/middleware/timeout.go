@@ -0,0 +1,38 @@
+// path: backend/internal/middleware/timeout.go
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+// Timeout cancels a request's context after d, like chi's Timeout, except
+// for the event streams registered in streams. Those are GET route
+// patterns, such as /api/v2/teams/{id}/events, whose handlers bound their
+// own lifetime. Headers alone never exempt a request.
+func Timeout(d time.Duration, streams ...string) func(http.Handler) http.Handler {
+	timeout := chimw.Timeout(d)
+	exempt := chi.NewRouter()
+	for _, pattern := range streams {
+		exempt.Get(pattern, http.NotFound)
+	}
+
+	return func(next http.Handler) http.Handler {
+		limited := timeout(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := r.URL.Path
+			if len(path) > 1 {
+				path = strings.TrimSuffix(path, "/")
+			}
+			if exempt.Match(chi.NewRouteContext(), r.Method, path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			limited.ServeHTTP(w, r)
+		})
+	}
+}
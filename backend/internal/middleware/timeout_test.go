@@ -0,0 +1,38 @@
+// path: backend/internal/middleware/timeout_test.go
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTimeoutExemptsOnlyRegisteredStreams(t *testing.T) {
+	var hasDeadline bool
+	handler := Timeout(time.Minute, "/api/v2/teams/{id}/events")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline = r.Context().Deadline()
+	}))
+
+	tests := []struct {
+		name, method, path string
+		header             http.Header
+		want               bool
+	}{
+		{"stream", http.MethodGet, "/api/v2/teams/42/events", nil, false},
+		{"stream with slash", http.MethodGet, "/api/v2/teams/42/events/", nil, false},
+		{"ticket", http.MethodPost, "/api/v2/teams/42/events/tickets", nil, true},
+		{"sse accept header", http.MethodGet, "/api/v2/posts", http.Header{"Accept": {"text/event-stream"}}, true},
+		{"websocket upgrade", http.MethodGet, "/api/v2/posts", http.Header{"Upgrade": {"websocket"}}, true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, tt.path, nil)
+		for k, v := range tt.header {
+			r.Header[k] = v
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		if hasDeadline != tt.want {
+			t.Errorf("%s: deadline = %v, want %v", tt.name, hasDeadline, tt.want)
+		}
+	}
+}
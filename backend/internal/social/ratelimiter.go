@@ -11,6 +11,8 @@ import (
 )
 
 // RateLimiter manages rate limiting for social platform API calls
+//
+// Deprecated: limits are per process; use services.PlatformRateLimiter.
 type RateLimiter struct {
 	limiters map[string]*rate.Limiter
 	mu       sync.RWMutex
@@ -0,0 +1,4 @@
+-- backend/migrations/20240101000003_add_publish_attempts.down.sql
+
+DROP TRIGGER IF EXISTS update_publish_attempts_updated_at ON publish_attempts;
+DROP TABLE IF EXISTS publish_attempts;
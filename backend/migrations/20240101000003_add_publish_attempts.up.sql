@@ -0,0 +1,31 @@
+-- backend/migrations/20240101000003_add_publish_attempts.up.sql
+
+-- One row per (post, target) publish. The idempotency key is written before
+-- the platform call so a retry after a crash can reconcile instead of
+-- publishing a duplicate.
+CREATE TABLE publish_attempts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scheduled_post_id UUID NOT NULL REFERENCES scheduled_posts(id) ON DELETE CASCADE,
+    social_account_id UUID NOT NULL REFERENCES social_accounts(id) ON DELETE CASCADE,
+    idempotency_key VARCHAR(255) NOT NULL UNIQUE,
+    content_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, succeeded, failed
+    platform_post_id VARCHAR(255),
+    platform_post_url TEXT,
+    error_message TEXT,
+    attempts INTEGER NOT NULL DEFAULT 1,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_publish_attempts_post_id ON publish_attempts(scheduled_post_id);
+CREATE INDEX idx_publish_attempts_account_hash ON publish_attempts(social_account_id, content_hash, completed_at)
+    WHERE status = 'succeeded';
+
+CREATE TRIGGER update_publish_attempts_updated_at BEFORE UPDATE ON publish_attempts
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON COLUMN publish_attempts.idempotency_key IS 'Stable key per (post, social account) target';
+COMMENT ON COLUMN publish_attempts.content_hash IS 'SHA-256 of normalized post text, used for reconciliation and duplicate warnings';
@@ -0,0 +1,13 @@
+-- backend/migrations/20240101000004_add_refresh_token_families.down.sql
+
+DROP INDEX IF EXISTS idx_refresh_tokens_family_id;
+DROP INDEX IF EXISTS idx_refresh_tokens_token_hash_unique;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS revoked_reason,
+    DROP COLUMN IF EXISTS revoked_at,
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS parent_id,
+    DROP COLUMN IF EXISTS family_id;
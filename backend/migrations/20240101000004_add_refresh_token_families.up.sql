@@ -0,0 +1,21 @@
+-- backend/migrations/20240101000004_add_refresh_token_families.up.sql
+
+-- Refresh tokens are rotated on every use. All tokens descending from one
+-- login share a family_id, which is the session the user sees; presenting an
+-- already rotated token revokes the whole family.
+ALTER TABLE refresh_tokens
+    ADD COLUMN family_id UUID NOT NULL DEFAULT uuid_generate_v4(),
+    ADD COLUMN parent_id UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL,
+    ADD COLUMN user_agent TEXT,
+    ADD COLUMN ip_address VARCHAR(45),
+    ADD COLUMN last_used_at TIMESTAMPTZ,
+    ADD COLUMN revoked_at TIMESTAMPTZ,
+    ADD COLUMN revoked_reason VARCHAR(50);
+
+UPDATE refresh_tokens SET family_id = id;
+
+CREATE UNIQUE INDEX idx_refresh_tokens_token_hash_unique ON refresh_tokens(token_hash);
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+
+COMMENT ON COLUMN refresh_tokens.family_id IS 'Session: every token rotated from the same login';
+COMMENT ON COLUMN refresh_tokens.revoked_reason IS 'rotated, logout, reuse_detected, session_revoked, password_changed';
@@ -0,0 +1,4 @@
+-- backend/migrations/20240101000005_add_two_factor.down.sql
+
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_two_factor;
@@ -0,0 +1,28 @@
+-- backend/migrations/20240101000005_add_two_factor.up.sql
+
+-- TOTP enrollment. The secret is encrypted with the application key; the
+-- enrollment only guards sign-in once enabled_at is set.
+CREATE TABLE user_two_factor (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_encrypted TEXT NOT NULL,
+    enabled_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TRIGGER update_user_two_factor_updated_at BEFORE UPDATE ON user_two_factor
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Single-use recovery codes, stored as SHA-256 hashes
+CREATE TABLE user_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_recovery_codes_user_id ON user_recovery_codes(user_id) WHERE used_at IS NULL;
+
+COMMENT ON COLUMN user_two_factor.last_used_step IS 'Last accepted TOTP time step, rejects replayed codes';
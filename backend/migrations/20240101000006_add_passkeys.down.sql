@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000006_add_passkeys.down.sql
+
+DROP TABLE IF EXISTS user_passkeys;
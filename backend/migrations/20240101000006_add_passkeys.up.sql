@@ -0,0 +1,20 @@
+-- backend/migrations/20240101000006_add_passkeys.up.sql
+
+-- WebAuthn credentials (passkeys). sign_count is the authenticator's
+-- signature counter, used to detect cloned keys.
+CREATE TABLE user_passkeys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    credential_id BYTEA NOT NULL UNIQUE,
+    public_key BYTEA NOT NULL,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    aaguid BYTEA,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_user_passkeys_user_id ON user_passkeys(user_id);
+
+COMMENT ON COLUMN user_passkeys.public_key IS 'COSE_Key from the attested credential data';
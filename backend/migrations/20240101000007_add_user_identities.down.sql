@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000007_add_user_identities.down.sql
+
+DROP TABLE IF EXISTS user_identities;
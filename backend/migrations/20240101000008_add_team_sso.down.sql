@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000008_add_team_sso.down.sql
+
+DROP TABLE IF EXISTS team_sso_configs;
@@ -0,0 +1,23 @@
+-- backend/migrations/20240101000008_add_team_sso.up.sql
+
+-- Per-team SAML 2.0 identity provider. Each email domain can be claimed by
+-- one team's configuration, which is enforced by the use case.
+CREATE TABLE team_sso_configs (
+    team_id UUID PRIMARY KEY REFERENCES teams(id) ON DELETE CASCADE,
+    idp_entity_id VARCHAR(1024) NOT NULL,
+    idp_sso_url TEXT NOT NULL,
+    idp_certificates TEXT[] NOT NULL,
+    email_attribute VARCHAR(255) NOT NULL,
+    first_name_attribute VARCHAR(255) NOT NULL,
+    last_name_attribute VARCHAR(255) NOT NULL,
+    role_attribute VARCHAR(255) NOT NULL DEFAULT '',
+    role_mapping JSONB NOT NULL DEFAULT '{}',
+    default_role VARCHAR(50) NOT NULL DEFAULT 'viewer',
+    domains TEXT[] NOT NULL DEFAULT '{}',
+    enforce_sso BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_team_sso_configs_domains ON team_sso_configs USING GIN (domains);
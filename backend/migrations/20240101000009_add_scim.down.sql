@@ -0,0 +1,5 @@
+-- backend/migrations/20240101000009_add_scim.down.sql
+
+DROP TABLE IF EXISTS team_scim_group_members;
+DROP TABLE IF EXISTS team_scim_groups;
+DROP TABLE IF EXISTS team_scim_tokens;
@@ -0,0 +1,35 @@
+-- backend/migrations/20240101000009_add_scim.up.sql
+
+-- Bearer tokens identity providers use to call a team's SCIM API
+CREATE TABLE team_scim_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_team_scim_tokens_team_id ON team_scim_tokens(team_id);
+
+-- Groups pushed by the identity provider. A group mapped to a role grants
+-- it to its members.
+CREATE TABLE team_scim_groups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    display_name VARCHAR(255) NOT NULL,
+    external_id VARCHAR(255),
+    role VARCHAR(50),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (team_id, display_name)
+);
+
+CREATE TABLE team_scim_group_members (
+    group_id UUID NOT NULL REFERENCES team_scim_groups(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_team_scim_group_members_user_id ON team_scim_group_members(user_id);
@@ -0,0 +1,23 @@
+-- backend/migrations/20240101000010_add_custom_roles.down.sql
+
+INSERT INTO roles (name, description, permissions, is_system) VALUES
+    ('member', 'Regular member who can create and schedule posts', '["posts.create", "posts.manage_own", "analytics.view"]', TRUE);
+
+UPDATE team_memberships
+SET role_id = (SELECT id FROM roles WHERE name = 'member' AND team_id IS NULL)
+WHERE role_id = (SELECT id FROM roles WHERE name = 'editor' AND team_id IS NULL);
+
+-- Members holding custom roles fall back to read-only access
+UPDATE team_memberships
+SET role_id = (SELECT id FROM roles WHERE name = 'viewer' AND team_id IS NULL)
+WHERE role_id IN (SELECT id FROM roles WHERE team_id IS NOT NULL);
+
+DELETE FROM roles WHERE team_id IS NOT NULL OR name = 'editor';
+
+UPDATE roles SET permissions = '["team.manage", "users.manage", "posts.manage", "analytics.view"]' WHERE name = 'admin';
+UPDATE roles SET permissions = '["posts.view", "analytics.view"]' WHERE name = 'viewer';
+
+DROP INDEX IF EXISTS idx_roles_team_name;
+DROP INDEX IF EXISTS idx_roles_system_name;
+ALTER TABLE roles ADD CONSTRAINT roles_name_key UNIQUE (name);
+ALTER TABLE roles DROP COLUMN team_id;
@@ -0,0 +1,28 @@
+-- backend/migrations/20240101000010_add_custom_roles.up.sql
+
+-- Custom roles belong to a team; built-in roles have no team
+ALTER TABLE roles ADD COLUMN team_id UUID REFERENCES teams(id) ON DELETE CASCADE;
+
+ALTER TABLE roles DROP CONSTRAINT roles_name_key;
+CREATE UNIQUE INDEX idx_roles_system_name ON roles(name) WHERE team_id IS NULL;
+CREATE UNIQUE INDEX idx_roles_team_name ON roles(team_id, name) WHERE team_id IS NOT NULL;
+
+-- The application has always used "editor"; the seeded "member" role was
+-- never assignable
+INSERT INTO roles (name, description, permissions, is_system) VALUES
+    ('editor', 'Creates posts and manages their own', '[]', TRUE);
+
+UPDATE team_memberships
+SET role_id = (SELECT id FROM roles WHERE name = 'editor' AND team_id IS NULL)
+WHERE role_id = (SELECT id FROM roles WHERE name = 'member' AND team_id IS NULL);
+
+DELETE FROM roles WHERE name = 'member' AND team_id IS NULL;
+
+-- Permissions from the registry in internal/domain/team/permission.go
+UPDATE roles SET permissions = '["*"]' WHERE name = 'owner' AND team_id IS NULL;
+UPDATE roles SET permissions = '["team.view", "team.manage", "members.*", "roles.manage", "posts.*", "accounts.*", "analytics.view"]'
+WHERE name = 'admin' AND team_id IS NULL;
+UPDATE roles SET permissions = '["team.view", "members.view", "posts.view", "posts.create", "accounts.view", "analytics.view"]'
+WHERE name = 'editor' AND team_id IS NULL;
+UPDATE roles SET permissions = '["team.view", "members.view", "posts.view", "accounts.view", "analytics.view"]'
+WHERE name = 'viewer' AND team_id IS NULL;
@@ -0,0 +1,4 @@
+-- backend/migrations/20240101000011_add_social_account_access.down.sql
+
+DROP TABLE IF EXISTS team_member_account_grants;
+DROP TABLE IF EXISTS team_member_account_restrictions;
@@ -0,0 +1,22 @@
+-- backend/migrations/20240101000011_add_social_account_access.up.sql
+
+-- Members listed here may only use the social accounts granted to them
+CREATE TABLE team_member_account_restrictions (
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    restricted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE TABLE team_member_account_grants (
+    team_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    social_account_id UUID NOT NULL REFERENCES social_accounts(id) ON DELETE CASCADE,
+    access VARCHAR(20) NOT NULL CHECK (access IN ('analytics', 'draft', 'publish')),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id, social_account_id),
+    FOREIGN KEY (team_id, user_id) REFERENCES team_member_account_restrictions(team_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_team_member_account_grants_account ON team_member_account_grants(social_account_id);
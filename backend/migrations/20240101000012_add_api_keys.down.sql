@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000012_add_api_keys.down.sql
+
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,20 @@
+-- backend/migrations/20240101000012_add_api_keys.up.sql
+
+-- Personal access tokens (team_id NULL) and team API keys. Only a hash of
+-- the key is stored; prefix is kept so users can recognize their keys.
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL,
+    key_hash VARCHAR(64) UNIQUE NOT NULL,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_keys_user_id ON api_keys(user_id) WHERE team_id IS NULL;
+CREATE INDEX idx_api_keys_team_id ON api_keys(team_id) WHERE team_id IS NOT NULL;
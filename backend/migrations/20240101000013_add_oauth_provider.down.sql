@@ -0,0 +1,5 @@
+-- backend/migrations/20240101000013_add_oauth_provider.down.sql
+
+DROP TABLE IF EXISTS oauth_refresh_tokens;
+DROP TABLE IF EXISTS oauth_authorization_codes;
+DROP TABLE IF EXISTS oauth_clients;
@@ -0,0 +1,44 @@
+-- backend/migrations/20240101000013_add_oauth_provider.up.sql
+
+-- Third-party applications registered by a team. secret_hash is NULL for
+-- public clients, which must use PKCE.
+CREATE TABLE oauth_clients (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    client_id VARCHAR(64) UNIQUE NOT NULL,
+    secret_hash VARCHAR(64),
+    name VARCHAR(100) NOT NULL,
+    redirect_uris JSONB NOT NULL DEFAULT '[]',
+    scopes JSONB NOT NULL DEFAULT '[]',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_oauth_clients_team_id ON oauth_clients(team_id);
+
+-- Single-use authorization codes, exchanged within minutes
+CREATE TABLE oauth_authorization_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    client_id UUID NOT NULL REFERENCES oauth_clients(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    redirect_uri TEXT NOT NULL,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    code_challenge VARCHAR(128),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- Rotating refresh tokens issued with authorization code grants
+CREATE TABLE oauth_refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    client_id UUID NOT NULL REFERENCES oauth_clients(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    scopes JSONB NOT NULL DEFAULT '[]',
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_oauth_refresh_tokens_client ON oauth_refresh_tokens(client_id);
@@ -0,0 +1,7 @@
+-- backend/migrations/20240101000014_add_audit_log.down.sql
+
+UPDATE roles SET permissions = permissions - 'audit.view'
+WHERE name = 'admin' AND team_id IS NULL;
+
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_immutable();
@@ -0,0 +1,39 @@
+-- backend/migrations/20240101000014_add_audit_log.up.sql
+
+-- Append-only audit log. No foreign keys: entries must outlive the teams,
+-- users and objects they describe. team_id is NULL for account-level
+-- actions such as logins.
+CREATE TABLE audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID,
+    actor_id UUID,
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50) NOT NULL DEFAULT '',
+    target_id VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    changes JSONB,
+    metadata JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_log_team_created ON audit_log(team_id, created_at DESC) WHERE team_id IS NOT NULL;
+CREATE INDEX idx_audit_log_actor_created ON audit_log(actor_id, created_at DESC) WHERE team_id IS NULL;
+
+CREATE FUNCTION audit_log_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_immutable();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_immutable();
+
+-- Owners hold every permission; admins can read the audit log too
+UPDATE roles SET permissions = permissions || '["audit.view"]'
+WHERE name = 'admin' AND team_id IS NULL;
@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000015_add_event_outbox.down.sql
+
+DROP TABLE IF EXISTS event_outbox;
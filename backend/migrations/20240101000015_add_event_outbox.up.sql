@@ -0,0 +1,24 @@
+-- backend/migrations/20240101000015_add_event_outbox.up.sql
+
+-- Transactional outbox for domain events. Rows are written in the same
+-- transaction as the change that raised them and relayed to subscribers
+-- and the Redis event stream at least once.
+CREATE TABLE event_outbox (
+    id UUID PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    team_id UUID,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    dispatched_at TIMESTAMPTZ,
+    failed_at TIMESTAMPTZ, -- set when delivery was given up
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_outbox_due ON event_outbox(next_attempt_at)
+    WHERE dispatched_at IS NULL AND failed_at IS NULL;
+CREATE INDEX idx_event_outbox_dispatched ON event_outbox(dispatched_at)
+    WHERE dispatched_at IS NOT NULL;
@@ -0,0 +1,4 @@
+-- backend/migrations/20240101000016_add_webhooks.down.sql
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
@@ -0,0 +1,10 @@
+-- backend/migrations/20240101000017_add_platform_webhook_processing.down.sql
+
+DROP INDEX IF EXISTS idx_social_accounts_platform_user;
+DROP INDEX IF EXISTS idx_webhooks_log_platform;
+DROP INDEX IF EXISTS idx_webhooks_log_due;
+
+ALTER TABLE webhooks_log
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS platform;
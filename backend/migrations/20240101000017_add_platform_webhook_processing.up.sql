@@ -0,0 +1,16 @@
+-- backend/migrations/20240101000017_add_platform_webhook_processing.up.sql
+
+-- Inbound platform webhooks are logged as soon as their signature checks
+-- out and processed by the worker, which retries failed entries.
+ALTER TABLE webhooks_log
+    ADD COLUMN platform VARCHAR(50),
+    ADD COLUMN attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_webhooks_log_due ON webhooks_log(next_attempt_at)
+    WHERE processed = FALSE;
+CREATE INDEX idx_webhooks_log_platform ON webhooks_log(platform, received_at DESC);
+
+-- Webhooks name the platform's user ID of the connected account
+CREATE INDEX idx_social_accounts_platform_user ON social_accounts(platform, platform_user_id)
+    WHERE deleted_at IS NULL;
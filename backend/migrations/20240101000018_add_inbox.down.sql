@@ -0,0 +1,8 @@
+-- backend/migrations/20240101000018_add_inbox.down.sql
+
+UPDATE roles SET permissions = permissions - 'inbox.*' - 'inbox.view' - 'inbox.reply'
+WHERE name IN ('admin', 'editor', 'viewer') AND team_id IS NULL;
+
+DROP TABLE IF EXISTS inbox_sync_cursors;
+DROP TABLE IF EXISTS inbox_messages;
+DROP TABLE IF EXISTS inbox_conversations;
@@ -0,0 +1,62 @@
+-- backend/migrations/20240101000018_add_inbox.up.sql
+
+-- Conversations of the social inbox, one per thread of a connected
+-- account: the comments of one person on one post, one mention, or the
+-- direct messages with one person
+CREATE TABLE inbox_conversations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    social_account_id UUID NOT NULL REFERENCES social_accounts(id) ON DELETE CASCADE,
+    platform VARCHAR(50) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('comment', 'mention', 'message')),
+    thread_key VARCHAR(512) NOT NULL,
+    object_id VARCHAR(255) NOT NULL DEFAULT '',
+    participant_id VARCHAR(255) NOT NULL DEFAULT '',
+    participant_name VARCHAR(255) NOT NULL DEFAULT '',
+    state VARCHAR(20) NOT NULL DEFAULT 'unread'
+        CHECK (state IN ('unread', 'read', 'archived')),
+    assigned_to UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_message_at TIMESTAMPTZ NOT NULL,
+    last_message_preview TEXT NOT NULL DEFAULT '',
+    message_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (social_account_id, kind, thread_key)
+);
+
+CREATE INDEX idx_inbox_conversations_team ON inbox_conversations(team_id, last_message_at DESC);
+CREATE INDEX idx_inbox_conversations_assignee ON inbox_conversations(assigned_to, last_message_at DESC)
+    WHERE assigned_to IS NOT NULL;
+
+-- Messages of a conversation. Inbound messages are deduplicated on the
+-- platform's ID, as webhooks and sync may both report them.
+CREATE TABLE inbox_messages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    conversation_id UUID NOT NULL REFERENCES inbox_conversations(id) ON DELETE CASCADE,
+    platform_message_id VARCHAR(255) NOT NULL,
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('inbound', 'outbound')),
+    author_id VARCHAR(255) NOT NULL DEFAULT '',
+    author_name VARCHAR(255) NOT NULL DEFAULT '',
+    text TEXT NOT NULL DEFAULT '',
+    sent_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    sent_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (conversation_id, platform_message_id)
+);
+
+CREATE INDEX idx_inbox_messages_conversation ON inbox_messages(conversation_id, sent_at);
+
+-- How far each account was read back by fetch-based sync
+CREATE TABLE inbox_sync_cursors (
+    social_account_id UUID PRIMARY KEY REFERENCES social_accounts(id) ON DELETE CASCADE,
+    synced_until TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Owners hold every permission
+UPDATE roles SET permissions = permissions || '["inbox.*"]'
+WHERE name = 'admin' AND team_id IS NULL;
+UPDATE roles SET permissions = permissions || '["inbox.view", "inbox.reply"]'
+WHERE name = 'editor' AND team_id IS NULL;
+UPDATE roles SET permissions = permissions || '["inbox.view"]'
+WHERE name = 'viewer' AND team_id IS NULL;
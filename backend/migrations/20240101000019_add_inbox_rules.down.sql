@@ -0,0 +1,9 @@
+-- backend/migrations/20240101000019_add_inbox_rules.down.sql
+
+DROP TABLE IF EXISTS inbox_rule_runs;
+DROP TABLE IF EXISTS inbox_rules;
+DROP TABLE IF EXISTS inbox_saved_replies;
+
+DROP INDEX IF EXISTS idx_inbox_messages_rules_due;
+ALTER TABLE inbox_messages DROP COLUMN IF EXISTS rules_attempts, DROP COLUMN IF EXISTS rules_due_at;
+ALTER TABLE inbox_conversations DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,56 @@
+-- backend/migrations/20240101000019_add_inbox_rules.up.sql
+
+-- Tags set on conversations, by rules for now
+ALTER TABLE inbox_conversations ADD COLUMN tags JSONB NOT NULL DEFAULT '[]';
+
+-- Received messages wait here for the rule processor. rules_due_at is NULL
+-- once the rules ran, and for replies.
+ALTER TABLE inbox_messages
+    ADD COLUMN rules_due_at TIMESTAMPTZ,
+    ADD COLUMN rules_attempts INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_inbox_messages_rules_due ON inbox_messages(rules_due_at)
+    WHERE rules_due_at IS NOT NULL;
+
+-- Reply templates of a team, with {{first_name}} and {{account}} variables
+CREATE TABLE inbox_saved_replies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    body TEXT NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_inbox_saved_replies_team ON inbox_saved_replies(team_id, name);
+
+-- Keyword rules acting on received messages. Empty kinds or account_ids
+-- match everything.
+CREATE TABLE inbox_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    keywords JSONB NOT NULL DEFAULT '[]',
+    kinds JSONB NOT NULL DEFAULT '[]',
+    account_ids JSONB NOT NULL DEFAULT '[]',
+    actions JSONB NOT NULL DEFAULT '[]',
+    rate_limit INT NOT NULL DEFAULT 30, -- messages acted on per hour
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_inbox_rules_team ON inbox_rules(team_id, created_at);
+
+-- Messages a rule acted on: enforces the rate limit and keeps a retried
+-- message from being acted on twice
+CREATE TABLE inbox_rule_runs (
+    rule_id UUID NOT NULL REFERENCES inbox_rules(id) ON DELETE CASCADE,
+    message_id UUID NOT NULL REFERENCES inbox_messages(id) ON DELETE CASCADE,
+    ran_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, message_id)
+);
+
+CREATE INDEX idx_inbox_rule_runs_recent ON inbox_rule_runs(rule_id, ran_at);
@@ -0,0 +1,4 @@
+-- backend/migrations/20240101000020_add_notifications.down.sql
+
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS notifications;
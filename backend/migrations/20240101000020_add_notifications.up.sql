@@ -0,0 +1,35 @@
+-- backend/migrations/20240101000020_add_notifications.up.sql
+
+-- Notifications of a user. in_app ones are listed in the app; email_pending
+-- ones wait for the user's next digest. A user has one notification per
+-- dedupe_key, so events delivered twice notify once.
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID REFERENCES teams(id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    link TEXT NOT NULL DEFAULT '',
+    dedupe_key VARCHAR(255) NOT NULL,
+    in_app BOOLEAN NOT NULL,
+    email_pending BOOLEAN NOT NULL,
+    read_at TIMESTAMPTZ,
+    emailed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, dedupe_key)
+);
+
+CREATE INDEX idx_notifications_user ON notifications(user_id, created_at DESC) WHERE in_app;
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE in_app AND read_at IS NULL;
+CREATE INDEX idx_notifications_email_pending ON notifications(user_id, created_at) WHERE email_pending;
+
+-- Per-user channels of each kind ({"post_failed": {"inApp": true, "email": false}})
+-- and digest frequency. Users without a row get the defaults.
+CREATE TABLE notification_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    kinds JSONB NOT NULL DEFAULT '{}',
+    email_digest VARCHAR(20) NOT NULL DEFAULT 'hourly',
+    digest_sent_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
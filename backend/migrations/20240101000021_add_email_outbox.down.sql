@@ -0,0 +1,3 @@
+-- backend/migrations/20240101000021_add_email_outbox.down.sql
+
+DROP TABLE IF EXISTS email_outbox;
@@ -0,0 +1,25 @@
+-- backend/migrations/20240101000021_add_email_outbox.up.sql
+
+-- Rendered emails waiting for delivery. Rows are written in the same
+-- transaction as the change that caused them and sent by the worker,
+-- retrying with backoff.
+CREATE TABLE email_outbox (
+    id UUID PRIMARY KEY,
+    from_address VARCHAR(320) NOT NULL,
+    to_address VARCHAR(320) NOT NULL,
+    subject TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    template VARCHAR(50) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    sent_at TIMESTAMPTZ,
+    failed_at TIMESTAMPTZ, -- set when delivery was given up
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_email_outbox_due ON email_outbox(next_attempt_at)
+    WHERE sent_at IS NULL AND failed_at IS NULL;
+CREATE INDEX idx_email_outbox_created ON email_outbox(created_at);
@@ -0,0 +1,10 @@
+-- backend/migrations/20240101000022_add_sso_domain_verification.down.sql
+
+ALTER TABLE team_sso_configs ADD COLUMN domains TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE team_sso_configs c
+SET domains = ARRAY(SELECT d.domain FROM team_sso_domains d WHERE d.team_id = c.team_id ORDER BY d.domain);
+
+CREATE INDEX idx_team_sso_configs_domains ON team_sso_configs USING GIN (domains);
+
+DROP TABLE IF EXISTS team_sso_domains;
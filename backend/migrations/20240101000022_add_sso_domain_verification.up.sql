@@ -0,0 +1,25 @@
+-- backend/migrations/20240101000022_add_sso_domain_verification.up.sql
+
+-- Email domains claimed by a team's single sign-on. A domain routes logins,
+-- provisions accounts and enforces SSO only once the team proved it owns
+-- the domain with a DNS TXT record. Any team may claim a domain, but only
+-- one can hold it verified.
+CREATE TABLE team_sso_domains (
+    team_id UUID NOT NULL REFERENCES team_sso_configs(team_id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, domain)
+);
+
+CREATE UNIQUE INDEX idx_team_sso_domains_verified ON team_sso_domains (domain) WHERE verified_at IS NOT NULL;
+
+-- Domains claimed so far were never proven, so they start unverified
+INSERT INTO team_sso_domains (team_id, domain, verification_token)
+SELECT c.team_id, d.domain, md5(random()::text || clock_timestamp()::text || d.domain)
+FROM team_sso_configs c, unnest(c.domains) AS d(domain)
+ON CONFLICT DO NOTHING;
+
+DROP INDEX IF EXISTS idx_team_sso_configs_domains;
+ALTER TABLE team_sso_configs DROP COLUMN domains;
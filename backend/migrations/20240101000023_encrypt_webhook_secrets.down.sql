@@ -0,0 +1,7 @@
+-- backend/migrations/20240101000023_encrypt_webhook_secrets.down.sql
+
+-- Encrypted secrets cannot be decrypted here; endpoints that only have one
+-- are removed and must be recreated
+DELETE FROM webhook_endpoints WHERE secret IS NULL;
+ALTER TABLE webhook_endpoints ALTER COLUMN secret SET NOT NULL;
+ALTER TABLE webhook_endpoints DROP COLUMN IF EXISTS secret_encrypted;
@@ -0,0 +1,7 @@
+-- backend/migrations/20240101000023_encrypt_webhook_secrets.up.sql
+
+-- Webhook signing secrets are encrypted with the application key, like
+-- TOTP secrets. Plaintext secrets stay readable until the API encrypts
+-- them at startup and clears the old column.
+ALTER TABLE webhook_endpoints ADD COLUMN secret_encrypted TEXT;
+ALTER TABLE webhook_endpoints ALTER COLUMN secret DROP NOT NULL;